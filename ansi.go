@@ -0,0 +1,126 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ANSI escape handling modes of a websocket connection, which are selected by
+// the "ansi" query parameter of the websocket URL.
+const (
+	// ansiRaw passes the program output through unmodified.
+	ansiRaw = ""
+
+	// ansiStrip removes all escape sequences from the program output.
+	ansiStrip = "strip"
+
+	// ansiColor removes all escape sequences except for SGR sequences
+	// (e.g., "\x1b[31m"), which the client renders as colors and styles.
+	// No SGR sequence is ever split across multiple messages.
+	ansiColor = "color"
+)
+
+// maxEscapeSize is the maximum size of an escape sequence that is held back
+// because it is incomplete at the end of an output message.
+// Longer sequences are assumed to be garbage and are sent as literal text.
+const maxEscapeSize = 256
+
+// An ansiFilter filters the escape sequences from the program output sent
+// over a single websocket connection.
+type ansiFilter struct {
+	mode    string
+	pending map[string]string // Incomplete escape sequence at the end of each output stream
+}
+
+// newANSIFilter returns a filter for the given mode, which must be one of
+// ansiRaw, ansiStrip, or ansiColor.
+func newANSIFilter(mode string) (*ansiFilter, error) {
+	switch mode {
+	case ansiRaw, ansiStrip, ansiColor:
+		return &ansiFilter{mode: mode, pending: make(map[string]string)}, nil
+	default:
+		return nil, fmt.Errorf("unknown ANSI mode: %q", mode)
+	}
+}
+
+// Filter returns the data of the message to send to the client and
+// reports whether the message should be sent at all, which it should not be
+// if all of the output was removed or held back as an incomplete escape
+// sequence.
+// Only appendStdout and appendStderr messages are modified.
+// Any other message discards the incomplete escape sequences held back.
+func (f *ansiFilter) Filter(action, data string) (string, bool) {
+	if f.mode == ansiRaw {
+		return data, true
+	}
+	if action != appendStdout && action != appendStderr {
+		for k := range f.pending {
+			delete(f.pending, k)
+		}
+		return data, true
+	}
+
+	s := f.pending[action] + data
+	delete(f.pending, action)
+	var b strings.Builder
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '\x1b')
+		if i < 0 {
+			b.WriteString(s)
+			break
+		}
+		b.WriteString(s[:i])
+		s = s[i:]
+		n, ok := escapeLen(s)
+		if !ok {
+			if len(s) <= maxEscapeSize {
+				f.pending[action] = s
+			} else {
+				b.WriteString(s)
+			}
+			break
+		}
+		if f.mode == ansiColor && n > 2 && s[1] == '[' && s[n-1] == 'm' {
+			b.WriteString(s[:n])
+		}
+		s = s[n:]
+	}
+	return b.String(), b.Len() > 0 || data == ""
+}
+
+// escapeLen reports the length of the escape sequence at the start of s,
+// which must begin with an ESC character. It reports false if s ends before
+// the sequence is complete.
+func escapeLen(s string) (int, bool) {
+	if len(s) < 2 {
+		return 0, false
+	}
+	switch s[1] {
+	case '[': // CSI: parameter and intermediate bytes, then a final byte
+		for i := 2; i < len(s); i++ {
+			if c := s[i]; c < 0x20 || c > 0x3f {
+				if c >= 0x40 && c <= 0x7e {
+					return i + 1, true
+				}
+				return i, true // Malformed; drop the introducer and parameters
+			}
+		}
+		return 0, false
+	case ']', 'P', 'X', '^', '_': // OSC and other strings: terminated by BEL or ST
+		for i := 2; i < len(s); i++ {
+			switch {
+			case s[i] == '\a':
+				return i + 1, true
+			case s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\':
+				return i + 2, true
+			}
+		}
+		return 0, false
+	default: // Two-byte sequence
+		return 2, true
+	}
+}
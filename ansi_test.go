@@ -0,0 +1,109 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestANSIFilter(t *testing.T) {
+	type msg struct{ action, data string }
+	longEscape := "\x1b]0;" + strings.Repeat("x", maxEscapeSize)
+	tests := []struct {
+		mode string
+		in   []msg
+		want []string // Data of the messages that are sent
+	}{{
+		mode: ansiRaw,
+		in:   []msg{{appendStdout, "\x1b[31mred\x1b[0m\x1b[2K"}},
+		want: []string{"\x1b[31mred\x1b[0m\x1b[2K"},
+	}, {
+		mode: ansiStrip,
+		in:   []msg{{appendStdout, "\x1b[1;31mred\x1b[0m \x1b]0;title\a\x1bcdone"}},
+		want: []string{"red done"},
+	}, {
+		mode: ansiColor,
+		in:   []msg{{appendStdout, "\x1b[1;31mred\x1b[0m \x1b[2K\x1b]0;title\x1b\\done"}},
+		want: []string{"\x1b[1;31mred\x1b[0m done"},
+	}, {
+		mode: ansiColor,
+		in:   []msg{{appendStdout, "a\x1b[3"}, {appendStderr, "b\x1b"}, {appendStdout, "2mc"}, {appendStderr, "[0md"}},
+		want: []string{"a", "b", "\x1b[32mc", "\x1b[0md"},
+	}, {
+		// An escape sequence split across chunks is held back until complete,
+		// and a chunk holding only part of the sequence is not sent.
+		mode: ansiColor,
+		in:   []msg{{appendStdout, "\x1b["}, {appendStdout, "3"}, {appendStdout, "1mred"}},
+		want: []string{"\x1b[31mred"},
+	}, {
+		mode: ansiStrip,
+		in:   []msg{{appendStdout, "\x1b]0;ti"}, {appendStdout, "tle\adone"}},
+		want: []string{"done"},
+	}, {
+		mode: ansiStrip,
+		in:   []msg{{appendStdout, "\x1b[2K"}, {appendStdout, ""}},
+		want: []string{""},
+	}, {
+		// An over-long escape sequence is sent as literal text.
+		mode: ansiStrip,
+		in:   []msg{{appendStdout, "a" + longEscape[:10]}, {appendStdout, longEscape[10:]}, {appendStdout, "b"}},
+		want: []string{"a", longEscape, "b"},
+	}, {
+		mode: ansiStrip,
+		in:   []msg{{appendStdout, "a\x1b[3"}, {statusStopped, "{}"}, {appendStdout, "2mc"}},
+		want: []string{"a", "{}", "2mc"},
+	}, {
+		mode: ansiStrip,
+		in:   []msg{{statusUpdate, "\x1b[31m"}},
+		want: []string{"\x1b[31m"},
+	}}
+	for _, tt := range tests {
+		f, err := newANSIFilter(tt.mode)
+		if err != nil {
+			t.Fatalf("newANSIFilter(%q) error: %v", tt.mode, err)
+		}
+		var got []string
+		for _, m := range tt.in {
+			if s, ok := f.Filter(m.action, m.data); ok {
+				got = append(got, s)
+			}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Filter(%q, %q):\ngot  %q\nwant %q", tt.mode, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestANSIMode(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	// Unknown modes are rejected before the connection is upgraded.
+	resp, err := http.Get(srv.URL + "/websocket?ansi=colour")
+	if err != nil {
+		t.Fatalf("http.Get error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Response.StatusCode = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
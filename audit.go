@@ -0,0 +1,138 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Actions recorded in the audit log.
+const (
+	auditCreate = "create"
+	auditUpdate = "update"
+	auditDelete = "delete"
+	auditStar   = "star"
+	auditRun    = "run"
+)
+
+const defaultAuditLimit = 100
+
+// auditEntry is a record in the append-only audit log,
+// which tracks who modified snippets or executed code and when.
+type auditEntry struct {
+	ID         int64     `json:"id"`
+	Time       time.Time `json:"time"`
+	User       string    `json:"user,omitempty"`
+	RemoteAddr string    `json:"remote_addr"`
+	Action     string    `json:"action"`
+	SnippetID  int64     `json:"snippet_id,omitempty"`
+	SourceHash string    `json:"source_hash,omitempty"` // SHA-256 of the executed code
+}
+
+func (e *auditEntry) MarshalBinary() ([]byte, error) {
+	type ae auditEntry
+	bb := new(bytes.Buffer)
+	enc := gob.NewEncoder(bb)
+	err := enc.Encode((*ae)(e))
+	return bb.Bytes(), err
+}
+
+func (e *auditEntry) UnmarshalBinary(b []byte) error {
+	type ae auditEntry
+	br := bytes.NewReader(b)
+	dec := gob.NewDecoder(br)
+	return dec.Decode((*ae)(e))
+}
+
+// requestUser identifies who made the request. It is the name of the API
+// token, the email address of the OIDC user, or "password" for users logged
+// in with the password. It is empty if authentication is disabled.
+func (pg *playground) requestUser(r *http.Request) string {
+	if token := bearerToken(r); token != "" {
+		if t, err := pg.store(r).LookupToken(hashAPIToken(token)); err == nil {
+			return "token:" + t.Name
+		}
+		return ""
+	}
+	sid := pg.currentSession(r)
+	if sid == "" {
+		return ""
+	}
+	s, err := pg.store(r).LookupSession(sid)
+	if err != nil || s.User == "" {
+		return "password"
+	}
+	return s.User
+}
+
+// audit records an action performed by the request in the audit log.
+// Failures are logged, but otherwise do not fail the request.
+func (pg *playground) audit(r *http.Request, action string, snippetID int64, source string) {
+	e := auditEntry{
+		User:       pg.requestUser(r),
+		RemoteAddr: pg.remoteAddr(r),
+		Action:     action,
+		SnippetID:  snippetID,
+	}
+	if action == auditRun {
+		h := sha256.Sum256([]byte(source))
+		e.SourceHash = hex.EncodeToString(h[:])
+	}
+	if _, err := pg.store(r).AppendAudit(e); err != nil {
+		pg.logf(r, "audit log error: %v", err)
+	}
+}
+
+// serveAudit provides an endpoint to query the audit log.
+// The response is a JSON list of audit entries with the newest first.
+//
+// The endpoint supports several URL query parameters:
+//
+//   - limit: int - The maximum number of entries to return.
+//     Default value is 100.
+//   - before: int - Only return entries with an ID less than this value.
+//     It is used to page through the log using the smallest ID
+//     of the previous page.
+func (pg *playground) serveAudit(w http.ResponseWriter, r *http.Request) {
+	limit, before := defaultAuditLimit, int64(0)
+	for k, v := range r.URL.Query() {
+		var err error
+		switch k {
+		case "limit":
+			limit, err = strconv.Atoi(v[0])
+			if err == nil && limit <= 0 {
+				err = fmt.Errorf("invalid limit value: %v", v[0])
+			}
+		case "before":
+			before, err = strconv.ParseInt(v[0], 10, 64)
+		default:
+			err = fmt.Errorf("unknown query field: %v", k)
+		}
+		if err != nil {
+			httpError(w, r, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	es, err := pg.store(r).QueryAudit(before, limit)
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if es == nil {
+		es = []auditEntry{} // Marshal as an empty list rather than null
+	}
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(es)
+	w.Write(b)
+}
@@ -0,0 +1,204 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	backupDir    = "backups"
+	backupPrefix = "snippets-"
+	backupSuffix = ".backup"
+
+	// backupTimeFormat is a fixed-width format such that the lexicographical
+	// order of the backup file names matches their chronological order.
+	backupTimeFormat = "20060102T150405.000000000Z"
+
+	defaultBackupRetention = 7
+)
+
+// backupInfo describes a single backup file.
+type backupInfo struct {
+	Name string `json:"name"`
+	Size int64  `json:"size"`
+
+	// Targets are the backup targets that the backup was copied to.
+	Targets []string `json:"targets,omitempty"`
+}
+
+// Backup writes a snapshot of the snippet database into the backup directory
+// and removes the oldest backups that exceed the retention count.
+// The backup is then copied to each of the backup targets.
+func (pg *playground) Backup() (backupInfo, error) {
+	pg.backupMu.Lock()
+	defer pg.backupMu.Unlock()
+
+	if err := os.MkdirAll(pg.backupPath, 0775); err != nil {
+		return backupInfo{}, err
+	}
+	name := backupPrefix + time.Now().UTC().Format(backupTimeFormat) + backupSuffix
+	path := filepath.Join(pg.backupPath, name)
+	if err := pg.sdb.Backup(path); err != nil {
+		return backupInfo{}, err
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return backupInfo{}, err
+	}
+	if err := pruneBackups(dirTarget{pg.backupPath}, pg.backupKeep); err != nil {
+		return backupInfo{}, err
+	}
+	bi := backupInfo{Name: name, Size: fi.Size()}
+	if len(pg.backupTargets) == 0 {
+		return bi, nil
+	}
+	bi.Targets, err = pg.copyBackup(path, name)
+	return bi, err
+}
+
+// copyBackup copies the backup file at path to all backup targets,
+// encrypting it first if a passphrase is configured, and removes the oldest
+// backups at each target that exceed the retention count. Failures of one
+// target do not prevent copying to the others. It returns the targets that
+// the backup was copied to and the first error encountered.
+func (pg *playground) copyBackup(path, name string) ([]string, error) {
+	if pg.backupPassphrase != "" {
+		enc := path + backupEncSuffix
+		err := writeFileAtomic(enc, func(w io.Writer) error {
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			return encryptBackup(w, f, pg.backupPassphrase)
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(enc)
+		path, name = enc, name+backupEncSuffix
+	}
+
+	var done []string
+	var firstErr error
+	for _, t := range pg.backupTargets {
+		err := t.Put(name, path)
+		if err == nil {
+			err = pruneBackups(t, pg.backupKeep)
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("backup target %v: %v", t, err)
+			}
+			pg.log.Printf("backup target %v error: %v", t, err)
+			continue
+		}
+		done = append(done, t.String())
+	}
+	return done, firstErr
+}
+
+// StartBackups periodically backs up the snippet database until the
+// playground is closed. It does nothing if interval is not positive.
+func (pg *playground) StartBackups(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	pg.wg.Add(1)
+	go func() {
+		defer pg.wg.Done()
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-pg.ctx.Done():
+				return
+			case <-t.C:
+				bi, err := pg.Backup()
+				if err != nil {
+					pg.log.Printf("scheduled backup error: %v", err)
+					continue
+				}
+				pg.log.Printf("created scheduled backup %s (%d bytes)", bi.Name, bi.Size)
+			}
+		}
+	}()
+}
+
+// serveBackup provides an endpoint to create a backup on demand.
+// The response is a JSON dict with the "name" and "size" of the backup
+// and the "targets" that it was copied to.
+func (pg *playground) serveBackup(w http.ResponseWriter, r *http.Request) {
+	bi, err := pg.Backup()
+	if err != nil {
+		pg.logf(r, "backup error: %v", err)
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pg.logf(r, "created backup %s (%d bytes)", bi.Name, bi.Size)
+
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(bi)
+	w.Write(b)
+}
+
+// pruneBackups removes the oldest backups at t such that at most keep
+// backups remain. If keep is not positive, then all backups are kept.
+func pruneBackups(t backupTarget, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+	all, err := t.List()
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, name := range all {
+		if strings.HasPrefix(name, backupPrefix) && (strings.HasSuffix(name, backupSuffix) || strings.HasSuffix(name, backupSuffix+backupEncSuffix)) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for len(names) > keep {
+		if err := t.Remove(names[0]); err != nil {
+			return err
+		}
+		names = names[1:]
+	}
+	return nil
+}
+
+// writeFileAtomic creates a new file at path with the contents written by f.
+// The contents are written to a temporary file that is only renamed to path
+// if f succeeds, such that path never holds a partially written file.
+func writeFileAtomic(path string, f func(io.Writer) error) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op after a successful rename
+	if err := f(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,490 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// backupTarget is a destination that backup files are copied to.
+type backupTarget interface {
+	// Put copies the local file at path to the target as name.
+	Put(name, path string) error
+	// List returns the names of all files at the target.
+	List() ([]string, error)
+	// Remove deletes the file of the given name from the target.
+	Remove(name string) error
+	// String returns the URL of the target for logging.
+	String() string
+}
+
+// newBackupTarget returns the target described by conf.
+func newBackupTarget(conf backupTargetConfig) (backupTarget, error) {
+	if strings.HasPrefix(conf.URL, "/") {
+		return dirTarget{conf.URL}, nil
+	}
+	u, err := url.Parse(conf.URL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "file":
+		if u.Path == "" {
+			return nil, fmt.Errorf("missing path in backup target: %q", conf.URL)
+		}
+		return dirTarget{u.Path}, nil
+	case "s3":
+		if u.Host == "" {
+			return nil, fmt.Errorf("missing bucket in backup target: %q", conf.URL)
+		}
+		t := &s3Target{
+			endpoint:  strings.TrimSuffix(conf.Endpoint, "/"),
+			bucket:    u.Host,
+			prefix:    strings.Trim(u.Path, "/"),
+			region:    conf.Region,
+			accessKey: conf.AccessKeyID,
+			secretKey: conf.SecretAccessKey,
+			client:    &http.Client{Timeout: 30 * time.Minute},
+			timeNow:   time.Now,
+		}
+		if t.region == "" {
+			t.region = "us-east-1"
+		}
+		if t.endpoint == "" {
+			t.endpoint = "https://s3." + t.region + ".amazonaws.com"
+		}
+		if t.accessKey == "" && t.secretKey == "" {
+			t.accessKey, t.secretKey = os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+		}
+		if t.accessKey == "" || t.secretKey == "" {
+			return nil, fmt.Errorf("missing S3 credentials for backup target: %q", conf.URL)
+		}
+		return t, nil
+	case "sftp":
+		if u.Host == "" || u.User == nil {
+			return nil, fmt.Errorf("missing user or host in backup target: %q", conf.URL)
+		}
+		dir := strings.TrimPrefix(u.Path, "/")
+		if dir == "" {
+			dir = "."
+		}
+		return &sftpTarget{
+			bin:      "sftp",
+			host:     u.User.Username() + "@" + u.Hostname(),
+			port:     u.Port(),
+			dir:      dir,
+			identity: conf.IdentityFile,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown scheme in backup target: %q", conf.URL)
+	}
+}
+
+// dirTarget copies backups to a local directory, which is typically on
+// a different disk than DataPath.
+type dirTarget struct{ dir string }
+
+func (t dirTarget) Put(name, p string) error {
+	if err := os.MkdirAll(t.dir, 0775); err != nil {
+		return err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return writeFileAtomic(filepath.Join(t.dir, name), func(w io.Writer) error {
+		_, err := io.Copy(w, f)
+		return err
+	})
+}
+
+func (t dirTarget) List() ([]string, error) {
+	fis, err := ioutil.ReadDir(t.dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	var names []string
+	for _, fi := range fis {
+		if fi.Mode().IsRegular() {
+			names = append(names, fi.Name())
+		}
+	}
+	return names, nil
+}
+
+func (t dirTarget) Remove(name string) error { return os.Remove(filepath.Join(t.dir, name)) }
+func (t dirTarget) String() string           { return t.dir }
+
+// s3Target copies backups to a bucket of an S3-compatible object store
+// using path-style requests signed with AWS Signature Version 4.
+type s3Target struct {
+	endpoint  string
+	bucket    string
+	prefix    string // Without leading or trailing slashes
+	region    string
+	accessKey string
+	secretKey string
+
+	client  *http.Client
+	timeNow func() time.Time
+}
+
+func (t *s3Target) key(name string) string {
+	if t.prefix == "" {
+		return name
+	}
+	return t.prefix + "/" + name
+}
+
+func (t *s3Target) Put(name, p string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	resp, err := t.do("PUT", t.key(name), nil, ioutil.NopCloser(f), size, hex.EncodeToString(h.Sum(nil)))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (t *s3Target) List() ([]string, error) {
+	var names []string
+	q := url.Values{"list-type": {"2"}}
+	if t.prefix != "" {
+		q.Set("prefix", t.prefix+"/")
+	}
+	for {
+		resp, err := t.do("GET", "", q, nil, 0, "")
+		if err != nil {
+			return nil, err
+		}
+		var res struct {
+			Contents []struct {
+				Key string
+			}
+			IsTruncated           bool
+			NextContinuationToken string
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&res)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range res.Contents {
+			if name := path.Base(c.Key); t.key(name) == c.Key {
+				names = append(names, name)
+			}
+		}
+		if !res.IsTruncated || res.NextContinuationToken == "" {
+			return names, nil
+		}
+		q.Set("continuation-token", res.NextContinuationToken)
+	}
+}
+
+func (t *s3Target) Remove(name string) error {
+	resp, err := t.do("DELETE", t.key(name), nil, nil, 0, "")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (t *s3Target) String() string { return "s3://" + t.bucket + "/" + t.prefix }
+
+// do sends a signed request for the object of the given key, or the bucket
+// itself if the key is empty. The payload hash is that of an empty body
+// if not specified. Responses other than 2xx are returned as errors.
+func (t *s3Target) do(method, key string, query url.Values, body io.ReadCloser, size int64, payloadHash string) (*http.Response, error) {
+	if payloadHash == "" {
+		payloadHash = hex.EncodeToString(sha256.New().Sum(nil))
+	}
+	u, err := url.Parse(t.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = "/" + t.bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = awsURIEncode(u.Path, false)
+	var qs []string
+	for k, vs := range query {
+		for _, v := range vs {
+			qs = append(qs, awsURIEncode(k, true)+"="+awsURIEncode(v, true))
+		}
+	}
+	sort.Strings(qs)
+	u.RawQuery = strings.Join(qs, "&")
+
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+	now := t.timeNow().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	// Sign the request as described by the AWS Signature Version 4 process.
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonical := strings.Join([]string{
+		method,
+		u.RawPath,
+		u.RawQuery,
+		"host:" + u.Host + "\n" +
+			"x-amz-content-sha256:" + payloadHash + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := now.Format("20060102") + "/" + t.region + "/s3/aws4_request"
+	hash := sha256.Sum256([]byte(canonical))
+	toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+	signingKey := []byte("AWS4" + t.secretKey)
+	for _, s := range []string{now.Format("20060102"), t.region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, s)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%x",
+		t.accessKey, scope, signedHeaders, hmacSHA256(signingKey, toSign)))
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s %s: %s: %s", method, u.Path, resp.Status, bytes.TrimSpace(b))
+	}
+	return resp, nil
+}
+
+func hmacSHA256(key []byte, s string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(s))
+	return h.Sum(nil)
+}
+
+// awsURIEncode escapes s as required by AWS signatures, where all but the
+// unreserved characters are percent-encoded. Slashes are kept as is
+// unless encodeSlash is set.
+func awsURIEncode(s string, encodeSlash bool) string {
+	var sb strings.Builder
+	for _, c := range []byte(s) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/' && !encodeSlash:
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+// sftpTarget copies backups to a directory on an SFTP server using the sftp
+// command in batch mode, which authenticates with the SSH configuration and
+// keys of the user running the playground.
+type sftpTarget struct {
+	bin      string
+	host     string // user@host
+	port     string
+	dir      string
+	identity string
+}
+
+// run runs the sftp commands and returns the output.
+func (t *sftpTarget) run(cmds ...string) ([]byte, error) {
+	args := []string{"-b", "-", "-o", "BatchMode=yes"}
+	if t.port != "" {
+		args = append(args, "-P", t.port)
+	}
+	if t.identity != "" {
+		args = append(args, "-i", t.identity)
+	}
+	cmd := exec.Command(t.bin, append(args, t.host)...)
+	cmd.Stdin = strings.NewReader(strings.Join(cmds, "\n") + "\n")
+	bb := new(bytes.Buffer)
+	cmd.Stdout, cmd.Stderr = bb, bb
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%v: %s", err, bytes.TrimSpace(bb.Bytes()))
+	}
+	return bb.Bytes(), nil
+}
+
+// quote quotes an argument of an sftp command.
+func (t *sftpTarget) quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func (t *sftpTarget) Put(name, p string) error {
+	// Upload to a temporary name so that a partially uploaded file is never
+	// mistaken for a complete backup.
+	dst := path.Join(t.dir, name)
+	_, err := t.run("-mkdir "+t.quote(t.dir), "put "+t.quote(p)+" "+t.quote(dst+".tmp"), "rename "+t.quote(dst+".tmp")+" "+t.quote(dst))
+	return err
+}
+
+func (t *sftpTarget) List() ([]string, error) {
+	out, err := t.run("ls -1 " + t.quote(t.dir))
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "sftp>") {
+			names = append(names, path.Base(line))
+		}
+	}
+	return names, nil
+}
+
+func (t *sftpTarget) Remove(name string) error {
+	_, err := t.run("rm " + t.quote(path.Join(t.dir, name)))
+	return err
+}
+
+func (t *sftpTarget) String() string {
+	if t.port != "" {
+		return "sftp://" + t.host + ":" + t.port + "/" + t.dir
+	}
+	return "sftp://" + t.host + "/" + t.dir
+}
+
+// Backups copied to targets are encrypted if a passphrase is configured.
+// The format is backupMagic and a random salt for deriving the key from
+// the passphrase with argon2id, followed by chunks of the backup that are
+// each sealed with AES-GCM. The nonce of each chunk is its sequence number
+// with the first byte set for the last chunk, which may be empty, such
+// that reordered or truncated chunks fail to decrypt.
+const (
+	backupMagic     = "playground-backup-v1\n"
+	backupEncSuffix = ".enc"
+	backupChunkSize = 64 << 10
+)
+
+// backupCipher derives the cipher for encrypting backups from passphrase.
+func backupCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(passphrase), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func backupNonce(aead cipher.AEAD, seq uint64, last bool) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], seq)
+	if last {
+		nonce[0] = 1
+	}
+	return nonce
+}
+
+// encryptBackup writes the encryption of r with passphrase to w.
+func encryptBackup(w io.Writer, r io.Reader, passphrase string) error {
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	aead, err := backupCipher(passphrase, salt)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, backupMagic); err != nil {
+		return err
+	}
+	if _, err := w.Write(salt); err != nil {
+		return err
+	}
+	buf := make([]byte, backupChunkSize)
+	for seq := uint64(0); ; seq++ {
+		n, err := io.ReadFull(r, buf)
+		last := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			return err
+		}
+		if _, err := w.Write(aead.Seal(nil, backupNonce(aead, seq, last), buf[:n], nil)); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// decryptBackup writes the decryption of r with passphrase to w.
+func decryptBackup(w io.Writer, r io.Reader, passphrase string) error {
+	br := bufio.NewReader(r)
+	hdr := make([]byte, len(backupMagic)+argon2SaltLen)
+	if _, err := io.ReadFull(br, hdr); err != nil || string(hdr[:len(backupMagic)]) != backupMagic {
+		return errors.New("not an encrypted backup")
+	}
+	aead, err := backupCipher(passphrase, hdr[len(backupMagic):])
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, backupChunkSize+aead.Overhead())
+	for seq := uint64(0); ; seq++ {
+		n, err := io.ReadFull(br, buf)
+		last := err == io.ErrUnexpectedEOF
+		switch {
+		case err == io.EOF:
+			return errors.New("truncated backup")
+		case err != nil && !last:
+			return err
+		}
+		b, err := aead.Open(buf[:0], backupNonce(aead, seq, last), buf[:n], nil)
+		if err != nil {
+			return errors.New("unable to decrypt backup (wrong passphrase or corrupted file)")
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
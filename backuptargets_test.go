@@ -0,0 +1,218 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewBackupTarget(t *testing.T) {
+	tests := []struct {
+		conf backupTargetConfig
+		want string // String of the target, or the error if prefixed by "error: "
+	}{
+		{backupTargetConfig{URL: "/mnt/backups"}, "/mnt/backups"},
+		{backupTargetConfig{URL: "file:///mnt/backups"}, "/mnt/backups"},
+		{backupTargetConfig{URL: "file://"}, "error: missing path"},
+		{backupTargetConfig{URL: "s3://bucket/a/b/", AccessKeyID: "id", SecretAccessKey: "secret"}, "s3://bucket/a/b"},
+		{backupTargetConfig{URL: "s3:///prefix", AccessKeyID: "id", SecretAccessKey: "secret"}, "error: missing bucket"},
+		{backupTargetConfig{URL: "sftp://backup@nas:2222/playground"}, "sftp://backup@nas:2222/playground"},
+		{backupTargetConfig{URL: "sftp://nas/playground"}, "error: missing user or host"},
+		{backupTargetConfig{URL: "ftp://nas/playground"}, "error: unknown scheme"},
+		{backupTargetConfig{URL: "backups"}, "error: unknown scheme"},
+	}
+	for _, tt := range tests {
+		bt, err := newBackupTarget(tt.conf)
+		var got string
+		if err != nil {
+			got = "error: " + err.Error()
+		} else {
+			got = bt.String()
+		}
+		if !strings.HasPrefix(got, tt.want) {
+			t.Errorf("newBackupTarget(%q) = %q, want %q", tt.conf.URL, got, tt.want)
+		}
+	}
+}
+
+func TestEncryptBackup(t *testing.T) {
+	for _, n := range []int{0, 1, backupChunkSize - 1, backupChunkSize, 2*backupChunkSize + 1} {
+		in := bytes.Repeat([]byte("0123456789"), n/10+1)[:n]
+		enc := new(bytes.Buffer)
+		if err := encryptBackup(enc, bytes.NewReader(in), "secret"); err != nil {
+			t.Fatalf("encryptBackup error: %v", err)
+		}
+		if n > 0 && bytes.Contains(enc.Bytes(), in) {
+			t.Errorf("encryption of %d bytes contains the plaintext", n)
+		}
+		out := new(bytes.Buffer)
+		if err := decryptBackup(out, bytes.NewReader(enc.Bytes()), "secret"); err != nil {
+			t.Errorf("decryptBackup of %d bytes error: %v", n, err)
+		} else if !bytes.Equal(out.Bytes(), in) {
+			t.Errorf("decryptBackup of %d bytes mismatch", n)
+		}
+
+		// Decryption fails with the wrong passphrase or a truncated backup.
+		if err := decryptBackup(ioutil.Discard, bytes.NewReader(enc.Bytes()), "wrong"); err == nil {
+			t.Errorf("decryptBackup of %d bytes with wrong passphrase succeeded", n)
+		}
+		if n >= backupChunkSize {
+			cut := enc.Len() - (n%backupChunkSize + 16) // Drop the last chunk
+			if err := decryptBackup(ioutil.Discard, bytes.NewReader(enc.Bytes()[:cut]), "secret"); err == nil {
+				t.Errorf("decryptBackup of %d bytes without the last chunk succeeded", n)
+			}
+		}
+	}
+	if err := decryptBackup(ioutil.Discard, strings.NewReader("plain backup"), "secret"); err == nil {
+		t.Errorf("decryptBackup of unencrypted backup succeeded")
+	}
+}
+
+// fakeS3 is a minimal S3-compatible object store for a single bucket.
+type fakeS3 struct {
+	bucket  string
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if auth := r.Header.Get("Authorization"); !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=id/") || r.Header.Get("X-Amz-Date") == "" {
+		http.Error(w, "unsigned request", http.StatusForbidden)
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/"+s.bucket), "/")
+	switch r.Method {
+	case "PUT":
+		b, _ := ioutil.ReadAll(r.Body)
+		if h := sha256.Sum256(b); r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(h[:]) {
+			http.Error(w, "content hash mismatch", http.StatusBadRequest)
+			return
+		}
+		s.objects[key] = b
+	case "DELETE":
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case "GET":
+		// List a single key per page to exercise continuation.
+		var keys []string
+		for k := range s.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) && k > r.URL.Query().Get("continuation-token") {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		type content struct{ Key string }
+		var res struct {
+			XMLName               xml.Name `xml:"ListBucketResult"`
+			Contents              []content
+			IsTruncated           bool
+			NextContinuationToken string `xml:",omitempty"`
+		}
+		if len(keys) > 0 {
+			res.Contents = []content{{keys[0]}}
+			res.IsTruncated = len(keys) > 1
+			if res.IsTruncated {
+				res.NextContinuationToken = keys[0]
+			}
+		}
+		b, _ := xml.Marshal(res)
+		w.Write(b)
+	}
+}
+
+func TestBackupTargets(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+
+	s3 := &fakeS3{bucket: "bucket", objects: map[string][]byte{"other/file": nil}}
+	srv := httptest.NewServer(s3)
+	defer srv.Close()
+	st, err := newBackupTarget(backupTargetConfig{URL: "s3://bucket/playground", Endpoint: srv.URL, AccessKeyID: "id", SecretAccessKey: "secret"})
+	if err != nil {
+		t.Fatalf("newBackupTarget error: %v", err)
+	}
+	dt := dirTarget{filepath.Join(tmpDir, "remote")}
+	pg.backupTargets = []backupTarget{dt, st}
+	pg.backupPassphrase = "secret"
+	pg.backupKeep = 2
+
+	var bi backupInfo
+	for i := 0; i < 3; i++ {
+		if bi, err = pg.Backup(); err != nil {
+			t.Fatalf("Backup error: %v", err)
+		}
+		time.Sleep(time.Millisecond) // Ensure distinct backup names
+	}
+	if len(bi.Targets) != 2 {
+		t.Errorf("backup copied to %q, want both targets", bi.Targets)
+	}
+
+	// Each target has the newest encrypted backups, and no others.
+	latest := bi.Name + backupEncSuffix
+	for _, bt := range pg.backupTargets {
+		names, err := bt.List()
+		if err != nil {
+			t.Fatalf("%v: List error: %v", bt, err)
+		}
+		sort.Strings(names)
+		if len(names) != 2 || names[1] != latest {
+			t.Errorf("%v: backups = %q, want 2 ending with %q", bt, names, latest)
+		}
+	}
+	if _, ok := s3.objects["other/file"]; !ok {
+		t.Errorf("S3 object outside of the prefix was removed")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, backupDir, latest)); !os.IsNotExist(err) {
+		t.Errorf("encrypted copy left in the backup directory: %v", err)
+	}
+
+	plain, err := ioutil.ReadFile(filepath.Join(tmpDir, backupDir, bi.Name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	local, _ := ioutil.ReadFile(filepath.Join(dt.dir, latest))
+	for _, enc := range [][]byte{local, s3.objects["playground/"+latest]} {
+		out := new(bytes.Buffer)
+		if err := decryptBackup(out, bytes.NewReader(enc), "secret"); err != nil {
+			t.Errorf("decryptBackup error: %v", err)
+		} else if !bytes.Equal(out.Bytes(), plain) {
+			t.Errorf("decrypted backup does not match the local backup")
+		}
+	}
+
+	// A failing target does not prevent copying to the others.
+	srv.Close()
+	bi, err = pg.Backup()
+	if err == nil || !strings.Contains(err.Error(), "s3://bucket/playground") {
+		t.Errorf("Backup with failing target: got error %v, want S3 failure", err)
+	}
+	if len(bi.Targets) != 1 || bi.Targets[0] != dt.dir {
+		t.Errorf("backup copied to %q, want only %q", bi.Targets, dt.dir)
+	}
+}
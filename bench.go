@@ -0,0 +1,149 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultBenchLimit = 100
+
+// benchRun is the set of benchmark results of a single run of a snippet.
+type benchRun struct {
+	SnippetID int64         `json:"snippet_id"`
+	Time      time.Time     `json:"time"`
+	GoVersion string        `json:"go_version,omitempty"` // Name of the Go version; empty for the default
+	Results   []benchResult `json:"results"`
+}
+
+// benchResult is the result of a single benchmark as reported by go test.
+type benchResult struct {
+	Name        string  `json:"name"`
+	N           int64   `json:"n"` // Number of iterations
+	NsPerOp     float64 `json:"ns_per_op"`
+	MBPerSec    float64 `json:"mb_per_sec,omitempty"`
+	BytesPerOp  int64   `json:"bytes_per_op,omitempty"`
+	AllocsPerOp int64   `json:"allocs_per_op,omitempty"`
+}
+
+func (br *benchRun) MarshalBinary() ([]byte, error) {
+	type bt benchRun
+	bb := new(bytes.Buffer)
+	enc := gob.NewEncoder(bb)
+	err := enc.Encode((*bt)(br))
+	return bb.Bytes(), err
+}
+
+func (br *benchRun) UnmarshalBinary(b []byte) error {
+	type bt benchRun
+	r := bytes.NewReader(b)
+	dec := gob.NewDecoder(r)
+	return dec.Decode((*bt)(br))
+}
+
+// parseBenchmarks parses the benchmark lines in the output of go test,
+// which look like:
+//
+//	BenchmarkFoo-8   	 1000000	      1052 ns/op	  97.31 MB/s	     128 B/op	       2 allocs/op
+//
+// All other lines are ignored.
+func parseBenchmarks(b []byte) []benchResult {
+	var rs []benchResult
+	for _, line := range strings.Split(string(b), "\n") {
+		fs := strings.Fields(line)
+		if len(fs) < 4 || !strings.HasPrefix(fs[0], "Benchmark") || fs[3] != "ns/op" {
+			continue
+		}
+		n, err1 := strconv.ParseInt(fs[1], 10, 64)
+		ns, err2 := strconv.ParseFloat(fs[2], 64)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		r := benchResult{Name: fs[0], N: n, NsPerOp: ns}
+		for i := 4; i+1 < len(fs); i += 2 {
+			switch fs[i+1] {
+			case "MB/s":
+				r.MBPerSec, _ = strconv.ParseFloat(fs[i], 64)
+			case "B/op":
+				r.BytesPerOp, _ = strconv.ParseInt(fs[i], 10, 64)
+			case "allocs/op":
+				r.AllocsPerOp, _ = strconv.ParseInt(fs[i], 10, 64)
+			}
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+// recordBenchmarks stores the benchmark results of a run of the snippet
+// with the given ID. Failures are logged, but otherwise ignored.
+func (pg *playground) recordBenchmarks(snippetID int64, goVersion string, rs []benchResult) {
+	br := benchRun{SnippetID: snippetID, GoVersion: goVersion, Results: rs}
+	if err := pg.sdb.AppendBenchmarks(br); err != nil {
+		pg.log.Printf("benchmark history error: %v", err)
+	}
+}
+
+// serveBenchmarks provides an endpoint to query the benchmark history of
+// a snippet. The response is a JSON list of benchmark runs with the newest
+// first, each of which has the "time" of the run, the "go_version" used,
+// and the list of "results".
+//
+// The endpoint supports several URL query parameters:
+//
+//   - limit: int - The maximum number of runs to return.
+//     Default value is 100.
+func (pg *playground) serveBenchmarks(w http.ResponseWriter, r *http.Request) {
+	ss := strings.Split(r.URL.Path, "/")
+	id, err := strconv.ParseInt(ss[len(ss)-2], 10, 64)
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+	limit := defaultBenchLimit
+	for k, v := range r.URL.Query() {
+		switch k {
+		case "limit":
+			limit, err = strconv.Atoi(v[0])
+			if err == nil && limit <= 0 {
+				err = fmt.Errorf("invalid limit value: %v", v[0])
+			}
+		default:
+			err = fmt.Errorf("unknown query field: %v", k)
+		}
+		if err != nil {
+			httpError(w, r, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	db := pg.store(r)
+	if _, err := db.Retrieve(id); err != nil {
+		status := http.StatusInternalServerError
+		if err == errNotFound {
+			status = http.StatusNotFound
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+	brs, err := db.QueryBenchmarks(id, limit)
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if brs == nil {
+		brs = []benchRun{} // Marshal as an empty list rather than null
+	}
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(brs)
+	w.Write(b)
+}
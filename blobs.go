@@ -0,0 +1,346 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"container/list"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	blobDir       = "blobs"
+	blobIndexFile = "index.json"
+
+	defaultBlobTTL = 24 * time.Hour
+
+	// maxBlobSweepInterval is the longest period between checks for
+	// expired blobs.
+	maxBlobSweepInterval = 10 * time.Minute
+)
+
+type blob struct {
+	data []byte
+	mime string // Sniffed from data when served if empty
+}
+
+// scriptlessMIME returns mime, unless browsers could run scripts in content
+// of that type (e.g., HTML and SVG), in which case it returns the type of
+// plain text. Blobs with content from programs are served from the origin
+// of the playground and must thus not be able to run scripts.
+func scriptlessMIME(mime string) string {
+	switch strings.TrimSpace(strings.SplitN(mime, ";", 2)[0]) {
+	case "text/html", "text/xml", "image/svg+xml", "application/javascript":
+		return "text/plain; charset=utf-8"
+	}
+	return mime
+}
+
+// blobEntry is the metadata of a blob in the index of a blobStore.
+type blobEntry struct {
+	Mime    string    `json:"mime"`
+	Size    int64     `json:"size"`
+	Used    time.Time `json:"used"`    // Last time the blob was inserted or retrieved
+	Expires time.Time `json:"expires"` // Zero if the blob was stored without a TTL
+
+	id      string
+	data    []byte          // Only set if the blobStore is not disk-backed
+	onEvict func(id string) // May be nil
+}
+
+// blobStore is a synchronized map of MD5 hashes to binary blobs.
+//
+// If dir is non-empty, then the blob data is stored as files in dir and only
+// the index is kept in memory. The index is persisted in dir as
+// blobIndexFile so that the blobs survive restarts.
+//
+// If maxSize is positive, then the least recently used blobs are evicted
+// whenever the total size of all blobs exceeds it.
+//
+// If ttl is positive, then blobs expire after that duration and are removed
+// by Expire. This reclaims blobs whose executor never deleted them.
+type blobStore struct {
+	dir     string
+	maxSize int64
+	ttl     time.Duration
+
+	mu   sync.Mutex
+	ll   *list.List // List of *blobEntry; most recently used at the front
+	m    map[string]*list.Element
+	size int64
+}
+
+// newBlobStore returns a blobStore that keeps all blobs in memory.
+func newBlobStore() *blobStore {
+	return &blobStore{ll: list.New(), m: make(map[string]*list.Element)}
+}
+
+// openBlobStore opens a disk-backed blobStore in dir, creating it if needed.
+// Blobs that are missing from either the index or dir are discarded.
+func openBlobStore(dir string) (*blobStore, error) {
+	if err := os.MkdirAll(dir, 0775); err != nil {
+		return nil, err
+	}
+	idx := make(map[string]*blobEntry)
+	b, err := ioutil.ReadFile(filepath.Join(dir, blobIndexFile))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(b, &idx); err != nil {
+			return nil, fmt.Errorf("invalid blob index: %v", err)
+		}
+	}
+
+	// Reconcile the index with the files on disk in case the server
+	// stopped between writing a blob and updating the index.
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var es []*blobEntry
+	for _, fi := range fis {
+		id := fi.Name()
+		if id == blobIndexFile {
+			continue
+		}
+		e, ok := idx[id]
+		if !ok || fi.IsDir() {
+			os.RemoveAll(filepath.Join(dir, id))
+			continue
+		}
+		e.id = id
+		es = append(es, e)
+	}
+	sort.Slice(es, func(i, j int) bool { return es[i].Used.After(es[j].Used) })
+
+	bs := newBlobStore()
+	bs.dir = dir
+	for _, e := range es {
+		bs.m[e.id] = bs.ll.PushBack(e)
+		bs.size += e.Size
+	}
+	if err := bs.writeIndex(); err != nil {
+		return nil, err
+	}
+	return bs, nil
+}
+
+// Insert adds the blob and returns its ID.
+// If the blob is later evicted to stay within maxSize, then onEvict
+// (if non-nil) is called with the ID of the blob.
+func (bs *blobStore) Insert(b blob, onEvict func(id string)) (id string, err error) {
+	if bs.maxSize > 0 && int64(len(b.data)) > bs.maxSize {
+		return "", errors.New("exceeds maximum blob store size")
+	}
+	h := md5.Sum(b.data) // Assume MIME doesn't change for given data
+	id = hex.EncodeToString(h[:])
+	now := time.Now().UTC()
+	e := &blobEntry{Mime: b.mime, Size: int64(len(b.data)), Used: now, id: id, onEvict: onEvict}
+	if bs.ttl > 0 {
+		e.Expires = now.Add(bs.ttl)
+	}
+	if bs.dir == "" {
+		e.data = b.data
+	} else if err := writeFileAtomic(filepath.Join(bs.dir, id), func(w io.Writer) error {
+		_, err := w.Write(b.data)
+		return err
+	}); err != nil {
+		return "", err
+	}
+
+	bs.mu.Lock()
+	if el, ok := bs.m[id]; ok {
+		el.Value = e // Same data, so the size is unchanged
+		bs.ll.MoveToFront(el)
+	} else {
+		bs.m[id] = bs.ll.PushFront(e)
+		bs.size += e.Size
+	}
+	evicted := bs.evict()
+	err = bs.writeIndex()
+	bs.mu.Unlock()
+
+	notifyEvicted(evicted)
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Retrieve returns the blob for id.
+// The returned blob has no data if it does not exist.
+func (bs *blobStore) Retrieve(id string) blob {
+	bs.mu.Lock()
+	el, ok := bs.m[id]
+	if !ok {
+		bs.mu.Unlock()
+		return blob{}
+	}
+	bs.ll.MoveToFront(el)
+	e := el.Value.(*blobEntry)
+	e.Used = time.Now().UTC() // Persisted upon the next update to the index
+	b := blob{data: e.data, mime: e.Mime}
+	bs.mu.Unlock()
+
+	if bs.dir == "" {
+		return b
+	}
+	data, err := ioutil.ReadFile(filepath.Join(bs.dir, id))
+	if err != nil {
+		return blob{}
+	}
+	b.data = data
+	return b
+}
+
+func (bs *blobStore) Delete(id string) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	if el, ok := bs.m[id]; ok {
+		bs.remove(el)
+		bs.writeIndex()
+	}
+}
+
+func (bs *blobStore) Len() int {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	return len(bs.m)
+}
+
+// Size reports the total size of all blobs in bytes.
+func (bs *blobStore) Size() int64 {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	return bs.size
+}
+
+// evict removes the least recently used blobs until the total size is within
+// maxSize and returns the removed entries. The caller must hold bs.mu.
+func (bs *blobStore) evict() (evicted []*blobEntry) {
+	for bs.maxSize > 0 && bs.size > bs.maxSize && bs.ll.Len() > 0 {
+		el := bs.ll.Back()
+		bs.remove(el)
+		evicted = append(evicted, el.Value.(*blobEntry))
+	}
+	return evicted
+}
+
+// Expire removes all blobs that expired before now and returns the number
+// of blobs removed. Blobs stored without a TTL expire relative to when they
+// were last used.
+func (bs *blobStore) Expire(now time.Time) (int, error) {
+	if bs.ttl <= 0 {
+		return 0, nil
+	}
+	bs.mu.Lock()
+	var expired []*blobEntry
+	for el := bs.ll.Front(); el != nil; {
+		next := el.Next()
+		e := el.Value.(*blobEntry)
+		expires := e.Expires
+		if expires.IsZero() {
+			expires = e.Used.Add(bs.ttl)
+		}
+		if now.After(expires) {
+			bs.remove(el)
+			expired = append(expired, e)
+		}
+		el = next
+	}
+	var err error
+	if len(expired) > 0 {
+		err = bs.writeIndex()
+	}
+	bs.mu.Unlock()
+
+	notifyEvicted(expired)
+	return len(expired), err
+}
+
+// notifyEvicted informs the owners of the blobs that they were removed.
+// This must be called without holding bs.mu since owners may call Delete.
+func notifyEvicted(es []*blobEntry) {
+	for _, e := range es {
+		if e.onEvict != nil {
+			e.onEvict(e.id)
+		}
+	}
+}
+
+// remove removes the blob in el. The caller must hold bs.mu.
+func (bs *blobStore) remove(el *list.Element) {
+	e := el.Value.(*blobEntry)
+	bs.ll.Remove(el)
+	delete(bs.m, e.id)
+	bs.size -= e.Size
+	if bs.dir != "" {
+		os.Remove(filepath.Join(bs.dir, e.id))
+	}
+}
+
+// StartBlobExpiry starts a background goroutine that periodically removes
+// expired blobs until the playground is closed.
+func (pg *playground) StartBlobExpiry() {
+	if pg.bs.ttl <= 0 {
+		return
+	}
+	interval := pg.bs.ttl
+	if interval > maxBlobSweepInterval {
+		interval = maxBlobSweepInterval
+	}
+	pg.wg.Add(1)
+	go func() {
+		defer pg.wg.Done()
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-pg.ctx.Done():
+				return
+			case now := <-t.C:
+				n, err := pg.bs.Expire(now)
+				if err != nil {
+					pg.log.Printf("blob expiry error: %v", err)
+				}
+				if n > 0 {
+					pg.log.Printf("removed %d expired blobs", n)
+				}
+			}
+		}
+	}()
+}
+
+// writeIndex persists the index of a disk-backed blobStore.
+// The caller must hold bs.mu.
+func (bs *blobStore) writeIndex() error {
+	if bs.dir == "" {
+		return nil
+	}
+	idx := make(map[string]*blobEntry, len(bs.m))
+	for id, el := range bs.m {
+		idx[id] = el.Value.(*blobEntry)
+	}
+	b, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(bs.dir, blobIndexFile), func(w io.Writer) error {
+		_, err := w.Write(b)
+		return err
+	})
+}
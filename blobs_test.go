@@ -0,0 +1,282 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBlobStore(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "blobs")
+	if err != nil {
+		t.Fatalf("TempDir error: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	dir := filepath.Join(tmpDir, blobDir)
+
+	mem := newBlobStore()
+	disk, err := openBlobStore(dir)
+	if err != nil {
+		t.Fatalf("openBlobStore error: %v", err)
+	}
+	for _, bs := range []*blobStore{mem, disk} {
+		id1, err := bs.Insert(blob{data: []byte("hello"), mime: "text/plain"}, nil)
+		if err != nil {
+			t.Fatalf("Insert error: %v", err)
+		}
+		id2, err := bs.Insert(blob{data: []byte("goodbye"), mime: "text/html"}, nil)
+		if err != nil {
+			t.Fatalf("Insert error: %v", err)
+		}
+		if b := bs.Retrieve(id1); string(b.data) != "hello" || b.mime != "text/plain" {
+			t.Errorf("Retrieve(%s) = (%q, %q), want (%q, %q)", id1, b.data, b.mime, "hello", "text/plain")
+		}
+		if b := bs.Retrieve("missing"); b.data != nil {
+			t.Errorf("unexpected Retrieve success for missing blob")
+		}
+		if n, size := bs.Len(), bs.Size(); n != 2 || size != 12 {
+			t.Errorf("Len, Size = %d, %d, want 2, 12", n, size)
+		}
+		bs.Delete(id2)
+		if b := bs.Retrieve(id2); b.data != nil {
+			t.Errorf("unexpected Retrieve success for deleted blob")
+		}
+	}
+
+	// Blobs survive reopening the store, while stray files and index
+	// entries for missing files are discarded.
+	if err := ioutil.WriteFile(filepath.Join(dir, "stray"), []byte("stray"), 0664); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+	id, _ := disk.Insert(blob{data: []byte("lost"), mime: "text/plain"}, nil)
+	os.Remove(filepath.Join(dir, id))
+	disk, err = openBlobStore(dir)
+	if err != nil {
+		t.Fatalf("openBlobStore error: %v", err)
+	}
+	if n := disk.Len(); n != 1 {
+		t.Errorf("Len = %d, want 1", n)
+	}
+	for _, el := range disk.m {
+		if b := el.Value.(*blobEntry); b.Mime != "text/plain" || b.Size != 5 {
+			t.Errorf("unexpected blob entry: %+v", b)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "stray")); !os.IsNotExist(err) {
+		t.Errorf("stray file not removed: %v", err)
+	}
+}
+
+func TestBlobStoreEviction(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "blobs")
+	if err != nil {
+		t.Fatalf("TempDir error: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	disk, err := openBlobStore(filepath.Join(tmpDir, blobDir))
+	if err != nil {
+		t.Fatalf("openBlobStore error: %v", err)
+	}
+	for _, bs := range []*blobStore{newBlobStore(), disk} {
+		bs.maxSize = 10
+		var evicted []string
+		onEvict := func(id string) { evicted = append(evicted, id) }
+
+		id1, _ := bs.Insert(blob{data: []byte("aaaa"), mime: "text/plain"}, onEvict)
+		id2, _ := bs.Insert(blob{data: []byte("bbbb"), mime: "text/plain"}, onEvict)
+		bs.Retrieve(id1) // Makes id2 the least recently used
+		id3, _ := bs.Insert(blob{data: []byte("cccc"), mime: "text/plain"}, onEvict)
+		if len(evicted) != 1 || evicted[0] != id2 {
+			t.Errorf("evicted = %v, want [%s]", evicted, id2)
+		}
+		for _, id := range []string{id1, id3} {
+			if b := bs.Retrieve(id); b.data == nil {
+				t.Errorf("unexpected Retrieve(%s) failure", id)
+			}
+		}
+		if b := bs.Retrieve(id2); b.data != nil {
+			t.Errorf("unexpected Retrieve(%s) success for evicted blob", id2)
+		}
+		if n := bs.Size(); n != 8 {
+			t.Errorf("Size = %d, want 8", n)
+		}
+		if _, err := bs.Insert(blob{data: []byte("too large blob"), mime: "text/plain"}, onEvict); err == nil {
+			t.Errorf("unexpected Insert success for blob larger than maxSize")
+		}
+	}
+}
+
+func TestBlobStoreExpiry(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "blobs")
+	if err != nil {
+		t.Fatalf("TempDir error: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	dir := filepath.Join(tmpDir, blobDir)
+
+	bs, err := openBlobStore(dir)
+	if err != nil {
+		t.Fatalf("openBlobStore error: %v", err)
+	}
+	var evicted []string
+	onEvict := func(id string) { evicted = append(evicted, id) }
+	id1, _ := bs.Insert(blob{data: []byte("no ttl"), mime: "text/plain"}, onEvict)
+	bs.ttl = time.Hour
+	id2, _ := bs.Insert(blob{data: []byte("with ttl"), mime: "text/plain"}, onEvict)
+
+	if n, err := bs.Expire(time.Now().Add(time.Minute)); n != 0 || err != nil {
+		t.Errorf("Expire = (%d, %v), want (0, nil)", n, err)
+	}
+	if n, err := bs.Expire(time.Now().Add(2 * time.Hour)); n != 2 || err != nil {
+		t.Errorf("Expire = (%d, %v), want (2, nil)", n, err)
+	}
+	if len(evicted) != 2 {
+		t.Errorf("evicted = %v, want [%s %s]", evicted, id1, id2)
+	}
+	if n := bs.Len(); n != 0 {
+		t.Errorf("Len = %d, want 0", n)
+	}
+
+	// Expired blobs are also removed from disk.
+	bs, err = openBlobStore(dir)
+	if err != nil {
+		t.Fatalf("openBlobStore error: %v", err)
+	}
+	if n := bs.Len(); n != 0 {
+		t.Errorf("Len after reopen = %d, want 0", n)
+	}
+}
+
+func TestServeDynamic(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	// Blobs without a MIME type are served with the sniffed type,
+	// except for types that browsers could run scripts in.
+	tests := []struct {
+		b        blob
+		wantMIME string
+	}{
+		{blob{data: []byte("\x89PNG\r\n\x1a\n"), mime: "image/png"}, "image/png"},
+		{blob{data: []byte("\x89PNG\r\n\x1a\n")}, "image/png"},
+		{blob{data: []byte("%PDF-1.4\n")}, "application/pdf"},
+		{blob{data: []byte("a,b\n")}, "text/plain; charset=utf-8"},
+		{blob{data: []byte("<html><script>")}, "text/plain; charset=utf-8"},
+		{blob{data: []byte{0, 1, 2}}, "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		id, err := pg.bs.Insert(tt.b, nil)
+		if err != nil {
+			t.Fatalf("Insert error: %v", err)
+		}
+		resp, err := http.Get(srv.URL + "/dynamic/" + id)
+		if err != nil {
+			t.Fatalf("http.Get error: %v", err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || string(body) != string(tt.b.data) {
+			t.Errorf("GET blob %q: status %d, body %q", tt.b.data, resp.StatusCode, body)
+		}
+		if got := resp.Header.Get("Content-Type"); got != tt.wantMIME {
+			t.Errorf("GET blob %q: Content-Type = %q, want %q", tt.b.data, got, tt.wantMIME)
+		}
+		if got := resp.Header.Get("X-Content-Type-Options"); got != "nosniff" {
+			t.Errorf("GET blob %q: X-Content-Type-Options = %q, want nosniff", tt.b.data, got)
+		}
+	}
+
+	resp, err := http.Get(srv.URL + "/dynamic/missing")
+	if err != nil {
+		t.Fatalf("http.Get error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("GET missing blob: status %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestServeBlobs(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	list := func() []map[string]interface{} {
+		t.Helper()
+		resp, err := http.Get(srv.URL + "/dynamic")
+		if err != nil {
+			t.Fatalf("http.Get error: %v", err)
+		}
+		defer resp.Body.Close()
+		var v []map[string]interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&v); err != nil || resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET /dynamic: status %d, error %v", resp.StatusCode, err)
+		}
+		return v
+	}
+	if got := list(); len(got) != 0 {
+		t.Errorf("initial blobs = %v, want none", got)
+	}
+
+	// Only the blobs of the sessions of the requester are listed, which is
+	// the anonymous user without authentication.
+	mine := pg.newSession(7, "")
+	other := pg.newSession(8, "alice@example.com")
+	mine.ex.reportBlob("chart.png", "image/png", []byte("\x89PNG\r\n\x1a\n"))
+	mine.ex.reportBlob("data.json", "application/json", []byte("{}"))
+	other.ex.reportBlob("secret.txt", "text/plain; charset=utf-8", []byte("secret"))
+	got := list()
+	if len(got) != 2 {
+		t.Fatalf("blobs = %v, want 2", got)
+	}
+	for i, want := range []map[string]interface{}{
+		{"name": "chart.png", "mime": "image/png", "size": 8.0, "client": 7.0},
+		{"name": "data.json", "mime": "application/json", "size": 2.0, "client": 7.0},
+	} {
+		want["id"] = got[i]["id"]
+		if !reflect.DeepEqual(got[i], want) {
+			t.Errorf("blob %d = %v, want %v", i, got[i], want)
+		}
+	}
+	if b := pg.bs.Retrieve(got[0]["id"].(string)); string(b.data) != "\x89PNG\r\n\x1a\n" {
+		t.Errorf("listed blob has data %q", b.data)
+	}
+
+	// Deleted blobs are no longer listed.
+	mine.ex.deleteBlobs()
+	if got := list(); len(got) != 0 {
+		t.Errorf("blobs after deletion = %v, want none", got)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// Paths of the branding files relative to the static directory.
+const (
+	brandingCSSPath  = "branding/custom.css"
+	brandingLogoPath = "branding/logo" // Suffixed with the file extension
+)
+
+// branding is the custom appearance of the playground.
+type branding struct {
+	title string
+
+	// files are the custom CSS and logo files keyed by their static path.
+	files    map[string]*staticFile
+	cssPath  string // Empty if there is no custom CSS
+	logoPath string // Empty if there is no logo
+}
+
+// loadBranding reads the files referenced by the branding configuration.
+func loadBranding(conf *brandingConfig) (*branding, error) {
+	br := &branding{title: conf.Title, files: make(map[string]*staticFile)}
+	if conf.CSSFile != "" {
+		b, err := ioutil.ReadFile(conf.CSSFile)
+		if err != nil {
+			return nil, err
+		}
+		br.cssPath = brandingCSSPath
+		br.files[br.cssPath] = newStaticFile(b)
+	}
+	if conf.LogoFile != "" {
+		ext := strings.ToLower(filepath.Ext(conf.LogoFile))
+		switch ext {
+		case ".png", ".jpg", ".svg", ".ico":
+		default:
+			return nil, fmt.Errorf("unsupported logo file type: %q", ext)
+		}
+		b, err := ioutil.ReadFile(conf.LogoFile)
+		if err != nil {
+			return nil, err
+		}
+		br.logoPath = brandingLogoPath + ext
+		br.files[br.logoPath] = newStaticFile(b)
+	}
+	return br, nil
+}
+
+// rewriteHTML adjusts an HTML page for the base path and the branding.
+// It returns the page unmodified if neither is configured.
+func (pg *playground) rewriteHTML(b []byte) []byte {
+	replace := func(old, new string) {
+		b = bytes.Replace(b, []byte(old), []byte(new), 1)
+	}
+	if pg.basePath != "" {
+		// The HTML pages reference all resources relative to the base URL.
+		replace(`<base href="/">`, `<base href="`+html.EscapeString(pg.basePath)+`/">`)
+	}
+	if br := pg.branding; br != nil {
+		if br.title != "" {
+			title := html.EscapeString(br.title)
+			replace(`<title>Go Playground</title>`, `<title>`+title+`</title>`)
+			replace(`<h1 id="title">Playground</h1>`, `<h1 id="title">`+title+`</h1>`)
+		}
+		if br.logoPath != "" {
+			replace(`<h1 id="title">`, `<h1 id="title"><img id="logo" src="static/`+br.logoPath+`" alt="">`)
+		}
+		if br.cssPath != "" {
+			// Load after the built-in stylesheets so that it takes precedence.
+			replace("\t</head>", "\t\t<link rel=\"stylesheet\" href=\"static/"+br.cssPath+"\">\n\t</head>")
+		}
+	}
+	return b
+}
@@ -0,0 +1,101 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// With a CPU limit, each program on the host runs in a new cgroup (version 2)
+// within the configured directory, which must be delegated to the user of
+// the playground (i.e., writable) and must not contain processes itself.
+// The cpu.max file of the cgroup limits the CPU time of its processes per
+// period. Since os/exec cannot start a process in a cgroup, sandbox-exec
+// creates and joins the cgroup before executing the program, and the cgroup
+// is removed along with any remaining processes once the program exits.
+
+// cgroupPrefix is the prefix of the names of the cgroups of programs.
+const cgroupPrefix = "playground-"
+
+// cpuPeriod is the period of the CPU limit in microseconds,
+// which is the default of the kernel.
+const cpuPeriod = 100000
+
+// newCgroupPath returns the path of a new cgroup within dir.
+func newCgroupPath(dir string) string {
+	var b [8]byte
+	rand.Read(b[:])
+	return filepath.Join(dir, cgroupPrefix+hex.EncodeToString(b[:]))
+}
+
+// joinCgroup creates the cgroup at path, limits it to cpus CPUs,
+// and moves the calling process into it.
+func joinCgroup(path string, cpus float64) error {
+	// The cpu controller must be enabled for the children of the parent,
+	// which is a no-op if it already is.
+	parent := filepath.Dir(path)
+	if err := ioutil.WriteFile(filepath.Join(parent, "cgroup.subtree_control"), []byte("+cpu"), 0); err != nil {
+		return err
+	}
+	if err := os.Mkdir(path, 0755); err != nil {
+		return err
+	}
+	quota := int64(cpus * cpuPeriod)
+	if err := ioutil.WriteFile(filepath.Join(path, "cpu.max"), []byte(fmt.Sprintf("%d %d", quota, cpuPeriod)), 0); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(path, "cgroup.procs"), []byte(strconv.Itoa(os.Getpid())), 0)
+}
+
+// removeCgroup removes the cgroup that cmd ran in, if any, after killing
+// the processes that remain in it (e.g., children of the program).
+func removeCgroup(cmd *exec.Cmd) {
+	if len(cmd.Args) < 3 || cmd.Args[1] != "sandbox-exec" || !strings.HasPrefix(cmd.Args[2], "--cgroup=") {
+		return
+	}
+	path := strings.TrimPrefix(cmd.Args[2], "--cgroup=")
+	ioutil.WriteFile(filepath.Join(path, "cgroup.kill"), []byte("1"), 0) // Since Linux 5.14
+	// Killed processes leave the cgroup asynchronously.
+	for i := 0; i < 10; i++ {
+		if err := os.Remove(path); err == nil || os.IsNotExist(err) {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// checkCgroupDir reports whether cgroups limited in CPU usage can be
+// created within dir.
+func checkCgroupDir(dir string) error {
+	if dir == "" {
+		return errors.New("CgroupDir is not set")
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "cgroup.controllers"))
+	if err != nil {
+		return err
+	}
+	var ok bool
+	for _, c := range strings.Fields(string(b)) {
+		ok = ok || c == "cpu"
+	}
+	if !ok {
+		return errors.New("cpu controller not available")
+	}
+	path := newCgroupPath(dir)
+	if err := os.Mkdir(path, 0755); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
@@ -0,0 +1,48 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCgroup(t *testing.T) {
+	// A regular directory stands in for the cgroup filesystem.
+	dir := t.TempDir()
+	if err := checkCgroupDir(dir); err == nil {
+		t.Errorf("checkCgroupDir of a non-cgroup succeeded")
+	}
+	ioutil.WriteFile(filepath.Join(dir, "cgroup.controllers"), []byte("cpuset io memory pids\n"), 0644)
+	if err := checkCgroupDir(dir); err == nil || !strings.Contains(err.Error(), "cpu controller") {
+		t.Errorf("checkCgroupDir without the cpu controller error = %v, want cpu controller error", err)
+	}
+	ioutil.WriteFile(filepath.Join(dir, "cgroup.controllers"), []byte("cpuset cpu io memory pids\n"), 0644)
+	if err := checkCgroupDir(dir); err != nil {
+		t.Errorf("checkCgroupDir error: %v", err)
+	}
+
+	path := newCgroupPath(dir)
+	if !strings.HasPrefix(filepath.Base(path), cgroupPrefix) || filepath.Dir(path) != dir {
+		t.Errorf("newCgroupPath = %q, want a path within %q", path, dir)
+	}
+	if err := joinCgroup(path, 0.5); err != nil {
+		t.Fatalf("joinCgroup error: %v", err)
+	}
+	for name, want := range map[string]string{
+		"../cgroup.subtree_control": "+cpu",
+		"cpu.max":                   "50000 100000",
+		"cgroup.procs":              strconv.Itoa(os.Getpid()),
+	} {
+		b, _ := ioutil.ReadFile(filepath.Join(path, name))
+		if string(b) != want {
+			t.Errorf("%s = %q, want %q", name, b, want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+)
+
+// A collabRoom is a collaborative editing session on a snippet.
+//
+// Websocket clients join the room of a snippet with the join action.
+// Edits are relayed to all other participants, where the last writer wins.
+// The room has a single executor shared by all participants such that
+// a run started by any participant is broadcast to all of them.
+type collabRoom struct {
+	id int64 // Snippet ID
+	ex *executor
+
+	mu      sync.Mutex // Protects code and members
+	code    string     // Latest source code of the snippet
+	members map[int64]*collabMember
+}
+
+type collabMember struct {
+	ID   int64  `json:"id"` // Client ID
+	User string `json:"user,omitempty"`
+
+	sess *wsSession
+}
+
+// collabCursor is the cursor position of a participant.
+type collabCursor struct {
+	ID   int64  `json:"id"`
+	User string `json:"user,omitempty"`
+	Line int    `json:"line"`
+	Ch   int    `json:"ch"`
+}
+
+// joinRoom adds the client of the session to the room of the snippet,
+// creating the room with the given code if it does not exist.
+// The participant is sent the latest code of the room, and all participants
+// are sent the updated list of participants.
+func (pg *playground) joinRoom(id int64, code string, sess *wsSession, user string) *collabRoom {
+	pg.roomsMu.Lock()
+	room := pg.rooms[id]
+	if room == nil {
+		room = &collabRoom{id: id, code: code, members: make(map[int64]*collabMember)}
+		room.ex = newExecutor(pg.bs, pg.exConf, func(action, data string) error {
+			room.broadcast(action, data, 0)
+			return nil
+		})
+		room.ex.SetSnippet(id)
+		pg.rooms[id] = room
+	}
+	room.mu.Lock()
+	room.members[sess.cid] = &collabMember{ID: sess.cid, User: user, sess: sess}
+	code = room.code
+	room.mu.Unlock()
+	pg.roomsMu.Unlock()
+
+	sess.sendMsg(actionEdit, code)
+	room.broadcastParticipants()
+	return room
+}
+
+// leaveRoom removes the client from the room.
+// The room is closed once the last participant leaves.
+func (pg *playground) leaveRoom(room *collabRoom, cid int64) {
+	pg.roomsMu.Lock()
+	room.mu.Lock()
+	delete(room.members, cid)
+	empty := len(room.members) == 0
+	room.mu.Unlock()
+	if empty {
+		delete(pg.rooms, room.id)
+	}
+	pg.roomsMu.Unlock()
+
+	if empty {
+		room.ex.Close()
+	} else {
+		room.broadcastParticipants()
+	}
+}
+
+// Edit replaces the code of the room and relays it to all other participants.
+func (room *collabRoom) Edit(cid int64, code string) {
+	room.mu.Lock()
+	room.code = code
+	room.mu.Unlock()
+	room.broadcast(actionEdit, code, cid)
+}
+
+// MoveCursor relays the cursor position of a participant to all others.
+// The data is a JSON dict with the "line" and "ch" fields.
+func (room *collabRoom) MoveCursor(cid int64, data string) {
+	var c collabCursor
+	if err := json.Unmarshal([]byte(data), &c); err != nil {
+		return
+	}
+	room.mu.Lock()
+	m := room.members[cid]
+	room.mu.Unlock()
+	if m == nil {
+		return
+	}
+	c.ID, c.User = m.ID, m.User
+	b, _ := json.Marshal(c)
+	room.broadcast(actionCursor, string(b), cid)
+}
+
+func (room *collabRoom) broadcastParticipants() {
+	room.mu.Lock()
+	ms := []*collabMember{}
+	for _, m := range room.members {
+		ms = append(ms, m)
+	}
+	room.mu.Unlock()
+	sort.Slice(ms, func(i, j int) bool { return ms[i].ID < ms[j].ID })
+	b, _ := json.Marshal(ms)
+	room.broadcast(collabMembers, string(b), 0)
+}
+
+// broadcast sends a message to all participants except the client with
+// the ID except (which is zero to send to all participants).
+func (room *collabRoom) broadcast(action, data string, except int64) {
+	room.mu.Lock()
+	var ss []*wsSession
+	for cid, m := range room.members {
+		if cid != except {
+			ss = append(ss, m.sess)
+		}
+	}
+	room.mu.Unlock()
+	for _, s := range ss {
+		s.sendMsg(action, data)
+	}
+}
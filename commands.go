@@ -0,0 +1,419 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// promptPasswordHash prompts the user on the terminal for a new password
+// and returns its argon2id hash.
+func promptPasswordHash() (string, error) {
+	fmt.Fprint(os.Stderr, "Enter a new Playground login password: ")
+	p, err := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("unable to read password: %v", err)
+	}
+	if len(bytes.TrimSpace(p)) < 8 {
+		return "", errors.New("insecure password")
+	}
+	h, err := newPasswordHash(p)
+	if err != nil {
+		return "", fmt.Errorf("unable to hash password: %v", err)
+	}
+	return h, nil
+}
+
+// runHashPass prints the hash of a password suitable for PasswordHash.
+func runHashPass() {
+	h, err := promptPasswordHash()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(h)
+}
+
+// runExport writes all snippets in the database to stdout as a JSON list.
+func runExport(confPath string) {
+	conf, logger, closer := loadConfig(confPath, false)
+	defer closer()
+	db, err := openStore(conf.StorageBackend, conf.DataPath)
+	if err != nil {
+		logger.Fatalf("openStore error: %v", err)
+	}
+	defer db.Close()
+
+	n, err := writeExport(os.Stdout, db, "json")
+	if err != nil {
+		logger.Fatalf("export error: %v", err)
+	}
+	logger.Printf("exported %d snippets", n)
+}
+
+// runImport reads snippets produced by export from stdin as either a JSON
+// list or a zip archive and adds them to the database.
+// The mapping of old IDs to new IDs is written to stdout as a JSON dict.
+func runImport(confPath string) {
+	conf, logger, closer := loadConfig(confPath, false)
+	defer closer()
+	db, err := openStore(conf.StorageBackend, conf.DataPath)
+	if err != nil {
+		logger.Fatalf("openStore error: %v", err)
+	}
+	defer db.Close()
+
+	b, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		logger.Fatalf("unable to read input: %v", err)
+	}
+	var ss []snippet
+	if bytes.HasPrefix(b, []byte("PK\x03\x04")) {
+		ss, err = parseExportZip(b)
+	} else {
+		err = json.Unmarshal(b, &ss)
+	}
+	if err != nil {
+		logger.Fatalf("unable to parse input: %v", err)
+	}
+	ids, err := db.Import(ss)
+	if err != nil {
+		logger.Fatalf("import error: %v", err)
+	}
+	for _, id := range ids {
+		if _, err := db.AppendAudit(auditEntry{User: "cli", Action: auditCreate, SnippetID: id}); err != nil {
+			logger.Printf("audit log error: %v", err)
+		}
+	}
+	logger.Printf("imported %d snippets", len(ids))
+
+	b, _ = json.Marshal(ids)
+	fmt.Println(string(b))
+}
+
+// runCompact compacts the database to reclaim unused space.
+func runCompact(confPath string) {
+	conf, logger, closer := loadConfig(confPath, false)
+	defer closer()
+	db, err := openStore(conf.StorageBackend, conf.DataPath)
+	if err != nil {
+		logger.Fatalf("openStore error: %v", err)
+	}
+	defer db.Close()
+
+	before, after, err := db.Compact()
+	if err != nil {
+		logger.Fatalf("compact error: %v", err)
+	}
+	logger.Printf("compacted database from %d to %d bytes (reclaimed %d bytes)", before, after, before-after)
+}
+
+// runDecryptBackup decrypts a backup encrypted with BackupPassphrase
+// from stdin and writes it to stdout.
+func runDecryptBackup(confPath string) {
+	conf, logger, closer := loadConfig(confPath, false)
+	defer closer()
+	if conf.BackupPassphrase == "" {
+		logger.Fatal("BackupPassphrase is not set")
+	}
+	if err := decryptBackup(os.Stdout, os.Stdin, conf.BackupPassphrase); err != nil {
+		logger.Fatalf("decrypt error: %v", err)
+	}
+}
+
+// configCheck is the result of checking a single aspect of the configuration.
+type configCheck struct {
+	Name   string // Name of the configuration field (e.g., "GoBinary")
+	Detail string // Description of what was found
+	Err    error  // Non-nil if the check failed
+}
+
+// checkConfig verifies that conf is usable without starting the server.
+// It checks that the Go toolchains and formatter run, that the linters
+// exist, that the TLS files can be loaded, and that the remaining fields
+// are well-formed.
+func checkConfig(conf config) []configCheck {
+	var cs []configCheck
+	add := func(name, detail string, err error) {
+		cs = append(cs, configCheck{name, detail, err})
+	}
+
+	// Check the Go toolchains and formatter,
+	// which are within the microVMs or the Docker image if either is used.
+	command := exec.Command
+	switch {
+	case conf.MicroVM != nil:
+		var logs bytes.Buffer
+		pool := newVMPool(*conf.MicroVM, log.New(&logs, "", 0))
+		defer pool.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 2*vmBootTimeout)
+		defer cancel()
+		vm, err := pool.Get(ctx)
+		if err != nil {
+			pool.Close() // Flushes the logs
+			err = fmt.Errorf("no VM booted: %s", firstLine(logs.String()))
+		} else {
+			pool.Release(vm.vsock)
+		}
+		if conf.DockerImage != "" {
+			err = errors.New("DockerImage is also set")
+		}
+		add("MicroVM", conf.MicroVM.RootFS, err)
+		dir, _ := ioutil.TempDir("", "check")
+		defer os.RemoveAll(dir)
+		self, _ := os.Executable()
+		command = func(name string, args ...string) *exec.Cmd {
+			var vsock string
+			if vm, err := pool.Get(ctx); err == nil {
+				vsock = vm.vsock
+			}
+			args = append([]string{"vm-exec", "--socket=" + vsock, "--dir=" + dir, "--", name}, args...)
+			return exec.Command(self, args...)
+		}
+	case conf.DockerImage != "":
+		out, err := exec.Command(conf.DockerBinary, "version", "--format", "{{.Server.Version}}").CombinedOutput()
+		if err == nil {
+			// Images that are only available locally are not pulled.
+			out, err = exec.Command(conf.DockerBinary, "image", "inspect", "--format", "{{.Id}}", conf.DockerImage).CombinedOutput()
+			if err != nil {
+				out, err = exec.Command(conf.DockerBinary, "pull", "--quiet", conf.DockerImage).CombinedOutput()
+			}
+		}
+		add("DockerImage", firstLine(string(out)), err)
+		command = func(name string, args ...string) *exec.Cmd {
+			args = append([]string{"run", "--rm", "--interactive", conf.DockerImage, name}, args...)
+			return exec.Command(conf.DockerBinary, args...)
+		}
+	}
+	checkGo := func(name, bin string) {
+		out, err := command(bin, "version").CombinedOutput()
+		add(name, strings.TrimSpace(string(out)), err)
+	}
+	checkGo("GoBinary", conf.GoBinary)
+	for _, k := range sortedKeys(conf.GoVersions) {
+		checkGo(fmt.Sprintf("GoVersions[%q]", k), conf.GoVersions[k])
+	}
+	cmd := command(conf.FmtBinary)
+	cmd.Stdin = strings.NewReader("package main\n")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		add("FmtBinary", strings.TrimSpace(string(out)), err)
+	} else {
+		add("FmtBinary", conf.FmtBinary, nil)
+	}
+	if conf.GoTipInterval != "" {
+		_, err := time.ParseDuration(conf.GoTipInterval)
+		if err == nil {
+			_, err = exec.LookPath("git")
+		}
+		add("GoTipInterval", conf.GoTipInterval, err)
+	}
+	if conf.GoplsBinary != "" {
+		out, err := exec.Command(conf.GoplsBinary, "version").CombinedOutput()
+		add("GoplsBinary", firstLine(string(out)), err)
+	}
+	if conf.StaticDir != "" {
+		_, err := os.Stat(filepath.Join(conf.StaticDir, "html", "playground.html"))
+		add("StaticDir", conf.StaticDir, err)
+	}
+	if conf.Branding != nil {
+		_, err := loadBranding(conf.Branding)
+		add("Branding", conf.Branding.Title, err)
+	}
+	for _, k := range sortedKeys(conf.Linters) {
+		p, err := exec.LookPath(conf.Linters[k])
+		add(fmt.Sprintf("Linters[%q]", k), p, err)
+	}
+	if len(conf.AllowedModules) > 0 {
+		var err error
+		if !conf.EnableModules {
+			err = errors.New("has no effect unless EnableModules is set")
+		}
+		for _, m := range conf.AllowedModules {
+			if strings.TrimSuffix(strings.SplitN(m, "@", 2)[0], "/") == "" {
+				err = fmt.Errorf("invalid module: %q", m)
+			}
+		}
+		add("AllowedModules", strings.Join(conf.AllowedModules, ", "), err)
+	}
+	for _, k := range sortedKeys(conf.Generators) {
+		p, err := exec.LookPath(conf.Generators[k])
+		add(fmt.Sprintf("Generators[%q]", k), p, err)
+	}
+
+	// Check the TLS certificates.
+	checkTLS := func(name, certFile, keyFile string) {
+		_, err := tls.LoadX509KeyPair(certFile, keyFile)
+		add(name, certFile+", "+keyFile, err)
+	}
+	if conf.TLSCertFile != "" || conf.TLSKeyFile != "" {
+		checkTLS("TLSCertFile", conf.TLSCertFile, conf.TLSKeyFile)
+	}
+	for _, lc := range conf.ServeAddress {
+		if lc.TLSCertFile != "" || lc.TLSKeyFile != "" {
+			checkTLS(fmt.Sprintf("ServeAddress[%q]", lc.Address), lc.TLSCertFile, lc.TLSKeyFile)
+		}
+	}
+
+	// Check the authentication settings.
+	pw, err := parsePasswordHash(conf.PasswordHash, conf.PasswordSalt)
+	switch {
+	case err != nil:
+		add("PasswordHash", "", err)
+	case pw == nil:
+		add("PasswordHash", "not set (authentication is disabled)", nil)
+	case pw.argon2:
+		add("PasswordHash", "argon2id", nil)
+	case pw.legacy:
+		add("PasswordHash", "legacy SHA256 (deprecated)", nil)
+	default:
+		add("PasswordHash", "bcrypt", nil)
+	}
+	if conf.OIDCIssuer != "" {
+		var err error
+		if conf.OIDCClientID == "" || conf.OIDCClientSecret == "" || len(conf.OIDCAllowedEmails) == 0 {
+			err = errors.New("OIDCClientID, OIDCClientSecret, and OIDCAllowedEmails must be set")
+		}
+		add("OIDCIssuer", conf.OIDCIssuer, err)
+	}
+	if len(conf.TrustedProxies) > 0 {
+		_, err := parseTrustedProxies(conf.TrustedProxies)
+		add("TrustedProxies", strings.Join(conf.TrustedProxies, ", "), err)
+	}
+	if conf.BackupInterval != "" {
+		_, err := time.ParseDuration(conf.BackupInterval)
+		add("BackupInterval", conf.BackupInterval, err)
+	}
+	for i, tc := range conf.BackupTargets {
+		t, err := newBackupTarget(tc)
+		if err == nil {
+			tc.URL = t.String()
+			if _, ok := t.(*sftpTarget); ok {
+				_, err = exec.LookPath("sftp")
+			}
+		}
+		add(fmt.Sprintf("BackupTargets[%d]", i), tc.URL, err)
+	}
+	for i, h := range conf.Webhooks {
+		add(fmt.Sprintf("Webhooks[%d]", i), h.URL, checkWebhook(h))
+	}
+	if conf.CoordinatorURL != "" {
+		err := checkCoordinatorURL(conf.CoordinatorURL)
+		if err == nil && conf.WorkerToken == "" {
+			err = errors.New("WorkerToken is not set")
+		}
+		add("CoordinatorURL", conf.CoordinatorURL, err)
+	}
+	if conf.BlobTTL != "" {
+		_, err := time.ParseDuration(conf.BlobTTL)
+		add("BlobTTL", conf.BlobTTL, err)
+	}
+	if conf.MaxTotalReportSize != 0 {
+		max := conf.MaxReportSize
+		if max <= 0 {
+			max = defaultMaxReport
+		}
+		var err error
+		if conf.MaxTotalReportSize < max {
+			err = fmt.Errorf("less than the maximum size of a report (%d bytes)", max)
+		}
+		add("MaxTotalReportSize", fmt.Sprint(conf.MaxTotalReportSize), err)
+	}
+	if sp := conf.SecurityProfile; sp != nil {
+		detail, err := checkSecurityProfile(*sp)
+		if err == nil && (conf.DockerImage != "" || conf.MicroVM != nil) {
+			err = errors.New("not used with DockerImage or MicroVM")
+		}
+		add("SecurityProfile", detail, err)
+	}
+	if conf.DisableNetwork && conf.DockerImage == "" && conf.MicroVM == nil {
+		add("DisableNetwork", "network namespaces", checkIsolateNetwork())
+	}
+	if conf.RunNiceness != 0 {
+		var err error
+		if conf.RunNiceness < -20 || conf.RunNiceness > 19 {
+			err = errors.New("must be between -20 and 19")
+		}
+		add("RunNiceness", fmt.Sprint(conf.RunNiceness), err)
+	}
+	if conf.RunCPULimit != 0 {
+		var err error
+		switch {
+		case conf.RunCPULimit < 0.01:
+			err = errors.New("must be at least 0.01")
+		case conf.DockerImage == "" && conf.MicroVM == nil:
+			err = checkCgroupDir(conf.CgroupDir)
+		}
+		add("RunCPULimit", fmt.Sprintf("%g CPUs", conf.RunCPULimit), err)
+	}
+	if conf.StopSignal != "" {
+		var err error
+		if _, ok := signals[conf.StopSignal]; !ok {
+			err = errors.New("unknown signal")
+		}
+		add("StopSignal", conf.StopSignal, err)
+	}
+	if conf.StopGracePeriod != "" {
+		_, err := time.ParseDuration(conf.StopGracePeriod)
+		add("StopGracePeriod", conf.StopGracePeriod, err)
+	}
+	return cs
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
+
+func sortedKeys(m map[string]string) []string {
+	var ks []string
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+// runCheckConfig loads the configuration and prints a report of checkConfig.
+// It exits with a non-zero status if any check failed.
+func runCheckConfig(confPath string) {
+	conf, logger, closer := loadConfig(confPath, false)
+	defer closer()
+
+	var failed int
+	for _, c := range checkConfig(conf) {
+		status, detail := "ok  ", c.Detail
+		if c.Err != nil {
+			status, detail = "FAIL", c.Err.Error()
+			if c.Detail != "" {
+				detail += ": " + c.Detail
+			}
+			failed++
+		}
+		fmt.Printf("%s  %s: %s\n", status, c.Name, detail)
+	}
+	if failed > 0 {
+		logger.Fatalf("configuration has %d problem(s)", failed)
+	}
+	fmt.Println("configuration is valid")
+}
@@ -0,0 +1,64 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"testing"
+)
+
+func TestCheckConfig(t *testing.T) {
+	conf := config{
+		ServeAddress:   serveAddresses{{Address: ":8443", TLSCertFile: "missing.pem", TLSKeyFile: "missing.key"}},
+		PasswordHash:   "$argon2id$invalid",
+		GoBinary:       "go",
+		FmtBinary:      "gofmt",
+		Linters:        map[string]string{"missing": "no-such-linter-binary"},
+		TrustedProxies: []string{"10.0.0.0/8"},
+		BackupInterval: "daily",
+		BackupTargets:  []backupTargetConfig{{URL: "/mnt/backups"}, {URL: "ftp://nas/backups"}},
+		BlobTTL:        "1h",
+		Webhooks:       []webhookConfig{{URL: "https://example.com/hook"}, {URL: "example.com/hook"}},
+		CoordinatorURL: "https://play.example.com",
+		StopSignal:     "SIGBOGUS",
+		AllowedModules: []string{"golang.org/x"},
+
+		MaxTotalReportSize: 1 << 20, // Less than the default MaxReportSize
+	}
+	want := map[string]bool{ // Whether the check should fail
+		"GoBinary":              false,
+		"FmtBinary":             false,
+		`Linters["missing"]`:    true,
+		`ServeAddress[":8443"]`: true,
+		"PasswordHash":          true,
+		"TrustedProxies":        false,
+		"BackupInterval":        true,
+		"BackupTargets[0]":      false,
+		"BackupTargets[1]":      true,
+		"BlobTTL":               false,
+		"Webhooks[0]":           false,
+		"Webhooks[1]":           true,
+		"CoordinatorURL":        true, // WorkerToken is not set
+		"StopSignal":            true,
+		"AllowedModules":        true, // EnableModules is not set
+		"MaxTotalReportSize":    true,
+	}
+
+	got := make(map[string]bool)
+	for _, c := range checkConfig(conf) {
+		got[c.Name] = c.Err != nil
+	}
+	for name, wantFail := range want {
+		gotFail, ok := got[name]
+		switch {
+		case !ok:
+			t.Errorf("missing check for %s", name)
+		case gotFail != wantFail:
+			t.Errorf("check for %s failed = %v, want %v", name, gotFail, wantFail)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d checks, want %d", len(got), len(want))
+	}
+}
@@ -0,0 +1,358 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/webdav"
+)
+
+// davPrefix is the URL path that snippets are served under over WebDAV.
+const davPrefix = "/dav"
+
+// davExt is the extension of the files of snippets served over WebDAV.
+const davExt = ".go"
+
+var (
+	davEscaper   = strings.NewReplacer("%", "%25", "/", "%2F")
+	davUnescaper = strings.NewReplacer("%25", "%", "%2F", "/", "%2f", "/")
+)
+
+// serveDAV serves the snippets as a flat directory of Go source files over
+// WebDAV so that they may be mounted and edited with a local editor.
+//
+// Each snippet is a file named by the snippet name with a ".go" extension,
+// where any "%" and "/" in the name are escaped as "%25" and "%2F".
+// Since names need not be unique, all but the snippet with the lowest ID
+// among those of the same name have the ID appended (e.g., "name~42.go").
+// Writing to a file updates the code of the snippet, or creates a new snippet
+// if no file of that name exists; moving a file renames the snippet; and
+// deleting a file deletes the snippet. Sub-directories and files not ending
+// in ".go" cannot be created.
+func (pg *playground) serveDAV(w http.ResponseWriter, r *http.Request) {
+	// The prefix includes the base path since it is also stripped from
+	// the Destination header of COPY and MOVE requests.
+	r.URL.Path = pg.basePath + r.URL.Path
+	h := &webdav.Handler{
+		Prefix:     pg.basePath + davPrefix,
+		FileSystem: &davFS{pg: pg, r: r},
+		LockSystem: pg.davLocks,
+	}
+	h.ServeHTTP(w, r)
+}
+
+// davFS is a webdav.FileSystem of the snippets for a single request.
+type davFS struct {
+	pg *playground
+	r  *http.Request
+
+	entries map[string]davEntry // Lazily loaded by file name
+}
+
+// davEntry is a snippet in the directory listing.
+type davEntry struct {
+	id       int64
+	name     string // Name of the snippet
+	code     string
+	modified time.Time
+}
+
+// load reads all snippets and assigns them file names.
+func (fs *davFS) load() error {
+	if fs.entries != nil {
+		return nil
+	}
+	ss, err := fs.pg.store(fs.r).QueryByID(0, -1)
+	if err != nil {
+		return err
+	}
+	sort.Slice(ss, func(i, j int) bool { return ss[i].ID < ss[j].ID })
+	fs.entries = make(map[string]davEntry, len(ss))
+	for _, s := range ss {
+		name := davEscaper.Replace(s.Name)
+		if _, ok := fs.entries[name+davExt]; ok {
+			name += "~" + strconv.FormatInt(s.ID, 10)
+		}
+		fs.entries[name+davExt] = davEntry{s.ID, s.Name, s.Code, s.Modified}
+	}
+	return nil
+}
+
+// lookup returns the entry for the given slash-separated path, which is
+// the file name with a leading slash.
+func (fs *davFS) lookup(name string) (davEntry, bool, error) {
+	if err := fs.load(); err != nil {
+		return davEntry{}, false, err
+	}
+	e, ok := fs.entries[strings.TrimPrefix(name, "/")]
+	return e, ok, nil
+}
+
+// davSnippetName returns the name of a new snippet for the given path.
+func davSnippetName(name string) (string, error) {
+	name = strings.TrimPrefix(name, "/")
+	if strings.Contains(name, "/") || strings.HasPrefix(name, ".") || !strings.HasSuffix(name, davExt) {
+		return "", os.ErrPermission // Ignore the hidden files of editors
+	}
+	name = davUnescaper.Replace(strings.TrimSuffix(name, davExt))
+	if strings.TrimSpace(name) == "" {
+		return "", os.ErrPermission
+	}
+	return name, nil
+}
+
+// davError converts errors from the store into those understood by
+// the webdav package.
+func davError(err error) error {
+	if _, ok := err.(requestError); ok {
+		return os.ErrPermission
+	}
+	if err == errNotFound {
+		return os.ErrNotExist
+	}
+	return err
+}
+
+func (fs *davFS) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	if name == "/" {
+		return os.ErrExist
+	}
+	return os.ErrPermission
+}
+
+func (fs *davFS) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (webdav.File, error) {
+	if name == "/" {
+		if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC) != 0 {
+			return nil, os.ErrPermission
+		}
+		if err := fs.load(); err != nil {
+			return nil, err
+		}
+		var fis []os.FileInfo
+		for fname, e := range fs.entries {
+			fis = append(fis, e.fileInfo(fname))
+		}
+		sort.Slice(fis, func(i, j int) bool { return fis[i].Name() < fis[j].Name() })
+		return &davDir{fis: fis}, nil
+	}
+
+	e, ok, err := fs.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if flag&os.O_CREATE == 0 {
+			return nil, os.ErrNotExist
+		}
+		if e.name, err = davSnippetName(name); err != nil {
+			return nil, err
+		}
+	}
+	f := &davFile{fs: fs, fi: e.fileInfo(strings.TrimPrefix(name, "/")), e: e}
+	if flag&(os.O_WRONLY|os.O_RDWR) == 0 {
+		f.rd = strings.NewReader(e.code)
+		return f, nil
+	}
+	f.wr = new(bytes.Buffer)
+	if flag&os.O_TRUNC == 0 {
+		f.wr.WriteString(e.code)
+	}
+	f.dirty = !ok // New files are always created
+	return f, nil
+}
+
+func (fs *davFS) RemoveAll(ctx context.Context, name string) error {
+	if name == "/" {
+		return os.ErrPermission
+	}
+	e, ok, err := fs.lookup(name)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return os.ErrNotExist
+	}
+	if err := fs.pg.store(fs.r).Delete(e.id); err != nil {
+		return davError(err)
+	}
+	fs.entries = nil
+	fs.pg.logf(fs.r, "deleted snippet %d over WebDAV", e.id)
+	fs.pg.audit(fs.r, auditDelete, e.id, "")
+	return nil
+}
+
+func (fs *davFS) Rename(ctx context.Context, oldName, newName string) error {
+	e, ok, err := fs.lookup(oldName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return os.ErrNotExist
+	}
+	if _, ok, _ := fs.lookup(newName); ok {
+		return os.ErrExist
+	}
+	name, err := davSnippetName(newName)
+	if err != nil {
+		return err
+	}
+	fs.pg.updateMu.Lock()
+	err = fs.pg.store(fs.r).Update(snippet{Name: name}, e.id)
+	fs.pg.updateMu.Unlock()
+	if err != nil {
+		return davError(err)
+	}
+	fs.entries = nil
+	fs.pg.logf(fs.r, "renamed snippet %d over WebDAV", e.id)
+	fs.pg.audit(fs.r, auditUpdate, e.id, "")
+	return nil
+}
+
+func (fs *davFS) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	if name == "/" {
+		return davFileInfo{name: "/", dir: true}, nil
+	}
+	e, ok, err := fs.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return e.fileInfo(strings.TrimPrefix(name, "/")), nil
+}
+
+func (e davEntry) fileInfo(name string) davFileInfo {
+	return davFileInfo{name: name, size: int64(len(e.code)), modTime: e.modified}
+}
+
+// davFileInfo implements os.FileInfo for the files of davFS.
+type davFileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	dir     bool
+}
+
+func (fi davFileInfo) Name() string       { return fi.name }
+func (fi davFileInfo) Size() int64        { return fi.size }
+func (fi davFileInfo) ModTime() time.Time { return fi.modTime }
+func (fi davFileInfo) IsDir() bool        { return fi.dir }
+func (fi davFileInfo) Sys() interface{}   { return nil }
+func (fi davFileInfo) Mode() os.FileMode {
+	if fi.dir {
+		return os.ModeDir | 0755
+	}
+	return 0644
+}
+
+// davDir is the root directory of davFS.
+type davDir struct {
+	fis []os.FileInfo
+	pos int
+}
+
+func (d *davDir) Close() error                                 { return nil }
+func (d *davDir) Read(p []byte) (int, error)                   { return 0, os.ErrInvalid }
+func (d *davDir) Write(p []byte) (int, error)                  { return 0, os.ErrPermission }
+func (d *davDir) Seek(offset int64, whence int) (int64, error) { return 0, os.ErrInvalid }
+func (d *davDir) Stat() (os.FileInfo, error)                   { return davFileInfo{name: "/", dir: true}, nil }
+
+func (d *davDir) Readdir(count int) ([]os.FileInfo, error) {
+	fis := d.fis[d.pos:]
+	if count > 0 {
+		if len(fis) == 0 {
+			return nil, io.EOF
+		}
+		if len(fis) > count {
+			fis = fis[:count]
+		}
+	}
+	d.pos += len(fis)
+	return fis, nil
+}
+
+// davFile is a snippet opened for either reading or writing.
+// The code written to the file is stored when the file is closed.
+type davFile struct {
+	fs *davFS
+	fi davFileInfo
+	e  davEntry
+
+	rd    *strings.Reader // Non-nil if opened for reading
+	wr    *bytes.Buffer   // Non-nil if opened for writing
+	dirty bool
+}
+
+func (f *davFile) Readdir(count int) ([]os.FileInfo, error) { return nil, os.ErrInvalid }
+
+func (f *davFile) Read(p []byte) (int, error) {
+	if f.rd == nil {
+		return 0, os.ErrInvalid
+	}
+	return f.rd.Read(p)
+}
+
+func (f *davFile) Seek(offset int64, whence int) (int64, error) {
+	if f.rd == nil {
+		return 0, os.ErrInvalid
+	}
+	return f.rd.Seek(offset, whence)
+}
+
+func (f *davFile) Write(p []byte) (int, error) {
+	if f.wr == nil {
+		return 0, os.ErrPermission
+	}
+	f.dirty = true
+	return f.wr.Write(p)
+}
+
+func (f *davFile) Stat() (os.FileInfo, error) {
+	fi := f.fi
+	if f.wr != nil {
+		fi.size = int64(f.wr.Len())
+	}
+	return fi, nil
+}
+
+func (f *davFile) Close() error {
+	if f.wr == nil || !f.dirty {
+		return nil
+	}
+	f.dirty = false
+	pg, r, db := f.fs.pg, f.fs.r, f.fs.pg.store(f.fs.r)
+	f.fs.entries = nil
+	if f.e.id == 0 {
+		id, err := db.Create(snippet{Name: f.e.name, Code: f.wr.String()})
+		if err != nil {
+			return davError(err)
+		}
+		pg.logf(r, "created snippet %d over WebDAV", id)
+		pg.audit(r, auditCreate, id, "")
+		return nil
+	}
+
+	// The store treats empty code as unchanged, so truncating the file
+	// leaves the code of an existing snippet as is.
+	pg.updateMu.Lock()
+	err := db.Update(snippet{Code: f.wr.String()}, f.e.id)
+	pg.updateMu.Unlock()
+	if err != nil {
+		return davError(err)
+	}
+	pg.logf(r, "updated snippet %d over WebDAV", f.e.id)
+	pg.audit(r, auditUpdate, f.e.id, "")
+	return nil
+}
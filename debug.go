@@ -0,0 +1,393 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// debugStartTimeout is how long Delve may take to start listening.
+var debugStartTimeout = 30 * time.Second
+
+// debugSession is a connection to a headless Delve debugger, which is driven
+// through its JSON-RPC API (version 2).
+type debugSession struct {
+	mu     sync.Mutex // Serializes commands, since continuing blocks until the program stops
+	client *rpc.Client
+	file   string // Path of the source file as recorded in the binary
+}
+
+// The following types are the subsets of the Delve API types that are used.
+
+type dlvBreakpoint struct {
+	ID   int    `json:"id,omitempty"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+type dlvState struct {
+	Exited        bool       `json:"exited"`
+	ExitStatus    int        `json:"exitStatus"`
+	CurrentThread *dlvThread `json:"currentThread,omitempty"`
+}
+
+type dlvThread struct {
+	File     string       `json:"file"`
+	Line     int          `json:"line"`
+	Function *dlvFunction `json:"function,omitempty"`
+}
+
+type dlvFunction struct {
+	Name string `json:"name"`
+}
+
+type dlvVariable struct {
+	Name       string        `json:"name"`
+	Addr       uint64        `json:"addr"`
+	Type       string        `json:"type"`
+	Kind       reflect.Kind  `json:"kind"`
+	Value      string        `json:"value"`
+	Len        int64         `json:"len"`
+	Children   []dlvVariable `json:"children"`
+	Unreadable string        `json:"unreadable"`
+}
+
+type dlvScope struct {
+	GoroutineID int64
+	Frame       int
+}
+
+type dlvLoadConfig struct {
+	FollowPointers     bool
+	MaxVariableRecurse int
+	MaxStringLen       int
+	MaxArrayValues     int
+	MaxStructFields    int
+}
+
+// debugLoadConfig limits how much of a variable is loaded by inspect.
+var debugLoadConfig = dlvLoadConfig{
+	FollowPointers:     true,
+	MaxVariableRecurse: 2,
+	MaxStringLen:       256,
+	MaxArrayValues:     64,
+	MaxStructFields:    -1,
+}
+
+// debugPosition is the data of the debugState message.
+type debugPosition struct {
+	Line     int    `json:"line,omitempty"` // Zero if stopped outside of the snippet
+	File     string `json:"file,omitempty"`
+	Function string `json:"function,omitempty"`
+	Exited   bool   `json:"exited,omitempty"`
+	ExitCode int    `json:"exitCode,omitempty"`
+}
+
+// handleDebug builds the program in code with optimizations and inlining
+// disabled and runs it under a headless Delve, which is driven by the
+// setBreakpoint, continue, step, and inspect actions (see Debug).
+// The program starts halted so that breakpoints may be set before
+// continuing, and the debug action stops once the program exits.
+func (ex *executor) handleDebug(code string) {
+	defer ex.wg.Done()
+	defer ex.sendMsg(statusStopped, "")
+	ex.sendMsg(clearOutput, "")
+	if ex.dlv == "" {
+		ex.sendMsg(statusUpdate, "Debugging is not supported.\n")
+		return
+	}
+
+	// Best effort at clearing out directory and stale data.
+	ex.clearWorkspace()
+	ex.deleteBlobs()
+
+	// Wait for permission to build and run.
+	if err := ex.queue.Acquire(ex.ctx, func(pos int) {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Queued, position %d.\n", pos))
+	}); err != nil {
+		return
+	}
+	defer ex.queue.Release()
+
+	const name = "main.go"
+	if _, files, err := splitFiles(code); err != nil || len(files) > 0 {
+		ex.sendMsg(statusUpdate, "Only single-file programs can be debugged.\n")
+		return
+	}
+	if !ex.writeFile(name, code) {
+		return
+	}
+	info, ok := ex.parseFile(filepath.Join(ex.tmpDir, name))
+	if !ok {
+		return
+	}
+	if !info.hasMain {
+		ex.sendMsg(statusUpdate, "Only programs with a main function can be debugged.\n")
+		return
+	}
+	ex.env = ex.snippetEnv(info)
+	defer func() { ex.env = nil }()
+	if ex.modules && !ex.setupModules() {
+		return
+	}
+
+	ex.sendMsg(statusUpdate, "Compiling program...\n")
+	ex.setState(execBuilding)
+	bb := new(bytes.Buffer)
+	if !ex.runCommand(bb, ex.gc, "build", "-gcflags=all=-N -l", name) {
+		ex.reportBadLines(bb.Bytes())
+		return
+	}
+
+	// Positions in the binary use the path of the directory with any
+	// symbolic links resolved, since that is what the go command sees.
+	dir, err := filepath.EvalSymlinks(ex.tmpDir)
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+		return
+	}
+	addr, err := freeLoopbackAddr()
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+		return
+	}
+	ex.sendMsg(statusUpdate, "Starting debugger...\n")
+	cmd := ex.command(ioutil.Discard, ex.dlv, "exec", "--headless", "--api-version=2", "--listen="+addr, "./main")
+	done := make(chan bool, 1)
+	go func() { done <- ex.run(cmd, 0) }()
+
+	conn, err := dialDebugger(addr, done)
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to start debugger: %v\n", err))
+		ex.Interrupt()
+		<-done
+		return
+	}
+	ds := &debugSession{client: jsonrpc.NewClient(conn), file: filepath.Join(dir, name)}
+	defer ds.client.Close()
+	ex.mu.Lock()
+	ex.dbg = ds
+	ex.mu.Unlock()
+	defer func() {
+		ex.mu.Lock()
+		ex.dbg = nil
+		ex.mu.Unlock()
+	}()
+	ex.setState(execDebugging)
+	ex.sendMsg(statusUpdate, "Debugger started; set breakpoints and continue.\n")
+	ex.sendMsg(debugState, "{}")
+
+	select {
+	case <-done:
+		return
+	case <-ex.ctx.Done():
+	}
+	// Killing Delve may leave the traced program behind, so ask Delve to
+	// kill it first. The pending command is interrupted by the detach.
+	ds.client.Go("RPCServer.Detach", struct{ Kill bool }{true}, new(struct{}), nil)
+	<-done
+}
+
+// dialDebugger connects to the Delve API server at addr once it is listening.
+// It gives up if Delve exits, which is signaled on done.
+func dialDebugger(addr string, done chan bool) (net.Conn, error) {
+	deadline := time.Now().Add(debugStartTimeout)
+	for {
+		c, err := net.DialTimeout("tcp", addr, time.Second)
+		if err == nil {
+			return c, nil
+		}
+		select {
+		case ok := <-done:
+			done <- ok // Let the caller observe the exit as well
+			return nil, errors.New("debugger exited")
+		case <-time.After(50 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			return nil, errors.New("debugger did not start in time")
+		}
+	}
+}
+
+// Debug handles the setBreakpoint, continue, step, and inspect actions on the
+// program of an on-going debug action. Since continuing blocks until the
+// program stops, the command runs asynchronously.
+func (ex *executor) Debug(action, data string) {
+	ex.mu.Lock()
+	ds := ex.dbg
+	ex.mu.Unlock()
+	if ds == nil {
+		ex.sendMsg(statusUpdate, "No program is being debugged.\n")
+		return
+	}
+	go ex.debugCommand(ds, action, data)
+}
+
+func (ex *executor) debugCommand(ds *debugSession, action, data string) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	switch action {
+	case actionBreakpoint:
+		line, err := strconv.Atoi(data)
+		if err != nil || line <= 0 {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Invalid line number: %v\n", data))
+			return
+		}
+		var out struct{ Breakpoint dlvBreakpoint }
+		in := struct{ Breakpoint dlvBreakpoint }{dlvBreakpoint{File: ds.file, Line: line}}
+		if err := ds.client.Call("RPCServer.CreateBreakpoint", in, &out); err != nil {
+			ex.sendDebugError("Unable to set breakpoint", err)
+			return
+		}
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Breakpoint %d set at line %d.\n", out.Breakpoint.ID, out.Breakpoint.Line))
+	case actionContinue, actionStep:
+		name := "continue"
+		if action == actionStep {
+			name = "next" // Stepping into functions would mostly enter the standard library
+		}
+		var out struct{ State dlvState }
+		if err := ds.client.Call("RPCServer.Command", map[string]string{"name": name}, &out); err != nil {
+			ex.sendDebugError("Unable to "+action, err)
+			return
+		}
+		ex.reportDebugState(ds, out.State)
+	case actionInspect:
+		expr := strings.TrimSpace(data)
+		var out struct{ Variable *dlvVariable }
+		in := struct {
+			Scope dlvScope
+			Expr  string
+			Cfg   *dlvLoadConfig
+		}{dlvScope{GoroutineID: -1}, expr, &debugLoadConfig}
+		if err := ds.client.Call("RPCServer.Eval", in, &out); err != nil {
+			ex.sendDebugError("Unable to inspect "+expr, err)
+			return
+		}
+		if out.Variable == nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("%s has no value\n", expr))
+			return
+		}
+		ex.sendMsg(statusUpdate, fmt.Sprintf("%s (%s) = %s\n", expr, out.Variable.Type, formatDlvVariable(*out.Variable)))
+	default:
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unknown action: %s\n", action))
+	}
+}
+
+// sendDebugError reports a failed debugger command, unless the failure is
+// only because the debugging session ended.
+func (ex *executor) sendDebugError(msg string, err error) {
+	if err == rpc.ErrShutdown || err == io.ErrUnexpectedEOF {
+		return
+	}
+	ex.sendMsg(statusUpdate, fmt.Sprintf("%s: %v\n", msg, err))
+}
+
+// reportDebugState informs the client where the program stopped. Once the
+// program exits, the debugger is detached so that the debug action stops.
+func (ex *executor) reportDebugState(ds *debugSession, st dlvState) {
+	var pos debugPosition
+	switch {
+	case st.Exited:
+		pos = debugPosition{Exited: true, ExitCode: st.ExitStatus}
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Program exited with code %d.\n", st.ExitStatus))
+	case st.CurrentThread != nil:
+		t := st.CurrentThread
+		if t.Function != nil {
+			pos.Function = t.Function.Name
+		}
+		loc := fmt.Sprintf("line %d", t.Line)
+		if t.File == ds.file {
+			pos.Line = t.Line
+		} else {
+			pos.File = t.File
+			loc = fmt.Sprintf("%s:%d", t.File, t.Line)
+		}
+		if pos.Function != "" {
+			loc += " in " + pos.Function
+		}
+		ex.sendMsg(statusUpdate, "Stopped at "+loc+".\n")
+	}
+	b, _ := json.Marshal(pos)
+	ex.sendMsg(debugState, string(b))
+	if st.Exited {
+		ds.client.Call("RPCServer.Detach", struct{ Kill bool }{true}, new(struct{}))
+	}
+}
+
+// formatDlvVariable formats a variable loaded by Delve similar to
+// how the fmt package formats values with the %v verb.
+func formatDlvVariable(v dlvVariable) string {
+	if v.Unreadable != "" {
+		return "(unreadable " + v.Unreadable + ")"
+	}
+	join := func(vs []dlvVariable, sep string, more bool) string {
+		var ss []string
+		for _, c := range vs {
+			ss = append(ss, formatDlvVariable(c))
+		}
+		if more {
+			ss = append(ss, "...")
+		}
+		return strings.Join(ss, sep)
+	}
+	switch v.Kind {
+	case reflect.String:
+		s := strconv.Quote(v.Value)
+		if int64(len(v.Value)) < v.Len {
+			s += "..."
+		}
+		return s
+	case reflect.Ptr:
+		if len(v.Children) == 0 || v.Children[0].Addr == 0 {
+			return "nil"
+		}
+		if c := v.Children[0]; c.Value == "" && len(c.Children) == 0 {
+			return fmt.Sprintf("%#x", c.Addr) // Pointee was not loaded
+		}
+		return "&" + formatDlvVariable(v.Children[0])
+	case reflect.Interface:
+		if len(v.Children) == 0 || v.Children[0].Kind == reflect.Invalid {
+			return "nil"
+		}
+		return formatDlvVariable(v.Children[0])
+	case reflect.Array, reflect.Slice:
+		return "[" + join(v.Children, " ", int64(len(v.Children)) < v.Len) + "]"
+	case reflect.Struct:
+		var ss []string
+		for _, c := range v.Children {
+			ss = append(ss, c.Name+":"+formatDlvVariable(c))
+		}
+		return "{" + strings.Join(ss, " ") + "}"
+	case reflect.Map:
+		// Delve loads the keys and values of maps as alternating children.
+		var ss []string
+		for i := 0; i+1 < len(v.Children); i += 2 {
+			ss = append(ss, formatDlvVariable(v.Children[i])+":"+formatDlvVariable(v.Children[i+1]))
+		}
+		if int64(len(v.Children)/2) < v.Len {
+			ss = append(ss, "...")
+		}
+		return "map[" + strings.Join(ss, " ") + "]"
+	default:
+		if v.Value == "" && len(v.Children) > 0 {
+			return "{" + join(v.Children, " ", false) + "}"
+		}
+		return v.Value
+	}
+}
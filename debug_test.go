@@ -0,0 +1,178 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"errors"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// fakeDelve implements the subset of the Delve API used by debugSession.
+type fakeDelve struct {
+	file     string
+	states   []dlvState // States returned by successive commands
+	commands []string
+	detached bool
+}
+
+func (fd *fakeDelve) CreateBreakpoint(in struct{ Breakpoint dlvBreakpoint }, out *struct{ Breakpoint dlvBreakpoint }) error {
+	if in.Breakpoint.File != fd.file || in.Breakpoint.Line > 10 {
+		return errors.New("could not find statement")
+	}
+	out.Breakpoint = in.Breakpoint
+	out.Breakpoint.ID = 1
+	return nil
+}
+
+func (fd *fakeDelve) Command(in map[string]string, out *struct{ State dlvState }) error {
+	fd.commands = append(fd.commands, in["name"])
+	out.State, fd.states = fd.states[0], fd.states[1:]
+	return nil
+}
+
+func (fd *fakeDelve) Eval(in struct {
+	Scope dlvScope
+	Expr  string
+	Cfg   *dlvLoadConfig
+}, out *struct{ Variable *dlvVariable }) error {
+	if in.Expr != "s" || in.Scope.GoroutineID != -1 || in.Cfg == nil {
+		return errors.New("could not find symbol value for " + in.Expr)
+	}
+	out.Variable = &dlvVariable{Name: "s", Type: "[]int", Kind: reflect.Slice, Len: 2, Children: []dlvVariable{
+		{Kind: reflect.Int, Value: "1"}, {Kind: reflect.Int, Value: "2"},
+	}}
+	return nil
+}
+
+func (fd *fakeDelve) Detach(in struct{ Kill bool }, out *struct{}) error {
+	fd.detached = in.Kill
+	return nil
+}
+
+func TestDebugCommands(t *testing.T) {
+	const file = "/tmp/sandbox/main.go"
+	fd := &fakeDelve{file: file, states: []dlvState{
+		{CurrentThread: &dlvThread{File: file, Line: 7, Function: &dlvFunction{"main.main"}}},
+		{CurrentThread: &dlvThread{File: "/usr/lib/go/src/fmt/print.go", Line: 274}},
+		{Exited: true, ExitStatus: 3},
+	}}
+	srv := rpc.NewServer()
+	if err := srv.RegisterName("RPCServer", fd); err != nil {
+		t.Fatal(err)
+	}
+	c1, c2 := net.Pipe()
+	go srv.ServeCodec(jsonrpc.NewServerCodec(c1))
+	ds := &debugSession{client: jsonrpc.NewClient(c2), file: file}
+	defer ds.client.Close()
+
+	var mu sync.Mutex
+	var got []message
+	ex := newExecutor(newBlobStore(), execConfig{}, func(action, data string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, message{action, data})
+		return nil
+	})
+	defer ex.Close()
+
+	tests := []struct {
+		action, data string
+		want         []message
+	}{{
+		actionBreakpoint, "7",
+		[]message{{statusUpdate, "Breakpoint 1 set at line 7.\n"}},
+	}, {
+		actionBreakpoint, "42",
+		[]message{{statusUpdate, "Unable to set breakpoint: could not find statement\n"}},
+	}, {
+		actionBreakpoint, "x",
+		[]message{{statusUpdate, "Invalid line number: x\n"}},
+	}, {
+		actionContinue, "",
+		[]message{
+			{statusUpdate, "Stopped at line 7 in main.main.\n"},
+			{debugState, `{"line":7,"function":"main.main"}`},
+		},
+	}, {
+		actionInspect, " s ",
+		[]message{{statusUpdate, "s ([]int) = [1 2]\n"}},
+	}, {
+		actionInspect, "t",
+		[]message{{statusUpdate, "Unable to inspect t: could not find symbol value for t\n"}},
+	}, {
+		actionStep, "",
+		[]message{
+			{statusUpdate, "Stopped at /usr/lib/go/src/fmt/print.go:274.\n"},
+			{debugState, `{"file":"/usr/lib/go/src/fmt/print.go"}`},
+		},
+	}, {
+		actionContinue, "",
+		[]message{
+			{statusUpdate, "Program exited with code 3.\n"},
+			{debugState, `{"exited":true,"exitCode":3}`},
+		},
+	}}
+	for _, tt := range tests {
+		mu.Lock()
+		got = nil
+		mu.Unlock()
+		ex.debugCommand(ds, tt.action, tt.data)
+		mu.Lock()
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s %q:\ngot  %q\nwant %q", tt.action, tt.data, got, tt.want)
+		}
+		mu.Unlock()
+	}
+	if want := []string{"continue", "next", "continue"}; !reflect.DeepEqual(fd.commands, want) {
+		t.Errorf("commands = %q, want %q", fd.commands, want)
+	}
+	if !fd.detached {
+		t.Errorf("debugger not detached after the program exited")
+	}
+
+	mu.Lock()
+	got = nil
+	mu.Unlock()
+	ex.Debug(actionContinue, "")
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []message{{statusUpdate, "No program is being debugged.\n"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Debug without session:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestFormatDlvVariable(t *testing.T) {
+	i := func(s string) dlvVariable { return dlvVariable{Kind: reflect.Int, Value: s} }
+	tests := []struct {
+		v    dlvVariable
+		want string
+	}{
+		{i("5"), "5"},
+		{dlvVariable{Kind: reflect.String, Value: "a\"b", Len: 3}, `"a\"b"`},
+		{dlvVariable{Kind: reflect.String, Value: "ab", Len: 300}, `"ab"...`},
+		{dlvVariable{Kind: reflect.Slice, Len: 3, Children: []dlvVariable{i("1"), i("2")}}, "[1 2 ...]"},
+		{dlvVariable{Kind: reflect.Struct, Children: []dlvVariable{
+			{Name: "X", Kind: reflect.Int, Value: "1"},
+			{Name: "S", Kind: reflect.String, Value: "s", Len: 1},
+		}}, `{X:1 S:"s"}`},
+		{dlvVariable{Kind: reflect.Ptr, Children: []dlvVariable{{Kind: reflect.Struct}}}, "nil"},
+		{dlvVariable{Kind: reflect.Ptr, Children: []dlvVariable{{Addr: 0xc000010000, Kind: reflect.Int, Value: "7"}}}, "&7"},
+		{dlvVariable{Kind: reflect.Ptr, Children: []dlvVariable{{Addr: 0xc000010000, Kind: reflect.Struct}}}, "0xc000010000"},
+		{dlvVariable{Kind: reflect.Map, Len: 1, Children: []dlvVariable{{Kind: reflect.String, Value: "k", Len: 1}, i("1")}}, `map["k":1]`},
+		{dlvVariable{Kind: reflect.Interface, Children: []dlvVariable{i("4")}}, "4"},
+		{dlvVariable{Kind: reflect.Interface, Children: []dlvVariable{{Kind: reflect.Invalid}}}, "nil"},
+		{dlvVariable{Unreadable: "bad address"}, "(unreadable bad address)"},
+	}
+	for _, tt := range tests {
+		if got := formatDlvVariable(tt.v); got != tt.want {
+			t.Errorf("formatDlvVariable(%+v) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// With a Docker image configured, every command in the sandbox (e.g., go
+// build and the program itself) runs in a new container of the image instead
+// of on the host. Only the temporary directory of the executor is mounted,
+// at the same path as on the host so that paths in arguments and in the
+// output remain valid. Containers run as the user of the playground so that
+// the files they create in the directory can be removed afterwards.
+//
+// The docker client forwards signals (e.g., the stop signal and SIGQUIT upon
+// a timeout) to the container, but it cannot forward SIGKILL. A container
+// whose client was killed is thus killed through the docker client as well.
+
+// containerPrefix is the prefix of the names of the containers of runs.
+const containerPrefix = "playground-"
+
+// dockerCommand returns the arguments that run args in a new container of
+// ex.dockerImage, with the variables named in env passed through from the
+// environment of the docker client. Since GOCACHE is a path on the host,
+// it is mounted at the same path so that containers share the build cache.
+func (ex *executor) dockerCommand(args, env []string) []string {
+	var b [8]byte
+	rand.Read(b[:])
+	dargs := []string{ex.docker, "run",
+		"--name=" + containerPrefix + hex.EncodeToString(b[:]),
+		"--rm", "--init",
+		fmt.Sprintf("--user=%d:%d", os.Getuid(), os.Getgid()),
+		"--volume=" + ex.tmpDir + ":" + ex.tmpDir,
+		"--workdir=" + ex.tmpDir,
+		"--env=HOME=/tmp", // The user may not exist in the image
+	}
+	dargs = append(dargs, ex.progArgs...)
+	dargs = append(dargs, ex.dockerArgs...)
+	seen := make(map[string]bool)
+	for _, kv := range env {
+		// Only the names are passed so that the values (e.g., credentials
+		// in GOPROXY) do not appear in the arguments of the process.
+		k := strings.SplitN(kv, "=", 2)[0]
+		if !seen[k] {
+			seen[k] = true
+			if v := strings.TrimPrefix(kv, k+"="); k == "GOCACHE" && filepath.IsAbs(v) {
+				dargs = append(dargs, "--volume="+v+":"+v)
+			}
+			dargs = append(dargs, "--env="+k)
+		}
+	}
+	return append(append(dargs, ex.dockerImage), args...)
+}
+
+// containerName returns the name of the container that cmd runs in,
+// or the empty string if cmd runs on the host.
+func containerName(cmd *exec.Cmd) string {
+	if len(cmd.Args) > 2 && cmd.Args[1] == "run" && strings.HasPrefix(cmd.Args[2], "--name="+containerPrefix) {
+		return strings.TrimPrefix(cmd.Args[2], "--name=")
+	}
+	return ""
+}
+
+// killContainer kills the container of cmd if its docker client did not
+// exit normally (e.g., it was killed), which leaves the container running.
+func (ex *executor) killContainer(cmd *exec.Cmd) {
+	name := containerName(cmd)
+	if name == "" || cmd.ProcessState == nil || cmd.ProcessState.Exited() {
+		return
+	}
+	exec.Command(ex.docker, "kill", name).Run()
+}
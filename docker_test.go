@@ -0,0 +1,121 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDocker(t *testing.T) {
+	// The fake docker client logs its arguments and runs the command
+	// following the options and the image directly.
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "docker.log")
+	docker := filepath.Join(dir, "docker.sh")
+	script := fmt.Sprintf(`#!/bin/sh
+echo "$*" >> %s
+[ "$1" = run ] || exit 0
+shift
+while [ "${1#--}" != "$1" ]; do shift; done
+shift
+exec "$@"
+`, logFile)
+	if err := ioutil.WriteFile(docker, []byte(script), 0775); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+
+	var mu sync.Mutex
+	var out strings.Builder
+	stopped := make(chan string, 1)
+	// The build cache of the host is mounted into the containers.
+	goCache := filepath.Join(dir, "gocache")
+	if err := os.Mkdir(goCache, 0775); err != nil {
+		t.Fatalf("Mkdir error: %v", err)
+	}
+	conf := execConfig{gc: "go", fmt: "gofmt", dockerImage: "golang:test", docker: docker, dockerArgs: []string{"--memory=1g"}, goEnv: []string{"GOCACHE=" + goCache}}
+	ex := newExecutor(newBlobStore(), conf, func(action, data string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		switch action {
+		case appendStdout, appendStderr:
+			out.WriteString(data)
+		case statusStopped:
+			stopped <- data
+		}
+		return nil
+	})
+	defer ex.Close()
+	output := func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return out.String()
+	}
+
+	ex.Start(actionRun, "package main\n\nimport \"os\"\n\nfunc main() { println(\"modules\", os.Getenv(\"GO111MODULE\")) }\n")
+	select {
+	case <-stopped:
+	case <-time.After(30 * time.Second):
+		t.Fatal("run did not finish")
+	}
+	if got := output(); got != "modules off\n" {
+		t.Errorf("output = %q, want %q", got, "modules off\n")
+	}
+	b, _ := ioutil.ReadFile(logFile)
+	opts := fmt.Sprintf(`run --name=playground-[0-9a-f]{16} --rm --init --user=%d:%d --volume=%[3]s:%[3]s --workdir=%[3]s --env=HOME=/tmp --memory=1g --env=GO111MODULE --volume=%[4]s:%[4]s --env=GOCACHE golang:test`,
+		os.Getuid(), os.Getgid(), regexp.QuoteMeta(ex.tmpDir), regexp.QuoteMeta(goCache))
+	for _, cmd := range []string{"go build", "./main"} {
+		if !regexp.MustCompile("(?m)^" + opts + " " + regexp.QuoteMeta(cmd)).Match(b) {
+			t.Errorf("no container ran %q; docker invocations:\n%s", cmd, b)
+		}
+	}
+	if fis, _ := ioutil.ReadDir(goCache); len(fis) == 0 {
+		t.Errorf("build cache %s is empty", goCache)
+	}
+
+	// Only the containers of programs without network access have no network.
+	os.Remove(logFile)
+	ex.Start(actionRun, "package main\n\n//playground:nonet\n\nfunc main() {}\n")
+	select {
+	case <-stopped:
+	case <-time.After(30 * time.Second):
+		t.Fatal("run did not finish")
+	}
+	b, _ = ioutil.ReadFile(logFile)
+	if !regexp.MustCompile(`(?m)^run .* --network=none .*\./main$`).Match(b) || regexp.MustCompile(`(?m)^run .* --network=none .* go build`).Match(b) {
+		t.Errorf("network of containers not disabled for the program only; docker invocations:\n%s", b)
+	}
+
+	// Containers whose client is stopped by a signal are killed.
+	os.Remove(logFile)
+	mu.Lock()
+	out.Reset()
+	mu.Unlock()
+	ex.Start(actionRun, "package main\n\nimport \"time\"\n\nfunc main() { println(\"sleeping\"); time.Sleep(time.Hour) }\n")
+	for i := 0; !strings.Contains(output(), "sleeping"); i++ {
+		if i == 200 {
+			t.Fatal("program did not start")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	ex.Interrupt()
+	select {
+	case <-stopped:
+	case <-time.After(10 * time.Second):
+		t.Fatal("run did not stop")
+	}
+	b, _ = ioutil.ReadFile(logFile)
+	m := regexp.MustCompile(`(?m)^run --name=(playground-[0-9a-f]+) .* \./main$`).FindSubmatch(b)
+	if m == nil || !strings.Contains(string(b), "\nkill "+string(m[1])+"\n") {
+		t.Errorf("container of the stopped program was not killed; docker invocations:\n%s", b)
+	}
+}
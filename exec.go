@@ -6,23 +6,34 @@ package main
 
 import (
 	"bytes"
+	"container/list"
 	"context"
-	"crypto/md5"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"go/ast"
+	"go/build"
+	"go/importer"
 	"go/parser"
+	"go/scanner"
 	"go/token"
+	"go/types"
 	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"regexp"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
+	"time"
+	"unicode/utf8"
 )
 
 const (
@@ -33,7 +44,15 @@ const (
 	tagVersions  = "goversions" // Runs the binary across all of the listed versions
 	tagBuildArgs = "buildargs"  // Builds the binary with the specified flags
 	tagExecArgs  = "execargs"   // Executes the binary with the specified flags
-	tagProfile   = "pprof"      // Runs pprof on the test; args are "cpu" and/or "mem"
+	tagProfile   = "pprof"      // Runs pprof on the test; args are "cpu", "mem", "block", "mutex", and/or "trace"
+	tagCover     = "cover"      // Generates a coverage report for the test; optional arg is the cover mode
+	tagAsm       = "asm"        // Reports the generated assembly instead of executing the binary
+	tagGCFlags   = "gcflags"    // Builds the binary with the specified compiler flags; "-m" annotates optimizations
+	tagBuildTags = "buildtags"  // Builds the binary with the specified build tags
+	tagCGO       = "cgo"        // Enables or disables cgo for the build; arg is "on" (the default) or "off"
+	tagGenerate  = "generate"   // Runs "go generate" with the specified flags before building
+	tagGCTrace   = "gctrace"    // Runs the binary with GODEBUG=gctrace=1 and reports the GC cycles as a JSON series
+	tagNoNet     = "nonet"      // Runs the binary without network access, except for its own loopback interface
 )
 
 // Communication with the executor is done by sending requests and receiving
@@ -44,19 +63,45 @@ const (
 // These constants define all possible actions.
 const (
 	// Sent by client to server.
-	actionFormat = "format" // Server formats the Go source in the data
-	actionRun    = "run"    // Server runs the Go source in the data
-	actionStop   = "stop"   // Stop any on-going format or run actions
+	actionFormat     = "format"        // Server formats the Go source in the data
+	actionFormatRun  = "formatRun"     // Server formats the Go source in the data, replies with the format action, and then runs it
+	actionRun        = "run"           // Server runs the Go source in the data
+	actionLint       = "lint"          // Server runs all configured linters on the Go source in the data
+	actionReplay     = "replay"        // Server replays the output of the recent run with the ID in the data
+	actionHistory    = "history"       // Server lists recent runs; server replies with a JSON list of dicts with "id", "time", and "code" fields
+	actionCheck      = "check"         // Server type-checks the Go source in the data without building it; server replies with a JSON list of dicts with "line", "column", "kind", and "message" fields
+	actionStop       = "stop"          // Stop any on-going format, run, lint, replay, or debug actions
+	actionSignal     = "signal"        // Server sends the signal named in the data (e.g., "SIGQUIT") to the running program
+	actionDebug      = "debug"         // Server builds the Go source in the data without optimizations and runs it halted under the Delve debugger
+	actionBreakpoint = "setBreakpoint" // Server sets a breakpoint on the line number in the data of the program being debugged
+	actionContinue   = "continue"      // Server continues the program being debugged until it hits a breakpoint or exits
+	actionStep       = "step"          // Server steps the program being debugged to the next source line, stepping over function calls
+	actionInspect    = "inspect"       // Server evaluates the Go expression in the data in the current scope of the program being debugged
+	actionSnapshot   = "snapshot"      // Server stores the workspace along with the Go source in the data as a snapshot; server responds with the ID of the snapshot as the data
+	actionRestore    = "restore"       // Server replaces the workspace with the snapshot with the ID in the data, which is kept across later runs; server responds with the format action
+	actionDownload   = "download"      // Server archives the workspace along with the Go source in the data as a zip file; server responds with the blob ID of the archive as the data
+	actionUpload     = "upload"        // Server writes the file in the data, a JSON dict with "name" and base64-encoded "data" fields, into the workspace, where it is kept across later runs until the next restore
+	actionOpen       = "open"          // Server records the benchmark results of later runs in the history of the snippet with the ID in the data; an empty ID stops recording
+	actionJoin       = "join"          // Server adds the client to the collaborative editing session of the snippet with the ID in the data
+	actionLeave      = "leave"         // Server removes the client from its collaborative editing session
+	actionEdit       = "edit"          // Server relays the Go source in the data to all other collaborators; server sends this when another collaborator edits
+	actionCursor     = "cursor"        // Server relays the JSON dict with "line" and "ch" fields to all other collaborators; server sends this with added "id" and "user" fields
+	actionShare      = "share"         // Server responds with the watch ID of the client's session as the data
+	actionWatch      = "watch"         // Server forwards the output of the session with the watch ID in the data in read-only mode; an empty ID stops watching
 
 	// Sent by server to client.
 	clearOutput   = "clearOutput"   // Client clears the output console; has no data
-	markLines     = "markLines"     // Client highlights the specified lines; data is JSON list of integers
+	markLines     = "markLines"     // Client highlights the specified lines; data is JSON list of integers for errors, or JSON dict with "mode" ("covered" or "uncovered") and "lines" fields
+	markNotes     = "markNotes"     // Client annotates the specified lines; data is JSON list of dicts with "line", "column" (zero if unknown), "kind", and "message" fields
 	appendStdout  = "appendStdout"  // Client appends the data as stdout from the server's action
 	appendStderr  = "appendStderr"  // Client appends the data as stderr from the server's action
-	reportProfile = "reportProfile" // Server informs client about new profile; data is JSON dict with "name" and "id" fields
+	reportProfile = "reportProfile" // Server informs client about new profile; data is JSON dict with "name" and "id" fields, and a "mime" field if served as a blob or a "url" field if not
 	statusStarted = "statusStarted" // Server informs client that some action started; data is optional message
 	statusUpdate  = "statusUpdate"  // Server informs client about some on-going action; data is required message
-	statusStopped = "statusStopped" // Server informs client that some action stopped; data is optional message
+	statusStopped = "statusStopped" // Server informs client that some action stopped; data is the JSON exit status if a program ran
+	debugState    = "debugState"    // Client highlights where the program being debugged stopped; data is JSON dict with "line" (zero if outside the snippet), "file", and "function" fields, "exited" and "exitCode" fields, or no fields once the program starts halted
+	collabMembers = "collabMembers" // Client updates the list of collaborators; data is JSON list of dicts with "id" and "user" fields
+	sessionToken  = "sessionToken"  // Client stores the data as the token to resume the session with upon reconnecting
 )
 
 type writerFunc func([]byte) (int, error)
@@ -65,20 +110,196 @@ func (wf writerFunc) Write(b []byte) (int, error) {
 	return wf(b)
 }
 
-type executor struct {
-	// blobStore is a synchronized map of MD5 hashes to binary blobs.
-	bs   *blobStore
-	bmu  sync.Mutex // Protects bids
-	bids []string   // List of blob IDs to clear out
-
+// execConfig is the set of tools and settings used by an executor.
+type execConfig struct {
 	// gc, fmt, and gcs are full paths to the go and gofmt binaries.
 	gc  string            // Go binary to use
 	fmt string            // Go formatter to use
 	gcs map[string]string // Other Go versions available
 
+	// dlv is the full path to the Delve debugger used by the debug action.
+	// If empty, debugging is not supported.
+	dlv string
+
+	// linters is a map of linter names to the binaries that implement them.
+	// Each binary is invoked with the name of the source file as the argument.
+	linters map[string]string
+
+	// generators is a map of command names to the binaries that implement
+	// them, which are available to go:generate directives.
+	generators map[string]string
+
+	// modules builds snippets in module mode, downloading the modules that
+	// they import. If allowedModules is non-empty, only the modules matching
+	// one of its entries may be imported (see allowedModule).
+	modules        bool
+	allowedModules []string
+
+	// goEnv is the additional environment of builds and runs
+	// (e.g., GOPROXY=https://proxy.example.com).
+	goEnv []string
+
+	// maxOutput is the maximum number of bytes of stdout and stderr sent
+	// for each task. If exceeded, the output is truncated and the process
+	// is killed. If zero, the output is unlimited.
+	maxOutput int64
+
+	// maxDisk is the maximum number of bytes of files in tmpDir while the
+	// commands of a task run. If exceeded, the process is killed.
+	// If zero, the disk usage is unlimited.
+	maxDisk int64
+
+	// maxReport is the maximum number of bytes of each report stored in
+	// blobStore, or defaultMaxReport if zero. maxReports is the maximum
+	// total number of bytes of the reports of the executor at once,
+	// which are deleted by the next run. If zero, it is unlimited.
+	maxReport  int64
+	maxReports int64
+
+	// cache holds the output of prior runs. It may be nil.
+	cache *runCache
+
+	// queue limits the number of concurrent runs. It may be nil.
+	queue *runQueue
+
+	// tracer records spans for the phases of each run. It may be nil.
+	tracer *tracer
+
+	// recordBench is called with the benchmark results of each successful
+	// run of a snippet with a known ID (see executor.SetSnippet) and the
+	// name of the Go version used, which is empty for the default.
+	// It may be nil.
+	recordBench func(snippetID int64, goVersion string, rs []benchResult)
+
+	// snippetFiles returns the data files attached to the snippet with the
+	// given ID (see executor.SetSnippet), which are written to the testdata
+	// directory for every run. It may be nil.
+	snippetFiles func(snippetID int64) (map[string][]byte, error)
+
+	// runDone is called with the result of each run once it finishes,
+	// unless it was stopped before it started. It may be nil.
+	runDone func(runResult)
+
+	// pprofUI serves the profiles of runs in interactive pprof web UIs.
+	// It may be nil.
+	pprofUI *pprofServers
+
+	// disableCGO builds programs with cgo disabled unless they enable it
+	// with the cgo magic comment.
+	disableCGO bool
+
+	// stopSignal is sent to a running process when it is stopped, and the
+	// process is only killed if it does not exit within stopGrace.
+	// If stopSignal is nil, the process is killed immediately.
+	stopSignal os.Signal
+	stopGrace  time.Duration
+
+	// workers are the remote workers that runs are dispatched to.
+	// If it is nil or no worker is connected, runs are local.
+	workers *workerPool
+
+	// runTimeout is how long a program may run. Once exceeded, the program
+	// is sent SIGQUIT so that the Go runtime dumps the stack traces of all
+	// goroutines, and it is killed if it does not exit within stopGrace.
+	// If zero, programs may run forever.
+	runTimeout time.Duration
+
+	// dockerImage is the Docker image in which each build and run happens,
+	// with only tmpDir mounted (see dockerCommand). The Go binaries are then
+	// paths within the image. docker is the docker client binary and
+	// dockerArgs are additional arguments of "docker run" (e.g., limits).
+	// If dockerImage is empty, commands run on the host.
+	dockerImage string
+	docker      string
+	dockerArgs  []string
+
+	// vms runs each build and run in a fresh microVM of the pool instead
+	// (see vmCommand). It takes precedence over dockerImage. It may be nil.
+	vms *vmPool
+
+	// security restricts the programs of snippets that run on the host
+	// (see programCommand). It may be nil.
+	security *securityProfile
+
+	// disableNetwork runs every program without network access,
+	// as if with the nonet magic comment (see programCommand).
+	disableNetwork bool
+
+	// niceness is the nice value of programs on the host, which lowers
+	// their priority if positive. cpuLimit is the number of CPUs that
+	// each program may use, which is enforced by a cgroup created within
+	// cgroupDir on the host (see cgroupCommand) or by the container.
+	// If zero, programs have the priority and CPU usage of the playground.
+	niceness  int
+	cpuLimit  float64
+	cgroupDir string
+}
+
+// runResult summarizes a finished run for execConfig.runDone.
+type runResult struct {
+	snippetID int64         // Zero if unknown
+	status    string        // JSON exit status of the last program run; empty if none ran
+	duration  time.Duration // Wall-clock duration of the entire run
+	output    string        // Output of the run, including status updates
+	reports   []string      // Data of the reportProfile messages
+}
+
+// defaultStopGrace is how long a stopped process may take to exit
+// before it is killed.
+const defaultStopGrace = 2 * time.Second
+
+// defaultMaxReport is the maximum size of a report if maxReport is not set.
+const defaultMaxReport = 16 << 20
+
+// diskCheckInterval is how often the disk usage of tmpDir is measured
+// while a process runs if maxDisk is set.
+const diskCheckInterval = 200 * time.Millisecond
+
+// signals are the signals that may be sent to a process by name.
+var signals = map[string]syscall.Signal{
+	"SIGHUP":  syscall.SIGHUP,
+	"SIGINT":  syscall.SIGINT,
+	"SIGTERM": syscall.SIGTERM,
+	"SIGQUIT": syscall.SIGQUIT,
+	"SIGKILL": syscall.SIGKILL,
+	"SIGUSR1": syscall.SIGUSR1,
+	"SIGUSR2": syscall.SIGUSR2,
+}
+
+type executor struct {
+	// blobStore is a synchronized map of MD5 hashes to binary blobs.
+	bs     *blobStore
+	bmu    sync.Mutex   // Protects bids and pprofs
+	bids   []reportInfo // List of blobs to clear out
+	pprofs []string     // List of pprof web UI IDs to stop
+
+	execConfig
+
 	// tmpDir is a temporary directory to use for running binaries.
 	tmpDir string
 
+	// env is the additional environment of the commands of an on-going run.
+	// It is only accessed by the goroutine of the run.
+	env []string
+
+	// progArgs are additional arguments of dockerCommand for the container
+	// of a program (see programCommand). It is only accessed by the
+	// goroutine of the run.
+	progArgs []string
+
+	// keep is the set of names at the top of tmpDir that were restored from
+	// a snapshot or uploaded, which are kept across runs. It is only accessed
+	// by the goroutine of a task.
+	keep map[string]bool
+
+	// timedOut reports whether the last process of an on-going run exceeded
+	// its time limit. It is only accessed by the goroutine of the run.
+	timedOut bool
+
+	// diskExceeded reports whether the last process of an on-going run
+	// exceeded maxDisk. It is only accessed by the goroutine of the run.
+	diskExceeded bool
+
 	// sendMsg is a callback for the server to send (action, data) messages
 	// back to the client.
 	sendMsg func(action, data string) error
@@ -88,31 +309,110 @@ type executor struct {
 	stdout io.Writer
 	stderr io.Writer
 
-	mu     sync.Mutex // Protects closed, ctx, and cancel
-	closed bool
-	ctx    context.Context
-	cancel context.CancelFunc
-	wg     sync.WaitGroup
+	// outSize is the number of bytes of output sent for the on-going task.
+	omu       sync.Mutex // Protects outSize and truncated
+	outSize   int64
+	truncated bool
+
+	// rec records the messages of an on-going run for storage in the cache
+	// and in the run history.
+	rmu sync.Mutex // Protects rec
+	rec *cachedRun
+
+	// history is a ring buffer of the most recent runs, indexed by run ID
+	// modulo maxRunHistory.
+	hmu     sync.Mutex // Protects history and numRuns
+	history [maxRunHistory]*runRecord
+	numRuns int
+
+	// state is the current activity of the executor and
+	// stateTime is when it was entered.
+	smu       sync.Mutex // Protects state and stateTime
+	state     string
+	stateTime time.Time
+
+	mu          sync.Mutex // Protects closed, ctx, cancel, checkCancel, proc, snippetID, dbg, worker, and workerJob
+	closed      bool
+	ctx         context.Context
+	cancel      context.CancelFunc
+	checkCancel context.CancelFunc // Cancels the on-going check; nil if none
+	proc        *os.Process        // Currently running process; nil if none
+	snippetID   int64              // ID of the snippet that runs belong to; zero if unknown
+	dbg         *debugSession      // Debugger of the on-going debug action; nil if none
+	worker      *workerConn        // Worker of the on-going remote run; nil if none
+	workerJob   int64              // Job of the on-going remote run on worker
+	wg          sync.WaitGroup
+
+	cmu    sync.Mutex     // Serializes sending the diagnostics of checks
+	checks sync.WaitGroup // On-going checks, which Stop does not wait for
 }
 
-func newExecutor(bs *blobStore, gcBin, fmtBin string, gcs map[string]string, sendMsg func(action, data string) error) *executor {
+func newExecutor(bs *blobStore, conf execConfig, sendMsg func(action, data string) error) *executor {
 	tmpDir, err := ioutil.TempDir("", "sandbox")
 	if err != nil {
 		sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
 	}
 
-	ex := &executor{bs: bs, gc: gcBin, fmt: fmtBin, gcs: gcs, tmpDir: tmpDir, sendMsg: sendMsg}
+	ex := &executor{bs: bs, execConfig: conf, tmpDir: tmpDir}
+	ex.setState(execIdle)
+	ex.sendMsg = func(action, data string) error {
+		if action == statusStopped {
+			ex.setState(execIdle)
+		}
+		ex.recordMsg(action, data)
+		return sendMsg(action, data)
+	}
 	ex.stdout = writerFunc(func(b []byte) (int, error) {
-		return len(b), sendMsg(appendStdout, string(b))
+		return len(b), ex.sendOutput(appendStdout, b)
 	})
 	ex.stderr = writerFunc(func(b []byte) (int, error) {
-		return len(b), sendMsg(appendStderr, string(b))
+		return len(b), ex.sendOutput(appendStderr, b)
 	})
 	ex.ctx, ex.cancel = context.WithCancel(context.Background())
 	return ex
 }
 
-// Start handles either the format or run actions on some given Go source code.
+// sendOutput sends the output of a process to the client. Once maxOutput is
+// exceeded, the output is truncated and the task is stopped by killing
+// the process, since a process that floods the client may never stop.
+func (ex *executor) sendOutput(action string, b []byte) error {
+	if ex.maxOutput > 0 {
+		ex.omu.Lock()
+		if ex.truncated {
+			ex.omu.Unlock()
+			return nil
+		}
+		exceeded := int64(len(b)) > ex.maxOutput-ex.outSize
+		if exceeded {
+			n := ex.maxOutput - ex.outSize
+			for n > 0 && !utf8.RuneStart(b[n]) {
+				n-- // Avoid splitting a multi-byte character
+			}
+			b = b[:n]
+			ex.truncated = true
+		}
+		ex.outSize += int64(len(b))
+		ex.omu.Unlock()
+
+		if exceeded {
+			if len(b) > 0 {
+				ex.sendMsg(action, string(b))
+			}
+			ex.sendMsg(statusUpdate, fmt.Sprintf("\nOutput limit exceeded (%d bytes); program killed.\n", ex.maxOutput))
+			ex.mu.Lock()
+			if ex.proc != nil {
+				ex.proc.Kill()
+			}
+			ex.cancel()
+			ex.mu.Unlock()
+			return nil
+		}
+	}
+	return ex.sendMsg(action, string(b))
+}
+
+// Start handles either the format, formatRun, run, lint, replay, debug,
+// snapshot, or restore actions on some given data.
 // If there is already an on-going action, then this stops that action before
 // preceding with the new action.
 func (ex *executor) Start(action, data string) {
@@ -125,28 +425,134 @@ func (ex *executor) Start(action, data string) {
 		return
 	}
 	ex.ctx, ex.cancel = context.WithCancel(context.Background())
-	ex.wg.Add(1) // Done is called either in handleFormat or handleRun
+	ex.wg.Add(1) // Done is called by the handler of the action
 	ex.mu.Unlock()
+	ex.omu.Lock()
+	ex.outSize, ex.truncated = 0, false
+	ex.omu.Unlock()
 
 	switch action {
 	case actionFormat:
+		ex.setState(execFormatting)
 		ex.sendMsg(statusStarted, "")
 		go ex.handleFormat(data)
+	case actionFormatRun:
+		ex.setState(execFormatting)
+		ex.sendMsg(statusStarted, "")
+		go ex.handleFormatRun(data)
 	case actionRun:
+		ex.setState(execQueued)
 		ex.sendMsg(statusStarted, "")
 		go ex.handleRun(data)
+	case actionLint:
+		ex.setState(execLinting)
+		ex.sendMsg(statusStarted, "")
+		go ex.handleLint(data)
+	case actionReplay:
+		ex.setState(execReplaying)
+		ex.sendMsg(statusStarted, "")
+		go ex.handleReplay(data)
+	case actionDebug:
+		ex.setState(execQueued)
+		ex.sendMsg(statusStarted, "")
+		go ex.handleDebug(data)
+	case actionSnapshot:
+		ex.sendMsg(statusStarted, "")
+		go ex.handleSnapshot(data)
+	case actionRestore:
+		ex.sendMsg(statusStarted, "")
+		go ex.handleRestore(data)
+	case actionDownload:
+		ex.sendMsg(statusStarted, "")
+		go ex.handleDownload(data)
+	case actionUpload:
+		ex.sendMsg(statusStarted, "")
+		go ex.handleUpload(data)
 	default:
 		ex.sendMsg(statusUpdate, fmt.Sprintf("Unknown action: %s\n", action))
 		ex.wg.Done()
 	}
 }
 
+// SetSnippet associates later runs with the snippet of the given ID
+// so that their benchmark results are recorded. Zero disassociates them.
+func (ex *executor) SetSnippet(id int64) {
+	ex.mu.Lock()
+	ex.snippetID = id
+	ex.mu.Unlock()
+}
+
+// Snippet returns the ID of the snippet that runs belong to,
+// which is zero if unknown.
+func (ex *executor) Snippet() int64 {
+	ex.mu.Lock()
+	defer ex.mu.Unlock()
+	return ex.snippetID
+}
+
 // Stop cancels any on-going tasks and blocks until all tasks have stopped.
 func (ex *executor) Stop() {
+	ex.Interrupt()
+	ex.wg.Wait()
+}
+
+// Interrupt cancels any on-going task without waiting for it to stop.
+// Unlike Stop, it may be called concurrently with Start.
+func (ex *executor) Interrupt() {
 	ex.mu.Lock()
 	ex.cancel()
 	ex.mu.Unlock()
-	ex.wg.Wait()
+}
+
+// Signal sends the named signal to the running program, if any.
+func (ex *executor) Signal(name string) {
+	sig, ok := signals[name]
+	if !ok {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unknown signal: %s\n", name))
+		return
+	}
+	ex.mu.Lock()
+	p, w, job := ex.proc, ex.worker, ex.workerJob
+	ex.mu.Unlock()
+	if w != nil {
+		w.send(workerMsg{Job: job, Action: actionSignal, Data: name})
+		return // The worker reports the outcome
+	}
+	if state, _ := ex.State(); p == nil || state != execRunning {
+		ex.sendMsg(statusUpdate, "No program is running.\n")
+		return
+	}
+	if err := p.Signal(sig); err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to send %s: %v\n", name, err))
+		return
+	}
+	ex.sendMsg(statusUpdate, fmt.Sprintf("Sent %s to program.\n", name))
+}
+
+// Executor states reported by State.
+const (
+	execIdle       = "idle"
+	execQueued     = "queued"
+	execBuilding   = "building"
+	execRunning    = "running"
+	execProfiling  = "profiling"
+	execFormatting = "formatting"
+	execLinting    = "linting"
+	execReplaying  = "replaying"
+	execDebugging  = "debugging"
+)
+
+func (ex *executor) setState(state string) {
+	ex.smu.Lock()
+	defer ex.smu.Unlock()
+	ex.state, ex.stateTime = state, time.Now()
+}
+
+// State reports the current activity of the executor and when it started.
+func (ex *executor) State() (state string, since time.Time) {
+	ex.smu.Lock()
+	defer ex.smu.Unlock()
+	return ex.state, ex.stateTime
 }
 
 // Close stops any on-going tasks and releases any used resources.
@@ -154,59 +560,556 @@ func (ex *executor) Close() {
 	ex.mu.Lock()
 	ex.closed = true
 	ex.cancel()
+	if ex.checkCancel != nil {
+		ex.checkCancel()
+	}
 	ex.mu.Unlock()
 	ex.wg.Wait()
+	ex.checks.Wait()
 	ex.deleteBlobs()
 	os.RemoveAll(ex.tmpDir)
 }
 
-// deleteBlobs removes all blobs that this executor added to the blobStore.
+// deleteBlobs removes all blobs that this executor added to the blobStore
+// and stops all pprof web UIs that it started.
 func (ex *executor) deleteBlobs() {
 	ex.bmu.Lock()
-	for _, id := range ex.bids {
-		ex.bs.Delete(id)
+	for _, ri := range ex.bids {
+		ex.bs.Delete(ri.ID)
 	}
-	ex.bids = nil
+	for _, id := range ex.pprofs {
+		ex.pprofUI.Stop(id)
+	}
+	ex.bids, ex.pprofs = nil, nil
 	ex.bmu.Unlock()
 }
 
+// forgetBlob removes a blob that the blobStore evicted from the list of
+// blobs to delete.
+func (ex *executor) forgetBlob(id string) {
+	ex.bmu.Lock()
+	defer ex.bmu.Unlock()
+	for i, ri := range ex.bids {
+		if ri.ID == id {
+			ex.bids = append(ex.bids[:i], ex.bids[i+1:]...)
+			break
+		}
+	}
+}
+
+// reportInfo describes a blob that an executor added to the blobStore.
+type reportInfo struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Mime string `json:"mime"`
+	Size int64  `json:"size"`
+}
+
+// Reports returns the blobs that the executor added to the blobStore and
+// has yet to delete, in the order they were added.
+func (ex *executor) Reports() []reportInfo {
+	ex.bmu.Lock()
+	defer ex.bmu.Unlock()
+	return append([]reportInfo(nil), ex.bids...)
+}
+
+// reportLimit returns the maximum number of bytes of a report.
+func (ex *executor) reportLimit() int64 {
+	if ex.maxReport > 0 {
+		return ex.maxReport
+	}
+	return defaultMaxReport
+}
+
+// storeBlob stores the named report in blobStore and returns its ID. Like the
+// other blobs of the executor, it is deleted by the next run. It fails if the
+// report exceeds maxReport or if the reports would exceed maxReports.
+func (ex *executor) storeBlob(name string, b blob) (string, error) {
+	if max := ex.reportLimit(); int64(len(b.data)) > max {
+		return "", fmt.Errorf("file too large: %d bytes, limit is %d bytes", len(b.data), max)
+	}
+	if ex.maxReports > 0 {
+		ex.bmu.Lock()
+		total := int64(len(b.data))
+		for _, ri := range ex.bids {
+			total += ri.Size
+		}
+		ex.bmu.Unlock()
+		if total > ex.maxReports {
+			return "", fmt.Errorf("reports too large: %d bytes in total, limit is %d bytes", total, ex.maxReports)
+		}
+	}
+
+	// The blobStore may call forgetBlob, so bmu must not be held.
+	id, err := ex.bs.Insert(b, ex.forgetBlob)
+	if err != nil {
+		return "", err
+	}
+	ex.bmu.Lock()
+	ex.bids = append(ex.bids, reportInfo{id, name, b.mime, int64(len(b.data))}) // Make sure executor knows to delete this later
+	ex.bmu.Unlock()
+	return id, nil
+}
+
+// startRecording starts recording all messages sent to the client.
+func (ex *executor) startRecording() {
+	ex.rmu.Lock()
+	ex.rec = &cachedRun{ok: true}
+	ex.rmu.Unlock()
+}
+
+// stopRecording stops recording and returns the recorded messages.
+func (ex *executor) stopRecording() *cachedRun {
+	ex.rmu.Lock()
+	defer ex.rmu.Unlock()
+	rec := ex.rec
+	ex.rec = nil
+	return rec
+}
+
+// recordMsg records the message if there is an on-going recording.
+// Reports are not recorded and make the run uncacheable since the reported
+// blobs are deleted when the next run starts. Output beyond maxCachedRunSize
+// is not recorded either. Check results describe the code being edited
+// rather than the run and are ignored.
+func (ex *executor) recordMsg(action, data string) {
+	ex.rmu.Lock()
+	defer ex.rmu.Unlock()
+	if ex.rec == nil || action == actionCheck {
+		return
+	}
+	if action == reportProfile {
+		ex.rec.reports = append(ex.rec.reports, data)
+		ex.rec.ok = false
+		return
+	}
+	if ex.rec.size > maxCachedRunSize {
+		return
+	}
+	ex.rec.size += len(data)
+	if ex.rec.size > maxCachedRunSize {
+		ex.rec.ok = false
+		return
+	}
+	ex.rec.msgs = append(ex.rec.msgs, cachedMsg{action, data})
+}
+
+// addHistory adds a run of code with the given output to the run history.
+func (ex *executor) addHistory(code string, msgs []cachedMsg) {
+	ex.hmu.Lock()
+	defer ex.hmu.Unlock()
+	id := ex.numRuns
+	ex.history[id%maxRunHistory] = &runRecord{id: id, time: time.Now(), code: code, msgs: msgs}
+	ex.numRuns++
+}
+
+// lookupHistory returns the run with the given ID or nil if it is no longer
+// in the run history.
+func (ex *executor) lookupHistory(id int) *runRecord {
+	ex.hmu.Lock()
+	defer ex.hmu.Unlock()
+	if id < 0 || id >= ex.numRuns || id < ex.numRuns-maxRunHistory {
+		return nil
+	}
+	return ex.history[id%maxRunHistory]
+}
+
+// ListHistory sends the client a list of the recent runs, newest first.
+// Unlike Start, this does not stop any on-going action.
+func (ex *executor) ListHistory() {
+	type runInfo struct {
+		ID   int       `json:"id"`
+		Time time.Time `json:"time"`
+		Code string    `json:"code"`
+	}
+	runs := []runInfo{}
+	ex.hmu.Lock()
+	for id := ex.numRuns - 1; id >= 0 && id >= ex.numRuns-maxRunHistory; id-- {
+		r := ex.history[id%maxRunHistory]
+		runs = append(runs, runInfo{r.id, r.time, r.code})
+	}
+	ex.hmu.Unlock()
+	b, _ := json.Marshal(runs)
+	ex.sendMsg(actionHistory, string(b))
+}
+
+// Check type-checks code in the background and sends the client the
+// diagnostics. Unlike Start, this does not stop any on-going action,
+// but it cancels any on-going check since its diagnostics are stale.
+func (ex *executor) Check(code string) {
+	ex.mu.Lock()
+	if ex.closed {
+		ex.mu.Unlock()
+		return
+	}
+	if ex.checkCancel != nil {
+		ex.checkCancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	ex.checkCancel = cancel
+	ex.checks.Add(1)
+	ex.mu.Unlock()
+
+	go func() {
+		defer ex.checks.Done()
+		if ctx.Err() != nil {
+			return
+		}
+		if src, _, err := splitFiles(code); err == nil {
+			code = src // Only the main file is checked
+		}
+		b, _ := json.Marshal(checkSource(code))
+
+		// Checking for cancelation while holding cmu ensures that
+		// the diagnostics of an older check are never sent after newer ones.
+		ex.cmu.Lock()
+		defer ex.cmu.Unlock()
+		if ctx.Err() == nil {
+			ex.sendMsg(actionCheck, string(b))
+		}
+	}()
+}
+
+// maxCheckNotes is the maximum number of diagnostics reported by checkSource.
+const maxCheckNotes = 50
+
+// checkImporter imports packages for checkSource from their compiled
+// export data. It caches imported packages and is not safe for concurrent use.
+var checkImporter = struct {
+	sync.Mutex
+	types.Importer
+}{Importer: importer.Default()}
+
+// checkSource parses and type-checks code as a main package and returns
+// any errors found as notes of the "error" kind.
+// Packages that cannot be imported are not reported since they may be
+// fetched when the snippet is actually built.
+func checkSource(code string) []note {
+	notes := []note{}
+	add := func(pos token.Position, msg string) {
+		if len(notes) < maxCheckNotes {
+			notes = append(notes, note{pos.Line, pos.Column, "error", msg})
+		}
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "main.go", code, parser.AllErrors)
+	if err != nil {
+		if el, ok := err.(scanner.ErrorList); ok {
+			el.RemoveMultiples() // Avoid cascading errors on the same line
+			for _, e := range el {
+				add(e.Pos, e.Msg)
+			}
+		} else {
+			add(token.Position{Line: 1, Column: 1}, err.Error())
+		}
+		return notes
+	}
+
+	checkImporter.Lock()
+	defer checkImporter.Unlock()
+	conf := types.Config{
+		Importer: checkImporter.Importer,
+		Error: func(err error) {
+			if e, ok := err.(types.Error); ok && !strings.HasPrefix(e.Msg, "could not import") {
+				add(fset.Position(e.Pos), e.Msg)
+			}
+		},
+	}
+	conf.Check("main", fset, []*ast.File{f}, nil)
+	return notes
+}
+
 // runCommand runs an arbitrary command in args and returns true if successful.
 // The stderr of the process is also captured and written to w.
 func (ex *executor) runCommand(w io.Writer, args ...string) bool {
-	cmd := exec.CommandContext(ex.ctx, args[0], args[1:]...)
+	return ex.run(ex.command(w, args...), 0)
+}
+
+// command returns the command in args to run in the sandbox.
+// The stderr of the process is also captured and written to w.
+func (ex *executor) command(w io.Writer, args ...string) *exec.Cmd {
+	// Modules are disabled to force operating in GOPATH mode,
+	// unless ex.env enables them for a run in module mode.
+	env := append([]string{"GO111MODULE=off"}, ex.env...)
+	switch {
+	case ex.vms != nil:
+		args = ex.vmCommand(args, env)
+	case ex.dockerImage != "":
+		args = ex.dockerCommand(args, env)
+	}
+	cmd := exec.Command(args[0], args[1:]...)
 	cmd.Dir = ex.tmpDir
 	cmd.Stdout = ex.stdout
 	cmd.Stderr = io.MultiWriter(ex.stderr, w)
-	// TODO: Modules are disabled to force operating in GOPATH mode.
-	// Add proper support for moules in the future.
-	if cmd.Env == nil {
-		cmd.Env = append([]string(nil), os.Environ()...)
-	}
-	cmd.Env = append(cmd.Env, "GO111MODULE=off")
-	if err := cmd.Run(); err != nil {
+	cmd.Env = append(append([]string(nil), os.Environ()...), env...)
+	return cmd
+}
+
+// run runs cmd with the time limit of runCmdTimeout
+// and returns true if successful.
+func (ex *executor) run(cmd *exec.Cmd, timeout time.Duration) bool {
+	if err := ex.runCmdTimeout(cmd, timeout); err != nil {
 		ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
 		return false
 	}
 	return true
 }
 
+// runCmd runs cmd until it exits or the current task is stopped.
+// A stopped process is first sent stopSignal so that it may run any cleanup
+// (e.g., deferred functions and flushing of profiles) before it is killed.
+func (ex *executor) runCmd(cmd *exec.Cmd) error {
+	return ex.runCmdTimeout(cmd, 0)
+}
+
+// runCmdTimeout is like runCmd, but if timeout is positive and the process
+// runs for longer, it is sent SIGQUIT so that the Go runtime dumps the stack
+// traces of all goroutines before exiting, which makes deadlocks debuggable.
+// The process is killed if it does not exit within stopGrace, or right away
+// if the files in tmpDir exceed maxDisk.
+func (ex *executor) runCmdTimeout(cmd *exec.Cmd, timeout time.Duration) error {
+	ex.timedOut, ex.diskExceeded = false, false
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	ex.mu.Lock()
+	ex.proc = cmd.Process
+	ex.mu.Unlock()
+	defer func() {
+		ex.mu.Lock()
+		ex.proc = nil
+		ex.mu.Unlock()
+		ex.killContainer(cmd)
+		ex.releaseVM(cmd)
+		removeCgroup(cmd)
+	}()
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	var timer <-chan time.Time
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		timer = t.C
+	}
+	var tick <-chan time.Time
+	if ex.maxDisk > 0 {
+		t := time.NewTicker(diskCheckInterval)
+		defer t.Stop()
+		tick = t.C
+	}
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-tick:
+			if diskUsage(ex.tmpDir) <= ex.maxDisk {
+				continue
+			}
+			ex.diskExceeded = true
+			ex.sendMsg(statusUpdate, fmt.Sprintf("\nDisk quota exceeded (%d bytes); program killed.\n", ex.maxDisk))
+			cmd.Process.Kill()
+			return <-done
+		case <-timer:
+			ex.timedOut = true
+			ex.sendMsg(statusUpdate, fmt.Sprintf("\nTime limit exceeded (%v); dumping goroutines.\n", timeout))
+			if cmd.Process.Signal(syscall.SIGQUIT) == nil {
+				t := time.NewTimer(ex.stopGrace)
+				defer t.Stop()
+				select {
+				case err := <-done:
+					return err
+				case <-t.C:
+				case <-ex.ctx.Done():
+				}
+			}
+			cmd.Process.Kill()
+			return <-done
+		case <-ex.ctx.Done():
+		}
+		break // Stopped
+	}
+	if ex.stopSignal != nil && ex.stopSignal != os.Kill && cmd.Process.Signal(ex.stopSignal) == nil {
+		t := time.NewTimer(ex.stopGrace)
+		defer t.Stop()
+		select {
+		case err := <-done:
+			return err
+		case <-t.C:
+		}
+	}
+	cmd.Process.Kill()
+	return <-done
+}
+
+// diskUsage returns the total size of the files in dir.
+// Files that vanish while dir is walked are ignored.
+func diskUsage(dir string) int64 {
+	var n int64
+	filepath.Walk(dir, func(_ string, fi os.FileInfo, err error) error {
+		if err == nil && fi.Mode().IsRegular() {
+			n += fi.Size()
+		}
+		return nil
+	})
+	return n
+}
+
+// exitStatus is the data of the statusStopped message sent after running
+// a program, which allows the client to distinguish failures from clean exits.
+type exitStatus struct {
+	ExitCode int     `json:"exitCode"`         // -1 if terminated by a signal
+	Signal   string  `json:"signal,omitempty"` // Name of the terminating signal (e.g., "SIGKILL")
+	Reason   string  `json:"reason"`           // One of "exit", "signal", "stopped", "outputLimit", "diskQuota", or "timeout"
+	Duration float64 `json:"duration"`         // Wall-clock duration in seconds
+	MaxRSS   int64   `json:"maxRSS"`           // Maximum resident set size in bytes
+}
+
+// exitStatus returns the JSON exit status of cmd, which ran for d,
+// or an empty string if cmd never started.
+// A process that is killed after exceeding a memory limit is reported as
+// terminated by SIGKILL, as there is no other way to tell it apart.
+func (ex *executor) exitStatus(cmd *exec.Cmd, d time.Duration) string {
+	ps := cmd.ProcessState
+	if ps == nil {
+		return ""
+	}
+	st := exitStatus{ExitCode: ps.ExitCode(), Reason: "exit", Duration: d.Seconds(), MaxRSS: maxRSS(ps)}
+	if ws, ok := ps.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+		st.Reason, st.Signal = "signal", ws.Signal().String()
+		for name, sig := range signals {
+			if sig == ws.Signal() {
+				st.Signal = name
+			}
+		}
+	}
+	ex.omu.Lock()
+	truncated := ex.truncated
+	ex.omu.Unlock()
+	switch {
+	case truncated:
+		st.Reason = "outputLimit"
+	case ex.diskExceeded:
+		st.Reason = "diskQuota"
+	case ex.timedOut:
+		st.Reason = "timeout"
+	case ex.ctx.Err() != nil:
+		st.Reason = "stopped"
+	}
+	b, _ := json.Marshal(st)
+	return string(b)
+}
+
+// maxRSS returns the maximum resident set size in bytes of the exited process
+// as reported by wait4, or zero if unavailable.
+func maxRSS(ps *os.ProcessState) int64 {
+	ru, ok := ps.SysUsage().(*syscall.Rusage)
+	if !ok {
+		return 0
+	}
+	if runtime.GOOS == "darwin" {
+		return int64(ru.Maxrss) // Already in bytes on macOS
+	}
+	return int64(ru.Maxrss) * 1024
+}
+
+// formatSize formats n bytes using the largest binary unit that fits.
+func formatSize(n int64) string {
+	switch {
+	case n >= 1<<30:
+		return fmt.Sprintf("%.1f GiB", float64(n)/(1<<30))
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MiB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KiB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
+
 // Regexp for parsing out line numbers from the stderr of go build.
 // This works on all versions of Go (current latest release is 1.8).
 var reLine = regexp.MustCompile(`^(\./)?main(_test)?\.go:(\d+)`)
 
 // reportBadLines parses the stderr of a go build for all offending lines.
+// The column and message of each error are also sent as notes so that the
+// client can underline the offending token and show the message inline.
+// Lines indented with a tab continue the message of the prior error.
 func (ex *executor) reportBadLines(b []byte) {
 	var lines []int
+	var notes []note
 	for _, s := range strings.Split(string(b), "\n") {
 		if m := reLine.FindString(s); m != "" {
-			m = m[strings.Index(m, ":")+1:]
-			i, _ := strconv.Atoi(m)
+			i, _ := strconv.Atoi(m[strings.Index(m, ":")+1:])
 			lines = append(lines, i)
+			n := note{Line: i, Kind: "error"}
+			if sm := reNote.FindStringSubmatch(s); sm != nil {
+				n.Column, _ = strconv.Atoi(sm[2])
+				n.Message = sm[3]
+			} else {
+				n.Message = strings.TrimSpace(strings.TrimPrefix(s[len(m):], ":")) // Column is unknown
+			}
+			notes = append(notes, n)
+		} else if strings.HasPrefix(s, "\t") && len(notes) > 0 {
+			notes[len(notes)-1].Message += "\n" + strings.TrimSpace(s)
 		}
 	}
 	if len(lines) > 0 {
 		b, _ := json.Marshal(lines)
 		ex.sendMsg(markLines, string(b))
+		b, _ = json.Marshal(notes)
+		ex.sendMsg(markNotes, string(b))
+	}
+}
+
+// note is an annotation of a source position sent to the client.
+type note struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+// Regexp for parsing out the position and message of diagnostics printed
+// by the compiler when optimization decisions are requested with -m.
+var reNote = regexp.MustCompile(`^(?:\./)?main(?:_test)?\.go:(\d+):(\d+): (.*)$`)
+
+// hasOptDiagnostics reports whether the compiler flags request diagnostics
+// about optimization decisions (e.g., -m, -m=2, or -m -m).
+func hasOptDiagnostics(gcFlags []string) bool {
+	for _, f := range gcFlags {
+		if f == "-m" || strings.HasPrefix(f, "-m=") {
+			return true
+		}
+	}
+	return false
+}
+
+// reportOptDiagnostics parses the stderr of a go build with the -m compiler
+// flag for escape analysis and inlining decisions.
+func (ex *executor) reportOptDiagnostics(b []byte) {
+	var notes []note
+	for _, s := range strings.Split(string(b), "\n") {
+		m := reNote.FindStringSubmatch(s)
+		if m == nil {
+			continue
+		}
+		n := note{Message: m[3], Kind: "info"}
+		n.Line, _ = strconv.Atoi(m[1])
+		n.Column, _ = strconv.Atoi(m[2])
+		switch {
+		case strings.Contains(n.Message, "does not escape"):
+			n.Kind = "noescape"
+		case strings.Contains(n.Message, "escapes to heap") || strings.HasPrefix(n.Message, "moved to heap"):
+			n.Kind = "escape"
+		case strings.Contains(n.Message, "inlin"):
+			n.Kind = "inline"
+		}
+		notes = append(notes, n)
+	}
+	if len(notes) > 0 {
+		b, _ := json.Marshal(notes)
+		ex.sendMsg(markNotes, string(b))
 	}
 }
 
@@ -231,53 +1134,457 @@ func (ex *executor) handleFormat(code string) {
 	defer ex.wg.Done()
 	defer ex.sendMsg(statusStopped, "")
 
-	// Format the input source.
+	if _, ok := ex.formatCode(code); !ok {
+		return
+	}
 	ex.sendMsg(clearOutput, "")
-	ex.sendMsg(statusUpdate, "Formatting source...\n")
-	if !ex.writeFile("main.go", code) {
+	ex.sendMsg(statusUpdate, "Source formatted.\n")
+}
+
+// handleFormatRun formats the source, sends it back to the client,
+// and then runs the formatted source, all in a single action.
+func (ex *executor) handleFormatRun(code string) {
+	code, ok := ex.formatCode(code)
+	if !ok || ex.ctx.Err() != nil {
+		ex.sendMsg(statusStopped, "")
+		ex.wg.Done()
 		return
 	}
+	ex.setState(execQueued)
+	ex.handleRun(code) // Calls ex.wg.Done and sends statusStopped
+}
+
+// formatCode formats the input source and sends it back to the client.
+// Any formatting errors are reported to the client.
+func (ex *executor) formatCode(code string) (string, bool) {
+	ex.sendMsg(clearOutput, "")
+	ex.sendMsg(statusUpdate, "Formatting source...\n")
+	src, files, err := splitFiles(code)
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Invalid file tree: %v\n", err))
+		return "", false
+	}
+	if !ex.writeFile("main.go", src) || !ex.writeFiles(files) {
+		return "", false
+	}
+	args := []string{ex.fmt, "-w", "main.go"}
+	for _, f := range files {
+		if strings.HasSuffix(f.name, ".go") {
+			args = append(args, filepath.FromSlash(f.name))
+		}
+	}
 	bb := new(bytes.Buffer)
-	if !ex.runCommand(bb, ex.fmt, "-w", "main.go") {
+	if !ex.runCommand(bb, args...) {
 		ex.reportBadLines(bb.Bytes())
+		return "", false
+	}
+	src, ok := ex.readFile("main.go")
+	for i := 0; ok && i < len(files); i++ {
+		files[i].data, ok = ex.readFile(filepath.FromSlash(files[i].name))
+	}
+	if !ok {
+		return "", false
+	}
+	code = joinFiles(src, files)
+	ex.sendMsg(actionFormat, code)
+	return code, true
+}
+
+func (ex *executor) handleLint(code string) {
+	const tmpName = "temp.go"
+
+	defer ex.wg.Done()
+	defer ex.sendMsg(statusStopped, "")
+	ex.sendMsg(clearOutput, "")
+
+	if len(ex.linters) == 0 {
+		ex.sendMsg(statusUpdate, "No linters configured.\n")
 		return
 	}
 
-	// Send the formatted source back to client.
-	code, ok := ex.readFile("main.go")
+	// Parse the source file to determine whether it is a test suite.
+	// Only the main file of a snippet with a file tree is linted.
+	if src, _, err := splitFiles(code); err == nil {
+		code = src
+	}
+	if !ex.writeFile(tmpName, code) {
+		return
+	}
+	info, ok := ex.parseFile(filepath.Join(ex.tmpDir, tmpName))
 	if !ok {
 		return
 	}
-	ex.sendMsg(actionFormat, code)
+	name := "main_test.go"
+	if info.hasMain {
+		name = "main.go"
+	}
+	if err := os.Rename(filepath.Join(ex.tmpDir, tmpName), filepath.Join(ex.tmpDir, name)); err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+		return
+	}
+
+	// Linters see the same environment that go build does.
+	ex.env = ex.snippetEnv(info)
+	defer func() { ex.env = nil }()
+
+	// Run each linter in a deterministic order.
+	var linters []string
+	for k := range ex.linters {
+		linters = append(linters, k)
+	}
+	sort.Strings(linters)
+	for _, linter := range linters {
+		// Check for cancelation.
+		select {
+		case <-ex.ctx.Done():
+			return
+		default:
+		}
+
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Running %s...\n", linter))
+		bb := new(bytes.Buffer)
+		if ex.runLinter(bb, ex.linters[linter], name) {
+			ex.sendMsg(statusUpdate, "No issues found.\n")
+		} else {
+			ex.reportBadLines(bb.Bytes())
+		}
+		ex.sendMsg(statusUpdate, "\n")
+	}
+}
+
+// setupModules creates a go.mod file for the snippet and downloads the
+// modules that it imports. Imports of modules outside allowedModules are
+// rejected before anything is downloaded. Since the dependencies of the
+// imported modules are only known once downloaded, they are checked after.
+func (ex *executor) setupModules() bool {
+	// Gather the imports of third-party packages, which are those whose
+	// first path element looks like a domain name.
+	// The packages of the file tree of a snippet are included as well.
+	var imports []string
+	filepath.Walk(ex.tmpDir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if fi.IsDir() {
+			if p != ex.tmpDir && (fi.Name() == "testdata" || strings.HasPrefix(fi.Name(), ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(fi.Name(), ".go") {
+			return nil
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), p, nil, parser.ImportsOnly)
+		if err != nil {
+			return nil // Allow the build to report errors later
+		}
+		for _, imp := range f.Imports {
+			p, _ := strconv.Unquote(imp.Path.Value)
+			if strings.Contains(strings.Split(p, "/")[0], ".") {
+				imports = append(imports, p)
+			}
+		}
+		return nil
+	})
+
+	// Pin the versions of the allowed modules.
+	gomod := "module playground\n"
+	pinned := make(map[string]bool)
+	for _, p := range imports {
+		mod, version, ok := ex.allowedModule(p)
+		if !ok {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Import of %q is not allowed; allowed modules are: %s\n", p, strings.Join(ex.allowedModules, ", ")))
+			return false
+		}
+		if version != "" && !pinned[mod] {
+			gomod += fmt.Sprintf("require %s %s\n", mod, version)
+			pinned[mod] = true
+		}
+	}
+	if !ex.writeFile("go.mod", gomod) {
+		return false
+	}
+
+	if len(imports) > 0 {
+		ex.sendMsg(statusUpdate, "Downloading modules...\n")
+	}
+	bb := new(bytes.Buffer)
+	if !ex.runCommand(bb, ex.gc, "mod", "tidy") {
+		return false
+	}
+	b, err := ioutil.ReadFile(filepath.Join(ex.tmpDir, "go.mod"))
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+		return false
+	}
+	for _, req := range parseRequires(string(b)) {
+		if _, version, ok := ex.allowedModule(req[0]); !ok || (version != "" && version != req[1]) {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Dependency %s@%s is not allowed; allowed modules are: %s\n", req[0], req[1], strings.Join(ex.allowedModules, ", ")))
+			return false
+		}
+	}
+	return true
+}
+
+// allowedModule reports whether the package or module path p is allowed by
+// allowedModules. Each entry is a module path or path prefix
+// (e.g., "golang.org/x"), optionally with a version (e.g., "rsc.io/quote@v1.5.2")
+// that the module is pinned to. It returns the path and version of the entry.
+func (ex *executor) allowedModule(p string) (mod, version string, ok bool) {
+	if len(ex.allowedModules) == 0 {
+		return p, "", true
+	}
+	for _, e := range ex.allowedModules {
+		mod, version = e, ""
+		if i := strings.IndexByte(e, '@'); i >= 0 {
+			mod, version = e[:i], e[i+1:]
+		}
+		if p == mod || strings.HasPrefix(p, mod+"/") {
+			return mod, version, true
+		}
+	}
+	return "", "", false
+}
+
+// parseRequires returns the path and version of each required module
+// in the go.mod file.
+func parseRequires(gomod string) (reqs [][2]string) {
+	var inBlock bool
+	for _, line := range strings.Split(gomod, "\n") {
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		fs := strings.Fields(line)
+		switch {
+		case len(fs) == 2 && fs[0] == "require" && fs[1] == "(":
+			inBlock = true
+		case inBlock && len(fs) == 1 && fs[0] == ")":
+			inBlock = false
+		case inBlock && len(fs) == 2:
+			reqs = append(reqs, [2]string{fs[0], fs[1]})
+		case len(fs) == 3 && fs[0] == "require":
+			reqs = append(reqs, [2]string{fs[1], fs[2]})
+		}
+	}
+	return reqs
+}
+
+// runGenerate runs "go generate" on the snippet with the specified flags and
+// returns the names of the Go source files that were generated.
+// The configured generators are made available in the PATH.
+func (ex *executor) runGenerate(flags []string) (files []string, ok bool) {
+	binDir := filepath.Join(ex.tmpDir, ".bin") // Ignored by the ./... pattern
+	if err := os.Mkdir(binDir, 0775); err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+		return nil, false
+	}
+	for name, bin := range ex.generators {
+		p, err := exec.LookPath(bin)
+		if err == nil {
+			err = os.Symlink(p, filepath.Join(binDir, name))
+		}
+		if err != nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to setup generator %s: %v\n", name, err))
+			return nil, false
+		}
+	}
+
+	existing := make(map[string]bool)
+	fis, _ := ioutil.ReadDir(ex.tmpDir)
+	for _, fi := range fis {
+		existing[fi.Name()] = true
+	}
+	args := append(append([]string{ex.gc, "generate"}, flags...), "./...")
+	ex.sendMsg(statusUpdate, fmt.Sprintf("Generating code... (command: %v)\n", strings.Join(args, " ")))
+	env := ex.env
+	ex.env = append(append([]string(nil), env...), "PATH="+binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	bb := new(bytes.Buffer)
+	ok = ex.runCommand(bb, args...)
+	ex.env = env
+	if !ok {
+		ex.reportBadLines(bb.Bytes())
+		return nil, false
+	}
+
+	fis, _ = ioutil.ReadDir(ex.tmpDir)
+	for _, fi := range fis {
+		if !existing[fi.Name()] && !fi.IsDir() && strings.HasSuffix(fi.Name(), ".go") {
+			files = append(files, fi.Name())
+		}
+	}
+	return files, true
+}
+
+// snippetEnv returns the additional environment of the commands that
+// operate on the snippet described by info.
+func (ex *executor) snippetEnv(info snippetInfo) []string {
+	env := append([]string(nil), ex.goEnv...)
+	if info.cgo != "" || ex.disableCGO {
+		cgo := "0"
+		if info.cgo == "on" {
+			cgo = "1"
+		}
+		env = append(env, "CGO_ENABLED="+cgo)
+	}
+	if ex.modules {
+		env = append(env, "GO111MODULE=on")
+	}
+	return env
+}
+
+// runLinter runs the linter binary on the named file and returns true if
+// the linter reported no issues. Linters report diagnostics on either stdout
+// or stderr, so both are captured and written to w.
+func (ex *executor) runLinter(w io.Writer, bin, name string) bool {
+	cmd := exec.Command(bin, name)
+	cmd.Dir = ex.tmpDir
+	cmd.Stdout = io.MultiWriter(ex.stdout, w)
+	cmd.Stderr = io.MultiWriter(ex.stderr, w)
+	cmd.Env = append(append(append([]string(nil), os.Environ()...), "GO111MODULE=off"), ex.env...)
+	if err := ex.runCmd(cmd); err != nil {
+		if _, ok := err.(*exec.ExitError); ok && ex.ctx.Err() == nil {
+			ex.sendMsg(statusUpdate, "Linter reported issues.\n")
+		} else {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+		}
+		return false
+	}
+	return true
+}
+
+// handleReplay replays the output of the recent run whose ID is in data.
+func (ex *executor) handleReplay(data string) {
+	defer ex.wg.Done()
+	defer ex.sendMsg(statusStopped, "")
+
+	id, err := strconv.Atoi(data)
+	r := ex.lookupHistory(id)
+	if err != nil || r == nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unknown run: %v\n", data))
+		return
+	}
 	ex.sendMsg(clearOutput, "")
-	ex.sendMsg(statusUpdate, "Source formatted.\n")
+	for _, m := range r.msgs {
+		if ex.ctx.Err() != nil {
+			return
+		}
+		ex.sendMsg(m.action, m.data)
+	}
+	ex.sendMsg(statusUpdate, fmt.Sprintf("Output replayed from run %d.\n", r.id))
 }
 
 func (ex *executor) handleRun(code string) {
 	const tmpName = "temp.go"
 
 	defer ex.wg.Done()
-	defer ex.sendMsg(statusStopped, "")
+	ex.mu.Lock()
+	snippetID := ex.snippetID
+	ex.mu.Unlock()
+	start := time.Now()
+	var stopped string // Exit status of the last program run
+	var rec *cachedRun // Messages of the run; nil if it never started
+	defer func() {
+		if rec != nil && ex.runDone != nil {
+			ex.runDone(runResult{snippetID, stopped, time.Since(start), rec.output(), rec.reports})
+		}
+		ex.sendMsg(statusStopped, stopped)
+	}()
 	ex.sendMsg(clearOutput, "")
 
+	ctx, sp := ex.tracer.Start(context.Background(), "run")
+	defer sp.End()
+
 	// Best effort at clearing out directory and stale data.
-	fis, _ := ioutil.ReadDir(ex.tmpDir)
-	for _, fi := range fis {
-		os.RemoveAll(filepath.Join(ex.tmpDir, fi.Name()))
-	}
+	ex.clearWorkspace()
 	ex.deleteBlobs()
+	attached, ok := ex.writeSnippetFiles(snippetID)
+	if !ok {
+		return
+	}
+
+	// Replay the output of a prior run of the same snippet if possible.
+	// Runs with restored, uploaded, or attached files are not cached since
+	// the output may depend on those files. Runs of a known snippet are not
+	// cached either so that each of them adds to its benchmark history.
+	key := ex.cache.Key(&ex.execConfig, code)
+	local := len(ex.keep) > 0 || attached > 0
+	cacheable := !local && (snippetID == 0 || ex.recordBench == nil)
+	if msgs, status, ok := ex.cache.Load(key); ok && cacheable {
+		sp.SetAttr("run.cached", true)
+		for _, m := range msgs {
+			ex.sendMsg(m.action, m.data)
+		}
+		ex.sendMsg(statusUpdate, "Output replayed from cache.\n")
+		ex.addHistory(code, msgs)
+		rec = &cachedRun{msgs: msgs}
+		stopped = status
+		return
+	}
+
+	// Dispatch the run to a remote worker if any is connected, which queues
+	// the run itself. Runs with restored, uploaded, or attached files are
+	// always local.
+	var worker *workerConn
+	if !local {
+		worker = ex.workers.Pick()
+	}
+	if worker == nil {
+		// Wait for permission to build and run.
+		_, qsp := ex.tracer.Start(ctx, "queue")
+		err := ex.queue.Acquire(ex.ctx, func(pos int) {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Queued, position %d.\n", pos))
+		})
+		qsp.SetError(err)
+		qsp.End()
+		if err != nil {
+			return
+		}
+		defer ex.queue.Release()
+	}
+	ex.startRecording()
+	defer func() {
+		rec = ex.stopRecording()
+		ex.addHistory(code, rec.msgs)
+		if rec.ok && ex.ctx.Err() == nil && cacheable {
+			ex.cache.Store(key, rec.msgs, stopped)
+		}
+	}()
+
+	if worker != nil {
+		_, wsp := ex.tracer.Start(ctx, "worker")
+		wsp.SetAttr("worker.name", worker.name)
+		stopped = ex.runOnWorker(worker, snippetID, code)
+		wsp.End()
+		return
+	}
 
 	// Parse the source file to determine some properties of it.
-	if !ex.writeFile(tmpName, code) {
+	src, files, err := splitFiles(code)
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Invalid file tree: %v\n", err))
+		return
+	}
+	if !ex.writeFile(tmpName, src) || !ex.writeFiles(files) {
 		return
 	}
-	hasMain, gcs, buildArgs, execArgs, profArgs, ok := ex.parseFile(filepath.Join(ex.tmpDir, tmpName))
+	info, ok := ex.parseFile(filepath.Join(ex.tmpDir, tmpName))
 	if !ok {
 		return
 	}
-	verbose := len(gcs)+len(buildArgs)+len(execArgs)+len(profArgs) > 0
+	hasMain, gcs, buildArgs, execArgs, profArgs := info.hasMain, info.gcs, info.buildArgs, info.execArgs, info.profArgs
+	verbose := len(gcs)+len(buildArgs)+len(execArgs)+len(profArgs)+len(info.gcFlags)+len(info.buildTags)+len(files) > 0 || info.coverMode != "" || info.asm || info.cgo != "" || info.generate != nil || info.gcTrace
+
+	// Setup the environment for building and executing.
+	// A snippet with a file tree is built as a module so that the main
+	// package may import the other packages of the tree.
+	ex.env = ex.snippetEnv(info)
+	defer func() { ex.env = nil }()
+	if len(files) > 0 && !ex.setupTree(files) {
+		return
+	}
 
 	// Setup the Go compiler version.
+	gcNames := append([]string(nil), gcs...)
 	if len(gcs) == 0 {
 		gcs = []string{ex.gc}
 	} else {
@@ -305,6 +1612,12 @@ func (ex *executor) handleRun(code string) {
 				execArgs = append(execArgs, "-test.cpuprofile=cpu.prof")
 			case "mem":
 				execArgs = append(execArgs, "-test.memprofile=mem.prof")
+			case "block":
+				execArgs = append(execArgs, "-test.blockprofile=block.prof")
+			case "mutex":
+				execArgs = append(execArgs, "-test.mutexprofile=mutex.prof")
+			case "trace":
+				execArgs = append(execArgs, "-test.trace=trace.out")
 			default:
 				ex.sendMsg(statusUpdate, fmt.Sprintf("Unknown profiling argument: %v\n", arg))
 				return
@@ -312,15 +1625,57 @@ func (ex *executor) handleRun(code string) {
 		}
 	}
 
+	// Setup arguments for coverage analysis.
+	if info.coverMode != "" {
+		switch info.coverMode {
+		case "set", "count", "atomic":
+		default:
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Unknown coverage mode: %v\n", info.coverMode))
+			return
+		}
+		if len(execArgs) == 0 {
+			execArgs = []string{"-test.v", "-test.run=.", "-test.bench=."}
+		}
+		buildArgs = append(buildArgs, "-covermode="+info.coverMode)
+		execArgs = append(execArgs, "-test.coverprofile=cover.out")
+	}
+
+	// Setup arguments for the compiler (e.g., printing the generated assembly).
+	gcFlags := info.gcFlags
+	if info.asm {
+		gcFlags = append(gcFlags, "-S")
+	}
+	if len(gcFlags) > 0 {
+		buildArgs = append(buildArgs, "-gcflags="+strings.Join(gcFlags, " "))
+	}
+	if len(info.buildTags) > 0 {
+		buildArgs = append(buildArgs, "-tags="+strings.Join(info.buildTags, ","))
+	}
+
 	// Final adjustments on arguments for building and executing.
+	// A snippet with a file tree is built as the package in the root of the
+	// module, which includes any other files of the main package.
 	var name string
 	if hasMain {
 		name = "main.go"
-		buildArgs = append(append([]string{"build"}, buildArgs...), name)
+		buildArgs = append([]string{"build"}, buildArgs...)
+		if len(files) > 0 {
+			buildArgs = append(buildArgs, "-o", "main", ".")
+		} else {
+			buildArgs = append(buildArgs, name)
+		}
 		execArgs = append([]string{"./main"}, execArgs...)
 	} else {
 		name = "main_test.go"
-		buildArgs = append(append([]string{"test", "-c"}, buildArgs...), name)
+		buildArgs = append([]string{"test", "-c"}, buildArgs...)
+		switch {
+		case len(files) > 0:
+			buildArgs = append(buildArgs, "-o", "main.test", ".")
+		case info.coverMode != "":
+			buildArgs = append(buildArgs, "main.go", name) // Test functions are moved to main.go
+		default:
+			buildArgs = append(buildArgs, name)
+		}
 		if len(execArgs) == 0 {
 			execArgs = []string{"./main.test", "-test.v", "-test.run=.", "-test.bench=."}
 		} else {
@@ -332,9 +1687,36 @@ func (ex *executor) handleRun(code string) {
 		ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
 		return
 	}
+	if info.coverMode != "" {
+		code, tests, err := coverSources(filepath.Join(ex.tmpDir, name))
+		if err != nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to setup coverage: %v\n", err))
+			return
+		}
+		if !ex.writeFile("main.go", code) || !ex.writeFile(name, tests) {
+			return
+		}
+	}
+
+	if ex.modules && !ex.setupModules() {
+		return
+	}
+
+	// Generate code before building. Since the go command only builds the
+	// files named on the command line, the generated files are added
+	// unless the whole package is built.
+	if info.generate != nil {
+		generated, ok := ex.runGenerate(info.generate)
+		if !ok {
+			return
+		}
+		if len(files) == 0 {
+			buildArgs = append(buildArgs, generated...)
+		}
+	}
 
 	// Build and execute the source file for each go compiler versions.
-	for _, gc := range gcs {
+	for i, gc := range gcs {
 		// Check for cancelation.
 		select {
 		case <-ex.ctx.Done():
@@ -343,48 +1725,152 @@ func (ex *executor) handleRun(code string) {
 		}
 
 		if verbose {
-			cmd := strings.Join(append([]string{gc}, buildArgs...), " ")
+			cmd := strings.Join(append(append(append([]string(nil), ex.env...), gc), buildArgs...), " ")
 			ex.sendMsg(statusUpdate, fmt.Sprintf("Compiling program... (command: %v)\n", cmd))
 		} else {
 			ex.sendMsg(statusUpdate, "Compiling program...\n")
 		}
+		ex.setState(execBuilding)
+		_, bsp := ex.tracer.Start(ctx, "build")
+		bsp.SetAttr("go.binary", gc)
+		if info.asm {
+			output := "asm.s"
+			if len(gcNames) > 0 {
+				output = fmt.Sprintf("asm_%s.s", gcNames[i])
+			}
+			ex.processAssembly(output, append([]string{gc}, buildArgs...)...)
+			bsp.End()
+			ex.sendMsg(statusUpdate, "\n")
+			continue
+		}
 		bb := new(bytes.Buffer)
-		if !ex.runCommand(bb, append([]string{gc}, buildArgs...)...) {
+		buildStart := time.Now()
+		ok := ex.runCommand(bb, append([]string{gc}, buildArgs...)...)
+		buildTime := time.Since(buildStart)
+		bsp.SetAttr("build.ok", ok)
+		bsp.End()
+		if !ok {
 			ex.reportBadLines(bb.Bytes())
 			continue
 		}
+		if hasOptDiagnostics(info.gcFlags) {
+			ex.reportOptDiagnostics(bb.Bytes())
+		}
 
 		// HACK: Go1.0 would output the test binary as different name from all
 		// other versions of Go. Thus, we preemptively rename the old name to
 		// the new one before running the test.
 		os.Rename(filepath.Join(ex.tmpDir, "command-line-arguments.test"), filepath.Join(ex.tmpDir, "main.test"))
 
+		var execEnv []string
+		if info.gcTrace {
+			execEnv = []string{"GODEBUG=gctrace=1"}
+		}
 		if verbose {
-			cmd := strings.Join(execArgs, " ")
+			cmd := strings.Join(append(append([]string(nil), execEnv...), execArgs...), " ")
 			ex.sendMsg(statusUpdate, fmt.Sprintf("Starting program... (command: %v)\n", cmd))
 		} else {
 			ex.sendMsg(clearOutput, "")
 		}
-		if !ex.runCommand(ioutil.Discard, execArgs...) {
+		ex.setState(execRunning)
+		_, esp := ex.tracer.Start(ctx, "execute")
+		eb := new(bytes.Buffer)
+		var ew io.Writer = ioutil.Discard
+		if info.gcTrace {
+			ew = eb // Only buffer the output if it is parsed
+		}
+		env := ex.env
+		ex.env = append(append([]string(nil), env...), execEnv...)
+		cmd := ex.programCommand(ew, info.noNet || ex.disableNetwork, execArgs...)
+		ex.env = env
+		existing := workspaceFiles(ex.tmpDir)
+		ob := new(bytes.Buffer)
+		recordBench := !hasMain && snippetID != 0 && ex.recordBench != nil
+		if recordBench {
+			cmd.Stdout = io.MultiWriter(cmd.Stdout, ob)
+		}
+		runStart := time.Now()
+		ok = ex.run(cmd, ex.runTimeout)
+		runTime := time.Since(runStart)
+		stopped = ex.exitStatus(cmd, runTime)
+		esp.SetAttr("execute.ok", ok)
+		esp.End()
+		if ok {
+			ex.sendMsg(statusUpdate, "Program exited.\n")
+		}
+		if cmd.ProcessState != nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Compiled in %v, ran in %v, peak memory %s.\n",
+				buildTime.Round(time.Microsecond), runTime.Round(time.Microsecond), formatSize(maxRSS(cmd.ProcessState))))
+			ex.reportArtifacts(existing)
+		}
+		if info.gcTrace && cmd.ProcessState != nil {
+			output := "gctrace.json"
+			if len(gcNames) > 0 {
+				output = fmt.Sprintf("gctrace_%s.json", gcNames[i])
+			}
+			ex.processGCTrace(output, eb.Bytes())
+		}
+		if !ok {
 			ex.sendMsg(statusUpdate, "\n")
 			continue
 		}
-		ex.sendMsg(statusUpdate, "Program exited.\n")
+
+		if recordBench {
+			if rs := parseBenchmarks(ob.Bytes()); len(rs) > 0 {
+				var gcName string
+				if len(gcNames) > 0 {
+					gcName = gcNames[i]
+				}
+				ex.recordBench(snippetID, gcName, rs)
+			}
+		}
 
 		if len(profArgs) > 0 {
+			ex.setState(execProfiling)
+			_, psp := ex.tracer.Start(ctx, "profile")
+			psp.SetAttr("profile.modes", strings.Join(profArgs, " "))
 			ex.processProfiles(profArgs)
+			psp.End()
+		}
+		if info.coverMode != "" {
+			_, csp := ex.tracer.Start(ctx, "coverage")
+			ex.processCoverage(gc)
+			csp.End()
 		}
 		ex.sendMsg(statusUpdate, "\n")
 	}
 }
 
-// parseFile parses a Go source file and reports various properties:
-//	hasMain: whether the file has a main function (as opposed to a test suite)
-//	gcs: versions of Go to use; nil if not specified
-//	buildArgs: custom build arguments; nil if not specified
-//	execArgs: custom execution arguments; nil if not specified
-//	profArgs: pprof modes to use (mem and/or cpu); nil if not specified
-func (ex *executor) parseFile(file string) (hasMain bool, gcs, buildArgs, execArgs, profArgs []string, parseOk bool) {
+// clearWorkspace removes the files of the last task from tmpDir,
+// except for those restored from a snapshot or uploaded.
+func (ex *executor) clearWorkspace() {
+	fis, _ := ioutil.ReadDir(ex.tmpDir)
+	for _, fi := range fis {
+		if !ex.keep[fi.Name()] {
+			os.RemoveAll(filepath.Join(ex.tmpDir, fi.Name()))
+		}
+	}
+}
+
+// snippetInfo reports various properties of a Go source file.
+type snippetInfo struct {
+	hasMain   bool     // Whether the file has a main function (as opposed to a test suite)
+	gcs       []string // Versions of Go to use; nil if not specified
+	buildArgs []string // Custom build arguments; nil if not specified
+	execArgs  []string // Custom execution arguments; nil if not specified
+	profArgs  []string // pprof modes to use (cpu, mem, block, mutex, and/or trace); nil if not specified
+	coverMode string   // Coverage mode to use (set, count, or atomic); empty if not specified
+	asm       bool     // Whether to report the generated assembly instead of running
+	gcFlags   []string // Custom compiler flags; nil if not specified
+	buildTags []string // Custom build tags; nil if not specified
+	cgo       string   // Whether cgo is "on" or "off"; empty if not specified
+	generate  []string // Flags for go generate; nil if not specified
+	gcTrace   bool     // Whether to report the GC cycles traced by the runtime
+	noNet     bool     // Whether to run without network access
+}
+
+// parseFile parses a Go source file and reports various properties about it.
+func (ex *executor) parseFile(file string) (info snippetInfo, parseOk bool) {
 	// Parse source file for package name and comments.
 	fset := token.NewFileSet()
 	f, err := parser.ParseFile(fset, file, nil, parser.PackageClauseOnly|parser.ParseComments)
@@ -415,16 +1901,13 @@ func (ex *executor) parseFile(file string) (hasMain bool, gcs, buildArgs, execAr
 	var hasTests bool
 	for _, dd := range f.Decls {
 		if fd, ok := dd.(*ast.FuncDecl); ok {
-			hasMain = hasMain || (fd.Recv == nil && fd.Name.Name == "main" &&
+			info.hasMain = info.hasMain || (fd.Recv == nil && fd.Name.Name == "main" &&
 				(fd.Type.Params == nil || fd.Type.Params.NumFields() == 0) &&
 				(fd.Type.Results == nil || fd.Type.Results.NumFields() == 0))
-			hasTests = hasTests || (fd.Recv == nil &&
-				(strings.HasPrefix(fd.Name.Name, "Benchmark") || strings.HasPrefix(fd.Name.Name, "Test")) &&
-				(fd.Type.Params != nil && fd.Type.Params.NumFields() == 1) &&
-				(fd.Type.Results == nil || fd.Type.Results.NumFields() == 0))
+			hasTests = hasTests || isTestFunc(fd)
 		}
 	}
-	if hasMain == hasTests {
+	if info.hasMain == hasTests {
 		ex.sendMsg(statusUpdate, "Program must have either a main function or a set of test functions.\n")
 		return
 	}
@@ -438,23 +1921,138 @@ func (ex *executor) parseFile(file string) (hasMain bool, gcs, buildArgs, execAr
 		}
 		switch args[0] {
 		case tagVersions:
-			gcs = args[1:]
+			info.gcs = args[1:]
 		case tagBuildArgs:
-			buildArgs = args[1:]
+			info.buildArgs = args[1:]
 		case tagExecArgs:
-			execArgs = args[1:]
+			info.execArgs = args[1:]
 		case tagProfile:
-			profArgs = args[1:]
+			info.profArgs = args[1:]
+		case tagCover:
+			info.coverMode = "set"
+			if len(args) > 1 {
+				info.coverMode = args[1]
+			}
+		case tagAsm:
+			info.asm = true
+		case tagGCFlags:
+			info.gcFlags = args[1:]
+		case tagBuildTags:
+			info.buildTags = args[1:]
+		case tagGenerate:
+			info.generate = append([]string{}, args[1:]...)
+		case tagGCTrace:
+			info.gcTrace = true
+		case tagNoNet:
+			info.noNet = true
+		case tagCGO:
+			info.cgo = "on"
+			if len(args) > 1 {
+				info.cgo = args[1]
+			}
+			if info.cgo != "on" && info.cgo != "off" {
+				ex.sendMsg(statusUpdate, fmt.Sprintf("Unknown cgo setting: %v\n", info.cgo))
+				return
+			}
 		default:
 			ex.sendMsg(statusUpdate, fmt.Sprintf("Unknown magic comment: %q", magicComment+c))
 			return
 		}
 	}
-	if !hasTests && len(profArgs) > 0 {
+
+	// The go command ignores the build constraints of files named on the
+	// command line, so check whether the snippet is excluded by them.
+	bctx := build.Default
+	bctx.BuildTags = info.buildTags
+	switch {
+	case info.cgo == "on":
+		bctx.CgoEnabled = true
+	case info.cgo == "off" || ex.disableCGO:
+		bctx.CgoEnabled = false
+	}
+	if usesCgo(f) && !bctx.CgoEnabled {
+		ex.sendMsg(statusUpdate, "Program imports \"C\", but cgo is disabled; use the cgo magic comment to enable it.\n")
+		return
+	}
+	if ok, err := bctx.MatchFile(filepath.Dir(file), filepath.Base(file)); err == nil && !ok {
+		ex.sendMsg(statusUpdate, "Program is excluded by its build constraints; use the buildtags magic comment to satisfy them.\n")
+		return
+	}
+	if !hasTests && len(info.profArgs) > 0 {
 		ex.sendMsg(statusUpdate, "Profiling is only available on test suites")
 		return
 	}
-	return hasMain, gcs, buildArgs, execArgs, profArgs, true
+	if !hasTests && info.coverMode != "" {
+		ex.sendMsg(statusUpdate, "Coverage is only available on test suites")
+		return
+	}
+	return info, true
+}
+
+// usesCgo reports whether f imports "C".
+func usesCgo(f *ast.File) bool {
+	for _, imp := range f.Imports {
+		if imp.Path.Value == `"C"` {
+			return true
+		}
+	}
+	return false
+}
+
+// isTestFunc reports whether fd is a test or benchmark function.
+func isTestFunc(fd *ast.FuncDecl) bool {
+	return fd.Recv == nil &&
+		(strings.HasPrefix(fd.Name.Name, "Benchmark") || strings.HasPrefix(fd.Name.Name, "Test")) &&
+		(fd.Type.Params != nil && fd.Type.Params.NumFields() == 1) &&
+		(fd.Type.Results == nil || fd.Type.Results.NumFields() == 0)
+}
+
+// coverSources rewrites a test suite such that the code within the test
+// functions is measured by the coverage tool, which never instruments
+// _test.go files. Every test function is renamed in place by lower-casing its
+// first letter (which preserves all line and column positions) and the
+// returned tests source declares wrappers with the original names.
+func coverSources(file string) (code, tests string, err error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", "", err
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, b, 0)
+	if err != nil {
+		return "", "", err
+	}
+	funcs := make(map[string]bool)
+	for _, dd := range f.Decls {
+		if fd, ok := dd.(*ast.FuncDecl); ok && fd.Recv == nil {
+			funcs[fd.Name.Name] = true
+		}
+	}
+
+	bb := new(bytes.Buffer)
+	bb.WriteString("package main\n\nimport \"testing\"\n")
+	for _, dd := range f.Decls {
+		fd, ok := dd.(*ast.FuncDecl)
+		if !ok || !(isTestFunc(fd) || fd.Name.Name == "TestMain") {
+			continue
+		}
+		se, ok := fd.Type.Params.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		sel, ok := se.X.(*ast.SelectorExpr)
+		if !ok {
+			continue
+		}
+		name := fd.Name.Name
+		lower := strings.ToLower(name[:1]) + name[1:]
+		if funcs[lower] {
+			return "", "", fmt.Errorf("cannot rename %s since %s is already declared", name, lower)
+		}
+		b[fset.Position(fd.Name.Pos()).Offset] = lower[0]
+		fmt.Fprintf(bb, "\nfunc %s(x *testing.%s) { %s(x) }\n", name, sel.Sel.Name, lower)
+	}
+	return string(b), bb.String(), nil
 }
 
 // processProfiles generates SVG and HTML files for the pprof profiles
@@ -498,28 +2096,37 @@ func (ex *executor) processProfiles(profArgs []string) {
 		// This is incorrect, but easier than trying to have a set of flags that
 		// works for every Go version thus far. The arguments used here assume
 		// that it is for a relatively newer version of Go (1.6 and higher).
-		cmd := exec.CommandContext(ex.ctx, ex.gc, append([]string{"tool", "pprof"}, args...)...)
+		cmd := exec.Command(ex.gc, append([]string{"tool", "pprof"}, args...)...)
 		cmd.Dir = ex.tmpDir
 		cmd.Env = append(cmd.Env, fmt.Sprintf("PPROF_TMPDIR=%s", ex.tmpDir))
 		cmd.Env = append(cmd.Env, fmt.Sprintf("BROWSER=%s %s", filepath.Join(ex.tmpDir, "prof_copy"), output))
 		cmd.Env = append(cmd.Env, os.Environ()...)
-		if err := cmd.Run(); err != nil {
+		if err := ex.runCmd(cmd); err != nil {
 			ex.sendMsg(statusUpdate, fmt.Sprintf("\tDropped report: %s (unexpected error: %v)\n", output, err))
 			return
 		}
 
 		b, _ := ioutil.ReadFile(filepath.Join(ex.tmpDir, output))
-		if len(b) > 1<<24 {
-			ex.sendMsg(statusUpdate, fmt.Sprintf("\tDropped report: %s (file too large: %d bytes)\n", output, len(b)))
-		} else if len(b) > 0 {
-			id := ex.bs.Insert(blob{data: b, mime: mimeFromPath(output)})
-			ex.mu.Lock()
-			ex.bids = append(ex.bids, id) // Make sure executor knows to delete this later
-			ex.mu.Unlock()
+		ex.reportBlob(output, mimeFromPath(output), b)
+	}
 
-			b, _ = json.Marshal(map[string]string{"name": output, "id": id})
-			ex.sendMsg(reportProfile, string(b))
+	// traceProf extracts a profile of the given type from the execution trace.
+	traceProf := func(output, typ string) bool {
+		f, err := os.Create(filepath.Join(ex.tmpDir, output))
+		if err != nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+			return false
 		}
+		defer f.Close()
+		cmd := exec.Command(ex.gc, "tool", "trace", "-pprof="+typ, "trace.out")
+		cmd.Dir = ex.tmpDir
+		cmd.Stdout = f
+		cmd.Env = os.Environ()
+		if err := ex.runCmd(cmd); err != nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("\tDropped report: %s (unexpected error: %v)\n", output, err))
+			return false
+		}
+		return true
 	}
 
 	// Create all relevant profiles.
@@ -528,12 +2135,223 @@ func (ex *executor) processProfiles(profArgs []string) {
 		case "cpu":
 			runProf("cpu_graph.svg", "-web", "main.test", "cpu.prof")
 			runProf("cpu_list.html", "-weblist=.", "main.test", "cpu.prof")
+			ex.startPprofUI("cpu.prof")
 		case "mem":
 			runProf("mem_objects_graph.svg", "-alloc_objects", "-web", "main.test", "mem.prof")
 			runProf("mem_objects_list.html", "-alloc_objects", "-weblist=.", "main.test", "mem.prof")
 			runProf("mem_space_graph.svg", "-alloc_space", "-web", "main.test", "mem.prof")
 			runProf("mem_space_list.html", "-alloc_space", "-weblist=.", "main.test", "mem.prof")
+			ex.startPprofUI("mem.prof")
+		case "block":
+			runProf("block_graph.svg", "-web", "main.test", "block.prof")
+			runProf("block_list.html", "-weblist=.", "main.test", "block.prof")
+			ex.startPprofUI("block.prof")
+		case "mutex":
+			runProf("mutex_graph.svg", "-web", "main.test", "mutex.prof")
+			runProf("mutex_list.html", "-weblist=.", "main.test", "mutex.prof")
+			ex.startPprofUI("mutex.prof")
+		case "trace":
+			// The raw trace can be inspected locally using "go tool trace".
+			b, _ := ioutil.ReadFile(filepath.Join(ex.tmpDir, "trace.out"))
+			ex.reportBlob("trace.out", "application/octet-stream", b)
+			if traceProf("sched.prof", "sched") {
+				runProf("trace_sched_graph.svg", "-web", "main.test", "sched.prof")
+			}
+			if traceProf("sync.prof", "sync") {
+				runProf("trace_sync_graph.svg", "-web", "main.test", "sync.prof")
+			}
+		}
+	}
+}
+
+// startPprofUI launches an interactive pprof web UI for the named profile
+// of the test binary if enabled, and informs the client of it.
+func (ex *executor) startPprofUI(prof string) {
+	if ex.pprofUI == nil {
+		return
+	}
+	name := prof + " (interactive)"
+	id, err := ex.pprofUI.Start(ex.gc, filepath.Join(ex.tmpDir, "main.test"), filepath.Join(ex.tmpDir, prof))
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("\tDropped report: %s (unexpected error: %v)\n", name, err))
+		return
+	}
+	ex.bmu.Lock()
+	ex.pprofs = append(ex.pprofs, id) // Make sure executor knows to stop this later
+	ex.bmu.Unlock()
+
+	b, _ := json.Marshal(map[string]string{"name": name, "id": id, "url": "pprof/" + id + "/"})
+	ex.sendMsg(reportProfile, string(b))
+}
+
+// processCoverage generates an HTML report for the coverage profile generated
+// by go test using the provided Go toolchain. It stores the output file in
+// blobStore and informs the client of the report.
+func (ex *executor) processCoverage(gc string) {
+	ex.sendMsg(statusUpdate, "Generating coverage report...\n")
+	defer ex.sendMsg(statusUpdate, "Report generation done.\n")
+
+	if !ex.runCommand(ioutil.Discard, gc, "tool", "cover", "-html=cover.out", "-o=cover.html") {
+		return
+	}
+	b, _ := ioutil.ReadFile(filepath.Join(ex.tmpDir, "cover.html"))
+	ex.reportBlob("cover.html", mimeFromPath("cover.html"), b)
+
+	// Paint the coverage onto the snippet as well.
+	b, _ = ioutil.ReadFile(filepath.Join(ex.tmpDir, "cover.out"))
+	covered, uncovered := parseCoverProfile(b, "main.go")
+	for _, m := range []lineMarks{{"covered", covered}, {"uncovered", uncovered}} {
+		if len(m.Lines) > 0 {
+			b, _ := json.Marshal(m)
+			ex.sendMsg(markLines, string(b))
+		}
+	}
+}
+
+// lineMarks is the data of a markLines message for lines other than errors.
+type lineMarks struct {
+	Mode  string `json:"mode"`
+	Lines []int  `json:"lines"`
+}
+
+// parseCoverProfile parses a coverage profile for the lines of the named
+// file that are covered and uncovered by the tests. Each line of the profile
+// describes a block of statements and how often it ran, like:
+//
+//	command-line-arguments/main.go:4.21,5.12 1 1
+//
+// Since blocks start and end mid-line, a line is only reported as uncovered
+// if no covered block touches it.
+func parseCoverProfile(b []byte, name string) (covered, uncovered []int) {
+	counts := make(map[int]bool) // Whether each line is covered
+	for _, line := range strings.Split(string(b), "\n") {
+		i := strings.LastIndexByte(line, ':')
+		if i < 0 || path.Base(line[:i]) != name {
+			continue
+		}
+		var l0, c0, l1, c1, n, cnt int
+		if _, err := fmt.Sscanf(line[i+1:], "%d.%d,%d.%d %d %d", &l0, &c0, &l1, &c1, &n, &cnt); err != nil {
+			continue
+		}
+		for l := l0; l <= l1; l++ {
+			counts[l] = counts[l] || cnt > 0
+		}
+	}
+	for l, ok := range counts {
+		if ok {
+			covered = append(covered, l)
+		} else {
+			uncovered = append(uncovered, l)
+		}
+	}
+	sort.Ints(covered)
+	sort.Ints(uncovered)
+	return covered, uncovered
+}
+
+// processAssembly builds the program using the build command in args, which
+// must instruct the compiler to print the generated assembly. Rather than
+// forwarding the compiler output to the client, it is stored in blobStore
+// and the client is informed of the report.
+func (ex *executor) processAssembly(output string, args ...string) {
+	bb := new(bytes.Buffer)
+	cmd := ex.command(ioutil.Discard, args...)
+	cmd.Stderr = bb
+	if err := ex.runCmd(cmd); err != nil {
+		ex.stderr.Write(bb.Bytes())
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+		ex.reportBadLines(bb.Bytes())
+		return
+	}
+
+	// Strip the package headers and the path to the temporary directory.
+	var lines []string
+	for _, s := range strings.SplitAfter(bb.String(), "\n") {
+		if !strings.HasPrefix(s, "# ") {
+			lines = append(lines, strings.Replace(s, ex.tmpDir+string(filepath.Separator), "", -1))
+		}
+	}
+	ex.sendMsg(statusUpdate, "Assembly generated.\n")
+	ex.reportBlob(output, "text/plain; charset=utf-8", []byte(strings.Join(lines, "")))
+}
+
+// gcCycle is a single garbage collection as traced by GODEBUG=gctrace=1.
+// Sizes are in megabytes and durations in milliseconds unless noted.
+type gcCycle struct {
+	Cycle      int     `json:"cycle"`      // GC number, incremented at each GC
+	Time       float64 `json:"time"`       // Seconds since program start
+	CPUPercent int     `json:"cpuPercent"` // Percentage of time spent in GC since program start
+	Pause      float64 `json:"pause"`      // Stop-the-world time of sweep termination and mark termination
+	Mark       float64 `json:"mark"`       // Wall-clock time of the concurrent mark phase
+	HeapStart  int     `json:"heapStart"`  // Heap size at GC start
+	HeapEnd    int     `json:"heapEnd"`    // Heap size at GC end
+	HeapLive   int     `json:"heapLive"`   // Live heap after marking
+	HeapGoal   int     `json:"heapGoal"`   // Goal heap size for the next GC
+	Forced     bool    `json:"forced"`     // Whether the GC was forced by runtime.GC
+}
+
+// Regexp for parsing a GC trace line, which looks like:
+//
+//	gc 4 @0.012s 2%: 0.026+0.39+0.10 ms clock, 0.21+0.88/0.74/0+0.80 ms cpu, 4->4->1 MB, 5 MB goal, 0 MB stacks, 0 MB globals, 8 P (forced)
+var gcTraceRx = regexp.MustCompile(`^gc (\d+) @([0-9.]+)s (\d+)%: ([0-9.]+)\+([0-9.]+)\+([0-9.]+) ms clock, [^,]* ms cpu, (\d+)->(\d+)->(\d+) MB, (\d+) MB goal,.*?( \(forced\))?$`)
+
+// parseGCTrace parses the GC trace lines in the stderr output of a program.
+// All other lines are ignored.
+func parseGCTrace(b []byte) []gcCycle {
+	var cycles []gcCycle
+	for _, line := range strings.Split(string(b), "\n") {
+		m := gcTraceRx.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		atoi := func(s string) int { n, _ := strconv.Atoi(s); return n }
+		atof := func(s string) float64 { f, _ := strconv.ParseFloat(s, 64); return f }
+		cycles = append(cycles, gcCycle{
+			Cycle:      atoi(m[1]),
+			Time:       atof(m[2]),
+			CPUPercent: atoi(m[3]),
+			Pause:      atof(m[4]) + atof(m[6]),
+			Mark:       atof(m[5]),
+			HeapStart:  atoi(m[7]),
+			HeapEnd:    atoi(m[8]),
+			HeapLive:   atoi(m[9]),
+			HeapGoal:   atoi(m[10]),
+			Forced:     m[11] != "",
+		})
+	}
+	return cycles
+}
+
+// processGCTrace parses the GC trace in the stderr output of a program.
+// It stores the series of GC cycles as JSON in blobStore and informs
+// the client of the report.
+func (ex *executor) processGCTrace(output string, stderr []byte) {
+	cycles := parseGCTrace(stderr)
+	if len(cycles) == 0 {
+		ex.sendMsg(statusUpdate, "No garbage collections were traced.\n")
+		return
+	}
+	b, err := json.Marshal(cycles)
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+		return
+	}
+	ex.sendMsg(statusUpdate, fmt.Sprintf("Traced %d garbage collections.\n", len(cycles)))
+	ex.reportBlob(output, "application/json", b)
+}
+
+// reportBlob stores the named report in blobStore and informs the client
+// of the report by sending a reportProfile message.
+func (ex *executor) reportBlob(name, mime string, b []byte) {
+	if len(b) > 0 {
+		id, err := ex.storeBlob(name, blob{data: b, mime: mime})
+		if err != nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("\tDropped report: %s (%v)\n", name, err))
+			return
 		}
+
+		b, _ = json.Marshal(map[string]string{"name": name, "id": id, "mime": mime})
+		ex.sendMsg(reportProfile, string(b))
 	}
 }
 
@@ -563,43 +2381,222 @@ func extractArgs(str string) ([]string, bool) {
 	return ss, true
 }
 
-type blob struct {
-	data []byte
-	mime string
+// maxCachedRunSize is the maximum size of the output of a cacheable run.
+const maxCachedRunSize = 1 << 20
+
+// maxRunHistory is the number of recent runs kept by each executor.
+const maxRunHistory = 10
+
+type runRecord struct {
+	id   int
+	time time.Time
+	code string
+	msgs []cachedMsg
+}
+
+type cachedMsg struct {
+	action, data string
+}
+
+type cachedRun struct {
+	msgs    []cachedMsg
+	reports []string // Data of the reportProfile messages, which are not cached
+	size    int
+	ok      bool
+}
+
+// output returns the text of the output and status messages of the run
+// since the output was last cleared.
+func (cr *cachedRun) output() string {
+	var sb strings.Builder
+	for _, m := range cr.msgs {
+		switch m.action {
+		case clearOutput:
+			sb.Reset()
+		case appendStdout, appendStderr, statusUpdate:
+			sb.WriteString(m.data)
+		}
+	}
+	return sb.String()
+}
+
+// runCache is a synchronized LRU cache of SHA256 hashes to the messages
+// output by a run and its exit status. A nil runCache is valid and never
+// caches anything.
+type runCache struct {
+	mu  sync.Mutex
+	max int
+	ll  *list.List // List of *runCacheEntry; most recently used at the front
+	m   map[string]*list.Element
+}
+
+type runCacheEntry struct {
+	key    string
+	msgs   []cachedMsg
+	status string // Data of the statusStopped message
+}
+
+// newRunCache returns a cache that holds up to max runs.
+// It returns nil if max is not positive.
+func newRunCache(max int) *runCache {
+	if max <= 0 {
+		return nil
+	}
+	return &runCache{max: max, ll: list.New(), m: make(map[string]*list.Element)}
+}
+
+// Key computes the cache key for running code with the given configuration.
+// The pragmas in the code determine the Go versions and arguments used,
+// while conf and the environment of the server determine how the Go binaries
+// build and run the program.
+func (rc *runCache) Key(conf *execConfig, code string) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%q\x00%q\x00%q\x00", conf.gc, conf.gcs, conf.generators) // Maps are printed in sorted order
+	fmt.Fprintf(h, "%v\x00%q\x00%v\x00", conf.modules, conf.allowedModules, conf.disableCGO)
+	fmt.Fprintf(h, "%q\x00%q\x00%d\x00%d\x00%d\x00", conf.goEnv, os.Environ(), conf.maxOutput, conf.maxDisk, conf.runTimeout)
+	fmt.Fprintf(h, "%q", code)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (rc *runCache) Load(key string) (msgs []cachedMsg, status string, ok bool) {
+	if rc == nil {
+		return nil, "", false
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	e, ok := rc.m[key]
+	if !ok {
+		return nil, "", false
+	}
+	rc.ll.MoveToFront(e)
+	re := e.Value.(*runCacheEntry)
+	return re.msgs, re.status, true
+}
+
+func (rc *runCache) Store(key string, msgs []cachedMsg, status string) {
+	if rc == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if e, ok := rc.m[key]; ok {
+		re := e.Value.(*runCacheEntry)
+		re.msgs, re.status = msgs, status
+		rc.ll.MoveToFront(e)
+		return
+	}
+	rc.m[key] = rc.ll.PushFront(&runCacheEntry{key, msgs, status})
+	for rc.ll.Len() > rc.max {
+		e := rc.ll.Back()
+		rc.ll.Remove(e)
+		delete(rc.m, e.Value.(*runCacheEntry).key)
+	}
+}
+
+// Clear removes all cached runs.
+func (rc *runCache) Clear() {
+	if rc == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.ll.Init()
+	rc.m = make(map[string]*list.Element)
+}
+
+func (rc *runCache) Len() int {
+	if rc == nil {
+		return 0
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.ll.Len()
 }
 
-type blobStore struct {
-	mu sync.Mutex
-	m  map[string]blob
+// runQueue is a synchronized FIFO queue that limits the number of concurrent
+// runs across all executors. A nil runQueue is valid and imposes no limit.
+type runQueue struct {
+	mu      sync.Mutex
+	max     int
+	active  int
+	waiters []*int        // Waiters in FIFO order; pointers are unique tokens
+	changed chan struct{} // Closed and replaced whenever the queue changes
 }
 
-func newBlobStore() *blobStore {
-	return &blobStore{m: make(map[string]blob)}
+// newRunQueue returns a queue that permits up to max concurrent runs.
+// It returns nil if max is not positive.
+func newRunQueue(max int) *runQueue {
+	if max <= 0 {
+		return nil
+	}
+	return &runQueue{max: max, changed: make(chan struct{})}
 }
 
-func (bs *blobStore) Insert(b blob) (id string) {
-	h := md5.Sum(b.data) // Assume MIME doesn't change for given data
-	id = hex.EncodeToString(h[:])
-	bs.mu.Lock()
-	defer bs.mu.Unlock()
-	bs.m[id] = b
-	return id
+// Acquire blocks until the caller may start a run or until ctx is canceled.
+// While waiting, notify is called with the 1-based position in the queue
+// whenever the position changes. Release must be called if Acquire succeeds.
+func (q *runQueue) Acquire(ctx context.Context, notify func(pos int)) error {
+	if q == nil {
+		return nil
+	}
+	w := new(int) // Not a zero-sized type so that the pointer is unique
+	q.mu.Lock()
+	q.waiters = append(q.waiters, w)
+	var lastPos int
+	for {
+		var pos int
+		for pos < len(q.waiters) && q.waiters[pos] != w {
+			pos++
+		}
+		if pos == 0 && q.active < q.max {
+			q.remove(w)
+			q.active++
+			q.mu.Unlock()
+			return nil
+		}
+		changed := q.changed
+		q.mu.Unlock()
+
+		if pos+1 != lastPos {
+			notify(pos + 1)
+			lastPos = pos + 1
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			q.mu.Lock()
+			q.remove(w)
+			q.mu.Unlock()
+			return ctx.Err()
+		}
+		q.mu.Lock()
+	}
 }
 
-func (bs *blobStore) Retrieve(id string) blob {
-	bs.mu.Lock()
-	defer bs.mu.Unlock()
-	return bs.m[id]
+// Release informs the queue that a run has finished.
+func (q *runQueue) Release() {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	q.active--
+	q.broadcast()
+	q.mu.Unlock()
 }
 
-func (bs *blobStore) Delete(id string) {
-	bs.mu.Lock()
-	defer bs.mu.Unlock()
-	delete(bs.m, id)
+// remove removes w from the waiters. The lock must be held.
+func (q *runQueue) remove(w *int) {
+	for i, w2 := range q.waiters {
+		if w2 == w {
+			q.waiters = append(q.waiters[:i], q.waiters[i+1:]...)
+			q.broadcast()
+			return
+		}
+	}
 }
 
-func (bs *blobStore) Len() int {
-	bs.mu.Lock()
-	defer bs.mu.Unlock()
-	return len(bs.m)
+// broadcast wakes up all waiters. The lock must be held.
+func (q *runQueue) broadcast() {
+	close(q.changed)
+	q.changed = make(chan struct{})
 }
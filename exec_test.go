@@ -5,7 +5,15 @@
 package main
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"reflect"
 	"regexp"
 	"runtime"
 	"strings"
@@ -16,6 +24,18 @@ import (
 
 const reMagic = "RE> "
 
+// exitOK is the exit status of a program that exited successfully.
+var exitOK = wantExit(`{"exitCode":0,"reason":"exit"}`)
+
+// runSummary matches the summary printed after a program runs.
+const runSummary = reMagic + `^Compiled in .+, ran in .+, peak memory .+\.\n$`
+
+// wantExit returns a regexp matching the JSON exit status s
+// with any duration and memory usage.
+func wantExit(s string) string {
+	return reMagic + "^" + regexp.QuoteMeta(strings.TrimSuffix(s, "}")) + `,"duration":[0-9.e-]+,"maxRSS":[0-9]+\}$`
+}
+
 type message struct {
 	action, data string // If data starts with a "RE> ", then it is a regexp
 }
@@ -137,9 +157,40 @@ func TestExecutor(t *testing.T) {
 	mt := newMessageTester(t)
 	bs := newBlobStore()
 	gcs := map[string]string{"go-alpha": "go", "go-beta": "go"}
-	ex := newExecutor(bs, "go", "gofmt", gcs, mt.SendMessage)
+	linters := map[string]string{"gofmt": "gofmt"}
+	ex := newExecutor(bs, execConfig{gc: "go", fmt: "gofmt", gcs: gcs, linters: linters, cache: newRunCache(1)}, mt.SendMessage)
 	defer ex.Close()
 
+	// reportChecker returns a check function that verifies that a run
+	// reports each of the named files before it stops.
+	// It is called by SendMessage, which already holds the lock on mt.
+	reportChecker := func(names ...string) func(action, data string) {
+		reported := make(map[string]bool)
+		return func(action, data string) {
+			switch action {
+			case reportProfile:
+				var r struct{ Name string }
+				if err := json.Unmarshal([]byte(data), &r); err != nil {
+					mt.t.Errorf("invalid reportProfile: %v", data)
+				}
+				reported[r.Name] = true
+			case statusStopped:
+				for _, name := range names {
+					if !reported[name] {
+						mt.t.Errorf("missing report: %v", name)
+					}
+				}
+				mt.Next <- struct{}{}
+			}
+		}
+	}
+
+	// A run of cachedCode is replayed from the cache when run again,
+	// which must report the same exit status.
+	const cachedCode = `package main; func main() { println("cached") }`
+	var cachedStatus string
+	var replayed bool
+
 	tests := []struct {
 		label string // Name of the test
 		long  bool   // Does this test take a long time?
@@ -178,6 +229,67 @@ func TestExecutor(t *testing.T) {
 			{appendStderr, "RE> main.go:4:1:.*\n"},
 			{statusUpdate, "RE> Unexpected error: .*\n"},
 			{markLines, "[4]"},
+			{markNotes, `RE> ^\[{"line":4,"column":1,"kind":"error","message":".+"}\]$`},
+			{statusStopped, ""},
+		},
+	}, {
+		label:  "FormatRunValid",
+		action: actionFormatRun,
+		data:   `package main;import "fmt"; func main() { fmt.Println("Hello, world!") }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Formatting source...\n"},
+			{actionFormat, "package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Println(\"Hello, world!\") }\n"},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program...\n"},
+			{clearOutput, ""},
+			{appendStdout, "Hello, world!\n"},
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label:  "FormatRunInvalid",
+		action: actionFormatRun,
+		data:   "package main\n\n\nnot valid go",
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Formatting source...\n"},
+			{appendStderr, "RE> main.go:4:1:.*\n"},
+			{statusUpdate, "RE> Unexpected error: .*\n"},
+			{markLines, "[4]"},
+			{markNotes, `RE> ^\[{"line":4,"column":1,"kind":"error","message":".+"}\]$`},
+			{statusStopped, ""},
+		},
+	}, {
+		label:  "LintValid",
+		action: actionLint,
+		data:   "package main\n\nfunc main() {}\n",
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Running gofmt...\n"},
+			{appendStdout, "package main\n\nfunc main() {}\n"},
+			{statusUpdate, "No issues found.\n"},
+			{statusUpdate, "\n"},
+			{statusStopped, ""},
+		},
+	}, {
+		label:  "LintInvalid",
+		action: actionLint,
+		data:   "package main\n\n\nnot valid go",
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Running gofmt...\n"},
+			{appendStderr, "RE> main_test.go:4:1:.*\n"},
+			{statusUpdate, "Linter reported issues.\n"},
+			{markLines, "[4]"},
+			{markNotes, `RE> ^\[{"line":4,"column":1,"kind":"error","message":".+"}\]$`},
+			{statusUpdate, "\n"},
 			{statusStopped, ""},
 		},
 	}, {
@@ -192,6 +304,7 @@ func TestExecutor(t *testing.T) {
 			{appendStderr, "RE> main_test.go:4:1:.*\n"},
 			{statusUpdate, "RE> Unexpected error: .*\n"},
 			{markLines, "[4]"},
+			{markNotes, `RE> ^\[{"line":4,"column":1,"kind":"error","message":".+"}\]$`},
 			{statusStopped, ""},
 		},
 	}, {
@@ -205,8 +318,9 @@ func TestExecutor(t *testing.T) {
 			{clearOutput, ""},
 			{appendStderr, "stderr\n"},
 			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
 			{statusUpdate, "\n"},
-			{statusStopped, ""},
+			{statusStopped, exitOK},
 		},
 	}, {
 		label:  "RunValid2",
@@ -219,8 +333,37 @@ func TestExecutor(t *testing.T) {
 			{clearOutput, ""},
 			{appendStdout, "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n"},
 			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
 			{statusUpdate, "\n"},
-			{statusStopped, ""},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label:  "RunExitCode",
+		action: actionRun,
+		data:   `package main; import "os"; func main() { os.Exit(3) }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program...\n"},
+			{clearOutput, ""},
+			{statusUpdate, "Unexpected error: exit status 3\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, wantExit(`{"exitCode":3,"reason":"exit"}`)},
+		},
+	}, {
+		label:  "RunKilled",
+		action: actionRun,
+		data:   `package main; import "syscall"; func main() { syscall.Kill(syscall.Getpid(), syscall.SIGKILL); select {} }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program...\n"},
+			{clearOutput, ""},
+			{statusUpdate, "Unexpected error: signal: killed\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, wantExit(`{"exitCode":-1,"signal":"SIGKILL","reason":"signal"}`)},
 		},
 	}, {
 		label:  "RunBadPackage",
@@ -254,8 +397,9 @@ func TestExecutor(t *testing.T) {
 			{clearOutput, ""},
 			{appendStdout, "RE> FAIL: Test(.*|\n)*test error\n"},
 			{statusUpdate, "RE> Unexpected error: .*\n"},
+			{statusUpdate, runSummary},
 			{statusUpdate, "\n"},
-			{statusStopped, ""},
+			{statusStopped, wantExit(`{"exitCode":1,"reason":"exit"}`)},
 		},
 	}, {
 		label:  "RunForever",
@@ -272,8 +416,9 @@ func TestExecutor(t *testing.T) {
 		action: actionStop,
 		want: []message{
 			{statusUpdate, "RE> Unexpected error:.*\n"},
+			{statusUpdate, runSummary},
 			{statusUpdate, "\n"},
-			{statusStopped, ""},
+			{statusStopped, wantExit(`{"exitCode":-1,"signal":"SIGKILL","reason":"stopped"}`)},
 		},
 	}, {
 		label:  "PragmaBadVersions",
@@ -330,6 +475,28 @@ func TestExecutor(t *testing.T) {
 			{statusUpdate, "Profiling is only available on test suites"},
 			{statusStopped, ""},
 		},
+	}, {
+		label:  "PragmaBadCoverUsage",
+		action: actionRun,
+		data: `//playground:cover
+			package main; func main(){}`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Coverage is only available on test suites"},
+			{statusStopped, ""},
+		},
+	}, {
+		label:  "PragmaBadCoverMode",
+		action: actionRun,
+		data: `//playground:cover mode-bad
+			package main; import "testing"; func Test(t *testing.T) {}`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Unknown coverage mode: mode-bad\n"},
+			{statusStopped, ""},
+		},
 	}, {
 		label:  "PragmaVersions",
 		action: actionRun,
@@ -342,13 +509,15 @@ func TestExecutor(t *testing.T) {
 			{statusUpdate, "Starting program... (command: ./main)\n"},
 			{appendStdout, "hello\n"},
 			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
 			{statusUpdate, "\n"},
 			{statusUpdate, "Compiling program... (command: go build main.go)\n"},
 			{statusUpdate, "Starting program... (command: ./main)\n"},
 			{appendStdout, "hello\n"},
 			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
 			{statusUpdate, "\n"},
-			{statusStopped, ""},
+			{statusStopped, exitOK},
 		},
 	}, {
 		label:  "PragmaBuildArgs",
@@ -362,6 +531,7 @@ func TestExecutor(t *testing.T) {
 					go func(i int) { x = i; c<-true }(i)
 				}
 				for i := 0; i < 10; i++ { <-c }
+				println(x)
 			}`,
 		want: []message{
 			{statusStarted, ""},
@@ -370,7 +540,64 @@ func TestExecutor(t *testing.T) {
 			{statusUpdate, "Starting program... (command: ./main)\n"},
 			{appendStderr, "RE> WARNING: DATA RACE"},
 			{statusUpdate, "RE> Unexpected error: .*\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, wantExit(`{"exitCode":66,"reason":"exit"}`)},
+		},
+	}, {
+		label:  "RunFileTree",
+		action: actionRun,
+		data: `package main
+import "playground/internal/greet"
+func main() { println(greet.Hello("tree")) }
+-- internal/greet/greet.go --
+package greet
+func Hello(s string) string { return "hello, " + s }
+`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program... (command: GO111MODULE=on GOPROXY=off go build -o main .)\n"},
+			{statusUpdate, "Starting program... (command: ./main)\n"},
+			{appendStderr, "hello, tree\n"},
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label:  "RunFileTreeTests",
+		action: actionRun,
+		data: `package main
+import ("testing"; "example.com/m/sum")
+func TestSum(t *testing.T) {
+	if sum.Sum(1, 2) != 3 { t.Fatal("wrong sum") }
+}
+-- go.mod --
+module example.com/m
+-- sum/sum.go --
+package sum
+func Sum(x, y int) int { return x + y }
+`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program... (command: GO111MODULE=on GOPROXY=off go test -c -o main.test .)\n"},
+			{statusUpdate, "Starting program... (command: ./main.test -test.v -test.run=. -test.bench=.)\n"},
+			{appendStdout, "RE> ^=== RUN   TestSum\n--- PASS: TestSum .*\nPASS\n$"},
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
 			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label:  "RunFileTreeInvalid",
+		action: actionRun,
+		data:   "package main\nfunc main() {}\n-- ../escape.go --\npackage escape\n",
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Invalid file tree: invalid file name: \"../escape.go\"\n"},
 			{statusStopped, ""},
 		},
 	}, {
@@ -392,9 +619,171 @@ func TestExecutor(t *testing.T) {
 			{statusUpdate, "Starting program... (command: ./main -myflag=1337)\n"},
 			{appendStdout, "1337\n"},
 			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label:  "PragmaAsm",
+		action: actionRun,
+		data: `//playground:asm
+			package main
+			func add(x, y int) int { return x + y }
+			func main() { println(add(1, 2)) }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program... (command: go build -gcflags=-S main.go)\n"},
+			{statusUpdate, "Assembly generated.\n"},
+			{reportProfile, `RE> ^{"id":"[0-9a-f]+","mime":"text/plain; charset=utf-8","name":"asm.s"}$`},
+			{statusUpdate, "\n"},
+			{statusStopped, ""},
+		},
+	}, {
+		label:  "PragmaGCFlags",
+		action: actionRun,
+		data: `//playground:gcflags -m
+			package main
+			func add(x, y int) int { return x + y }
+			func main() { println(add(1, 2)) }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program... (command: go build -gcflags=-m main.go)\n"},
+			{appendStderr, "RE> can inline add"},
+			{markNotes, `RE> {"line":3,"column":\d+,"kind":"inline","message":"can inline add"}`},
+			{statusUpdate, "Starting program... (command: ./main)\n"},
+			{appendStderr, "3\n"},
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label:  "PragmaBuildTags",
+		action: actionRun,
+		data: `//go:build foo
+
+			//playground:buildtags foo bar
+			package main
+			func main() { println("tagged") }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program... (command: go build -tags=foo,bar main.go)\n"},
+			{statusUpdate, "Starting program... (command: ./main)\n"},
+			{appendStderr, "tagged\n"},
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
 			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label:  "PragmaBuildTagsExcluded",
+		action: actionRun,
+		data: `//go:build foo
+
+			package main
+			func main() { println("tagged") }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Program is excluded by its build constraints; use the buildtags magic comment to satisfy them.\n"},
+			{statusStopped, ""},
+		},
+	}, {
+		label:  "PragmaCGO",
+		action: actionRun,
+		data: `//playground:cgo
+			package main
+
+			// int answer() { return undefined; }
+			import "C"
+
+			func main() { println(C.answer()) }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program... (command: CGO_ENABLED=1 go build main.go)\n"},
+			{appendStderr, "RE> main.go:4:.*undefined"},
+			{statusUpdate, "RE> Unexpected error: .*\n"},
+			{markLines, "RE> ^\\[4(,4)*\\]$"},
+			{markNotes, `RE> ^\[{"line":4,"column":\d+,"kind":"error","message":"[^"]*undefined`},
+			{statusStopped, ""},
+		},
+	}, {
+		label:  "PragmaCGOOff",
+		action: actionRun,
+		data: `//playground:cgo off
+			package main
+			import "C"
+			func main() {}`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Program imports \"C\", but cgo is disabled; use the cgo magic comment to enable it.\n"},
 			{statusStopped, ""},
 		},
+	}, {
+		label:  "PragmaCover",
+		action: actionRun,
+		data: `//playground:cover count
+			package main
+			import "testing"
+			func abs(x int) int {
+				if x < 0 {
+					return -x
+				}
+				return x
+			}
+			func TestAbs(t *testing.T) {
+				if abs(5) != 5 {
+					t.Error("abs(5) != 5")
+				}
+			}`,
+		check: func() func(action, data string) {
+			var hasStarted, hasCoverage, hasReport, hasCovered, hasUncovered, hasStopped bool
+			return func(action, data string) {
+				switch {
+				case !hasStarted:
+					if action == statusStarted {
+						hasStarted = true
+					}
+				case !hasCoverage:
+					if action == appendStdout && strings.Contains(data, "coverage: ") {
+						hasCoverage = true
+					}
+				case !hasReport:
+					if action == reportProfile {
+						if !strings.Contains(data, `"name":"cover.html"`) {
+							mt.Errorf("invalid reportProfile: %v", data)
+						}
+						hasReport = true
+					}
+				case !hasCovered:
+					if action == markLines {
+						if want := `{"mode":"covered","lines":[5,8,11]}`; data != want {
+							mt.t.Errorf("covered markLines = %v, want %v", data, want)
+						}
+						hasCovered = true
+					}
+				case !hasUncovered:
+					if action == markLines {
+						if want := `{"mode":"uncovered","lines":[6,7,12,13]}`; data != want {
+							mt.t.Errorf("uncovered markLines = %v, want %v", data, want)
+						}
+						hasUncovered = true
+					}
+				case !hasStopped:
+					if action == statusStopped {
+						mt.Next <- struct{}{}
+						hasStopped = true
+					}
+				default:
+					mt.Errorf("got unexpected message{action: %s, data: %q}", action, data)
+				}
+			}
+		}(),
 	}, {
 		label:  "PragmaPProfArgs",
 		long:   true,
@@ -438,6 +827,109 @@ func TestExecutor(t *testing.T) {
 				}
 			}
 		}(),
+	}, {
+		label:  "PragmaPProfTrace",
+		long:   true,
+		action: actionRun,
+		data: `//playground:pprof trace
+				//playground:execargs -test.run=- -test.bench=. -test.benchtime=1000x
+				package main
+				import "testing"
+				import "sync"
+				func Benchmark(b *testing.B) {
+					var wg sync.WaitGroup
+					for i:= 0; i < b.N; i++ {
+						wg.Add(1)
+						go wg.Done()
+						wg.Wait()
+					}
+				}`,
+		check: reportChecker("trace.out"),
+	}, {
+		label:  "PragmaPProfBlock",
+		long:   true,
+		action: actionRun,
+		data: `//playground:pprof block
+				//playground:execargs -test.run=- -test.bench=. -test.benchtime=1000x
+				package main
+				import "testing"
+				func Benchmark(b *testing.B) {
+					c := make(chan int)
+					for i:= 0; i < b.N; i++ {
+						go func() { c <- i }()
+						<-c
+					}
+				}`,
+		check: reportChecker("block_list.html"),
+	}, {
+		label:  "PragmaPProfMutex",
+		long:   true,
+		action: actionRun,
+		data: `//playground:pprof mutex
+				//playground:execargs -test.run=- -test.bench=. -test.benchtime=1000x
+				package main
+				import "testing"
+				import "sync"
+				import "time"
+				func Benchmark(b *testing.B) {
+					var mu sync.Mutex
+					var wg sync.WaitGroup
+					for i:= 0; i < b.N; i++ {
+						wg.Add(2)
+						for j := 0; j < 2; j++ {
+							go func() {
+								mu.Lock()
+								time.Sleep(time.Microsecond)
+								mu.Unlock()
+								wg.Done()
+							}()
+						}
+						wg.Wait()
+					}
+				}`,
+		check: reportChecker("mutex_list.html"),
+	}, {
+		label:  "PragmaGCTrace",
+		action: actionRun,
+		data: `//playground:gctrace
+				package main
+				import "runtime"
+				var sink []byte
+				func main() {
+					for i := 0; i < 3; i++ {
+						sink = make([]byte, 1<<20)
+						runtime.GC()
+					}
+				}`,
+		check: reportChecker("gctrace.json"),
+	}, {
+		label:  "RunCached",
+		action: actionRun,
+		data:   cachedCode,
+		check: func(action, data string) {
+			if action == statusStopped {
+				cachedStatus = data
+				mt.Next <- struct{}{}
+			}
+		},
+	}, {
+		label:  "RunCachedReplay",
+		action: actionRun,
+		data:   cachedCode,
+		check: func(action, data string) {
+			switch action {
+			case statusUpdate:
+				replayed = replayed || data == "Output replayed from cache.\n"
+			case statusStopped:
+				if !replayed {
+					mt.t.Errorf("output not replayed from cache")
+				}
+				if data == "" || data != cachedStatus {
+					mt.t.Errorf("mismatching statusStopped after replay:\ngot  %q\nwant %q", data, cachedStatus)
+				}
+				mt.Next <- struct{}{}
+			}
+		},
 	}}
 
 	for _, tt := range tests {
@@ -457,7 +949,7 @@ func TestExecutor(t *testing.T) {
 			}
 
 			switch tt.action {
-			case actionFormat, actionRun:
+			case actionFormat, actionFormatRun, actionRun, actionLint:
 				ex.Start(tt.action, tt.data)
 			case actionStop:
 				ex.Stop()
@@ -486,3 +978,1036 @@ func TestExecutor(t *testing.T) {
 		t.Errorf("unexpected non-empty blobStore: got %d blobs", n)
 	}
 }
+
+func TestRunCache(t *testing.T) {
+	mt := newMessageTester(t)
+	rc := newRunCache(1)
+	ex := newExecutor(newBlobStore(), execConfig{gc: "go", fmt: "gofmt", cache: rc}, mt.SendMessage)
+	defer ex.Close()
+
+	const code1 = `package main; import "fmt"; func main() { fmt.Println("Hello, world!") }`
+	const code2 = `package main; import "fmt"; func main() { fmt.Println("Goodbye, world!") }`
+	ran := func(s string) []message {
+		return []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program...\n"},
+			{clearOutput, ""},
+			{appendStdout, s},
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		}
+	}
+	replayed := func(s string) []message {
+		m := ran(s)
+		return append(m[:len(m)-1:len(m)-1], message{statusUpdate, "Output replayed from cache.\n"}, message{statusStopped, exitOK})
+	}
+
+	tests := []struct {
+		label string
+		data  string
+		want  []message
+	}{
+		{"Run1", code1, ran("Hello, world!\n")},
+		{"Replay1", code1, replayed("Hello, world!\n")},
+		{"Run2", code2, ran("Goodbye, world!\n")},
+		{"Replay2", code2, replayed("Goodbye, world!\n")},
+		{"Evicted1", code1, ran("Hello, world!\n")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			mt.SetT(t)
+			mt.WantMessages(tt.want)
+			ex.Start(actionRun, tt.data)
+			select {
+			case <-mt.Next:
+				if t.Failed() {
+					t.Fatalf("failed test")
+				}
+			case <-time.After(30 * time.Second):
+				t.Fatalf("timed out")
+			}
+		})
+	}
+	if n := rc.Len(); n != 1 {
+		t.Errorf("unexpected runCache size: got %d, want 1", n)
+	}
+
+	// A run with a different configuration must not replay cached output.
+	mt2 := newMessageTester(t)
+	ex2 := newExecutor(newBlobStore(), execConfig{gc: "go", fmt: "gofmt", cache: rc, goEnv: []string{"GOFLAGS=-trimpath"}}, mt2.SendMessage)
+	defer ex2.Close()
+	mt2.WantMessages(ran("Hello, world!\n"))
+	ex2.Start(actionRun, code1)
+	select {
+	case <-mt2.Next:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("timed out")
+	}
+
+	rc.Clear()
+	if _, _, ok := rc.Load(rc.Key(&ex.execConfig, code1)); ok || rc.Len() != 0 {
+		t.Errorf("runCache not empty after Clear")
+	}
+}
+
+func TestRunCacheKey(t *testing.T) {
+	const code = `package main; func main() {}`
+	base := func() execConfig {
+		return execConfig{gc: "go", gcs: map[string]string{"go1.20": "go1.20"}}
+	}
+	conf := base()
+	want := newRunCache(1).Key(&conf, code)
+
+	tests := []struct {
+		label  string
+		modify func(*execConfig)
+		same   bool
+	}{
+		{"Same", func(c *execConfig) {}, true},
+		{"Linters", func(c *execConfig) { c.linters = map[string]string{"vet": "go-vet"} }, true},
+		{"GoBinary", func(c *execConfig) { c.gc = "go2" }, false},
+		{"GoVersions", func(c *execConfig) { c.gcs["go1.21"] = "go1.21" }, false},
+		{"Generators", func(c *execConfig) { c.generators = map[string]string{"stringer": "stringer"} }, false},
+		{"Modules", func(c *execConfig) { c.modules = true }, false},
+		{"AllowedModules", func(c *execConfig) { c.allowedModules = []string{"golang.org/x"} }, false},
+		{"GoEnv", func(c *execConfig) { c.goEnv = []string{"GOPROXY=off"} }, false},
+		{"DisableCGO", func(c *execConfig) { c.disableCGO = true }, false},
+		{"MaxOutput", func(c *execConfig) { c.maxOutput = 1024 }, false},
+		{"MaxDisk", func(c *execConfig) { c.maxDisk = 1 << 20 }, false},
+		{"RunTimeout", func(c *execConfig) { c.runTimeout = time.Minute }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			conf := base()
+			tt.modify(&conf)
+			got := newRunCache(1).Key(&conf, code)
+			if (got == want) != tt.same {
+				t.Errorf("Key equality mismatch: got %v, want %v", got == want, tt.same)
+			}
+		})
+	}
+}
+
+func TestRunQueue(t *testing.T) {
+	q := newRunQueue(1)
+	if err := q.Acquire(context.Background(), func(int) { t.Errorf("unexpected wait") }); err != nil {
+		t.Fatalf("Acquire error: %v", err)
+	}
+
+	// Queue up two waiters behind the active run.
+	var mu sync.Mutex
+	var got []string
+	acquire := func(name string, ctx context.Context, done chan<- error) {
+		done <- q.Acquire(ctx, func(pos int) {
+			mu.Lock()
+			got = append(got, fmt.Sprintf("%s:%d", name, pos))
+			mu.Unlock()
+		})
+	}
+	waitPos := func(want ...string) {
+		for i := 0; i < 100; i++ {
+			mu.Lock()
+			ok := reflect.DeepEqual(got, want)
+			mu.Unlock()
+			if ok {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		t.Fatalf("mismatching positions:\ngot  %q\nwant %q", got, want)
+	}
+	ctxA, cancelA := context.WithCancel(context.Background())
+	doneA, doneB := make(chan error, 1), make(chan error, 1)
+	go acquire("A", ctxA, doneA)
+	waitPos("A:1")
+	go acquire("B", context.Background(), doneB)
+	waitPos("A:1", "B:2")
+
+	// Canceling the first waiter moves the second waiter up.
+	cancelA()
+	if err := <-doneA; err != context.Canceled {
+		t.Errorf("Acquire error: got %v, want %v", err, context.Canceled)
+	}
+	waitPos("A:1", "B:2", "B:1")
+
+	// Releasing the active run lets the second waiter proceed.
+	q.Release()
+	if err := <-doneB; err != nil {
+		t.Errorf("Acquire error: %v", err)
+	}
+	q.Release()
+
+	var nilQueue *runQueue
+	if err := nilQueue.Acquire(context.Background(), nil); err != nil {
+		t.Errorf("Acquire error: %v", err)
+	}
+	nilQueue.Release()
+}
+
+func TestGracefulStop(t *testing.T) {
+	// The linter handles the interrupt and exits after cleaning up.
+	linter := filepath.Join(t.TempDir(), "linter.sh")
+	script := "#!/bin/sh\ntrap 'echo cleanup; exit 1' INT\necho ready\nwhile :; do sleep 0.1; done\n"
+	if err := ioutil.WriteFile(linter, []byte(script), 0775); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+
+	tests := []struct {
+		label  string
+		action string
+		data   string
+	}{{
+		// The program handles the interrupt and exits after cleaning up.
+		label:  "Run",
+		action: actionRun,
+		data: `package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+)
+
+func main() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+	fmt.Println("ready")
+	<-c
+	fmt.Println("cleanup")
+}`,
+	}, {
+		label:  "Lint",
+		action: actionLint,
+		data:   "package main\n\nfunc main() {}\n",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			var mu sync.Mutex
+			var stdout string
+			ready := make(chan struct{}, 1)
+			mt := newMessageTester(t)
+			mt.MessageChecker(func(action, data string) {
+				mu.Lock()
+				defer mu.Unlock()
+				if action == appendStdout {
+					stdout += data
+					if strings.Contains(stdout, "ready") {
+						select {
+						case ready <- struct{}{}:
+						default:
+						}
+					}
+				}
+			})
+			conf := execConfig{gc: "go", fmt: "gofmt", linters: map[string]string{"sh": linter}, stopSignal: os.Interrupt, stopGrace: time.Minute}
+			ex := newExecutor(newBlobStore(), conf, mt.SendMessage)
+			defer ex.Close()
+
+			ex.Start(tt.action, tt.data)
+			select {
+			case <-ready:
+			case <-time.After(time.Minute):
+				t.Fatal("timed out waiting for process to start")
+			}
+			ex.Stop()
+
+			mu.Lock()
+			defer mu.Unlock()
+			if want := "ready\ncleanup\n"; stdout != want {
+				t.Errorf("stdout = %q, want %q", stdout, want)
+			}
+		})
+	}
+}
+
+func TestLinterEnv(t *testing.T) {
+	linter := filepath.Join(t.TempDir(), "linter.sh")
+	if err := ioutil.WriteFile(linter, []byte("#!/bin/sh\necho \"$GOFLAGS $CGO_ENABLED\"\n"), 0775); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+	mt := newMessageTester(t)
+	conf := execConfig{gc: "go", fmt: "gofmt", linters: map[string]string{"env": linter}, goEnv: []string{"GOFLAGS=-mod=mod"}, disableCGO: true}
+	ex := newExecutor(newBlobStore(), conf, mt.SendMessage)
+	defer ex.Close()
+
+	mt.WantMessages([]message{
+		{statusStarted, ""},
+		{clearOutput, ""},
+		{statusUpdate, "Running env...\n"},
+		{appendStdout, "-mod=mod 0\n"},
+		{statusUpdate, "No issues found.\n"},
+		{statusUpdate, "\n"},
+		{statusStopped, ""},
+	})
+	ex.Start(actionLint, "package main\n\nfunc main() {}\n")
+	select {
+	case <-mt.Next:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("timed out")
+	}
+}
+
+func TestParseBenchmarks(t *testing.T) {
+	const output = `goos: linux
+BenchmarkA-8   	 1000000	      1052 ns/op
+BenchmarkB-8   	     500	   2.5e+06 ns/op	  97.31 MB/s	     128 B/op	       2 allocs/op
+BenchmarkC-8   	--- FAIL: BenchmarkC-8
+PASS
+`
+	got := parseBenchmarks([]byte(output))
+	want := []benchResult{
+		{Name: "BenchmarkA-8", N: 1000000, NsPerOp: 1052},
+		{Name: "BenchmarkB-8", N: 500, NsPerOp: 2.5e6, MBPerSec: 97.31, BytesPerOp: 128, AllocsPerOp: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseBenchmarks mismatch:\ngot  %+v\nwant %+v", got, want)
+	}
+}
+
+func TestRecordBench(t *testing.T) {
+	type record struct {
+		id int64
+		rs []benchResult
+	}
+	records := make(chan record, 10)
+	stopped := make(chan struct{}, 10)
+	conf := execConfig{gc: "go", fmt: "gofmt", cache: newRunCache(1), recordBench: func(id int64, goVersion string, rs []benchResult) {
+		records <- record{id, rs}
+	}}
+	ex := newExecutor(newBlobStore(), conf, func(action, data string) error {
+		if action == statusStopped {
+			stopped <- struct{}{}
+		}
+		return nil
+	})
+	defer ex.Close()
+
+	const code = `package main
+		import "testing"
+		func BenchmarkNop(b *testing.B) { for i := 0; i < b.N; i++ {} }`
+	run := func(code string) {
+		t.Helper()
+		ex.Start(actionRun, code)
+		select {
+		case <-stopped:
+		case <-time.After(30 * time.Second):
+			t.Fatalf("timed out")
+		}
+	}
+
+	// Runs of unknown snippets are not recorded.
+	run(code)
+	if len(records) > 0 {
+		t.Errorf("unexpected benchmark record: %+v", <-records)
+	}
+
+	// Every run of a known snippet is recorded, even if the code is unchanged.
+	ex.SetSnippet(7)
+	for i := 0; i < 2; i++ {
+		run(code)
+		select {
+		case r := <-records:
+			if r.id != 7 || len(r.rs) != 1 || !strings.HasPrefix(r.rs[0].Name, "BenchmarkNop") || r.rs[0].N <= 0 {
+				t.Errorf("unexpected benchmark record: %+v", r)
+			}
+		default:
+			t.Errorf("missing benchmark record of run %d", i+1)
+		}
+	}
+}
+
+func TestSignal(t *testing.T) {
+	var mu sync.Mutex
+	var stdout, status string
+	ready := make(chan struct{}, 1)
+	stopped := make(chan struct{}, 1)
+	mt := newMessageTester(t)
+	mt.MessageChecker(func(action, data string) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch action {
+		case appendStdout:
+			stdout += data
+			if strings.Contains(stdout, "ready") {
+				select {
+				case ready <- struct{}{}:
+				default:
+				}
+			}
+		case statusUpdate:
+			status += data
+		case statusStopped:
+			stopped <- struct{}{}
+		}
+	})
+	ex := newExecutor(newBlobStore(), execConfig{gc: "go", fmt: "gofmt"}, mt.SendMessage)
+	defer ex.Close()
+
+	ex.Signal("SIGUSR1")
+	ex.Signal("SIGBOGUS")
+	ex.Start(actionRun, `package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func main() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGUSR1)
+	fmt.Println("ready")
+	fmt.Println(<-c)
+}`)
+	select {
+	case <-ready:
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for program to start")
+	}
+	ex.Signal("SIGUSR1")
+	select {
+	case <-stopped:
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for program to exit")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want := "ready\nuser defined signal 1\n"; stdout != want {
+		t.Errorf("stdout = %q, want %q", stdout, want)
+	}
+	for _, want := range []string{"No program is running.\n", "Unknown signal: SIGBOGUS\n", "Sent SIGUSR1 to program.\n"} {
+		if !strings.Contains(status, want) {
+			t.Errorf("status = %q, want it to contain %q", status, want)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	// The generator declares a constant in a new file.
+	binDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(binDir)
+	gen := filepath.Join(binDir, "gen.sh")
+	script := "#!/bin/sh\nprintf 'package main\\n\\nconst generated = \"hello\"\\n' > gen.go\n"
+	if err := ioutil.WriteFile(gen, []byte(script), 0775); err != nil {
+		t.Fatal(err)
+	}
+
+	mt := newMessageTester(t)
+	conf := execConfig{gc: "go", fmt: "gofmt", generators: map[string]string{"gen": gen}}
+	ex := newExecutor(newBlobStore(), conf, mt.SendMessage)
+	defer ex.Close()
+
+	tests := []struct {
+		label string
+		data  string
+		want  []message
+	}{{
+		label: "Generate",
+		data: `//playground:generate
+package main
+
+//go:generate gen
+
+func main() { println(generated) }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Generating code... (command: go generate ./...)\n"},
+			{statusUpdate, "Compiling program... (command: go build main.go gen.go)\n"},
+			{statusUpdate, "Starting program... (command: ./main)\n"},
+			{appendStderr, "hello\n"},
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label: "GenerateUnknown",
+		data: `//playground:generate
+package main
+
+//go:generate unknown-generator
+
+func main() {}`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Generating code... (command: go generate ./...)\n"},
+			{appendStderr, "RE> main.go:4: running \"unknown-generator\""},
+			{statusUpdate, "RE> Unexpected error: .*\n"},
+			{markLines, "[4]"},
+			{markNotes, `RE> ^\[{"line":4,"column":0,"kind":"error","message":"running \\"unknown-generator\\": .+"}\]$`},
+			{statusStopped, ""},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			mt.SetT(t)
+			mt.WantMessages(tt.want)
+			ex.Start(actionRun, tt.data)
+			select {
+			case <-mt.Next:
+			case <-time.After(30 * time.Second):
+				t.Fatalf("timed out")
+			}
+		})
+	}
+}
+
+func TestModules(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Serve a module from a local proxy and download it to a private cache.
+	proxyDir := filepath.Join(tmpDir, "proxy", "example.com", "greet", "@v")
+	if err := os.MkdirAll(proxyDir, 0775); err != nil {
+		t.Fatal(err)
+	}
+	const gomod = "module example.com/greet\n"
+	files := map[string]string{
+		"list":        "v1.0.0\nv1.1.0\n",
+		"v1.0.0.info": `{"Version":"v1.0.0"}`,
+		"v1.0.0.mod":  gomod,
+		"v1.1.0.info": `{"Version":"v1.1.0"}`,
+		"v1.1.0.mod":  gomod,
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(proxyDir, name), []byte(data), 0664); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, v := range []string{"v1.0.0", "v1.1.0"} {
+		bb := new(bytes.Buffer)
+		zw := zip.NewWriter(bb)
+		for name, data := range map[string]string{
+			"go.mod":   gomod,
+			"greet.go": "package greet\n\nconst Hello = \"hello from " + v + "\"\n",
+		} {
+			w, _ := zw.Create("example.com/greet@" + v + "/" + name)
+			w.Write([]byte(data))
+		}
+		zw.Close()
+		if err := ioutil.WriteFile(filepath.Join(proxyDir, v+".zip"), bb.Bytes(), 0664); err != nil {
+			t.Fatal(err)
+		}
+	}
+	goEnv := []string{
+		"GOPROXY=file://" + filepath.ToSlash(filepath.Join(tmpDir, "proxy")),
+		"GOSUMDB=off",
+		"GOMODCACHE=" + filepath.Join(tmpDir, "modcache"),
+		"GOFLAGS=-modcacherw",
+	}
+
+	mt := newMessageTester(t)
+	conf := execConfig{gc: "go", fmt: "gofmt", modules: true, allowedModules: []string{"example.com/greet@v1.0.0"}, goEnv: goEnv}
+	ex := newExecutor(newBlobStore(), conf, mt.SendMessage)
+	defer ex.Close()
+
+	tests := []struct {
+		label string
+		data  string
+		want  []message
+	}{{
+		label: "Stdlib",
+		data:  `package main; import "fmt"; func main() { fmt.Println("hello") }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program...\n"},
+			{clearOutput, ""},
+			{appendStdout, "hello\n"},
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label: "Allowed",
+		data:  `package main; import "fmt"; import "example.com/greet"; func main() { fmt.Println(greet.Hello) }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Downloading modules...\n"},
+			{appendStderr, "go: downloading example.com/greet v1.0.0\n"},
+			{statusUpdate, "Compiling program...\n"},
+			{clearOutput, ""},
+			{appendStdout, "hello from v1.0.0\n"}, // Pinned to an older version
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label: "Disallowed",
+		data:  `package main; import "example.com/other"; func main() { other.Do() }`,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Import of \"example.com/other\" is not allowed; allowed modules are: example.com/greet@v1.0.0\n"},
+			{statusStopped, ""},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			mt.SetT(t)
+			mt.WantMessages(tt.want)
+			ex.Start(actionRun, tt.data)
+			select {
+			case <-mt.Next:
+			case <-time.After(30 * time.Second):
+				t.Fatalf("timed out")
+			}
+		})
+	}
+}
+
+func TestOutputLimit(t *testing.T) {
+	var mu sync.Mutex
+	var stdout, status, exit string
+	stopped := make(chan struct{}, 1)
+	mt := newMessageTester(t)
+	mt.MessageChecker(func(action, data string) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch action {
+		case appendStdout:
+			stdout += data
+		case statusUpdate:
+			status += data
+		case statusStopped:
+			exit = data
+			stopped <- struct{}{}
+		}
+	})
+	ex := newExecutor(newBlobStore(), execConfig{gc: "go", fmt: "gofmt", maxOutput: 1000}, mt.SendMessage)
+	defer ex.Close()
+
+	// The program prints forever unless killed.
+	ex.Start(actionRun, `package main; import "fmt"; func main() { for { fmt.Println("spam") } }`)
+	select {
+	case <-stopped:
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for program to be killed")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(stdout) != 1000 {
+		t.Errorf("len(stdout) = %d, want 1000", len(stdout))
+	}
+	if want := "Output limit exceeded (1000 bytes); program killed.\n"; !strings.Contains(status, want) {
+		t.Errorf("status = %q, want it to contain %q", status, want)
+	}
+	if want := `{"exitCode":-1,"signal":"SIGKILL","reason":"outputLimit",`; !strings.HasPrefix(exit, want) {
+		t.Errorf("exit status = %q, want prefix %q", exit, want)
+	}
+	var st exitStatus
+	if err := json.Unmarshal([]byte(exit), &st); err != nil {
+		t.Errorf("json.Unmarshal error: %v", err)
+	} else if st.Duration <= 0 || st.MaxRSS <= 0 {
+		t.Errorf("exit status = %+v, want positive duration and maxRSS", st)
+	}
+}
+
+func TestDiskQuota(t *testing.T) {
+	var mu sync.Mutex
+	var status, exit string
+	stopped := make(chan struct{}, 1)
+	mt := newMessageTester(t)
+	mt.MessageChecker(func(action, data string) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch action {
+		case statusUpdate:
+			status += data
+		case statusStopped:
+			exit = data
+			stopped <- struct{}{}
+		}
+	})
+	ex := newExecutor(newBlobStore(), execConfig{gc: "go", fmt: "gofmt", maxDisk: 64 << 20}, mt.SendMessage)
+	defer ex.Close()
+
+	// The program writes forever unless killed.
+	ex.Start(actionRun, `package main
+
+import (
+	"os"
+	"time"
+)
+
+func main() {
+	f, _ := os.Create("big")
+	b := make([]byte, 1<<20)
+	for {
+		f.Write(b)
+		time.Sleep(time.Millisecond)
+	}
+}
+`)
+	select {
+	case <-stopped:
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for program to be killed")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want := "Disk quota exceeded (67108864 bytes); program killed.\n"; !strings.Contains(status, want) {
+		t.Errorf("status = %q, want it to contain %q", status, want)
+	}
+	if want := `{"exitCode":-1,"signal":"SIGKILL","reason":"diskQuota",`; !strings.HasPrefix(exit, want) {
+		t.Errorf("exit status = %q, want prefix %q", exit, want)
+	}
+}
+
+func TestReportLimits(t *testing.T) {
+	var status string
+	var reports []string
+	ex := newExecutor(newBlobStore(), execConfig{gc: "go", fmt: "gofmt", maxReport: 10, maxReports: 15}, func(action, data string) error {
+		switch action {
+		case statusUpdate:
+			status += data
+		case reportProfile:
+			var r struct{ Name string }
+			json.Unmarshal([]byte(data), &r)
+			reports = append(reports, r.Name)
+		}
+		return nil
+	})
+	defer ex.Close()
+
+	ex.reportBlob("large", "text/plain", []byte("0123456789a"))
+	ex.reportBlob("first", "text/plain", []byte("01234567"))
+	ex.reportBlob("second", "text/plain", []byte("abcdefgh"))
+	if want := []string{"first"}; !reflect.DeepEqual(reports, want) {
+		t.Errorf("reports = %q, want %q", reports, want)
+	}
+	want := "\tDropped report: large (file too large: 11 bytes, limit is 10 bytes)\n" +
+		"\tDropped report: second (reports too large: 16 bytes in total, limit is 15 bytes)\n"
+	if status != want {
+		t.Errorf("status = %q, want %q", status, want)
+	}
+
+	// The reports of a run are deleted by the next one.
+	ex.deleteBlobs()
+	reports = nil
+	ex.reportBlob("second", "text/plain", []byte("abcdefgh"))
+	if want := []string{"second"}; !reflect.DeepEqual(reports, want) {
+		t.Errorf("reports after deleting blobs = %q, want %q", reports, want)
+	}
+}
+
+func TestParseGCTrace(t *testing.T) {
+	const trace = `hello
+gc 1 @0.012s 2%: 0.026+0.39+0.10 ms clock, 0.21+0.88/0.74/0+0.80 ms cpu, 4->4->1 MB, 5 MB goal, 0 MB stacks, 0 MB globals, 8 P
+gc 2 @1.500s 3%: 0.5+6.0+0.25 ms clock, 0.006+0/0.13/0+0.007 ms cpu, 16->17->8 MB, 32 MB goal, 0 MB stacks, 0 MB globals, 1 P (forced)
+gc 3 @bad
+`
+	got := parseGCTrace([]byte(trace))
+	want := []gcCycle{
+		{Cycle: 1, Time: 0.012, CPUPercent: 2, Pause: 0.126, Mark: 0.39, HeapStart: 4, HeapEnd: 4, HeapLive: 1, HeapGoal: 5},
+		{Cycle: 2, Time: 1.5, CPUPercent: 3, Pause: 0.75, Mark: 6, HeapStart: 16, HeapEnd: 17, HeapLive: 8, HeapGoal: 32, Forced: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("parseGCTrace: got %d cycles, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if d := got[i].Pause - want[i].Pause; d < -1e-9 || d > 1e-9 {
+			t.Errorf("cycle %d: got pause %v, want %v", i, got[i].Pause, want[i].Pause)
+		}
+		got[i].Pause = want[i].Pause
+		if got[i] != want[i] {
+			t.Errorf("cycle %d:\ngot  %+v\nwant %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	var mu sync.Mutex
+	var stderr, status, exit string
+	stopped := make(chan struct{}, 1)
+	mt := newMessageTester(t)
+	mt.MessageChecker(func(action, data string) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch action {
+		case appendStderr:
+			stderr += data
+		case statusUpdate:
+			status += data
+		case statusStopped:
+			exit = data
+			stopped <- struct{}{}
+		}
+	})
+	conf := execConfig{gc: "go", fmt: "gofmt", runTimeout: 500 * time.Millisecond, stopGrace: 10 * time.Second}
+	ex := newExecutor(newBlobStore(), conf, mt.SendMessage)
+	defer ex.Close()
+
+	// The program deadlocks, but not in a way that the runtime detects.
+	ex.Start(actionRun, `package main
+		import "time"
+		func blocked(c chan int) { <-c }
+		func main() {
+			go blocked(make(chan int))
+			time.Sleep(time.Hour)
+		}`)
+	select {
+	case <-stopped:
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for program to be stopped")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want := "Time limit exceeded (500ms); dumping goroutines.\n"; !strings.Contains(status, want) {
+		t.Errorf("status = %q, want it to contain %q", status, want)
+	}
+	if !strings.Contains(stderr, "SIGQUIT") || !strings.Contains(stderr, "main.blocked") {
+		t.Errorf("stderr = %q, want goroutine dump with main.blocked", stderr)
+	}
+	if want := `{"exitCode":2,"reason":"timeout",`; !strings.HasPrefix(exit, want) {
+		t.Errorf("exit status = %q, want prefix %q", exit, want)
+	}
+}
+
+func TestReportBadLines(t *testing.T) {
+	var got []message
+	ex := newExecutor(newBlobStore(), execConfig{}, func(action, data string) error {
+		got = append(got, message{action, data})
+		return nil
+	})
+	defer ex.Close()
+
+	ex.reportBadLines([]byte(`# command-line-arguments
+./main.go:5:9: too many return values
+	have (number)
+	want ()
+main.go:7: running "stringer": exit status 1
+other.go:3:1: ignored
+`))
+	want := []message{
+		{markLines, "[5,7]"},
+		{markNotes, `[{"line":5,"column":9,"kind":"error","message":"too many return values\nhave (number)\nwant ()"},` +
+			`{"line":7,"column":0,"kind":"error","message":"running \"stringer\": exit status 1"}]`},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("messages mismatch:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestParseCoverProfile(t *testing.T) {
+	const profile = `mode: set
+command-line-arguments/main.go:4.21,5.12 1 1
+command-line-arguments/main.go:5.12,7.3 1 0
+command-line-arguments/main.go:8.2,8.10 1 1
+command-line-arguments/other.go:1.1,2.2 1 0
+example.com/m/main.go:20.2,21.3 1 0
+garbage
+`
+	covered, uncovered := parseCoverProfile([]byte(profile), "main.go")
+	if want := []int{4, 5, 8}; !reflect.DeepEqual(covered, want) {
+		t.Errorf("covered = %v, want %v", covered, want)
+	}
+	if want := []int{6, 7, 20, 21}; !reflect.DeepEqual(uncovered, want) {
+		t.Errorf("uncovered = %v, want %v", uncovered, want)
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+	tests := []struct {
+		in   int64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1536, "1.5 KiB"},
+		{5 << 20, "5.0 MiB"},
+		{3 << 30, "3.0 GiB"},
+	}
+	for _, tt := range tests {
+		if got := formatSize(tt.in); got != tt.want {
+			t.Errorf("formatSize(%d) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRunHistory(t *testing.T) {
+	mt := newMessageTester(t)
+	ex := newExecutor(newBlobStore(), execConfig{gc: "go", fmt: "gofmt"}, mt.SendMessage)
+	defer ex.Close()
+
+	const code = `package main; import "fmt"; func main() { fmt.Println("Hello, world!") }`
+	tests := []struct {
+		label  string
+		action string
+		data   string
+		want   []message
+	}{{
+		label:  "HistoryEmpty",
+		action: actionHistory,
+		want:   []message{{actionHistory, "[]"}},
+	}, {
+		label:  "Run",
+		action: actionRun,
+		data:   code,
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program...\n"},
+			{clearOutput, ""},
+			{appendStdout, "Hello, world!\n"},
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusStopped, exitOK},
+		},
+	}, {
+		label:  "History",
+		action: actionHistory,
+		want:   []message{{actionHistory, `RE> ^\[{"id":0,"time":"[^"]+","code":"package main; .*"}\]$`}},
+	}, {
+		label:  "Replay",
+		action: actionReplay,
+		data:   "0",
+		want: []message{
+			{statusStarted, ""},
+			{clearOutput, ""},
+			{statusUpdate, "Compiling program...\n"},
+			{clearOutput, ""},
+			{appendStdout, "Hello, world!\n"},
+			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
+			{statusUpdate, "\n"},
+			{statusUpdate, "Output replayed from run 0.\n"},
+			{statusStopped, ""},
+		},
+	}, {
+		label:  "ReplayUnknown",
+		action: actionReplay,
+		data:   "1",
+		want: []message{
+			{statusStarted, ""},
+			{statusUpdate, "Unknown run: 1\n"},
+			{statusStopped, ""},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			mt.SetT(t)
+			mt.WantMessages(tt.want)
+			switch tt.action {
+			case actionHistory:
+				ex.ListHistory()
+			default:
+				ex.Start(tt.action, tt.data)
+			}
+			select {
+			case <-mt.Next:
+				if t.Failed() {
+					t.Fatalf("failed test")
+				}
+			case <-time.After(30 * time.Second):
+				t.Fatalf("timed out")
+			}
+		})
+	}
+
+	// Old runs are evicted from the history.
+	for i := 0; i < maxRunHistory; i++ {
+		ex.addHistory(code, nil)
+	}
+	if r := ex.lookupHistory(0); r != nil {
+		t.Errorf("unexpected lookupHistory(0) success")
+	}
+	if r := ex.lookupHistory(maxRunHistory); r == nil || r.id != maxRunHistory {
+		t.Errorf("lookupHistory(%d) = %v, want run %d", maxRunHistory, r, maxRunHistory)
+	}
+}
+
+func TestCheckSource(t *testing.T) {
+	tests := []struct {
+		label string
+		code  string
+		want  []note
+	}{{
+		label: "Valid",
+		code:  "package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Println(\"Hello, world!\") }\n",
+		want:  []note{},
+	}, {
+		label: "SyntaxError",
+		code:  "package main\n\nfunc main() {\n\tx := \n}\n",
+		want:  []note{{5, 1, "error", "expected operand, found '}'"}},
+	}, {
+		label: "TypeErrors",
+		code:  "package main\n\nfunc main() {\n\tx := 1\n\tprintln(y)\n}\n",
+		want: []note{
+			{5, 10, "error", "undefined: y"},
+			{4, 2, "error", "declared and not used: x"},
+		},
+	}, {
+		label: "UnknownImport",
+		code:  "package main\n\nimport \"example.com/unknown\"\n\nfunc main() { unknown.Foo() }\n",
+		want:  []note{},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			got := checkSource(tt.code)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkSource mismatch:\ngot  %v\nwant %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	var mu sync.Mutex
+	var checks []string
+	done := make(chan struct{}, 1)
+	ex := newExecutor(newBlobStore(), execConfig{gc: "go", fmt: "gofmt"}, func(action, data string) error {
+		if action == actionCheck {
+			mu.Lock()
+			checks = append(checks, data)
+			mu.Unlock()
+			if data == "[]" {
+				done <- struct{}{}
+			}
+		}
+		return nil
+	})
+
+	// A newer check cancels an older one, whose diagnostics are either
+	// dropped or sent first.
+	const bad = "package main\n\nfunc main() { println(y) }\n"
+	const good = "package main\n\nfunc main() { println(1) }\n"
+	for i := 0; i < 10; i++ {
+		ex.Check(bad)
+	}
+	ex.Check(good)
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("timed out")
+	}
+	ex.Close() // Waits for any stale check
+
+	mu.Lock()
+	defer mu.Unlock()
+	if checks[len(checks)-1] != "[]" {
+		t.Errorf("unexpected check results: %q", checks)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const gistAPIURL = "https://api.github.com"
+
+// errGistNotFound indicates that the Gist no longer exists on GitHub.
+var errGistNotFound = errors.New("gist not found")
+
+// gistClient uploads snippets to GitHub Gists.
+type gistClient struct {
+	apiURL string // Base URL of the GitHub API
+	token  string // GitHub access token with the "gist" scope
+	client *http.Client
+}
+
+func newGistClient(token string) *gistClient {
+	return &gistClient{apiURL: gistAPIURL, token: token, client: http.DefaultClient}
+}
+
+// Upload creates a new Gist with the snippet if id is empty,
+// otherwise it updates the Gist with the given ID.
+// It returns the ID and the URL of the Gist.
+//
+// Gists are created as secret Gists, which are not publicly listed,
+// but are accessible to anyone with the URL.
+func (gc *gistClient) Upload(ctx context.Context, id string, s snippet) (newID, url string, err error) {
+	type gistFile struct {
+		Content string `json:"content"`
+	}
+	req := struct {
+		Description string              `json:"description"`
+		Public      *bool               `json:"public,omitempty"`
+		Files       map[string]gistFile `json:"files"`
+	}{
+		Description: s.Name,
+		Files:       map[string]gistFile{"main.go": {s.Code}},
+	}
+	method, path := "PATCH", "/gists/"+id
+	if id == "" {
+		public := false
+		method, path, req.Public = "POST", "/gists", &public
+	}
+	b, _ := json.Marshal(req)
+
+	r, err := http.NewRequest(method, strings.TrimSuffix(gc.apiURL, "/")+path, bytes.NewReader(b))
+	if err != nil {
+		return "", "", err
+	}
+	r = r.WithContext(ctx)
+	r.Header.Set("Accept", "application/vnd.github+json")
+	r.Header.Set("Authorization", "token "+gc.token)
+	r.Header.Set("Content-Type", "application/json")
+	resp, err := gc.client.Do(r)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	b, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound && id != "":
+		return "", "", errGistNotFound
+	case resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated:
+		var msg struct{ Message string }
+		json.Unmarshal(b, &msg)
+		return "", "", fmt.Errorf("GitHub API error: %s: %s", resp.Status, msg.Message)
+	}
+	var gist struct {
+		ID      string `json:"id"`
+		HTMLURL string `json:"html_url"`
+	}
+	if err := json.Unmarshal(b, &gist); err != nil {
+		return "", "", fmt.Errorf("GitHub API error: %v", err)
+	}
+	return gist.ID, gist.HTMLURL, nil
+}
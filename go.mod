@@ -3,8 +3,13 @@ module github.com/dsnet/playground
 go 1.16
 
 require (
+	github.com/BurntSushi/toml v1.2.1
 	github.com/boltdb/bolt v1.3.1
 	github.com/dsnet/golib/jsonfmt v1.0.0
 	github.com/gorilla/websocket v1.4.2
+	github.com/mattn/go-sqlite3 v1.14.16
 	golang.org/x/crypto v0.1.0
+	golang.org/x/net v0.1.0
+	golang.org/x/sys v0.1.0
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,655 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf16"
+)
+
+const (
+	// goplsTimeout is the maximum duration of a single request to gopls.
+	// The first request may take a while since gopls loads the standard
+	// library upon startup.
+	goplsTimeout = 30 * time.Second
+
+	// maxCompletions is the maximum number of completion candidates returned.
+	maxCompletions = 100
+)
+
+// gopls is a client of the gopls language server, which provides code
+// intelligence for snippets. The server is started upon the first request
+// and is restarted if it exits. Requests are serialized since the snippet
+// is always stored as the single "main.go" file in the workspace.
+type gopls struct {
+	// dial starts the language server in the workspace directory and
+	// returns a connection to it.
+	dial func(dir string) (io.ReadWriteCloser, error)
+
+	mu      sync.Mutex // Serializes requests and protects the fields below
+	dir     string     // Workspace directory
+	conn    *lspConn
+	version int // Version of the open document; zero if not yet opened
+}
+
+func newGopls(bin string) *gopls {
+	return &gopls{dial: func(dir string) (io.ReadWriteCloser, error) {
+		return startGopls(bin, dir)
+	}}
+}
+
+// goplsProcess is the connection to a gopls process over stdin and stdout.
+type goplsProcess struct {
+	io.WriteCloser
+	io.Reader
+	cmd *exec.Cmd
+}
+
+func startGopls(bin, dir string) (*goplsProcess, error) {
+	cmd := exec.Command(bin, "serve")
+	cmd.Dir = dir
+	// TODO: Modules are disabled to match how snippets are built.
+	cmd.Env = append(append([]string(nil), os.Environ()...), "GO111MODULE=off")
+	w, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	r, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &goplsProcess{w, r, cmd}, nil
+}
+
+func (p *goplsProcess) Close() error {
+	p.WriteCloser.Close()
+	p.cmd.Process.Kill()
+	return p.cmd.Wait()
+}
+
+// completion is a single completion candidate.
+type completion struct {
+	Label         string `json:"label"`
+	Kind          string `json:"kind,omitempty"`
+	Detail        string `json:"detail,omitempty"`
+	Documentation string `json:"documentation,omitempty"`
+
+	// Text replaces the code between the From and To offsets,
+	// which are in UTF-16 code units.
+	Text     string `json:"text"`
+	From, To int    `json:"-"`
+}
+
+func (c completion) MarshalJSON() ([]byte, error) {
+	type comp completion
+	return json.Marshal(struct {
+		comp
+		From int `json:"from"`
+		To   int `json:"to"`
+	}{comp(c), c.From, c.To})
+}
+
+// lspCompletionKinds are the names of the LSP CompletionItemKind values.
+var lspCompletionKinds = []string{
+	1: "text", "method", "function", "constructor", "field", "variable",
+	"class", "interface", "module", "property", "unit", "value", "enum",
+	"keyword", "snippet", "color", "file", "reference", "folder",
+	"enummember", "constant", "struct", "event", "operator", "typeparameter",
+}
+
+// Complete returns the completion candidates at the offset in the code,
+// where the offset is in UTF-16 code units.
+func (g *gopls) Complete(ctx context.Context, code string, offset int) ([]completion, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	c, err := g.openDocument(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw json.RawMessage
+	if err := c.Call(ctx, "textDocument/completion", g.positionParams(code, offset), &raw); err != nil {
+		return nil, err
+	}
+	type lspItem struct {
+		Label         string          `json:"label"`
+		Kind          int             `json:"kind"`
+		Detail        string          `json:"detail"`
+		Documentation json.RawMessage `json:"documentation"`
+		InsertText    string          `json:"insertText"`
+		TextEdit      *struct {
+			Range   lspRange `json:"range"`
+			NewText string   `json:"newText"`
+		} `json:"textEdit"`
+	}
+	var list struct {
+		Items []lspItem `json:"items"`
+	}
+	if len(raw) > 0 && raw[0] == '[' {
+		err = json.Unmarshal(raw, &list.Items) // Result is a list of items
+	} else if len(raw) > 0 && string(raw) != "null" {
+		err = json.Unmarshal(raw, &list)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cs []completion
+	for _, it := range list.Items {
+		if len(cs) == maxCompletions {
+			break
+		}
+		cc := completion{Label: it.Label, Detail: it.Detail, Documentation: lspMarkup(it.Documentation)}
+		if it.Kind > 0 && it.Kind < len(lspCompletionKinds) {
+			cc.Kind = lspCompletionKinds[it.Kind]
+		}
+		switch {
+		case it.TextEdit != nil:
+			cc.Text = it.TextEdit.NewText
+			cc.From = lspOffset(code, it.TextEdit.Range.Start)
+			cc.To = lspOffset(code, it.TextEdit.Range.End)
+		default:
+			cc.Text = it.InsertText
+			if cc.Text == "" {
+				cc.Text = it.Label
+			}
+			cc.From, cc.To = identStart(code, offset), offset
+		}
+		cs = append(cs, cc)
+	}
+	return cs, nil
+}
+
+// hoverInfo is the information about a position in the code.
+type hoverInfo struct {
+	Documentation string `json:"documentation"`
+	Signature     string `json:"signature"`
+	Parameter     int    `json:"parameter"` // Index of the active parameter; -1 if none
+}
+
+// Hover returns the documentation of the identifier and the signature of
+// the function call at the offset in the code, where the offset is in
+// UTF-16 code units.
+func (g *gopls) Hover(ctx context.Context, code string, offset int) (hoverInfo, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	h := hoverInfo{Parameter: -1}
+	c, err := g.openDocument(ctx, code)
+	if err != nil {
+		return h, err
+	}
+
+	var hover *struct {
+		Contents json.RawMessage `json:"contents"`
+	}
+	params := g.positionParams(code, offset)
+	if err := c.Call(ctx, "textDocument/hover", params, &hover); err != nil {
+		return h, err
+	}
+	if hover != nil {
+		h.Documentation = lspMarkup(hover.Contents)
+	}
+
+	var help *struct {
+		Signatures []struct {
+			Label           string `json:"label"`
+			ActiveParameter *int   `json:"activeParameter"`
+		} `json:"signatures"`
+		ActiveSignature int `json:"activeSignature"`
+		ActiveParameter int `json:"activeParameter"`
+	}
+	if err := c.Call(ctx, "textDocument/signatureHelp", params, &help); err != nil {
+		return h, err
+	}
+	if help != nil && help.ActiveSignature >= 0 && help.ActiveSignature < len(help.Signatures) {
+		sig := help.Signatures[help.ActiveSignature]
+		h.Signature, h.Parameter = sig.Label, help.ActiveParameter
+		if sig.ActiveParameter != nil {
+			h.Parameter = *sig.ActiveParameter
+		}
+	}
+	return h, nil
+}
+
+// Close stops the language server and removes the workspace.
+func (g *gopls) Close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.closeConn()
+	if g.dir != "" {
+		os.RemoveAll(g.dir)
+		g.dir = ""
+	}
+}
+
+func (g *gopls) closeConn() {
+	if g.conn == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if g.conn.Call(ctx, "shutdown", nil, nil) == nil {
+		g.conn.Notify("exit", nil)
+	}
+	g.conn.Close()
+	g.conn, g.version = nil, 0
+}
+
+// openDocument returns a connection to an initialized language server,
+// starting one if necessary, where the document content is the code.
+func (g *gopls) openDocument(ctx context.Context, code string) (*lspConn, error) {
+	if g.conn != nil && g.conn.Err() != nil {
+		g.closeConn() // Restart the server if it failed
+	}
+	if g.conn == nil {
+		if g.dir == "" {
+			dir, err := ioutil.TempDir("", "gopls")
+			if err != nil {
+				return nil, err
+			}
+			g.dir = dir
+		}
+		rwc, err := g.dial(g.dir)
+		if err != nil {
+			return nil, err
+		}
+		c := newLSPConn(rwc)
+		err = c.Call(ctx, "initialize", map[string]interface{}{
+			"processId": os.Getpid(),
+			"rootUri":   fileURI(g.dir),
+			"capabilities": map[string]interface{}{
+				"textDocument": map[string]interface{}{
+					"completion": map[string]interface{}{
+						"completionItem": map[string]interface{}{"documentationFormat": []string{"plaintext"}},
+					},
+					"hover": map[string]interface{}{"contentFormat": []string{"plaintext"}},
+					"signatureHelp": map[string]interface{}{
+						"signatureInformation": map[string]interface{}{"documentationFormat": []string{"plaintext"}},
+					},
+				},
+			},
+		}, nil)
+		if err == nil {
+			err = c.Notify("initialized", struct{}{})
+		}
+		if err != nil {
+			c.Close()
+			return nil, fmt.Errorf("gopls initialize: %v", err)
+		}
+		g.conn = c
+	}
+
+	// The file is written to disk as well so that gopls can load the package.
+	path := filepath.Join(g.dir, "main.go")
+	if err := ioutil.WriteFile(path, []byte(code), 0664); err != nil {
+		return nil, err
+	}
+	g.version++
+	doc := map[string]interface{}{"uri": fileURI(path), "version": g.version}
+	var err error
+	if g.version == 1 {
+		doc["languageId"], doc["text"] = "go", code
+		err = g.conn.Notify("textDocument/didOpen", map[string]interface{}{"textDocument": doc})
+	} else {
+		err = g.conn.Notify("textDocument/didChange", map[string]interface{}{
+			"textDocument":   doc,
+			"contentChanges": []interface{}{map[string]string{"text": code}},
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return g.conn, nil
+}
+
+// positionParams returns the LSP TextDocumentPositionParams for the
+// offset in the code, where the offset is in UTF-16 code units.
+func (g *gopls) positionParams(code string, offset int) interface{} {
+	return map[string]interface{}{
+		"textDocument": map[string]string{"uri": fileURI(filepath.Join(g.dir, "main.go"))},
+		"position":     lspPosition(code, offset),
+	}
+}
+
+func fileURI(path string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
+
+// lspMarkup returns the text of a documentation field, which is either
+// a string or a MarkupContent dict.
+func lspMarkup(b json.RawMessage) string {
+	var s string
+	if json.Unmarshal(b, &s) == nil {
+		return s
+	}
+	var mc struct {
+		Value string `json:"value"`
+	}
+	json.Unmarshal(b, &mc)
+	return mc.Value
+}
+
+// lspPos is a zero-based line and character offset in UTF-16 code units.
+type lspPos struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start lspPos `json:"start"`
+	End   lspPos `json:"end"`
+}
+
+// lspPosition converts an offset in UTF-16 code units into a position.
+// Offsets beyond the end of the code are clamped to the end.
+func lspPosition(code string, offset int) lspPos {
+	var p lspPos
+	var n int
+	for _, r := range code {
+		if n >= offset {
+			break
+		}
+		n += utf16.RuneLen(r)
+		if r == '\n' {
+			p.Line, p.Character = p.Line+1, 0
+		} else {
+			p.Character += utf16.RuneLen(r)
+		}
+	}
+	return p
+}
+
+// lspOffset converts a position into an offset in UTF-16 code units.
+// It is the inverse of lspPosition.
+func lspOffset(code string, p lspPos) int {
+	var line, char, n int
+	for _, r := range code {
+		if line == p.Line && char >= p.Character || line > p.Line {
+			break
+		}
+		n += utf16.RuneLen(r)
+		if r == '\n' {
+			if line == p.Line {
+				n-- // Position is beyond the end of the line
+				break
+			}
+			line, char = line+1, 0
+		} else {
+			char += utf16.RuneLen(r)
+		}
+	}
+	return n
+}
+
+// identStart returns the offset of the start of the identifier that ends at
+// the offset in the code, where offsets are in UTF-16 code units.
+func identStart(code string, offset int) int {
+	rs := utf16.Decode(utf16.Encode([]rune(code)))
+	var units []int // Offset of each rune in UTF-16 code units
+	var n int
+	for _, r := range rs {
+		units = append(units, n)
+		n += utf16.RuneLen(r)
+	}
+	i := len(units)
+	for i > 0 && units[i-1] >= offset {
+		i--
+	}
+	for i > 0 && (rs[i-1] == '_' || unicode.IsLetter(rs[i-1]) || unicode.IsDigit(rs[i-1])) {
+		i--
+	}
+	if i == len(units) {
+		return offset
+	}
+	return units[i]
+}
+
+// lspConn is a JSON-RPC 2.0 connection to a language server using the
+// framing of the Language Server Protocol.
+type lspConn struct {
+	rwc io.ReadWriteCloser
+	wmu sync.Mutex // Serializes writes
+
+	mu      sync.Mutex // Protects the fields below
+	seq     int64
+	pending map[int64]chan lspMessage
+	err     error // Non-nil once the connection is broken
+	done    chan struct{}
+}
+
+type lspMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *lspError       `json:"error,omitempty"`
+}
+
+type lspError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *lspError) Error() string {
+	return fmt.Sprintf("gopls error %d: %s", e.Code, e.Message)
+}
+
+func newLSPConn(rwc io.ReadWriteCloser) *lspConn {
+	c := &lspConn{rwc: rwc, pending: make(map[int64]chan lspMessage), done: make(chan struct{})}
+	go c.readLoop()
+	return c
+}
+
+// Call sends a request and stores the result in result, if non-nil.
+func (c *lspConn) Call(ctx context.Context, method string, params, result interface{}) error {
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return c.err
+	}
+	c.seq++
+	id := c.seq
+	ch := make(chan lspMessage, 1)
+	c.pending[id] = ch
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+
+	if err := c.write(map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": params}); err != nil {
+		return err
+	}
+	select {
+	case m := <-ch:
+		if m.Error != nil {
+			return m.Error
+		}
+		if result != nil && len(m.Result) > 0 {
+			return json.Unmarshal(m.Result, result)
+		}
+		return nil
+	case <-c.done:
+		return c.Err()
+	case <-ctx.Done():
+		c.Notify("$/cancelRequest", map[string]int64{"id": id})
+		return ctx.Err()
+	}
+}
+
+// Notify sends a notification, which has no response.
+func (c *lspConn) Notify(method string, params interface{}) error {
+	return c.write(map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params})
+}
+
+// Err reports the error that broke the connection, if any.
+func (c *lspConn) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+func (c *lspConn) Close() error {
+	return c.rwc.Close()
+}
+
+func (c *lspConn) write(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if _, err := fmt.Fprintf(c.rwc, "Content-Length: %d\r\n\r\n%s", len(b), b); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *lspConn) readLoop() {
+	var err error
+	defer func() {
+		c.mu.Lock()
+		c.err = fmt.Errorf("gopls connection closed: %v", err)
+		c.mu.Unlock()
+		close(c.done)
+	}()
+
+	tr := textproto.NewReader(bufio.NewReader(c.rwc))
+	for {
+		var hdr textproto.MIMEHeader
+		if hdr, err = tr.ReadMIMEHeader(); err != nil {
+			return
+		}
+		var n int
+		if n, err = strconv.Atoi(hdr.Get("Content-Length")); err != nil {
+			return
+		}
+		b := make([]byte, n)
+		if _, err = io.ReadFull(tr.R, b); err != nil {
+			return
+		}
+		var m lspMessage
+		if err = json.Unmarshal(b, &m); err != nil {
+			return
+		}
+
+		switch {
+		case m.Method != "" && len(m.ID) > 0:
+			// Reply to requests from the server with an empty result since
+			// the client does not advertise any capabilities for them.
+			// The reply is asynchronous so that reads are never blocked
+			// by the server not reading.
+			go c.write(map[string]interface{}{"jsonrpc": "2.0", "id": m.ID, "result": nil})
+		case m.Method != "":
+			// Ignore notifications (e.g., diagnostics and log messages).
+		default:
+			id, _ := strconv.ParseInt(string(m.ID), 10, 64)
+			c.mu.Lock()
+			ch := c.pending[id]
+			c.mu.Unlock()
+			if ch != nil {
+				ch <- m
+			}
+		}
+	}
+}
+
+// serveComplete provides an endpoint for code completion using gopls.
+//
+// The request is a JSON dict with the "code" of the snippet and the "offset"
+// of the cursor in UTF-16 code units (as used by JavaScript strings).
+// The response is a JSON list of candidates, where each has the "label" to
+// display, the "kind" of candidate (e.g., "function" or "variable"), the
+// "detail" (e.g., the type), optional "documentation", and the "text" that
+// replaces the code between the "from" and "to" offsets.
+func (pg *playground) serveComplete(w http.ResponseWriter, r *http.Request) {
+	req, ok := pg.decodeGoplsRequest(w, r)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), goplsTimeout)
+	defer cancel()
+	cs, err := pg.gopls.Complete(ctx, req.Code, req.Offset)
+	if err != nil {
+		pg.logf(r, "gopls error: %v", err)
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if cs == nil {
+		cs = []completion{} // Marshal as an empty list rather than null
+	}
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(cs)
+	w.Write(b)
+}
+
+// serveHover provides an endpoint for the documentation of the identifier
+// and the signature of the function call at a position using gopls.
+//
+// The request is the same as for the completion endpoint.
+// The response is a JSON dict with the "documentation" (e.g., the
+// declaration and doc comment of the identifier), the "signature" of the
+// enclosing function call, and the index of the "parameter" in the signature
+// at the position (or -1). Fields are empty if there is no information.
+func (pg *playground) serveHover(w http.ResponseWriter, r *http.Request) {
+	req, ok := pg.decodeGoplsRequest(w, r)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), goplsTimeout)
+	defer cancel()
+	h, err := pg.gopls.Hover(ctx, req.Code, req.Offset)
+	if err != nil {
+		pg.logf(r, "gopls error: %v", err)
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(h)
+	w.Write(b)
+}
+
+// goplsRequest is the request to the gopls endpoints.
+type goplsRequest struct {
+	Code   string `json:"code"`
+	Offset int    `json:"offset"` // In UTF-16 code units
+}
+
+// decodeGoplsRequest decodes the request to a gopls endpoint.
+// Any error is reported to the client, in which case it reports false.
+func (pg *playground) decodeGoplsRequest(w http.ResponseWriter, r *http.Request) (req goplsRequest, ok bool) {
+	if pg.gopls == nil {
+		httpError(w, r, "code intelligence is not configured", http.StatusNotImplemented)
+		return req, false
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httpError(w, r, err.Error(), http.StatusBadRequest)
+		return req, false
+	}
+	if req.Offset < 0 {
+		httpError(w, r, "invalid offset", http.StatusBadRequest)
+		return req, false
+	}
+	return req, true
+}
@@ -0,0 +1,206 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestLSPPosition(t *testing.T) {
+	const code = "package main\n\n// 世界 😀\nfunc main() {}\n"
+	tests := []struct {
+		offset int
+		pos    lspPos
+	}{
+		{0, lspPos{0, 0}},
+		{8, lspPos{0, 8}},
+		{13, lspPos{1, 0}},
+		{14, lspPos{2, 0}},
+		{18, lspPos{2, 4}},  // After "世"
+		{20, lspPos{2, 6}},  // Before "😀"
+		{22, lspPos{2, 8}},  // After "😀", which is two UTF-16 code units
+		{23, lspPos{3, 0}},  // Start of "func"
+		{38, lspPos{4, 0}},  // End of the code
+		{100, lspPos{4, 0}}, // Clamped to the end of the code
+	}
+	for _, tt := range tests {
+		if got := lspPosition(code, tt.offset); got != tt.pos {
+			t.Errorf("lspPosition(%d) = %v, want %v", tt.offset, got, tt.pos)
+		}
+		if tt.offset > 38 {
+			continue
+		}
+		if got := lspOffset(code, tt.pos); got != tt.offset {
+			t.Errorf("lspOffset(%v) = %d, want %d", tt.pos, got, tt.offset)
+		}
+	}
+	if got := lspOffset(code, lspPos{0, 100}); got != 12 {
+		t.Errorf("lspOffset beyond end of line = %d, want 12", got)
+	}
+	if got := identStart("x := fmt.Pri", 12); got != 9 {
+		t.Errorf("identStart = %d, want 9", got)
+	}
+}
+
+// fakeGopls serves the language server protocol over conn, responding to
+// requests with the results for each method.
+func fakeGopls(t *testing.T, conn io.ReadWriteCloser, results map[string]interface{}) {
+	defer conn.Close()
+	tr := textproto.NewReader(bufio.NewReader(conn))
+	write := func(v interface{}) {
+		b, _ := json.Marshal(v)
+		fmt.Fprintf(conn, "Content-Length: %d\r\n\r\n%s", len(b), b)
+	}
+	for {
+		hdr, err := tr.ReadMIMEHeader()
+		if err != nil {
+			return
+		}
+		n, _ := strconv.Atoi(hdr.Get("Content-Length"))
+		b := make([]byte, n)
+		if _, err := io.ReadFull(tr.R, b); err != nil {
+			return
+		}
+		var m struct {
+			ID     *int64          `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		json.Unmarshal(b, &m)
+		var result interface{}
+		switch m.Method {
+		case "initialize":
+			result = map[string]interface{}{"capabilities": map[string]interface{}{}}
+		case "textDocument/completion":
+			// Interleave a request and a notification from the server.
+			write(map[string]interface{}{"jsonrpc": "2.0", "id": "srv1", "method": "workspace/configuration", "params": map[string]interface{}{}})
+			write(map[string]interface{}{"jsonrpc": "2.0", "method": "window/logMessage", "params": map[string]interface{}{}})
+			result = results[m.Method]
+		case "exit":
+			return
+		default:
+			result = results[m.Method]
+		}
+		if m.ID != nil {
+			write(map[string]interface{}{"jsonrpc": "2.0", "id": *m.ID, "result": result})
+		}
+	}
+}
+
+func TestGoplsComplete(t *testing.T) {
+	const code = "package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Pri }\n"
+	offset := len("package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Pri")
+	completions := map[string]interface{}{
+		"isIncomplete": false,
+		"items": []interface{}{
+			map[string]interface{}{
+				"label":         "Println",
+				"kind":          3,
+				"detail":        "func(a ...any) (n int, err error)",
+				"documentation": map[string]string{"kind": "plaintext", "value": "Println formats..."},
+				"textEdit": map[string]interface{}{
+					"range":   lspRange{lspPos{4, 18}, lspPos{4, 21}},
+					"newText": "Println",
+				},
+			},
+			map[string]interface{}{"label": "Print", "kind": 3},
+		},
+	}
+
+	g := newFakeGopls(t, map[string]interface{}{"textDocument/completion": completions})
+	defer g.Close()
+
+	want := []completion{{
+		Label:         "Println",
+		Kind:          "function",
+		Detail:        "func(a ...any) (n int, err error)",
+		Documentation: "Println formats...",
+		Text:          "Println",
+		From:          offset - 3,
+		To:            offset,
+	}, {
+		Label: "Print",
+		Kind:  "function",
+		Text:  "Print",
+		From:  offset - 3,
+		To:    offset,
+	}}
+	for i := 0; i < 2; i++ { // Second request updates the open document
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		got, err := g.Complete(ctx, code, offset)
+		cancel()
+		if err != nil {
+			t.Fatalf("Complete error: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Complete:\ngot  %+v\nwant %+v", got, want)
+		}
+	}
+	if g.version != 2 {
+		t.Errorf("document version = %d, want 2", g.version)
+	}
+}
+
+func newFakeGopls(t *testing.T, results map[string]interface{}) *gopls {
+	return &gopls{dial: func(string) (io.ReadWriteCloser, error) {
+		c1, c2 := net.Pipe()
+		go fakeGopls(t, c2, results)
+		return c1, nil
+	}}
+}
+
+func TestGoplsHover(t *testing.T) {
+	const code = "package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Println(1, 2) }\n"
+	tests := []struct {
+		results map[string]interface{}
+		want    hoverInfo
+	}{{
+		results: map[string]interface{}{
+			"textDocument/hover": map[string]interface{}{
+				"contents": map[string]string{"kind": "plaintext", "value": "func fmt.Println(a ...any) (n int, err error)"},
+			},
+			"textDocument/signatureHelp": map[string]interface{}{
+				"signatures": []interface{}{
+					map[string]interface{}{"label": "Println(a ...any) (n int, err error)"},
+				},
+				"activeSignature": 0,
+				"activeParameter": 0,
+			},
+		},
+		want: hoverInfo{
+			Documentation: "func fmt.Println(a ...any) (n int, err error)",
+			Signature:     "Println(a ...any) (n int, err error)",
+			Parameter:     0,
+		},
+	}, {
+		results: map[string]interface{}{}, // Null results
+		want:    hoverInfo{Parameter: -1},
+	}}
+
+	for _, tt := range tests {
+		g := newFakeGopls(t, tt.results)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		got, err := g.Hover(ctx, code, len("package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Prin"))
+		cancel()
+		g.Close()
+		if err != nil {
+			t.Errorf("Hover error: %v", err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Hover:\ngot  %+v\nwant %+v", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// goRepoURL is the Git repository of the Go project.
+	goRepoURL = "https://go.googlesource.com/go"
+
+	// gotipName is the name of the Go version built from the
+	// development branch, as used in the goversions pragma.
+	gotipName = "gotip"
+
+	// gotipRevFile is the file in the gotip root that records the
+	// revision of Go that the toolchain was built from.
+	gotipRevFile = "REVISION"
+)
+
+// gotipBinary returns the path of the go binary within the gotip root.
+func gotipBinary(dir string) string {
+	return filepath.Join(dir, "bin", "go")
+}
+
+// updateGoTip builds the latest revision of the development branch of Go
+// into dir using the bootstrap go binary. It does nothing if dir already
+// contains a toolchain built from the latest revision.
+//
+// The toolchain is built in a separate directory and then moved into place
+// such that runs never observe a partially built toolchain.
+// It reports the revision of the toolchain in dir and whether it changed.
+func updateGoTip(ctx context.Context, dir, bootstrap string) (rev string, updated bool, err error) {
+	run := func(wd string, env []string, name string, args ...string) ([]byte, error) {
+		cmd := exec.CommandContext(ctx, name, args...)
+		cmd.Dir = wd
+		cmd.Env = append(os.Environ(), env...)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %v\n%s", name, strings.Join(args, " "), err, out)
+		}
+		return out, nil
+	}
+
+	// Check whether the toolchain is already up to date.
+	out, err := run("", nil, "git", "ls-remote", goRepoURL, "refs/heads/master")
+	if err != nil {
+		return "", false, err
+	}
+	if fs := strings.Fields(string(out)); len(fs) > 0 {
+		rev = fs[0]
+	}
+	if b, _ := ioutil.ReadFile(filepath.Join(dir, gotipRevFile)); rev != "" && strings.TrimSpace(string(b)) == rev {
+		return rev, false, nil
+	}
+
+	// Build the toolchain in a temporary directory.
+	out, err = run("", nil, bootstrap, "env", "GOROOT")
+	if err != nil {
+		return "", false, err
+	}
+	bootstrapRoot := strings.TrimSpace(string(out))
+	tmpDir := dir + ".new"
+	os.RemoveAll(tmpDir)
+	defer os.RemoveAll(tmpDir)
+	if _, err := run("", nil, "git", "clone", "--depth=1", "--quiet", goRepoURL, tmpDir); err != nil {
+		return "", false, err
+	}
+	if out, err = run(tmpDir, nil, "git", "rev-parse", "HEAD"); err != nil {
+		return "", false, err
+	}
+	rev = strings.TrimSpace(string(out))
+	env := []string{"GOROOT_BOOTSTRAP=" + bootstrapRoot, "GOROOT=", "GOFLAGS="}
+	if _, err := run(filepath.Join(tmpDir, "src"), env, "./make.bash"); err != nil {
+		return "", false, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, gotipRevFile), []byte(rev+"\n"), 0664); err != nil {
+		return "", false, err
+	}
+
+	// Swap the new toolchain into place.
+	oldDir := dir + ".old"
+	os.RemoveAll(oldDir)
+	if err := os.Rename(dir, oldDir); err != nil && !os.IsNotExist(err) {
+		return "", false, err
+	}
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return "", false, err
+	}
+	os.RemoveAll(oldDir)
+	return rev, true, nil
+}
+
+// StartGoTip builds the gotip toolchain into dir and then periodically
+// rebuilds it from the latest development branch of Go until the playground
+// is closed. It does nothing if interval is not positive.
+//
+// Runs that use gotip fail until the first build completes.
+// The run cache is cleared whenever the toolchain changes since the
+// path of the gotip binary remains the same.
+func (pg *playground) StartGoTip(dir string, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	pg.wg.Add(1)
+	go func() {
+		defer pg.wg.Done()
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			rev, updated, err := updateGoTip(pg.ctx, dir, pg.exConf.gc)
+			switch {
+			case pg.ctx.Err() != nil:
+				return
+			case err != nil:
+				pg.log.Printf("gotip update error: %v", err)
+			case updated:
+				pg.exConf.cache.Clear()
+				pg.log.Printf("updated gotip to revision %s", rev)
+			}
+			select {
+			case <-pg.ctx.Done():
+				return
+			case <-t.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,102 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// goInstallGlobs are the common install locations of Go toolchains.
+// Paths starting with "~/" are relative to the home directory.
+var goInstallGlobs = []string{
+	"~/sdk/go*/bin/go",      // Installed by golang.org/dl
+	"/usr/local/go*/bin/go", // Installed from the official archives
+	"/usr/lib/go-*/bin/go",  // Installed by Debian and Ubuntu packages
+}
+
+var (
+	// reGoBinary matches the names of go binaries in the PATH, including
+	// the wrappers installed by golang.org/dl (e.g., "go1.21.5").
+	reGoBinary = regexp.MustCompile(`^go(1(\.[0-9]+)+((rc|beta)[0-9]+)?)?$`)
+
+	// reGoVersion matches the versions reported by "go version".
+	reGoVersion = regexp.MustCompile(`^go1(\.[0-9]+){1,2}((rc|beta)[0-9]+)?$`)
+
+	// reGoRelease matches the versions of releases, where the first
+	// submatch is the minor version and the second is the patch number.
+	reGoRelease = regexp.MustCompile(`^(go1\.[0-9]+)(?:\.([0-9]+))?$`)
+)
+
+// goCandidates returns the paths of possible go binaries in the directories
+// of the pathList (in the format of $PATH) and matching the globs
+// (e.g., goInstallGlobs), where "~/" is replaced by the home directory.
+func goCandidates(globs []string, home, pathList string) []string {
+	var bins []string
+	for _, dir := range filepath.SplitList(pathList) {
+		ms, _ := filepath.Glob(filepath.Join(dir, "go*"))
+		for _, m := range ms {
+			if reGoBinary.MatchString(filepath.Base(m)) {
+				bins = append(bins, m)
+			}
+		}
+	}
+	for _, g := range globs {
+		if strings.HasPrefix(g, "~/") {
+			if home == "" {
+				continue
+			}
+			g = filepath.Join(home, g[len("~/"):])
+		}
+		ms, _ := filepath.Glob(g)
+		bins = append(bins, ms...)
+	}
+	return bins
+}
+
+// discoverGoVersions verifies each of the go binaries with "go version" and
+// returns a map of versions (e.g., "go1.21.5") to binaries. If multiple
+// binaries report the same version, then the first one is used.
+// Development versions are ignored.
+//
+// Each minor version is also mapped to its newest patch release such that
+// "go1.21" refers to the newest installed release of Go 1.21.
+func discoverGoVersions(bins []string) map[string]string {
+	vs := make(map[string]string)
+	for _, bin := range bins {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		out, err := exec.CommandContext(ctx, bin, "version").Output()
+		cancel()
+		fs := strings.Fields(string(out))
+		if err != nil || len(fs) < 3 || fs[0] != "go" || fs[1] != "version" || !reGoVersion.MatchString(fs[2]) {
+			continue
+		}
+		if _, ok := vs[fs[2]]; !ok {
+			vs[fs[2]] = bin
+		}
+	}
+
+	aliases := make(map[string]string)
+	patches := make(map[string]int)
+	for v, bin := range vs {
+		m := reGoRelease.FindStringSubmatch(v)
+		if m == nil {
+			continue
+		}
+		patch, _ := strconv.Atoi(m[2]) // The initial release has no patch number
+		if p, ok := patches[m[1]]; !ok || patch > p {
+			aliases[m[1]], patches[m[1]] = bin, patch
+		}
+	}
+	for v, bin := range aliases {
+		vs[v] = bin
+	}
+	return vs
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestDiscoverGoVersions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test uses shell scripts as fake go binaries")
+	}
+	tmpDir, err := ioutil.TempDir("", "goversions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Create fake go binaries that report the given version.
+	fakeGo := func(path, output string) {
+		if err := os.MkdirAll(filepath.Dir(path), 0775); err != nil {
+			t.Fatal(err)
+		}
+		script := "#!/bin/sh\necho '" + output + "'\n"
+		if err := ioutil.WriteFile(path, []byte(script), 0775); err != nil {
+			t.Fatal(err)
+		}
+	}
+	home := filepath.Join(tmpDir, "home")
+	bin1 := filepath.Join(tmpDir, "bin1")
+	bin2 := filepath.Join(tmpDir, "bin2")
+	fakeGo(filepath.Join(bin1, "go"), "go version go1.21.5 linux/amd64")
+	fakeGo(filepath.Join(bin1, "go1.20"), "go version go1.20 linux/amd64")
+	fakeGo(filepath.Join(bin1, "gofmt"), "not a go binary")
+	fakeGo(filepath.Join(bin2, "go"), "go version go1.21.5 linux/amd64")
+	fakeGo(filepath.Join(bin2, "go1.19.2"), "go1.19.2: not downloaded. Run 'go1.19.2 download' to install to ~/sdk/go1.19.2")
+	fakeGo(filepath.Join(home, "sdk", "go1.21.3", "bin", "go"), "go version go1.21.3 linux/amd64")
+	fakeGo(filepath.Join(home, "sdk", "go1.22rc1", "bin", "go"), "go version go1.22rc1 linux/amd64")
+	fakeGo(filepath.Join(home, "sdk", "gotip", "bin", "go"), "go version devel go1.23-abcdef linux/amd64")
+
+	bins := goCandidates([]string{"~/sdk/go*/bin/go"}, home, bin1+string(filepath.ListSeparator)+bin2)
+	got := discoverGoVersions(bins)
+	want := map[string]string{
+		"go1.21.5":  filepath.Join(bin1, "go"),
+		"go1.21.3":  filepath.Join(home, "sdk", "go1.21.3", "bin", "go"),
+		"go1.21":    filepath.Join(bin1, "go"),
+		"go1.20":    filepath.Join(bin1, "go1.20"),
+		"go1.22rc1": filepath.Join(home, "sdk", "go1.22rc1", "bin", "go"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("discoverGoVersions(%v):\ngot  %v\nwant %v", bins, got, want)
+	}
+}
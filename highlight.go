@@ -0,0 +1,196 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// A snippet is exported as a standalone HTML page, whose code is highlighted
+// on the server and numbered like in the editor. The styles are inline so
+// that they survive the page being pasted into documents or code review
+// tools, which commonly drop stylesheets.
+
+// hlClass is the syntax class of a byte of highlighted code.
+type hlClass uint8
+
+const (
+	hlPlain hlClass = iota
+	hlKeyword
+	hlString
+	hlNumber
+	hlComment
+	hlHeader // File separator of the file tree of a snippet
+)
+
+// hlStyles are the inline styles of the syntax classes.
+var hlStyles = [...]string{
+	hlKeyword: "color:#a626a4;font-weight:bold",
+	hlString:  "color:#50a14f",
+	hlNumber:  "color:#986801",
+	hlComment: "color:#a0a1a7;font-style:italic",
+	hlHeader:  "color:#4078f2;font-weight:bold",
+}
+
+// highlightGo returns the syntax class of every byte of the Go source src.
+// Since go/scanner recovers from errors, src need not be valid Go.
+func highlightGo(src string) []hlClass {
+	classes := make([]hlClass, len(src))
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	s.Init(file, []byte(src), nil, scanner.ScanComments)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		var c hlClass
+		switch {
+		case tok.IsKeyword():
+			c, lit = hlKeyword, tok.String()
+		case tok == token.STRING || tok == token.CHAR:
+			c = hlString
+		case tok == token.INT || tok == token.FLOAT || tok == token.IMAG:
+			c = hlNumber
+		case tok == token.COMMENT:
+			c = hlComment
+		default:
+			continue
+		}
+		i := file.Offset(pos)
+		end := tokenEnd(src, i, lit)
+		for j := i; j < end; j++ {
+			classes[j] = c
+		}
+	}
+	return classes
+}
+
+// tokenEnd returns the end offset of the token at offset i of src with
+// the literal lit. The scanner strips carriage returns from general comments
+// and raw strings, which may thus be longer in src than lit.
+func tokenEnd(src string, i int, lit string) int {
+	var opening, closing string
+	switch {
+	case strings.HasPrefix(lit, "/*"):
+		opening, closing = "/*", "*/"
+	case strings.HasPrefix(lit, "`"):
+		opening, closing = "`", "`"
+	default:
+		return i + len(lit)
+	}
+	j := strings.Index(src[i+len(opening):], closing)
+	if j < 0 {
+		return len(src) // Unterminated
+	}
+	return i + len(opening) + j + len(closing)
+}
+
+// highlightSnippet returns the code of a snippet along with the syntax class
+// of every byte, highlighting its main file and the Go files of its file tree.
+func highlightSnippet(code string) (string, []hlClass) {
+	main, files, err := splitFiles(code)
+	if err != nil {
+		return code, highlightGo(code)
+	}
+	var bb strings.Builder
+	var classes []hlClass
+	add := func(s string, c []hlClass) {
+		bb.WriteString(s)
+		classes = append(classes, c...)
+	}
+	add(main, highlightGo(main))
+	for _, f := range files {
+		hdr := fmt.Sprintf("-- %s --\n", f.name)
+		c := make([]hlClass, len(hdr))
+		for i := range c {
+			c[i] = hlHeader
+		}
+		add(hdr, c)
+		if strings.HasSuffix(f.name, ".go") {
+			add(f.data, highlightGo(f.data))
+		} else {
+			add(f.data, make([]hlClass, len(f.data)))
+		}
+	}
+	return bb.String(), classes
+}
+
+// snippetHTML renders a snippet as a standalone HTML page.
+func snippetHTML(s snippet) string {
+	code, classes := highlightSnippet(s.Code)
+	title := s.Name
+	if title == "" {
+		title = fmt.Sprintf("Snippet %d", s.ID)
+	}
+
+	// Each line is rendered on its own so that no span crosses lines.
+	var nums, lines strings.Builder
+	var line int
+	for i := 0; i < len(code); {
+		j := strings.IndexByte(code[i:], '\n')
+		if j < 0 {
+			j = len(code)
+		} else {
+			j += i
+		}
+		line++
+		fmt.Fprintf(&nums, "%d\n", line)
+		for k := i; k < j; {
+			c := classes[k]
+			n := k + 1
+			for n < j && classes[n] == c {
+				n++
+			}
+			text := html.EscapeString(strings.TrimSuffix(code[k:n], "\r"))
+			if c == hlPlain {
+				lines.WriteString(text)
+			} else {
+				fmt.Fprintf(&lines, `<span style="%s">%s</span>`, hlStyles[c], text)
+			}
+			k = n
+		}
+		lines.WriteByte('\n')
+		i = j + 1
+	}
+
+	const pre = "margin:0;font-family:monospace;font-size:13px;line-height:1.4;tab-size:4;-moz-tab-size:4"
+	var bb strings.Builder
+	bb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&bb, "<title>%s</title>\n</head>\n<body>\n", html.EscapeString(title))
+	bb.WriteString(`<table style="border-collapse:collapse;background:#fafafa;color:#383a42"><tr>`)
+	fmt.Fprintf(&bb, `<td style="padding:4px 8px;text-align:right;vertical-align:top;color:#9d9d9f;border-right:1px solid #e0e0e0;user-select:none"><pre style="%s">%s</pre></td>`, pre, nums.String())
+	fmt.Fprintf(&bb, `<td style="padding:4px 8px;vertical-align:top"><pre style="%s">%s</pre></td>`, pre, lines.String())
+	bb.WriteString("</tr></table>\n</body>\n</html>\n")
+	return bb.String()
+}
+
+// serveSnippetHTML serves a snippet as a standalone HTML page.
+func (pg *playground) serveSnippetHTML(w http.ResponseWriter, r *http.Request) {
+	ss := strings.Split(r.URL.Path, "/")
+	id, err := strconv.ParseInt(ss[len(ss)-2], 10, 64)
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s, err := pg.store(r).Retrieve(id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err == errNotFound {
+			status = http.StatusNotFound
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(snippetHTML(s)))
+}
@@ -0,0 +1,129 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestHighlightSnippet(t *testing.T) {
+	// spans returns the highlighted parts of code by class.
+	spans := func(code string) map[hlClass][]string {
+		s, classes := highlightSnippet(code)
+		if s != code {
+			t.Errorf("highlightSnippet(%q) changed the code to %q", code, s)
+		}
+		m := make(map[hlClass][]string)
+		for i := 0; i < len(classes); {
+			j := i + 1
+			for j < len(classes) && classes[j] == classes[i] {
+				j++
+			}
+			if classes[i] != hlPlain {
+				m[classes[i]] = append(m[classes[i]], s[i:j])
+			}
+			i = j
+		}
+		return m
+	}
+
+	tests := []struct {
+		code string
+		want map[hlClass][]string
+	}{{
+		code: "package main\n\n// Hello.\nfunc main() { println(\"<hi>\", 'x', 0x1F) }\n",
+		want: map[hlClass][]string{
+			hlKeyword: {"package", "func"},
+			hlComment: {"// Hello."},
+			hlString:  {`"<hi>"`, "'x'"},
+			hlNumber:  {"0x1F"},
+		},
+	}, {
+		// Carriage returns are stripped from raw strings by the scanner.
+		code: "package main\r\n\r\nvar s = `a\r\nb` /* c\r\nd */\r\n",
+		want: map[hlClass][]string{
+			hlKeyword: {"package", "var"},
+			hlString:  {"`a\r\nb`"},
+			hlComment: {"/* c\r\nd */"},
+		},
+	}, {
+		code: "package main\n\nvar s = `unterminated\n",
+		want: map[hlClass][]string{
+			hlKeyword: {"package", "var"},
+			hlString:  {"`unterminated\n"},
+		},
+	}, {
+		// Only the Go files of the file tree are highlighted.
+		code: "package main\n-- go.mod --\nmodule example.com/m\n-- m/m.go --\npackage m\n",
+		want: map[hlClass][]string{
+			hlKeyword: {"package", "package"},
+			hlHeader:  {"-- go.mod --\n", "-- m/m.go --\n"},
+		},
+	}}
+	for _, tt := range tests {
+		got := spans(tt.code)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("highlightSnippet(%q) spans:\ngot  %q\nwant %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestSnippetHTML(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	get := func(url string, wantStatus int) string {
+		t.Helper()
+		resp, err := http.Get(srv.URL + url)
+		if err != nil {
+			t.Fatalf("http.Get error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		if resp.StatusCode != wantStatus {
+			t.Fatalf("GET %s: status %d, want %d: %s", url, resp.StatusCode, wantStatus, b)
+		}
+		if ct := resp.Header.Get("Content-Type"); wantStatus == http.StatusOK && ct != "text/html; charset=utf-8" {
+			t.Errorf("GET %s: Content-Type = %q, want text/html", url, ct)
+		}
+		return string(b)
+	}
+	code := "package main\n\n/* a\nb */\nfunc main() { println(\"<&>\") }\n"
+	id, err := pg.sdb.Create(snippet{Name: "<hello>", Code: code})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+
+	page := get(fmt.Sprintf("/snippets/%d/html", id), http.StatusOK)
+	for _, want := range []string{
+		"<title>&lt;hello&gt;</title>",
+		">1\n2\n3\n4\n5\n</pre>",
+		`<span style="` + hlStyles[hlComment] + `">/* a</span>` + "\n" +
+			`<span style="` + hlStyles[hlComment] + `">b */</span>` + "\n",
+		`println(<span style="` + hlStyles[hlString] + `">&#34;&lt;&amp;&gt;&#34;</span>)`,
+	} {
+		if !strings.Contains(page, want) {
+			t.Errorf("page does not contain %q:\n%s", want, page)
+		}
+	}
+	get("/snippets/999/html", http.StatusNotFound)
+}
@@ -7,27 +7,32 @@ package main
 import (
 	"bytes"
 	"context"
-	"crypto/rand"
 	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"os/signal"
 	"path"
 	"path/filepath"
-	"regexp"
+	"reflect"
+	"sort"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/BurntSushi/toml"
 	"github.com/dsnet/golib/jsonfmt"
-	"golang.org/x/crypto/ssh/terminal"
+	"golang.org/x/crypto/acme/autocert"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"gopkg.in/yaml.v3"
 )
 
 // Version of the playground binary. May be set by linker when building.
@@ -39,24 +44,108 @@ Playground is a server application for running Go snippets over the browser.
 The JSON configuration file takes the following form:
 {
 	// The socket address to serve on (default is localhost:8080).
+	//
+	// To serve on multiple addresses, this may instead be a list where
+	// each entry is either an address string or a listener of the form:
+	//	{
+	//		"Address": ":443",
+	//		"TLSCertFile": "", // Serve HTTPS using this certificate and key
+	//		"TLSKeyFile": "",
+	//		"AutoTLS": false,  // Serve HTTPS using the AutoTLS certificates
+	//		"Redirect": "",    // Redirect all requests to this base URL
+	//	}
+	//
+	// For example, the following redirects HTTP to HTTPS and also serves
+	// HTTP on the loopback interface:
+	//	["localhost:8080", {"Address": ":443", "AutoTLS": true},
+	//	 {"Address": ":80", "Redirect": "https://play.example.com"}]
+	//
+	// The top-level TLS settings apply to every address given as a string.
 	"ServeAddress": "",
 
+	// H2C enables HTTP/2 over cleartext TCP on listeners that do not use TLS.
+	// This is useful when running behind a reverse proxy that speaks h2c
+	// and terminates TLS itself. HTTP/1.1 requests continue to be served.
+	"H2C": false,
+
+	// BasePath is the URL path prefix that the playground is served under
+	// (e.g., "/playground"). This allows a reverse proxy to forward a
+	// subdirectory of a domain to the playground without rewriting paths.
+	// Requests outside of the prefix are rejected.
+	"BasePath": "",
+
+	// ExternalURL is the scheme and host that clients use to reach the
+	// playground (e.g., "https://play.example.com"), which is used to build
+	// absolute URLs such as the OIDC redirect URL. If not set, it is derived
+	// from the X-Forwarded-Proto and X-Forwarded-Host headers of requests
+	// from TrustedProxies, or otherwise from the request itself.
+	"ExternalURL": "",
+
+	// StaticDir is a directory to serve the static files (i.e., HTML, CSS,
+	// and JavaScript) from instead of the copies built into the binary.
+	// The files are read upon every request, so changes take effect by
+	// reloading the page without rebuilding the binary.
+	// This is intended for frontend development and is typically "public".
+	"StaticDir": "",
+
+	// Branding customizes the appearance of the playground so that separate
+	// instances (e.g., staging and production) can be told apart.
+	//
+	// Title replaces the page title and the heading.
+	// CSSFile is a stylesheet that is loaded after the built-in stylesheets.
+	// LogoFile is an image (PNG, JPEG, SVG, or ICO) shown before the heading.
+	// The files are read once when the server starts.
+	//
+	// If not set, the default appearance is used.
+	"Branding": {
+		"Title": "",
+		"CSSFile": "",
+		"LogoFile": "",
+	},
+
+	// TrustedProxies is a list of IP addresses or CIDR networks
+	// (e.g., "127.0.0.1" or "10.0.0.0/8") of reverse proxies in front of
+	// the playground. The client address is only obtained from the
+	// X-Real-IP or X-Forwarded-For headers if the request comes from one of
+	// these proxies. Otherwise, the headers are ignored since any client
+	// can set them.
+	"TrustedProxies": [],
+
+	// OTLPEndpoint is the URL of an OpenTelemetry collector to export traces
+	// to using OTLP over HTTP with JSON encoding
+	// (e.g., "http://localhost:4318/v1/traces").
+	// Traces cover HTTP requests, database operations, and the compile,
+	// execute, and profile phases of each run. Tracing is disabled if empty.
+	"OTLPEndpoint": "",
+
+	// EnablePprof serves the runtime profiles of the playground server
+	// itself at "/debug/pprof/" to authenticated users. For example:
+	//	curl -H "Authorization: Bearer $TOKEN" -o heap.pprof \
+	//		https://play.example.com/debug/pprof/heap
+	//	go tool pprof -http=: heap.pprof
+	// The profiles expose internal details of the server, so this should
+	// only be enabled while investigating a problem.
+	"EnablePprof": false,
+
 	// Path to a file to output the log (default is stdout).
 	"LogFile": "",
 
 	// If PasswordHash is set, then the server will require the user to login
 	// using some pre-determined password. This configuration file does not
-	// store the password itself, but a SHA256 hashed version of the password.
-	// The following script can be used to generate a salt and hash pair.
+	// store the password itself, but a hashed version of the password.
+	// The format of the hash is automatically detected and may either be
+	// argon2id in the PHC string format or bcrypt.
+	// An argon2id hash can be generated with:
+	//  playground hashpass
 	//
-	//  #!/bin/bash
-	//  read -s -p "Password: " PASSWORD && echo
-	//  PASSWORD_SALT=$(head -c 1024 /dev/urandom | sha256sum | head -c 64)
-	//  PASSWORD_HASH=$(echo -n "$(echo $PASSWORD_SALT | xxd -r -p)${PASSWORD}" | sha256sum | head -c 64)
-	//  echo -en "PasswordSalt: $PASSWORD_SALT\nPasswordHash: $PASSWORD_HASH\n"
-	//  unset PASSWORD PASSWORD_SALT PASSWORD_HASH
+	// Alternatively, a bcrypt hash can be generated with:
+	//  htpasswd -nBC 12 "" | tr -d ':\n'
 	//
-	// The password fields must be set.
+	// For backwards compatibility, PasswordHash may also be the hex-encoded
+	// SHA256 of the hex-decoded PasswordSalt concatenated with the password.
+	// This format is weak against brute force and is deprecated. Upon the
+	// first successful login with such a hash, the server logs an equivalent
+	// argon2id hash that should replace PasswordHash and PasswordSalt.
 	"PasswordSalt": "",
 	"PasswordHash": "",
 
@@ -68,12 +157,67 @@ The JSON configuration file takes the following form:
 	"TLSCertFile": "",
 	"TLSKeyFile": "",
 
+	// AutoTLS enables the server to automatically obtain and renew TLS
+	// certificates from Let's Encrypt using the ACME protocol as an
+	// alternative to TLSCertFile and TLSKeyFile. Typically, ServeAddress
+	// should be ":443" when AutoTLS is used.
+	//
+	// Hosts is the list of host names that certificates may be obtained for.
+	// Email is an optional contact address for the ACME account.
+	// CacheDir is the directory where certificates are cached, which
+	// defaults to "$DataPath/autocert".
+	// HTTPAddress is the socket address to serve the HTTP-01 challenge on,
+	// which defaults to ":80". All other HTTP requests are redirected to HTTPS.
+	//
+	// If not set, then certificates are not automatically obtained.
+	"AutoTLS": {
+		"Hosts": [],
+		"Email": "",
+		"CacheDir": "",
+		"HTTPAddress": "",
+	},
+
+	// Specifying an OpenID Connect provider allows users to login through
+	// a single sign-on service (e.g., "https://accounts.google.com") as an
+	// alternative to the password. If the password is not set, then the
+	// provider is the only way to login.
+	//
+	// The provider must be configured with a client whose redirect URL is
+	// "/login/oidc/callback" relative to the address of the playground
+	// (see ExternalURL).
+	// Only users whose verified email address is in OIDCAllowedEmails may
+	// login. An entry of the form "@example.com" allows all email addresses
+	// within that domain.
+	"OIDCIssuer": "",
+	"OIDCClientID": "",
+	"OIDCClientSecret": "",
+	"OIDCAllowedEmails": [],
+
 	// Path to the directory where persistent server data is to be stored.
 	// This can be a full path or a relative path to the CWD.
+	// Generated reports (e.g., profiles) are stored in "$DataPath/blobs"
+	// so that they survive restarts.
 	//
 	// If not set, this defaults to "$HOME/.playground"
 	"DataPath": "",
 
+	// Path to the directory used as the build cache (i.e., GOCACHE) by the go
+	// command. The cache is shared by all executors and persists across
+	// restarts, so that only the first build needs to compile the standard
+	// library. This can be a full path or a relative path to the CWD.
+	//
+	// If not set, this defaults to "$DataPath/gocache", unless GOCACHE is set
+	// in Environment, in which case GoCache must not be set.
+	"GoCache": "",
+
+	// The backend used to store snippets in DataPath, which is either "bolt"
+	// or "sqlite". The SQLite database is easier to inspect with external
+	// tools. Snippets can be migrated between backends using the export
+	// and import endpoints.
+	//
+	// Defaults to "bolt".
+	"StorageBackend": "",
+
 	// Path to the default binary used to build Go code.
 	// This can be a file path or a single binary name (located in the $PATH).
 	//
@@ -86,6 +230,17 @@ The JSON configuration file takes the following form:
 	// Defaults to "goimports" if available, otherwise "gofmt".
 	"FmtBinary": "",
 
+	// GoplsBinary is the path to the gopls binary used to provide code
+	// completion and hover documentation in the editor. If not set, gopls is
+	// used if it is found in the $PATH, otherwise these are disabled.
+	"GoplsBinary": "",
+
+	// DelveBinary is the path to the dlv binary used to debug programs with
+	// breakpoints in the browser. Programs being debugged are built with
+	// optimizations and inlining disabled. If not set, dlv is used if it is
+	// found in the $PATH, otherwise debugging is disabled.
+	"DelveBinary": "",
+
 	// GoVersions is a map of various versions of Go available on the system.
 	// It is useful to have multiple versions so that benchmarks can be tested
 	// on a variety of Go versions.
@@ -94,27 +249,671 @@ The JSON configuration file takes the following form:
 	// The value is a file path or a single binary name (located in the $PATH).
 	//
 	// It is valid for the map to be empty.
+	//
+	// Go toolchains found on startup in the PATH (including the wrappers
+	// from golang.org/dl, such as go1.21.5) and in common install locations
+	// (e.g., ~/sdk/go*, /usr/local/go*, and /usr/lib/go-*) are also added
+	// to the map under the version reported by "go version". Each minor
+	// version (e.g., go1.21) refers to the newest of its patch releases.
+	// Entries in the configuration take precedence over discovered ones.
 	"GoVersions": {},
 
+	// DisableGoDiscovery disables the discovery of Go toolchains
+	// described for GoVersions.
+	"DisableGoDiscovery": false,
+
+	// DisableCGO builds programs with cgo disabled (i.e., CGO_ENABLED=0)
+	// unless a program enables it with "//playground:cgo on".
+	// Conversely, "//playground:cgo off" disables cgo for a single program.
+	"DisableCGO": false,
+
+	// GoTipInterval is how often the "gotip" Go version is rebuilt from the
+	// latest development branch of Go (e.g., "24h"). If set, the toolchain is
+	// cloned into "$DataPath/gotip" using git and built using GoBinary upon
+	// startup, after which gotip may be used like any entry in GoVersions
+	// (e.g., "//playground:goversions gotip go1.22").
+	//
+	// If not set, gotip is not available.
+	"GoTipInterval": "",
+
+	// Linters is a map of static analysis tools available to the client.
+	// When linting is requested, every linter is run on the snippet.
+	//
+	// The key is an identifier for a given linter (e.g., staticcheck).
+	// The value is a file path or a single binary name (located in the $PATH).
+	// The binary is invoked with the name of the Go source file as the
+	// only argument and must exit with a non-zero status if issues are found.
+	//
+	// It is valid for the map to be empty.
+	"Linters": {},
+
+	// Generators is a map of code generators available to the
+	// "//go:generate" directives of snippets that use the
+	// "//playground:generate" magic comment.
+	//
+	// The key is the command name used in the directives (e.g., stringer).
+	// The value is a file path or a single binary name (located in the $PATH).
+	//
+	// It is valid for the map to be empty.
+	"Generators": {},
+
+	// EnableModules builds snippets in module mode, where the third-party
+	// modules that a snippet imports are downloaded. Otherwise, snippets are
+	// built in GOPATH mode and may only import packages in the GOPATH.
+	"EnableModules": false,
+
+	// AllowedModules is a list of the modules that snippets may import when
+	// EnableModules is set. Each entry is a module path or path prefix
+	// (e.g., "golang.org/x"), optionally with the version that the module
+	// is pinned to (e.g., "rsc.io/quote@v1.5.2"). Snippets that import
+	// other modules or that depend on them indirectly fail to build.
+	//
+	// If not set, any module may be downloaded.
+	"AllowedModules": [],
+
+	// GoProxy, GoSumDB, and GoPrivate set the GOPROXY, GOSUMDB, and GOPRIVATE
+	// environment variables of builds, which control where modules are
+	// downloaded from when EnableModules is set (e.g., an internal module
+	// proxy and private repositories).
+	//
+	// If not set, the environment of the server is used.
+	"GoProxy":   "",
+	"GoSumDB":   "",
+	"GoPrivate": "",
+
+	// MaxConcurrentRuns is the maximum number of snippets that may be built
+	// and run at the same time across all clients. Additional runs wait in
+	// a FIFO queue and the client is informed of its position in the queue.
+	//
+	// If not set, the number of concurrent runs is unlimited.
+	"MaxConcurrentRuns": 0,
+
+	// RunCacheSize is the number of runs whose output is cached in memory.
+	// Running a snippet identical to a cached run replays the prior output
+	// instead of rebuilding and running the snippet. Since the output of
+	// some programs varies from run to run, caching is disabled by default.
+	// Runs that are stopped or that produce reports are never cached.
+	"RunCacheSize": 0,
+
+	// MaxOutputSize is the maximum number of bytes of output (i.e., stdout
+	// and stderr) sent to the client for each run. When exceeded, the output
+	// is truncated and the program is killed.
+	//
+	// If not set, the output is unlimited.
+	"MaxOutputSize": 0,
+
+	// MaxDiskUsage is the maximum number of bytes of files (e.g., the binary
+	// and any files written by the program) in the temporary directory of
+	// a run. Its usage is checked periodically while commands run, and the
+	// command that exceeds it is killed. It is not enforced within microVMs,
+	// whose files are limited by their memory.
+	//
+	// If not set, the disk usage is unlimited.
+	"MaxDiskUsage": 0,
+
+	// MaxBlobStoreSize is the maximum total size in bytes of the generated
+	// reports (e.g., profiles) that are stored. When exceeded, the least
+	// recently viewed reports are evicted.
+	//
+	// If not set, the size of stored reports is unlimited.
+	"MaxBlobStoreSize": 0,
+
+	// MaxReportSize is the maximum number of bytes of each generated report
+	// (e.g., a profile or a file created by the program). Larger reports
+	// are dropped, which the status output of the run tells.
+	//
+	// If not set, this defaults to 16 MiB.
+	"MaxReportSize": 0,
+
+	// MaxTotalReportSize is the maximum total number of bytes of the
+	// reports of a client at once, which are deleted when it starts another
+	// run or disconnects. Reports beyond it are dropped like large ones.
+	//
+	// If not set, the total size of the reports of a client is unlimited.
+	"MaxTotalReportSize": 0,
+
+	// BlobTTL is how long generated reports (e.g., profiles) are kept
+	// (e.g., "24h"). Reports are normally deleted when the client that
+	// generated them disconnects, but this reclaims reports left behind
+	// when the server stops abruptly. A zero duration keeps them forever.
+	//
+	// If not set, this defaults to "24h".
+	"BlobTTL": "",
+
+	// EnablePprofUI serves each profile generated with the pprof magic
+	// comment in the interactive web UI of "go tool pprof -http"
+	// (e.g., with flame graph, top, and source views) in addition to the
+	// static reports. Each web UI is a separate process listening on
+	// a loopback port, which is reverse-proxied under "/pprof/" and stopped
+	// once the client starts another run or disconnects.
+	"EnablePprofUI": false,
+
+	// StopSignal is the signal sent to a running program when it is stopped
+	// (e.g., "SIGINT", "SIGTERM", "SIGQUIT", or "SIGKILL"). Unless the
+	// signal is "SIGKILL", the program is given StopGracePeriod to exit
+	// (e.g., to run deferred cleanup and flush profiles) before it is killed.
+	//
+	// If not set, this defaults to "SIGINT".
+	"StopSignal": "",
+
+	// StopGracePeriod is how long a stopped program may take to exit
+	// before it is killed (e.g., "2s").
+	//
+	// If not set, this defaults to "2s".
+	"StopGracePeriod": "",
+
+	// RunTimeout is how long a program may run (e.g., "30s"). Once exceeded,
+	// the program is sent SIGQUIT so that the Go runtime prints the stack
+	// traces of all goroutines, which makes deadlocks debuggable, and
+	// it is killed if it does not exit within StopGracePeriod.
+	//
+	// If not set, programs may run until stopped by the client.
+	"RunTimeout": "",
+
+	// DockerImage is the Docker image (e.g., "golang:1.22") in which every
+	// build and run happens, which provides a reproducible toolchain and
+	// isolates the host from the programs. Each command runs in a new
+	// container as the user of the playground, with only the temporary
+	// directory of the run mounted.
+	//
+	// GoBinary, FmtBinary, and GoVersions are then paths within the image,
+	// and the Go toolchains of the host are not discovered. GoCache is mounted
+	// at the same path so that containers share the build cache, and the
+	// other Go settings are passed into the container as is. Debugging and
+	// the Generators are not available, and the peak memory of a run is that
+	// of the docker client. Linters and profile reports still run on the host.
+	//
+	// If not set, commands run directly on the host.
+	"DockerImage": "",
+
+	// DockerBinary is the docker client used to run containers
+	// (e.g., "podman"). If not set, this defaults to "docker".
+	"DockerBinary": "",
+
+	// DockerArgs are additional arguments of "docker run" for every container
+	// (e.g., ["--memory=512m", "--cpus=1", "--network=none"]).
+	"DockerArgs": [],
+
+	// MicroVM runs every build and run in its own Firecracker microVM, which
+	// isolates programs from the host more strongly than DockerImage.
+	// A pool of PoolSize VMs is booted ahead of time to keep runs fast, and
+	// each VM is shut down after running a single command.
+	//
+	// KernelImage is the uncompressed Linux kernel of the VMs and RootFS is
+	// their root filesystem image, which is mounted read-only. RootFS must
+	// have the Go toolchains, with the same paths as GoBinary, FmtBinary, and
+	// GoVersions, and this binary at "/usr/local/bin/playground", which runs
+	// as the init process of the VM with the "vm-agent" command. KernelArgs
+	// defaults to "console=ttyS0 reboot=k panic=1 pci=off init=/usr/local/bin/playground -- vm-agent".
+	// VCPUs and MemoryMB are the resources of each VM, which default to
+	// 1 and 512. PoolSize defaults to 2. FirecrackerBinary defaults to
+	// "firecracker", which must be able to access /dev/kvm.
+	//
+	// The temporary directory of the playground (i.e., $TMPDIR) must be in
+	// /tmp, which is copied to and from the VM for each command. Programs
+	// run as the nobody user and have no network access. GoCache is not used
+	// since the VMs cannot access the host, so every VM starts with an empty
+	// build cache. The other Go settings are passed into the VM as is.
+	// As with DockerImage, debugging and the Generators are not available,
+	// and the peak memory of a run is not reported.
+	//
+	// If not set, microVMs are not used.
+	"MicroVM": {
+		"FirecrackerBinary": "",
+		"KernelImage": "",
+		"KernelArgs": "",
+		"RootFS": "",
+		"VCPUs": 0,
+		"MemoryMB": 0,
+		"PoolSize": 0,
+	},
+
+	// SecurityProfile hardens the programs of snippets when they run directly
+	// on the host (i.e., without DockerImage or MicroVM). Builds and other
+	// tools are not restricted. It is only supported on Linux.
+	//
+	// Seccomp denies system calls that administer the system or escape or
+	// inspect the sandbox (e.g., ptrace, mount, unshare, bpf, and keyctl)
+	// with EPERM. With Seccomp, DenyNetwork also denies creating sockets other
+	// than Unix domain sockets with EACCES. Landlock restricts filesystem
+	// access to the temporary directory of the run, which also becomes
+	// $TMPDIR, and read access to ReadPaths, which requires Linux 5.13.
+	// ReadPaths defaults to common devices (e.g., /dev/null), time zones,
+	// TLS certificates, and shared libraries (e.g., /usr/lib).
+	// If a restriction cannot be applied, programs do not run.
+	//
+	// If not set, programs are not restricted.
+	"SecurityProfile": {
+		"Seccomp": false,
+		"DenyNetwork": false,
+		"Landlock": false,
+		"ReadPaths": [],
+	},
+
+	// DisableNetwork runs the programs of all snippets without network access,
+	// as the "//playground:nonet" magic comment does for a single snippet.
+	// On the host, programs then run in a network namespace of their own with
+	// only a loopback interface, which requires Linux and, unless the
+	// playground runs as root, unprivileged user namespaces, in which the
+	// programs run as root. With DockerImage, containers of programs have no
+	// network, and programs in microVMs never have network access.
+	"DisableNetwork": false,
+
+	// RunNiceness is the nice value of the programs of snippets on the host
+	// (e.g., 10), which lowers their priority relative to the playground and
+	// other processes so that busy programs do not starve them. Negative
+	// values require privileges.
+	//
+	// If not set, programs run with the priority of the playground.
+	"RunNiceness": 0,
+
+	// RunCPULimit is the number of CPUs that the program of each snippet may
+	// use (e.g., 0.5), regardless of its number of threads. On the host, each
+	// program runs in a cgroup of its own, which is created in CgroupDir and
+	// requires Linux with cgroup version 2. CgroupDir must be a cgroup without
+	// processes that is writable by the playground (e.g., delegated by systemd
+	// with "Delegate=yes") and whose cpu controller is available.
+	// With DockerImage, the containers of programs are limited instead.
+	// RunNiceness and RunCPULimit do not apply within microVMs, which are
+	// limited by their number of CPUs.
+	//
+	// If not set, the CPU usage of programs is unlimited.
+	"RunCPULimit": 0,
+	"CgroupDir": "",
+
+	// GitHubToken is a GitHub access token with the "gist" scope.
+	// If set, snippets can be exported to GitHub Gists.
+	"GitHubToken": "",
+
+	// BackupInterval is how often the snippet database is backed up into
+	// "$DataPath/backups" (e.g., "24h"). A backup can also be created
+	// on demand by sending a POST request to "/admin/backup".
+	//
+	// If not set, scheduled backups are disabled.
+	"BackupInterval": "",
+
+	// BackupRetention is the number of most recent backups to keep.
+	// Older backups are deleted whenever a new backup is created.
+	// If negative, all backups are kept.
+	//
+	// Defaults to 7.
+	"BackupRetention": 0,
+
+	// BackupTargets is a list of destinations that each backup is copied to
+	// so that the backups survive the loss of the disk holding DataPath.
+	// The retention of BackupRetention also applies to each target.
+	// The URL of each target selects its type:
+	//	"/mnt/backups" or "file:///mnt/backups" for a local directory
+	//	"s3://bucket/prefix" for a bucket of an S3-compatible object store
+	//	"sftp://user@host:22/path" for a directory on an SFTP server
+	//
+	// For S3, the Endpoint defaults to AWS in the Region, which defaults to
+	// "us-east-1" (e.g., set "https://minio.example.com" for MinIO).
+	// The AccessKeyID and SecretAccessKey default to the AWS_ACCESS_KEY_ID
+	// and AWS_SECRET_ACCESS_KEY environment variables.
+	//
+	// For SFTP, the sftp command is run in batch mode with the SSH
+	// configuration of the user, optionally using the key in IdentityFile.
+	//
+	// For example:
+	//	[{"URL": "s3://backups/playground", "Region": "eu-west-1"},
+	//	 {"URL": "sftp://backup@nas.example.com/playground"}]
+	"BackupTargets": [],
+
+	// BackupPassphrase encrypts the backups copied to BackupTargets using
+	// AES-256-GCM with a key derived from the passphrase, in which case
+	// the copies have a ".enc" suffix. The backups in "$DataPath/backups"
+	// are not encrypted. An encrypted backup is decrypted with:
+	//	playground decrypt-backup CONF_FILE < in.backup.enc > out.backup
+	"BackupPassphrase": "",
+
+	// Templates is a map of template names to paths of files with the code
+	// that new snippets may start out with. Templates are also read from
+	// the files in "$DataPath/templates", where the name of each template
+	// is the file name without its extension (e.g., "grpc.go" is "grpc").
+	// These are added to the built-in templates ("hello", "table-test",
+	// "benchmark", "http-server", and "fuzz") and replace any of the same
+	// name. Templates are listed by "/templates" and a new snippet is
+	// created from one by sending a POST request to "/templates/{name}".
+	"Templates": {},
+
+	// Webhooks is a list of URLs that are sent a POST request with a JSON
+	// payload whenever a run finishes, such as to notify Slack or a CI
+	// dashboard. The payload has the following fields:
+	//	"event":      always "run"
+	//	"snippet_id": the ID of the snippet, if the run is of a saved snippet
+	//	"status":     the exit status of the program with the "exitCode",
+	//	              "signal", "reason", "duration", and "maxRSS", or null
+	//	              if no program ran (e.g., the build failed)
+	//	"duration":   the duration of the entire run in seconds
+	//	"output_url": a link to the output of the run as plain text
+	//	"reports":    the "name" and "url" of each profile or other report
+	//	"text":       a one-line summary of the run
+	// Links are absolute only if ExternalURL is set and require logging in
+	// like the rest of the playground. The output is kept for BlobTTL,
+	// while the reports are deleted when the session runs again.
+	//
+	// If Secret is set, the X-Playground-Signature header of each request
+	// is "sha256=" followed by the hex-encoded HMAC-SHA256 of the payload
+	// keyed by the secret. Failed deliveries are retried twice.
+	//
+	// For example:
+	//	[{"URL": "https://hooks.slack.com/services/...", "Secret": ""}]
+	"Webhooks": [],
+
+	// WorkerToken is a secret that allows remote workers to connect to
+	// the playground, which then builds and runs snippets on the workers
+	// so that heavy loads (e.g., benchmarks) do not compete with serving
+	// the UI and database. Each run is dispatched to the connected worker
+	// with the fewest on-going runs, or runs locally if no worker is
+	// connected. Runs of a workspace restored from a snapshot always run
+	// locally.
+	//
+	// A worker is started on another host with the worker command, using
+	// a configuration with the same WorkerToken and with CoordinatorURL set
+	// to the URL of the playground (including any BasePath). The worker
+	// builds and runs snippets with its own GoBinary, GoVersions,
+	// MaxConcurrentRuns, RunTimeout, and other settings of runs.
+	//
+	// For example:
+	//	"CoordinatorURL": "https://play.example.com/play"
+	"WorkerToken":    "",
+	"CoordinatorURL": "",
+
 	// Environment is a map of environment variables to set.
 	"Environment": {},
-}`
+}
+
+The configuration file may instead be written in TOML or YAML if the file
+name ends in ".toml", ".yaml", or ".yml". The fields are the same as above.
+For example, the following YAML is equivalent to a JSON configuration:
+
+	ServeAddress:
+	  - localhost:8080
+	  - {Address: ":443", AutoTLS: true}
+	AutoTLS:
+	  Hosts: [play.example.com]
+	GoVersions:
+	  go1.20: /usr/local/go1.20/bin/go
+
+Each field may be overridden by an environment variable named PLAYGROUND_
+followed by the field name in upper snake case (e.g., PLAYGROUND_SERVE_ADDRESS,
+PLAYGROUND_OIDC_CLIENT_ID, or PLAYGROUND_GITHUB_TOKEN), which takes precedence
+over the configuration file. Values of fields that are not strings are parsed
+as JSON (e.g., PLAYGROUND_H2C=true or PLAYGROUND_TRUSTED_PROXIES='["10.0.0.1"]'),
+while values for the ServeAddress field may also be a plain address.
+If PLAYGROUND_PASSWORD_HASH is set, then no configuration file is required.`
 
 type config struct {
-	ServeAddress string            `json:",omitempty"`
-	LogFile      string            `json:",omitempty"`
-	PasswordSalt string            `json:",omitempty"`
-	PasswordHash string            `json:",omitempty"`
-	TLSCertFile  string            `json:",omitempty"`
-	TLSKeyFile   string            `json:",omitempty"`
-	DataPath     string            `json:",omitempty"`
-	GoBinary     string            `json:",omitempty"`
-	FmtBinary    string            `json:",omitempty"`
-	GoVersions   map[string]string `json:",omitempty"`
-	Environment  map[string]string `json:",omitempty"`
+	ServeAddress       serveAddresses       `json:",omitempty"`
+	H2C                bool                 `json:",omitempty"`
+	BasePath           string               `json:",omitempty"`
+	ExternalURL        string               `json:",omitempty"`
+	StaticDir          string               `json:",omitempty"`
+	Branding           *brandingConfig      `json:",omitempty"`
+	TrustedProxies     []string             `json:",omitempty"`
+	OTLPEndpoint       string               `json:",omitempty"`
+	EnablePprof        bool                 `json:",omitempty"`
+	LogFile            string               `json:",omitempty"`
+	PasswordSalt       string               `json:",omitempty"`
+	PasswordHash       string               `json:",omitempty"`
+	TLSCertFile        string               `json:",omitempty"`
+	TLSKeyFile         string               `json:",omitempty"`
+	AutoTLS            *autoTLSConfig       `json:",omitempty"`
+	OIDCIssuer         string               `json:",omitempty"`
+	OIDCClientID       string               `json:",omitempty"`
+	OIDCClientSecret   string               `json:",omitempty"`
+	OIDCAllowedEmails  []string             `json:",omitempty"`
+	DataPath           string               `json:",omitempty"`
+	GoCache            string               `json:",omitempty"`
+	StorageBackend     string               `json:",omitempty"`
+	GoBinary           string               `json:",omitempty"`
+	FmtBinary          string               `json:",omitempty"`
+	GoplsBinary        string               `json:",omitempty"`
+	DelveBinary        string               `json:",omitempty"`
+	GoVersions         map[string]string    `json:",omitempty"`
+	GoTipInterval      string               `json:",omitempty"`
+	DisableGoDiscovery bool                 `json:",omitempty"`
+	DisableCGO         bool                 `json:",omitempty"`
+	Linters            map[string]string    `json:",omitempty"`
+	Generators         map[string]string    `json:",omitempty"`
+	EnableModules      bool                 `json:",omitempty"`
+	AllowedModules     []string             `json:",omitempty"`
+	GoProxy            string               `json:",omitempty"`
+	GoSumDB            string               `json:",omitempty"`
+	GoPrivate          string               `json:",omitempty"`
+	MaxConcurrentRuns  int                  `json:",omitempty"`
+	RunCacheSize       int                  `json:",omitempty"`
+	MaxOutputSize      int64                `json:",omitempty"`
+	MaxDiskUsage       int64                `json:",omitempty"`
+	MaxBlobStoreSize   int64                `json:",omitempty"`
+	MaxReportSize      int64                `json:",omitempty"`
+	MaxTotalReportSize int64                `json:",omitempty"`
+	BlobTTL            string               `json:",omitempty"`
+	EnablePprofUI      bool                 `json:",omitempty"`
+	StopSignal         string               `json:",omitempty"`
+	StopGracePeriod    string               `json:",omitempty"`
+	RunTimeout         string               `json:",omitempty"`
+	DockerImage        string               `json:",omitempty"`
+	DockerBinary       string               `json:",omitempty"`
+	DockerArgs         []string             `json:",omitempty"`
+	MicroVM            *microVMConfig       `json:",omitempty"`
+	SecurityProfile    *securityProfile     `json:",omitempty"`
+	DisableNetwork     bool                 `json:",omitempty"`
+	RunNiceness        int                  `json:",omitempty"`
+	RunCPULimit        float64              `json:",omitempty"`
+	CgroupDir          string               `json:",omitempty"`
+	GitHubToken        string               `json:",omitempty" env:"GITHUB_TOKEN"`
+	BackupInterval     string               `json:",omitempty"`
+	BackupRetention    int                  `json:",omitempty"`
+	BackupTargets      []backupTargetConfig `json:",omitempty"`
+	BackupPassphrase   string               `json:",omitempty"`
+	Templates          map[string]string    `json:",omitempty"`
+	Webhooks           []webhookConfig      `json:",omitempty"`
+	WorkerToken        string               `json:",omitempty"`
+	CoordinatorURL     string               `json:",omitempty"`
+	Environment        map[string]string    `json:",omitempty"`
+}
+
+// configToJSON converts the contents of a configuration file to standard JSON
+// based on the file extension. Files ending in ".toml", ".yaml", or ".yml"
+// are parsed as TOML or YAML, while all other files are parsed as JSON
+// with comments. Other formats are converted to JSON so that they are
+// decoded with the same semantics as JSON (e.g., for serveAddresses).
+func configToJSON(b []byte, ext string) ([]byte, error) {
+	var v interface{}
+	switch strings.ToLower(ext) {
+	case ".toml":
+		if err := toml.Unmarshal(b, &v); err != nil {
+			return nil, err
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(b, &v); err != nil {
+			return nil, err
+		}
+		if v == nil {
+			v = map[string]interface{}{} // Empty YAML document
+		}
+	default:
+		return jsonfmt.Format(b, jsonfmt.Standardize())
+	}
+	return json.Marshal(v)
+}
+
+// envPrefix is the prefix of environment variables that override
+// fields of the configuration.
+const envPrefix = "PLAYGROUND_"
+
+// applyEnvOverrides sets each field of conf for which lookup reports an
+// environment variable. The name of the variable is derived by envName,
+// unless it is specified by an "env" struct tag.
+func applyEnvOverrides(conf *config, lookup func(string) (string, bool)) error {
+	v := reflect.ValueOf(conf).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		name := f.Tag.Get("env")
+		if name == "" {
+			name = envName(f.Name)
+		}
+		name = envPrefix + name
+		s, ok := lookup(name)
+		if !ok {
+			continue
+		}
+
+		// Strings are used verbatim, while all other values are JSON.
+		// Fall back on treating the value as a JSON string so that
+		// fields like ServeAddress may be set to a plain address.
+		fv := v.Field(i)
+		if fv.Kind() == reflect.String {
+			fv.SetString(s)
+			continue
+		}
+		p := reflect.New(fv.Type())
+		if err := json.Unmarshal([]byte(s), p.Interface()); err != nil {
+			b, _ := json.Marshal(s)
+			if json.Unmarshal(b, p.Interface()) != nil {
+				return fmt.Errorf("%s: %v", name, err)
+			}
+		}
+		fv.Set(p.Elem())
+	}
+	return nil
+}
+
+// envName converts a field name in camel case to upper snake case,
+// where acronyms are kept as a single word (e.g., "OIDCClientID" to
+// "OIDC_CLIENT_ID").
+func envName(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		isUpper := func(j int) bool { return 'A' <= s[j] && s[j] <= 'Z' }
+		isLower := func(j int) bool { return 'a' <= s[j] && s[j] <= 'z' }
+		if i > 0 && isUpper(i) && (isLower(i-1) || (isUpper(i-1) && i+1 < len(s) && isLower(i+1))) {
+			b = append(b, '_')
+		}
+		if isLower(i) {
+			c -= 'a' - 'A'
+		}
+		b = append(b, c)
+	}
+	return string(b)
+}
+
+// listenerConfig configures a single socket address to serve on.
+type listenerConfig struct {
+	Address     string `json:",omitempty"`
+	TLSCertFile string `json:",omitempty"`
+	TLSKeyFile  string `json:",omitempty"`
+	AutoTLS     bool   `json:",omitempty"`
+	Redirect    string `json:",omitempty"`
+
+	// plain reports whether the listener was specified as an address string,
+	// in which case it uses the top-level TLS settings.
+	plain bool
+}
+
+// serveAddresses is the list of listeners, which is specified in JSON as
+// either a single address string, or a list of address strings and
+// listenerConfig objects.
+type serveAddresses []listenerConfig
+
+func (sa *serveAddresses) UnmarshalJSON(b []byte) error {
+	var addr string
+	if err := json.Unmarshal(b, &addr); err == nil {
+		*sa = serveAddresses{{Address: addr, plain: true}}
+		return nil
+	}
+	var rawList []json.RawMessage
+	if err := json.Unmarshal(b, &rawList); err != nil {
+		return err
+	}
+	*sa = nil
+	for _, raw := range rawList {
+		lc := listenerConfig{plain: true}
+		if err := json.Unmarshal(raw, &lc.Address); err != nil {
+			lc = listenerConfig{}
+			if err := json.Unmarshal(raw, &lc); err != nil {
+				return err
+			}
+		}
+		*sa = append(*sa, lc)
+	}
+	return nil
+}
+
+func (sa serveAddresses) MarshalJSON() ([]byte, error) {
+	if len(sa) == 1 && sa[0].plain {
+		return json.Marshal(sa[0].Address)
+	}
+	var vs []interface{}
+	for _, lc := range sa {
+		if lc.plain {
+			vs = append(vs, lc.Address)
+		} else {
+			vs = append(vs, lc)
+		}
+	}
+	return json.Marshal(vs)
+}
+
+func (sa serveAddresses) String() string {
+	var ss []string
+	for _, lc := range sa {
+		ss = append(ss, lc.Address)
+	}
+	return strings.Join(ss, ", ")
+}
+
+type brandingConfig struct {
+	Title    string `json:",omitempty"`
+	CSSFile  string `json:",omitempty"`
+	LogoFile string `json:",omitempty"`
+}
+
+type backupTargetConfig struct {
+	URL             string `json:",omitempty"`
+	Endpoint        string `json:",omitempty"`
+	Region          string `json:",omitempty"`
+	AccessKeyID     string `json:",omitempty"`
+	SecretAccessKey string `json:",omitempty"`
+	IdentityFile    string `json:",omitempty"`
+}
+
+type webhookConfig struct {
+	URL    string `json:",omitempty"`
+	Secret string `json:",omitempty"`
+}
+
+type autoTLSConfig struct {
+	Hosts       []string `json:",omitempty"`
+	Email       string   `json:",omitempty"`
+	CacheDir    string   `json:",omitempty"`
+	HTTPAddress string   `json:",omitempty"`
+}
+
+// goCacheDir returns the absolute path of the build cache shared by all
+// executors, which the go command requires. It returns an empty path if the
+// build cache is instead set by GOCACHE in the Environment.
+func goCacheDir(conf config) (string, error) {
+	if _, ok := conf.Environment["GOCACHE"]; ok {
+		if conf.GoCache != "" {
+			return "", errors.New("conflicts with GOCACHE in Environment")
+		}
+		return "", nil
+	}
+	dir := conf.GoCache
+	if dir == "" {
+		dir = filepath.Join(conf.DataPath, "gocache")
+	}
+	return filepath.Abs(dir)
 }
 
-func loadConfig(path string) (conf config, logger *log.Logger, closer func() error) {
+// loadConfig loads the configuration file at path, if any.
+// If there is no file and no password is configured, then the user is
+// prompted for a new password if prompt is set.
+func loadConfig(path string, prompt bool) (conf config, logger *log.Logger, closer func() error) {
 	var logBuf bytes.Buffer
 	logger = log.New(io.MultiWriter(os.Stderr, &logBuf), "", log.Ldate|log.Ltime|log.Lshortfile)
 
@@ -129,40 +928,79 @@ func loadConfig(path string) (conf config, logger *log.Logger, closer func() err
 		if err != nil {
 			logger.Fatalf("unable to read config: %v", err)
 		}
-		if c, err = jsonfmt.Format(c, jsonfmt.Standardize()); err != nil {
+		if c, err = configToJSON(c, filepath.Ext(path)); err != nil {
 			logger.Fatalf("unable to parse config: %v", err)
 		}
 		if err := json.Unmarshal(c, &conf); err != nil {
 			logger.Fatalf("unable to decode config: %v", err)
 		}
-	} else {
-		fmt.Print("Enter a new Playground login password: ")
-		p, err := terminal.ReadPassword(int(syscall.Stdin))
-		fmt.Println()
-		if err != nil {
-			logger.Fatalf("unable to read password: %v", err)
-		}
-		if len(bytes.TrimSpace(p)) < 8 {
-			logger.Fatal("error: insecure password")
-		}
-		s, err := ioutil.ReadAll(&io.LimitedReader{R: rand.Reader, N: 32})
-		if err != nil {
-			logger.Fatalf("unable to generate salt: %v", err)
+	}
+	if err := applyEnvOverrides(&conf, os.LookupEnv); err != nil {
+		logger.Fatalf("invalid environment variable: %v", err)
+	}
+	if path == "" && conf.PasswordHash == "" && prompt {
+		var err error
+		if conf.PasswordHash, err = promptPasswordHash(); err != nil {
+			logger.Fatalf("error: %v", err)
 		}
-		conf.PasswordSalt = fmt.Sprintf("%x", s)
-		conf.PasswordHash = fmt.Sprintf("%x", sha256.Sum256(append(s, p...)))
 	}
 
 	// Set default values.
-	if conf.ServeAddress == "" {
-		conf.ServeAddress = "localhost:8080"
+	if len(conf.ServeAddress) == 0 {
+		conf.ServeAddress = serveAddresses{{plain: true}}
+	}
+	for i, lc := range conf.ServeAddress {
+		if lc.Address == "" {
+			conf.ServeAddress[i].Address = "localhost:8080"
+		}
+		if lc.AutoTLS && conf.AutoTLS == nil {
+			logger.Fatalf("listener %v uses AutoTLS, which is not configured", lc.Address)
+		}
+		if (lc.TLSCertFile != "" || lc.TLSKeyFile != "") && (lc.AutoTLS || lc.Redirect != "") {
+			logger.Fatalf("listener %v has conflicting settings", lc.Address)
+		}
+	}
+	if conf.BasePath != "" {
+		if !strings.HasPrefix(conf.BasePath, "/") {
+			logger.Fatal("BasePath must start with a slash")
+		}
+		conf.BasePath = strings.TrimRight(conf.BasePath, "/")
+	}
+	if conf.ExternalURL != "" {
+		u, err := url.Parse(conf.ExternalURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.Trim(u.Path, "/") != "" {
+			logger.Fatalf("invalid ExternalURL: %q", conf.ExternalURL)
+		}
+		conf.ExternalURL = u.Scheme + "://" + u.Host
+	}
+	if conf.StaticDir != "" {
+		if fi, err := os.Stat(conf.StaticDir); err != nil || !fi.IsDir() {
+			logger.Fatalf("StaticDir %q is not a directory", conf.StaticDir)
+		}
 	}
 	if conf.DataPath == "" {
 		conf.DataPath = filepath.Join(os.Getenv("HOME"), ".playground")
 	}
+	if conf.AutoTLS != nil {
+		if len(conf.AutoTLS.Hosts) == 0 {
+			logger.Fatal("AutoTLS.Hosts must be set")
+		}
+		if conf.TLSCertFile != "" || conf.TLSKeyFile != "" {
+			logger.Fatal("AutoTLS cannot be used with TLSCertFile or TLSKeyFile")
+		}
+		if conf.AutoTLS.CacheDir == "" {
+			conf.AutoTLS.CacheDir = filepath.Join(conf.DataPath, "autocert")
+		}
+		if conf.AutoTLS.HTTPAddress == "" {
+			conf.AutoTLS.HTTPAddress = ":80"
+		}
+	}
 	if conf.GoBinary == "" {
 		conf.GoBinary = "go"
 	}
+	if conf.FmtBinary == "" && (conf.DockerImage != "" || conf.MicroVM != nil) {
+		conf.FmtBinary = "gofmt" // Part of every Go toolchain
+	}
 	if conf.FmtBinary == "" {
 		// Use goimports if available, otherwise fall back to gofmt.
 		conf.FmtBinary = "goimports"
@@ -173,8 +1011,22 @@ func loadConfig(path string) (conf config, logger *log.Logger, closer func() err
 			cmd.Process.Kill()
 		}
 	}
+	if conf.DockerBinary == "" {
+		conf.DockerBinary = "docker"
+	}
+	if conf.GoplsBinary == "" {
+		if _, err := exec.LookPath("gopls"); err == nil {
+			conf.GoplsBinary = "gopls"
+		}
+	}
+	if conf.DelveBinary == "" {
+		if _, err := exec.LookPath("dlv"); err == nil {
+			conf.DelveBinary = "dlv"
+		}
+	}
 
-	// Print the configuration.
+	// Print the configuration, excluding any secrets.
+	logConf := redactConfig(conf)
 	var b bytes.Buffer
 	enc := json.NewEncoder(&b)
 	enc.SetEscapeHTML(false)
@@ -183,7 +1035,7 @@ func loadConfig(path string) (conf config, logger *log.Logger, closer func() err
 		config
 		BinaryVersion string `json:",omitempty"`
 		BinarySHA256  string `json:",omitempty"`
-	}{conf, version, hash})
+	}{logConf, version, hash})
 	logger.Printf("loaded config:\n%s", b.String())
 
 	// Setup the log output.
@@ -201,40 +1053,123 @@ func loadConfig(path string) (conf config, logger *log.Logger, closer func() err
 		closer = f.Close
 	}
 
-	// Check security settings.
-	hasPass := conf.PasswordSalt != "" || conf.PasswordHash != ""
-	reHex := regexp.MustCompile(`^[0-9a-fA-F]{64}$`) // SHA256 hash in hex
-	if hasPass && !(reHex.MatchString(conf.PasswordSalt) && reHex.MatchString(conf.PasswordHash)) {
-		logger.Fatal("PasswordSalt and PasswordHash must be 32 byte long hex-strings")
-	}
-
 	// Apply environment variables.
 	for k, v := range conf.Environment {
 		os.Setenv(k, v)
 	}
 
+	// Share a persistent build cache across all executors.
+	goCache, err := goCacheDir(conf)
+	if err != nil {
+		logger.Fatalf("invalid GoCache: %v", err)
+	}
+	conf.GoCache = goCache
+
 	// Create the data directory if necessary.
 	if _, err := os.Stat(conf.DataPath); os.IsNotExist(err) {
 		if err := os.Mkdir(conf.DataPath, 0775); err != nil {
 			logger.Fatalf("unable to create directory: %v", err)
 		}
 	}
+	if goCache != "" {
+		if err := os.MkdirAll(goCache, 0775); err != nil {
+			logger.Fatalf("unable to create directory: %v", err)
+		}
+	}
 
 	return conf, logger, closer
 }
 
+const usage = `Usage:
+	%[1]s [serve] [CONF_FILE]
+		Start the playground server (the default command).
+	%[1]s --check-config [CONF_FILE]
+		Check the configuration and print a report without serving.
+	%[1]s hashpass
+		Prompt for a password and print the hash for PasswordHash.
+	%[1]s export [CONF_FILE]
+		Write all snippets as a JSON list to stdout.
+	%[1]s import [CONF_FILE]
+		Read snippets produced by export (as JSON or zip) from stdin.
+	%[1]s compact [CONF_FILE]
+		Compact the snippet database.
+	%[1]s decrypt-backup [CONF_FILE]
+		Decrypt a backup encrypted with BackupPassphrase from stdin to stdout.
+	%[1]s worker [CONF_FILE]
+		Build and run snippets for the playground at CoordinatorURL.
+	%[1]s run [--server=URL] [--token=TOKEN] [--quiet] FILE
+		Run a Go file (or stdin if "-") on a remote playground server,
+		streaming the output and exiting with the exit code of the program.
+		The server and token default to $PLAYGROUND_SERVER and $PLAYGROUND_TOKEN.
+	%[1]s vm-agent
+		Serve commands within a microVM as its init process (see MicroVM).
+
+The export, import, and compact commands operate directly on the database
+in the DataPath and must not be run while the server is running.
+`
+
 func main() {
-	if len(os.Args) > 2 || (len(os.Args) == 2 && strings.HasPrefix(os.Args[1], "-")) {
-		fmt.Fprintf(os.Stderr, "Usage: %s [CONF_FILE]\n%s\n", os.Args[0], Help)
+	cmd, args := "serve", os.Args[1:]
+	if len(args) > 0 {
+		switch args[0] {
+		case "run":
+			runRemote(args[1:]) // Has its own flags
+			return
+		case "vm-exec":
+			runVMExec(args[1:]) // Run by executors using MicroVM
+			return
+		case "vm-agent":
+			runVMAgent()
+			return
+		case "sandbox-exec":
+			runSandboxExec(args[1:]) // Run by executors using SecurityProfile
+			return
+		}
+	}
+	if len(args) > 0 {
+		switch args[0] {
+		case "serve", "hashpass", "export", "import", "compact", "decrypt-backup", "worker":
+			cmd, args = args[0], args[1:]
+		case "--check-config", "-check-config":
+			cmd, args = "check-config", args[1:]
+		}
+	}
+	maxArgs := 1
+	if cmd == "hashpass" {
+		maxArgs = 0
+	}
+	if len(args) > maxArgs || (len(args) == 1 && strings.HasPrefix(args[0], "-")) {
+		fmt.Fprintf(os.Stderr, usage+"%[2]s\n", os.Args[0], Help)
 		os.Exit(1)
 	}
-
-	// Parse the configuration file.
 	var confPath string
-	if len(os.Args) == 2 {
-		confPath = os.Args[1]
+	if len(args) == 1 {
+		confPath = args[0]
 	}
-	conf, logger, closer := loadConfig(confPath)
+
+	switch cmd {
+	case "serve":
+		runServe(confPath)
+	case "check-config":
+		runCheckConfig(confPath)
+	case "hashpass":
+		runHashPass()
+	case "export":
+		runExport(confPath)
+	case "import":
+		runImport(confPath)
+	case "compact":
+		runCompact(confPath)
+	case "decrypt-backup":
+		runDecryptBackup(confPath)
+	case "worker":
+		runWorker(confPath)
+	}
+}
+
+// runServe starts the playground server and blocks until it is shutdown.
+func runServe(confPath string) {
+	conf, logger, closer := loadConfig(confPath, true)
 	defer closer()
 
 	// Register shutdown hook.
@@ -251,32 +1186,103 @@ func main() {
 	defer logger.Printf("%s shutdown", path.Base(os.Args[0]))
 
 	// Start the server.
-	var pwHash, pwSalt [sha256.Size]byte
-	if conf.PasswordHash != "" || conf.PasswordSalt != "" {
-		hex.Decode(pwHash[:], []byte(conf.PasswordHash))
-		hex.Decode(pwSalt[:], []byte(conf.PasswordSalt))
+	pw, err := parsePasswordHash(conf.PasswordHash, conf.PasswordSalt)
+	if err != nil {
+		logger.Fatalf("invalid password: %v", err)
+	}
+	var gotipInterval time.Duration
+	gotipDir := filepath.Join(conf.DataPath, gotipName)
+	if conf.GoTipInterval != "" {
+		if gotipInterval, err = time.ParseDuration(conf.GoTipInterval); err != nil || gotipInterval <= 0 {
+			logger.Fatalf("invalid GoTipInterval: %q", conf.GoTipInterval)
+		}
+		if conf.GoVersions == nil {
+			conf.GoVersions = make(map[string]string)
+		}
+		conf.GoVersions[gotipName] = gotipBinary(gotipDir)
 	}
-	pg, err := newPlayground(pwHash, pwSalt, conf.DataPath, conf.GoBinary, conf.FmtBinary, conf.GoVersions, logger)
+	exConf := newExecConfig(&conf, logger)
+	defer exConf.vms.Close()
+	if conf.OTLPEndpoint != "" {
+		exConf.tracer = newTracer(conf.OTLPEndpoint, "playground", logger)
+		defer exConf.tracer.Close()
+	}
+	var backupInterval time.Duration
+	if conf.BackupInterval != "" {
+		d, err := time.ParseDuration(conf.BackupInterval)
+		if err != nil {
+			logger.Fatalf("invalid BackupInterval: %v", err)
+		}
+		backupInterval = d
+	}
+	blobTTL := defaultBlobTTL
+	if conf.BlobTTL != "" {
+		d, err := time.ParseDuration(conf.BlobTTL)
+		if err != nil {
+			logger.Fatalf("invalid BlobTTL: %v", err)
+		}
+		blobTTL = d
+	}
+	pg, err := newPlayground(pw, conf.StorageBackend, conf.DataPath, exConf, logger)
 	if err != nil {
 		logger.Fatalf("newPlayground error: %v", err)
 	}
 	defer pg.Close()
-
-	server := &http.Server{
-		Addr:     conf.ServeAddress,
-		Handler:  pg,
-		ErrorLog: log.New(ioutil.Discard, "", 0),
+	pg.basePath = conf.BasePath
+	pg.externalURL = conf.ExternalURL
+	pg.staticDir = conf.StaticDir
+	if conf.Branding != nil {
+		if pg.branding, err = loadBranding(conf.Branding); err != nil {
+			logger.Fatalf("invalid Branding: %v", err)
+		}
 	}
-	defer server.Close()
-	go func() {
+	pg.enablePprof = conf.EnablePprof
+	if pg.trustedProxies, err = parseTrustedProxies(conf.TrustedProxies); err != nil {
+		logger.Fatalf("invalid TrustedProxies: %v", err)
+	}
+	if conf.GitHubToken != "" {
+		pg.gist = newGistClient(conf.GitHubToken)
+	}
+	if conf.GoplsBinary != "" {
+		pg.gopls = newGopls(conf.GoplsBinary)
+	}
+	if conf.OIDCIssuer != "" {
+		if conf.OIDCClientID == "" || conf.OIDCClientSecret == "" || len(conf.OIDCAllowedEmails) == 0 {
+			logger.Fatal("OIDCClientID, OIDCClientSecret, and OIDCAllowedEmails must be set with OIDCIssuer")
+		}
+		pg.oidc = newOIDCProvider(conf.OIDCIssuer, conf.OIDCClientID, conf.OIDCClientSecret, conf.OIDCAllowedEmails)
+	}
+	pg.bs.maxSize = conf.MaxBlobStoreSize
+	pg.bs.ttl = blobTTL
+	if conf.BackupRetention != 0 {
+		pg.backupKeep = conf.BackupRetention
+	}
+	for _, tc := range conf.BackupTargets {
+		t, err := newBackupTarget(tc)
+		if err != nil {
+			logger.Fatalf("invalid BackupTargets: %v", err)
+		}
+		pg.backupTargets = append(pg.backupTargets, t)
+	}
+	pg.backupPassphrase = conf.BackupPassphrase
+	for _, h := range conf.Webhooks {
+		if err := checkWebhook(h); err != nil {
+			logger.Fatalf("invalid Webhooks: %v", err)
+		}
+	}
+	pg.webhooks = conf.Webhooks
+	pg.workerToken = conf.WorkerToken
+	if pg.templates, err = loadTemplates(filepath.Join(conf.DataPath, templatesDir), conf.Templates); err != nil {
+		logger.Fatalf("invalid Templates: %v", err)
+	}
+	pg.StartBackups(backupInterval)
+	pg.StartBlobExpiry()
+	pg.StartGoTip(gotipDir, gotipInterval)
+
+	// serve repeatedly calls listen until the server is shutdown.
+	serve := func(listen func() error) {
 		for {
-			var err error
-			if conf.TLSCertFile != "" || conf.TLSKeyFile != "" {
-				err = server.ListenAndServeTLS(conf.TLSCertFile, conf.TLSKeyFile)
-			} else {
-				err = server.ListenAndServe()
-			}
-			if err != nil {
+			if err := listen(); err != nil {
 				select {
 				case <-ctx.Done(): // Ignore error when closing
 				default:
@@ -285,6 +1291,187 @@ func main() {
 			}
 			time.Sleep(30 * time.Second)
 		}
-	}()
+	}
+
+	var m *autocert.Manager
+	if conf.AutoTLS != nil {
+		m = &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(conf.AutoTLS.Hosts...),
+			Cache:      autocert.DirCache(conf.AutoTLS.CacheDir),
+			Email:      conf.AutoTLS.Email,
+		}
+
+		// Serve the HTTP-01 challenge and redirect everything else to HTTPS.
+		challengeServer := &http.Server{
+			Addr:     conf.AutoTLS.HTTPAddress,
+			Handler:  m.HTTPHandler(nil),
+			ErrorLog: log.New(ioutil.Discard, "", 0),
+		}
+		defer challengeServer.Close()
+		go serve(challengeServer.ListenAndServe)
+	}
+
+	for _, lc := range conf.ServeAddress {
+		lc := lc
+		if lc.plain {
+			// Addresses given as strings use the top-level TLS settings.
+			lc.TLSCertFile, lc.TLSKeyFile, lc.AutoTLS = conf.TLSCertFile, conf.TLSKeyFile, conf.AutoTLS != nil
+		}
+		server := &http.Server{
+			Addr:     lc.Address,
+			Handler:  listenerHandler(lc, conf.H2C, pg),
+			ErrorLog: log.New(ioutil.Discard, "", 0),
+		}
+		defer server.Close()
+		switch {
+		case lc.Redirect != "":
+			go serve(server.ListenAndServe)
+		case lc.AutoTLS:
+			server.TLSConfig = m.TLSConfig()
+			go serve(func() error { return server.ListenAndServeTLS("", "") })
+		case lc.TLSCertFile != "" || lc.TLSKeyFile != "":
+			go serve(func() error { return server.ListenAndServeTLS(lc.TLSCertFile, lc.TLSKeyFile) })
+		default:
+			go serve(server.ListenAndServe)
+		}
+	}
 	<-ctx.Done()
 }
+
+// redactConfig returns a copy of conf with the secrets replaced.
+func redactConfig(conf config) config {
+	redact := func(s *string) {
+		if *s != "" {
+			*s = "REDACTED"
+		}
+	}
+	redact(&conf.GitHubToken)
+	redact(&conf.OIDCClientSecret)
+	redact(&conf.WorkerToken)
+	redact(&conf.BackupPassphrase)
+	conf.BackupTargets = append([]backupTargetConfig(nil), conf.BackupTargets...)
+	for i := range conf.BackupTargets {
+		redact(&conf.BackupTargets[i].SecretAccessKey)
+	}
+	conf.Webhooks = append([]webhookConfig(nil), conf.Webhooks...)
+	for i := range conf.Webhooks {
+		redact(&conf.Webhooks[i].Secret)
+	}
+	return conf
+}
+
+// newExecConfig returns the executor settings of the configuration,
+// adding any discovered Go versions to conf.GoVersions.
+func newExecConfig(conf *config, logger *log.Logger) execConfig {
+	if !conf.DisableGoDiscovery && conf.DockerImage == "" && conf.MicroVM == nil {
+		var found []string
+		for v, bin := range discoverGoVersions(goCandidates(goInstallGlobs, os.Getenv("HOME"), os.Getenv("PATH"))) {
+			if _, ok := conf.GoVersions[v]; !ok {
+				if conf.GoVersions == nil {
+					conf.GoVersions = make(map[string]string)
+				}
+				conf.GoVersions[v] = bin
+				found = append(found, v)
+			}
+		}
+		sort.Strings(found)
+		logger.Printf("discovered Go versions: %v", found)
+	}
+	exConf := execConfig{
+		gc:      conf.GoBinary,
+		fmt:     conf.FmtBinary,
+		gcs:     conf.GoVersions,
+		dlv:     conf.DelveBinary,
+		linters: conf.Linters,
+		cache:   newRunCache(conf.RunCacheSize),
+		queue:   newRunQueue(conf.MaxConcurrentRuns),
+
+		generators:     conf.Generators,
+		modules:        conf.EnableModules,
+		allowedModules: conf.AllowedModules,
+		disableCGO:     conf.DisableCGO,
+		maxOutput:      conf.MaxOutputSize,
+		maxDisk:        conf.MaxDiskUsage,
+		maxReport:      conf.MaxReportSize,
+		maxReports:     conf.MaxTotalReportSize,
+		stopSignal:     syscall.SIGINT,
+		stopGrace:      defaultStopGrace,
+	}
+	if conf.EnablePprofUI {
+		exConf.pprofUI = newPprofServers()
+	}
+	for _, kv := range [][2]string{{"GOCACHE", conf.GoCache}, {"GOPROXY", conf.GoProxy}, {"GOSUMDB", conf.GoSumDB}, {"GOPRIVATE", conf.GoPrivate}} {
+		if kv[1] != "" {
+			exConf.goEnv = append(exConf.goEnv, kv[0]+"="+kv[1])
+		}
+	}
+	if conf.StopSignal != "" {
+		sig, ok := signals[conf.StopSignal]
+		if !ok {
+			logger.Fatalf("invalid StopSignal: %q", conf.StopSignal)
+		}
+		exConf.stopSignal = sig
+	}
+	if conf.StopGracePeriod != "" {
+		d, err := time.ParseDuration(conf.StopGracePeriod)
+		if err != nil || d < 0 {
+			logger.Fatalf("invalid StopGracePeriod: %q", conf.StopGracePeriod)
+		}
+		exConf.stopGrace = d
+	}
+	if conf.RunTimeout != "" {
+		d, err := time.ParseDuration(conf.RunTimeout)
+		if err != nil || d < 0 {
+			logger.Fatalf("invalid RunTimeout: %q", conf.RunTimeout)
+		}
+		exConf.runTimeout = d
+	}
+	exConf.security = conf.SecurityProfile
+	exConf.disableNetwork = conf.DisableNetwork
+	exConf.niceness = conf.RunNiceness
+	exConf.cpuLimit = conf.RunCPULimit
+	exConf.cgroupDir = conf.CgroupDir
+	if conf.DockerImage != "" || conf.MicroVM != nil {
+		exConf.dockerImage = conf.DockerImage
+		exConf.docker = conf.DockerBinary
+		exConf.dockerArgs = conf.DockerArgs
+		if conf.MicroVM != nil {
+			exConf.vms = newVMPool(*conf.MicroVM, logger)
+
+			// GoCache is a path on the host, which VMs cannot access.
+			var goEnv []string
+			for _, kv := range exConf.goEnv {
+				if !strings.HasPrefix(kv, "GOCACHE=") {
+					goEnv = append(goEnv, kv)
+				}
+			}
+			exConf.goEnv = goEnv
+		}
+		// The debugger listens on a port within the sandbox and
+		// the generators are binaries of the host.
+		exConf.dlv = ""
+		exConf.generators = nil
+	}
+	return exConf
+}
+
+// listenerHandler returns the handler used to serve h on a listener.
+func listenerHandler(lc listenerConfig, enableH2C bool, h http.Handler) http.Handler {
+	if lc.Redirect != "" {
+		h = redirectHandler(lc.Redirect)
+	}
+	useTLS := lc.AutoTLS || lc.TLSCertFile != "" || lc.TLSKeyFile != ""
+	if enableH2C && !useTLS {
+		h = h2c.NewHandler(h, &http2.Server{})
+	}
+	return h
+}
+
+// redirectHandler redirects all requests to the same path relative to base.
+func redirectHandler(base string) http.Handler {
+	base = strings.TrimSuffix(base, "/")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, base+r.URL.RequestURI(), http.StatusMovedPermanently)
+	})
+}
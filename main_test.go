@@ -0,0 +1,355 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/http2"
+)
+
+func TestServeAddresses(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    serveAddresses
+		wantErr bool
+	}{{
+		in:   `"localhost:8080"`,
+		want: serveAddresses{{Address: "localhost:8080", plain: true}},
+	}, {
+		in: `["localhost:8080", {"Address": ":443", "AutoTLS": true}, {"Address": ":80", "Redirect": "https://example.com"}]`,
+		want: serveAddresses{
+			{Address: "localhost:8080", plain: true},
+			{Address: ":443", AutoTLS: true},
+			{Address: ":80", Redirect: "https://example.com"},
+		},
+	}, {
+		in:   `[{"Address": ":8443", "TLSCertFile": "cert.pem", "TLSKeyFile": "key.pem"}]`,
+		want: serveAddresses{{Address: ":8443", TLSCertFile: "cert.pem", TLSKeyFile: "key.pem"}},
+	}, {
+		in:      `8080`,
+		wantErr: true,
+	}, {
+		in:      `[8080]`,
+		wantErr: true,
+	}}
+
+	for _, tt := range tests {
+		var got serveAddresses
+		err := json.Unmarshal([]byte(tt.in), &got)
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, want error %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("json.Unmarshal(%s):\ngot  %+v\nwant %+v", tt.in, got, tt.want)
+		}
+
+		// Marshaling must preserve the form of each entry.
+		b, err := json.Marshal(got)
+		if err != nil {
+			t.Errorf("json.Marshal error: %v", err)
+			continue
+		}
+		var got2 serveAddresses
+		if err := json.Unmarshal(b, &got2); err != nil || !reflect.DeepEqual(got2, got) {
+			t.Errorf("round-trip mismatch: %s", b)
+		}
+	}
+}
+
+func TestConfigToJSON(t *testing.T) {
+	want := config{
+		ServeAddress:    serveAddresses{{Address: "localhost:8080", plain: true}, {Address: ":443", AutoTLS: true}},
+		H2C:             true,
+		AutoTLS:         &autoTLSConfig{Hosts: []string{"play.example.com"}},
+		GoVersions:      map[string]string{"go1.20": "/usr/local/go1.20/bin/go"},
+		BackupRetention: 3,
+	}
+	tests := []struct {
+		ext, in string
+	}{{
+		ext: ".json",
+		in: `{
+			// Comments are allowed.
+			"ServeAddress": ["localhost:8080", {"Address": ":443", "AutoTLS": true}],
+			"H2C": true,
+			"AutoTLS": {"Hosts": ["play.example.com"]},
+			"GoVersions": {"go1.20": "/usr/local/go1.20/bin/go"},
+			"BackupRetention": 3,
+		}`,
+	}, {
+		ext: ".yaml",
+		in: strings.Join([]string{
+			"# Comments are allowed.",
+			"ServeAddress:",
+			"  - localhost:8080",
+			`  - {Address: ":443", AutoTLS: true}`,
+			"H2C: true",
+			"AutoTLS:",
+			"  Hosts: [play.example.com]",
+			"GoVersions:",
+			"  go1.20: /usr/local/go1.20/bin/go",
+			"BackupRetention: 3",
+		}, "\n"),
+	}, {
+		ext: ".TOML",
+		in: strings.Join([]string{
+			"# Comments are allowed.",
+			`ServeAddress = ["localhost:8080", {Address = ":443", AutoTLS = true}]`,
+			"H2C = true",
+			"BackupRetention = 3",
+			"[AutoTLS]",
+			`Hosts = ["play.example.com"]`,
+			"[GoVersions]",
+			`"go1.20" = "/usr/local/go1.20/bin/go"`,
+		}, "\n"),
+	}}
+
+	for _, tt := range tests {
+		b, err := configToJSON([]byte(tt.in), tt.ext)
+		if err != nil {
+			t.Errorf("configToJSON(%s) error: %v", tt.ext, err)
+			continue
+		}
+		var got config
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Errorf("json.Unmarshal(%s) error: %v", b, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("configToJSON(%s):\ngot  %+v\nwant %+v", tt.ext, got, want)
+		}
+	}
+
+	if _, err := configToJSON([]byte("H2C: [true"), ".yml"); err == nil {
+		t.Errorf("configToJSON succeeded on invalid YAML, want error")
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"ServeAddress", "SERVE_ADDRESS"},
+		{"H2C", "H2C"},
+		{"TLSCertFile", "TLS_CERT_FILE"},
+		{"OIDCClientID", "OIDC_CLIENT_ID"},
+		{"OTLPEndpoint", "OTLP_ENDPOINT"},
+		{"GoVersions", "GO_VERSIONS"},
+	}
+	for _, tt := range tests {
+		if got := envName(tt.in); got != tt.want {
+			t.Errorf("envName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestApplyEnvOverrides(t *testing.T) {
+	tests := []struct {
+		env     map[string]string
+		in      config
+		want    config
+		wantErr bool
+	}{{
+		env:  map[string]string{},
+		in:   config{LogFile: "log.txt"},
+		want: config{LogFile: "log.txt"},
+	}, {
+		env: map[string]string{
+			"PLAYGROUND_SERVE_ADDRESS": ":8080",
+			"PLAYGROUND_H2C":           "true",
+			"PLAYGROUND_LOG_FILE":      "other.txt",
+			"PLAYGROUND_GITHUB_TOKEN":  "secret",
+		},
+		in: config{LogFile: "log.txt", DataPath: "data"},
+		want: config{
+			ServeAddress: serveAddresses{{Address: ":8080", plain: true}},
+			H2C:          true,
+			LogFile:      "other.txt",
+			DataPath:     "data",
+			GitHubToken:  "secret",
+		},
+	}, {
+		env: map[string]string{
+			"PLAYGROUND_SERVE_ADDRESS":       `["localhost:8080", {"Address": ":443", "AutoTLS": true}]`,
+			"PLAYGROUND_TRUSTED_PROXIES":     `["10.0.0.0/8"]`,
+			"PLAYGROUND_MAX_CONCURRENT_RUNS": "4",
+			"PLAYGROUND_ENVIRONMENT":         `{"GOFLAGS": "-mod=mod"}`,
+		},
+		want: config{
+			ServeAddress:      serveAddresses{{Address: "localhost:8080", plain: true}, {Address: ":443", AutoTLS: true}},
+			TrustedProxies:    []string{"10.0.0.0/8"},
+			MaxConcurrentRuns: 4,
+			Environment:       map[string]string{"GOFLAGS": "-mod=mod"},
+		},
+	}, {
+		env:     map[string]string{"PLAYGROUND_MAX_CONCURRENT_RUNS": "four"},
+		wantErr: true,
+	}}
+
+	for _, tt := range tests {
+		got := tt.in
+		err := applyEnvOverrides(&got, func(k string) (string, bool) {
+			v, ok := tt.env[k]
+			return v, ok
+		})
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("applyEnvOverrides(%v) error = %v, want error %v", tt.env, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("applyEnvOverrides(%v):\ngot  %+v\nwant %+v", tt.env, got, tt.want)
+		}
+	}
+}
+
+func TestGoCacheDir(t *testing.T) {
+	tests := []struct {
+		in      config
+		want    string
+		wantErr bool
+	}{{
+		in:   config{DataPath: "/data"},
+		want: "/data/gocache",
+	}, {
+		in:   config{DataPath: "/data", GoCache: "/cache"},
+		want: "/cache",
+	}, {
+		in:   config{DataPath: "/data", Environment: map[string]string{"GOCACHE": "/env"}},
+		want: "",
+	}, {
+		in:      config{DataPath: "/data", GoCache: "/cache", Environment: map[string]string{"GOCACHE": "/env"}},
+		wantErr: true,
+	}}
+
+	for _, tt := range tests {
+		got, err := goCacheDir(tt.in)
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("goCacheDir(%+v) error = %v, want error %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("goCacheDir(%+v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNewExecConfigGoEnv(t *testing.T) {
+	logger := log.New(ioutil.Discard, "", 0)
+	base := config{GoCache: "/data/gocache", GoProxy: "https://proxy.golang.org"}
+
+	// Containers mount the build cache of the host, while VMs cannot.
+	dconf := base
+	dconf.DockerImage = "golang:test"
+	if got, want := newExecConfig(&dconf, logger).goEnv, []string{"GOCACHE=/data/gocache", "GOPROXY=https://proxy.golang.org"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("goEnv with DockerImage = %q, want %q", got, want)
+	}
+	vconf := base
+	vconf.MicroVM = &microVMConfig{FirecrackerBinary: "/nonexistent/firecracker", PoolSize: 1}
+	exConf := newExecConfig(&vconf, logger)
+	defer exConf.vms.Close()
+	if got, want := exConf.goEnv, []string{"GOPROXY=https://proxy.golang.org"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("goEnv with MicroVM = %q, want %q", got, want)
+	}
+}
+
+func TestRedactConfig(t *testing.T) {
+	conf := config{
+		GitHubToken:      "github-token",
+		OIDCClientSecret: "oidc-secret",
+		WorkerToken:      "worker-token",
+		BackupPassphrase: "backup-passphrase",
+		BackupTargets:    []backupTargetConfig{{URL: "s3://bucket/backups", SecretAccessKey: "s3-secret"}},
+		Webhooks:         []webhookConfig{{URL: "https://example.com/hook", Secret: "hmac-key"}},
+	}
+	b, _ := json.Marshal(redactConfig(conf))
+	for _, secret := range []string{"github-token", "oidc-secret", "worker-token", "backup-passphrase", "s3-secret", "hmac-key"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("redacted config contains %q: %s", secret, b)
+		}
+	}
+	if conf.WorkerToken != "worker-token" || conf.BackupTargets[0].SecretAccessKey != "s3-secret" || conf.Webhooks[0].Secret != "hmac-key" {
+		t.Errorf("redactConfig modified the original config")
+	}
+}
+
+func TestRedirectHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "http://example.com/snippets?limit=5", nil)
+	redirectHandler("https://example.com/").ServeHTTP(w, r)
+	if got, want := w.Code, http.StatusMovedPermanently; got != want {
+		t.Errorf("status code = %d, want %d", got, want)
+	}
+	if got, want := w.Header().Get("Location"), "https://example.com/snippets?limit=5"; got != want {
+		t.Errorf("Location = %q, want %q", got, want)
+	}
+}
+
+func TestListenerHandlerH2C(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "HTTP/%d", r.ProtoMajor)
+	})
+	tests := []struct {
+		lc        listenerConfig
+		enableH2C bool
+		wantProto string // Empty if the HTTP/2 request must fail
+	}{
+		{lc: listenerConfig{Address: "localhost:0"}, enableH2C: true, wantProto: "HTTP/2"},
+		{lc: listenerConfig{Address: "localhost:0"}, enableH2C: false},
+		{lc: listenerConfig{Address: "localhost:0", TLSCertFile: "cert.pem", TLSKeyFile: "key.pem"}, enableH2C: true},
+	}
+	for _, tt := range tests {
+		srv := httptest.NewServer(listenerHandler(tt.lc, tt.enableH2C, h))
+		defer srv.Close()
+
+		// Use HTTP/2 with prior knowledge over a cleartext connection.
+		cln := &http.Client{Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+				return net.Dial(network, addr)
+			},
+		}}
+		resp, err := cln.Get(srv.URL)
+		if err != nil {
+			if tt.wantProto != "" {
+				t.Errorf("client.Get error: %v", err)
+			}
+			continue
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if got := string(b); got != tt.wantProto {
+			t.Errorf("protocol = %q, want %q", got, tt.wantProto)
+		}
+
+		// HTTP/1.1 requests must continue to work.
+		resp, err = http.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("http.Get error: %v", err)
+		}
+		b, _ = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if got, want := string(b), "HTTP/1"; got != want {
+			t.Errorf("protocol = %q, want %q", got, want)
+		}
+	}
+}
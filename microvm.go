@@ -0,0 +1,667 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// With MicroVM configured, every command in the sandbox (e.g., go build and
+// the program itself) runs in its own Firecracker microVM instead of on the
+// host, which isolates untrusted code by a hypervisor rather than by the
+// kernel that containers share with the host. Since booting takes a while,
+// a pool of VMs is booted ahead of time and each VM is discarded after
+// running a single command.
+//
+// The root filesystem of the VMs has the Go toolchains and runs the vm-agent
+// command of this binary as its init process, which serves commands on a
+// vsock port. For each command, the executor runs the vm-exec command of this
+// binary as a proxy, which connects to the agent of a VM, copies the temporary
+// directory to the same path within the VM, runs the command there, relays
+// its output and any signals, copies the directory back, and exits like the
+// command did. Thus, commands in VMs are handled like any other process.
+//
+// The proxy first sends a vmRequest to the agent and then a vmSignal for
+// every signal that it receives. The agent replies with vmOutput messages,
+// the last of which has the exit status. All messages are JSON.
+
+// vmAgentPort is the vsock port that the agent listens on.
+const vmAgentPort = 1024
+
+// maxVMFilesSize is the maximum number of bytes of files copied back from
+// a VM after running a command.
+const maxVMFilesSize = 256 << 20
+
+// vmRetryPeriod is how long to wait before booting another VM after a failure.
+const vmRetryPeriod = 5 * time.Second
+
+// vmBootTimeout is how long a VM may take until its agent accepts connections.
+const vmBootTimeout = 10 * time.Second
+
+type microVMConfig struct {
+	FirecrackerBinary string `json:",omitempty"`
+	KernelImage       string `json:",omitempty"`
+	KernelArgs        string `json:",omitempty"`
+	RootFS            string `json:",omitempty"`
+	VCPUs             int    `json:",omitempty"`
+	MemoryMB          int    `json:",omitempty"`
+	PoolSize          int    `json:",omitempty"`
+}
+
+type vmRequest struct {
+	Dir   string   // Working directory of the command
+	Args  []string // Command and its arguments
+	Env   []string // Additional environment of the command
+	Stdin []byte   // Standard input of the command
+	Files []byte   // Tarball of the files in Dir
+}
+
+type vmSignal struct {
+	Signal syscall.Signal // Sent to the running command
+}
+
+type vmOutput struct {
+	Stdout []byte  `json:",omitempty"`
+	Stderr []byte  `json:",omitempty"`
+	Exit   *vmExit `json:",omitempty"` // Set in the last message
+}
+
+type vmExit struct {
+	Code   int            // -1 if terminated by a signal
+	Signal syscall.Signal `json:",omitempty"` // Terminating signal, if any
+	Error  string         `json:",omitempty"` // Set if the command did not start
+	Files  []byte         // Tarball of the files in Dir afterwards
+}
+
+// vmPool is a pool of booted microVMs.
+type vmPool struct {
+	conf   microVMConfig
+	logger *log.Logger
+	ready  chan *microVM
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu    sync.Mutex
+	inUse map[string]*microVM // By vsock path
+}
+
+// microVM is a running Firecracker process.
+type microVM struct {
+	cmd   *exec.Cmd
+	dir   string // Directory of the sockets
+	vsock string // Unix socket of the vsock device
+}
+
+// newVMPool starts booting conf.PoolSize VMs, which are replaced with newly
+// booted ones as they are taken from the pool. Defaults are applied to conf.
+func newVMPool(conf microVMConfig, logger *log.Logger) *vmPool {
+	if conf.FirecrackerBinary == "" {
+		conf.FirecrackerBinary = "firecracker"
+	}
+	if conf.KernelArgs == "" {
+		conf.KernelArgs = "console=ttyS0 reboot=k panic=1 pci=off init=/usr/local/bin/playground -- vm-agent"
+	}
+	if conf.VCPUs <= 0 {
+		conf.VCPUs = 1
+	}
+	if conf.MemoryMB <= 0 {
+		conf.MemoryMB = 512
+	}
+	if conf.PoolSize <= 0 {
+		conf.PoolSize = 2
+	}
+	p := &vmPool{conf: conf, logger: logger, ready: make(chan *microVM), inUse: make(map[string]*microVM)}
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	for i := 0; i < conf.PoolSize; i++ {
+		p.wg.Add(1)
+		go p.fill()
+	}
+	return p
+}
+
+// fill keeps a single booted VM ready until the pool is closed.
+func (p *vmPool) fill() {
+	defer p.wg.Done()
+	for {
+		vm, err := p.boot()
+		if err != nil {
+			p.logger.Printf("microVM boot error: %v", err)
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-time.After(vmRetryPeriod):
+			}
+			continue
+		}
+		select {
+		case p.ready <- vm:
+		case <-p.ctx.Done():
+			vm.Close()
+			return
+		}
+	}
+}
+
+// Get takes a booted VM from the pool, waiting until one is ready.
+// The VM must be released with Release once the command ran.
+func (p *vmPool) Get(ctx context.Context) (*microVM, error) {
+	select {
+	case vm := <-p.ready:
+		p.mu.Lock()
+		p.inUse[vm.vsock] = vm
+		p.mu.Unlock()
+		return vm, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-p.ctx.Done():
+		return nil, errors.New("microVM pool is closed")
+	}
+}
+
+// Release shuts down the VM with the vsock path taken from the pool.
+func (p *vmPool) Release(vsock string) {
+	p.mu.Lock()
+	vm := p.inUse[vsock]
+	delete(p.inUse, vsock)
+	p.mu.Unlock()
+	if vm != nil {
+		vm.Close()
+	}
+}
+
+// Close shuts down all VMs. It is a no-op on a nil pool.
+func (p *vmPool) Close() {
+	if p == nil {
+		return
+	}
+	p.cancel()
+	p.wg.Wait()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for k, vm := range p.inUse {
+		vm.Close()
+		delete(p.inUse, k)
+	}
+}
+
+// boot starts a Firecracker process, configures the VM over its API socket,
+// and waits until the agent within the VM accepts connections.
+func (p *vmPool) boot() (*microVM, error) {
+	dir, err := ioutil.TempDir("", "microvm")
+	if err != nil {
+		return nil, err
+	}
+	vm := &microVM{dir: dir, vsock: filepath.Join(dir, "vsock.sock")}
+	apiSock := filepath.Join(dir, "api.sock")
+	vm.cmd = exec.Command(p.conf.FirecrackerBinary, "--api-sock", apiSock)
+	if err := vm.cmd.Start(); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	exited := make(chan struct{})
+	go func() {
+		vm.cmd.Wait()
+		close(exited)
+	}()
+	fail := func(err error) (*microVM, error) {
+		vm.Close()
+		return nil, err
+	}
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", apiSock)
+		},
+	}}
+	defer client.CloseIdleConnections()
+	put := func(path string, v interface{}) error {
+		b, _ := json.Marshal(v)
+		req, err := http.NewRequest(http.MethodPut, "http://localhost"+path, bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<10))
+			return fmt.Errorf("%s: %s: %s", path, resp.Status, bytes.TrimSpace(b))
+		}
+		return nil
+	}
+	deadline := time.Now().Add(vmBootTimeout)
+	for {
+		if _, err := os.Stat(apiSock); err == nil {
+			break
+		}
+		select {
+		case <-exited:
+			return fail(errors.New("firecracker exited"))
+		case <-time.After(10 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			return fail(errors.New("firecracker API socket not created"))
+		}
+	}
+	steps := []struct {
+		path string
+		v    interface{}
+	}{
+		{"/machine-config", map[string]interface{}{"vcpu_count": p.conf.VCPUs, "mem_size_mib": p.conf.MemoryMB}},
+		{"/boot-source", map[string]interface{}{"kernel_image_path": p.conf.KernelImage, "boot_args": p.conf.KernelArgs}},
+		{"/drives/rootfs", map[string]interface{}{"drive_id": "rootfs", "path_on_host": p.conf.RootFS, "is_root_device": true, "is_read_only": true}},
+		{"/vsock", map[string]interface{}{"guest_cid": 3, "uds_path": vm.vsock}},
+		{"/actions", map[string]interface{}{"action_type": "InstanceStart"}},
+	}
+	for _, s := range steps {
+		if err := put(s.path, s.v); err != nil {
+			return fail(err)
+		}
+	}
+	for {
+		conn, err := dialVM(vm.vsock)
+		if err == nil {
+			conn.Close()
+			return vm, nil
+		}
+		select {
+		case <-exited:
+			return fail(errors.New("firecracker exited"))
+		case <-p.ctx.Done():
+			return fail(p.ctx.Err())
+		case <-time.After(20 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			return fail(fmt.Errorf("agent not reachable: %v", err))
+		}
+	}
+}
+
+// Close kills the Firecracker process and removes its sockets.
+func (vm *microVM) Close() {
+	vm.cmd.Process.Kill()
+	os.RemoveAll(vm.dir)
+}
+
+// dialVM connects to the agent through the host side of the vsock device
+// of a VM at the Unix socket path.
+func dialVM(vsock string) (net.Conn, error) {
+	conn, err := net.DialTimeout("unix", vsock, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Now().Add(time.Second))
+	fmt.Fprintf(conn, "CONNECT %d\n", vmAgentPort)
+	// Read the reply byte by byte so that nothing after it is consumed.
+	var line []byte
+	for b := make([]byte, 1); len(line) < 64; {
+		if _, err := conn.Read(b); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if b[0] == '\n' {
+			break
+		}
+		line = append(line, b[0])
+	}
+	if !bytes.HasPrefix(line, []byte("OK ")) {
+		conn.Close()
+		return nil, fmt.Errorf("unexpected vsock reply: %q", line)
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// vmCommand returns the arguments that run args in a VM from the pool through
+// the vm-exec proxy, with the variables named in env passed through from the
+// environment of the proxy. If no VM can be taken (e.g., the task is stopped
+// while waiting), the proxy fails.
+func (ex *executor) vmCommand(args, env []string) []string {
+	var vsock string
+	if vm, err := ex.vms.Get(ex.ctx); err == nil {
+		vsock = vm.vsock
+	}
+	self, _ := os.Executable()
+	vargs := []string{self, "vm-exec", "--socket=" + vsock, "--dir=" + ex.tmpDir}
+	seen := make(map[string]bool)
+	for _, kv := range env {
+		k := strings.SplitN(kv, "=", 2)[0]
+		if !seen[k] {
+			seen[k] = true
+			vargs = append(vargs, "--env="+k)
+		}
+	}
+	return append(append(vargs, "--"), args...)
+}
+
+// releaseVM shuts down the VM that cmd ran in, if any.
+func (ex *executor) releaseVM(cmd *exec.Cmd) {
+	if ex.vms != nil && len(cmd.Args) > 2 && cmd.Args[1] == "vm-exec" {
+		ex.vms.Release(strings.TrimPrefix(cmd.Args[2], "--socket="))
+	}
+}
+
+// runVMExec is the vm-exec command, which runs a command in a VM and exits
+// like the command did. Signals are forwarded to the command.
+func runVMExec(args []string) {
+	fs := flag.NewFlagSet("vm-exec", flag.ExitOnError)
+	socket := fs.String("socket", "", "vsock Unix socket of the VM")
+	dir := fs.String("dir", "", "working directory, which is copied to and from the VM")
+	var env []string
+	fs.Func("env", "name of an environment variable passed to the command", func(k string) error {
+		env = append(env, k+"="+os.Getenv(k))
+		return nil
+	})
+	fs.Parse(args)
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGUSR1, syscall.SIGUSR2)
+	exit, err := vmExec(*socket, *dir, env, fs.Args(), os.Stdin, os.Stdout, os.Stderr, sigc)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "microVM error: %v\n", err)
+		os.Exit(1)
+	}
+	if exit.Signal != 0 {
+		// Terminate by the same signal so that it is reported as such.
+		signal.Reset(exit.Signal)
+		syscall.Kill(os.Getpid(), exit.Signal)
+		time.Sleep(time.Second)
+		os.Exit(128 + int(exit.Signal))
+	}
+	os.Exit(exit.Code)
+}
+
+// vmExec runs args in the VM with the vsock Unix socket, copying dir to the
+// VM beforehand and back from it afterwards. The command reads all of stdin
+// as its input, its output is written to stdout and stderr, and signals
+// received from sigc are sent to it.
+func vmExec(vsock, dir string, env, args []string, stdin io.Reader, stdout, stderr io.Writer, sigc <-chan os.Signal) (*vmExit, error) {
+	if vsock == "" {
+		return nil, errors.New("no VM available")
+	}
+	if len(args) == 0 {
+		return nil, errors.New("no command")
+	}
+	files, err := tarDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	in, err := ioutil.ReadAll(stdin)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := dialVM(vsock)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	enc := json.NewEncoder(conn)
+	if err := enc.Encode(vmRequest{Dir: dir, Args: args, Env: env, Stdin: in, Files: files}); err != nil {
+		return nil, err
+	}
+
+	outs := make(chan vmOutput)
+	errc := make(chan error, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		dec := json.NewDecoder(conn)
+		for {
+			var out vmOutput
+			if err := dec.Decode(&out); err != nil {
+				errc <- err
+				return
+			}
+			select {
+			case outs <- out:
+			case <-done:
+				return
+			}
+		}
+	}()
+	for {
+		select {
+		case sig := <-sigc:
+			if s, ok := sig.(syscall.Signal); ok {
+				enc.Encode(vmSignal{Signal: s})
+			}
+		case err := <-errc:
+			return nil, fmt.Errorf("connection lost: %v", err)
+		case out := <-outs:
+			stdout.Write(out.Stdout)
+			stderr.Write(out.Stderr)
+			if out.Exit == nil {
+				continue
+			}
+			if out.Exit.Error != "" {
+				return nil, errors.New(out.Exit.Error)
+			}
+			if err := untarDir(dir, out.Exit.Files); err != nil {
+				return nil, err
+			}
+			return out.Exit, nil
+		}
+	}
+}
+
+// runVMAgent is the vm-agent command, which is the init process of a VM.
+// It serves commands on the vsock port until the VM is shut down.
+func runVMAgent() {
+	if os.Getpid() == 1 {
+		if err := setupVMGuest(); err != nil {
+			fmt.Fprintf(os.Stderr, "vm-agent: %v\n", err)
+		}
+	}
+	accept, err := listenVsock(vmAgentPort)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "vm-agent: %v\n", err)
+		os.Exit(1)
+	}
+	// Commands do not run as root so that they cannot tamper with the agent.
+	cred := &syscall.Credential{Uid: 65534, Gid: 65534} // nobody
+	for {
+		conn, err := accept()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "vm-agent: %v\n", err)
+			os.Exit(1)
+		}
+		go func() {
+			defer conn.Close()
+			serveVMAgent(conn, "", cred)
+		}()
+	}
+}
+
+// serveVMAgent runs the command requested over conn with the credentials
+// of cred, if non-nil. The working directory is relative to root.
+// Connections without any request (e.g., probes during boot) are ignored.
+func serveVMAgent(conn io.ReadWriter, root string, cred *syscall.Credential) error {
+	dec := json.NewDecoder(conn)
+	var req vmRequest
+	if err := dec.Decode(&req); err != nil {
+		return err
+	}
+	var mu sync.Mutex
+	enc := json.NewEncoder(conn)
+	send := func(out vmOutput) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return enc.Encode(out)
+	}
+	fail := func(err error) error {
+		send(vmOutput{Exit: &vmExit{Code: -1, Error: err.Error()}})
+		return err
+	}
+
+	dir := filepath.Join(root, req.Dir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fail(err)
+	}
+	if err := untarDir(dir, req.Files); err != nil {
+		return fail(err)
+	}
+	if len(req.Args) == 0 {
+		return fail(errors.New("no command"))
+	}
+	cmd := exec.Command(req.Args[0], req.Args[1:]...)
+	cmd.Dir = dir
+	cmd.Env = append(append([]string(nil), os.Environ()...), req.Env...)
+	cmd.Stdin = bytes.NewReader(req.Stdin)
+	cmd.Stdout = writerFunc(func(b []byte) (int, error) {
+		return len(b), send(vmOutput{Stdout: b})
+	})
+	cmd.Stderr = writerFunc(func(b []byte) (int, error) {
+		return len(b), send(vmOutput{Stderr: b})
+	})
+	if cred != nil {
+		if err := chownDir(dir, int(cred.Uid), int(cred.Gid)); err != nil {
+			return fail(err)
+		}
+		cmd.SysProcAttr = &syscall.SysProcAttr{Credential: cred}
+	}
+	if err := cmd.Start(); err != nil {
+		return fail(err)
+	}
+	go func() {
+		for {
+			var s vmSignal
+			if err := dec.Decode(&s); err != nil {
+				cmd.Process.Kill() // The proxy is gone
+				return
+			}
+			cmd.Process.Signal(s.Signal)
+		}
+	}()
+	cmd.Wait()
+
+	exit := &vmExit{Code: cmd.ProcessState.ExitCode()}
+	if ws, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+		exit.Signal = ws.Signal()
+	}
+	files, err := tarDir(dir)
+	if err != nil {
+		return fail(err)
+	}
+	exit.Files = files
+	return send(vmOutput{Exit: exit})
+}
+
+// chownDir changes the owner of dir and all files in it.
+func chownDir(dir string, uid, gid int) error {
+	return filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		return os.Lchown(p, uid, gid)
+	})
+}
+
+// tarDir returns a tarball of the directories and regular files in dir.
+func tarDir(dir string) ([]byte, error) {
+	bb := new(bytes.Buffer)
+	tw := tar.NewWriter(bb)
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil || p == dir {
+			return err
+		}
+		name := filepath.ToSlash(strings.TrimPrefix(p, dir+string(filepath.Separator)))
+		switch {
+		case fi.IsDir():
+			return tw.WriteHeader(&tar.Header{Name: name + "/", Typeflag: tar.TypeDir, Mode: int64(fi.Mode().Perm())})
+		case !fi.Mode().IsRegular():
+			return nil // Skip symbolic links and special files
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: int64(fi.Mode().Perm()), Size: fi.Size()}); err != nil {
+			return err
+		}
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return bb.Bytes(), nil
+}
+
+// untarDir extracts a tarball produced by tarDir into dir,
+// replacing any existing files.
+func untarDir(dir string, b []byte) error {
+	tr := tar.NewReader(bytes.NewReader(b))
+	var size int64
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := path.Clean(h.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("invalid file name: %q", h.Name)
+		}
+		if size += h.Size; size > maxVMFilesSize {
+			return errors.New("files too large")
+		}
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		switch h.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(p, os.FileMode(h.Mode).Perm()|0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+				return err
+			}
+			os.Remove(p) // Replace rather than write through a symbolic link
+			f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, os.FileMode(h.Mode).Perm()|0600)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			if err1 := f.Close(); err == nil {
+				err = err1
+			}
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("invalid file type: %q", h.Name)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// setupVMGuest mounts the filesystems that the init process of a VM must
+// provide, with a writable /tmp for the working directories of commands.
+func setupVMGuest() error {
+	for _, m := range []struct{ src, dst, typ string }{
+		{"proc", "/proc", "proc"},
+		{"devtmpfs", "/dev", "devtmpfs"},
+		{"tmpfs", "/tmp", "tmpfs"},
+	} {
+		if err := unix.Mount(m.src, m.dst, m.typ, 0, ""); err != nil && err != unix.EBUSY {
+			return &os.PathError{Op: "mount", Path: m.dst, Err: err}
+		}
+	}
+	if os.Getenv("PATH") == "" {
+		os.Setenv("PATH", "/usr/local/go/bin:/usr/local/bin:/usr/bin:/bin")
+	}
+	os.Setenv("HOME", "/tmp") // Commands run as nobody
+	return nil
+}
+
+// listenVsock listens on the vsock port for connections from the host and
+// returns a function that accepts the next connection. Unlike other sockets,
+// vsock sockets are not supported by the net package.
+func listenVsock(port uint32) (accept func() (io.ReadWriteCloser, error), err error) {
+	fd, err := unix.Socket(unix.AF_VSOCK, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, os.NewSyscallError("socket", err)
+	}
+	if err := unix.Bind(fd, &unix.SockaddrVM{CID: unix.VMADDR_CID_ANY, Port: port}); err != nil {
+		unix.Close(fd)
+		return nil, os.NewSyscallError("bind", err)
+	}
+	if err := unix.Listen(fd, unix.SOMAXCONN); err != nil {
+		unix.Close(fd)
+		return nil, os.NewSyscallError("listen", err)
+	}
+	return func() (io.ReadWriteCloser, error) {
+		nfd, _, err := unix.Accept4(fd, unix.SOCK_CLOEXEC)
+		if err != nil {
+			return nil, os.NewSyscallError("accept", err)
+		}
+		return os.NewFile(uintptr(nfd), "vsock"), nil
+	}, nil
+}
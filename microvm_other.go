@@ -0,0 +1,21 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+//go:build !linux
+// +build !linux
+
+package main
+
+import (
+	"errors"
+	"io"
+)
+
+var errNoVsock = errors.New("microVMs are only supported on Linux")
+
+func setupVMGuest() error { return errNoVsock }
+
+func listenVsock(port uint32) (func() (io.ReadWriteCloser, error), error) {
+	return nil, errNoVsock
+}
@@ -0,0 +1,112 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestMicroVM(t *testing.T) {
+	// Serve the agent on a Unix socket that behaves like the host side of
+	// the vsock device of a VM, with the root of the guest in a directory.
+	guest := t.TempDir()
+	vsock := filepath.Join(t.TempDir(), "vsock.sock")
+	ln, err := net.Listen("unix", vsock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				line, _ := bufio.NewReader(conn).ReadString('\n') // Nothing follows until it is answered
+				if line != fmt.Sprintf("CONNECT %d\n", vmAgentPort) {
+					return
+				}
+				fmt.Fprintf(conn, "OK 1073741824\n")
+				serveVMAgent(conn, guest, nil)
+			}()
+		}
+	}()
+
+	// The working directory is copied to the VM and back.
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "in.txt"), []byte("file\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	script := `echo "$GREETING"; cat in.txt; cat; echo oops >&2; echo built > out.txt; exit 3`
+	exit, err := vmExec(vsock, dir, []string{"GREETING=hello"}, []string{"sh", "-c", script}, strings.NewReader("stdin\n"), &stdout, &stderr, nil)
+	if err != nil {
+		t.Fatalf("vmExec error: %v", err)
+	}
+	if exit.Code != 3 || exit.Signal != 0 {
+		t.Errorf("exit = %+v, want exit code 3", exit)
+	}
+	if got, want := stdout.String(), "hello\nfile\nstdin\n"; got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+	if got, want := stderr.String(), "oops\n"; got != want {
+		t.Errorf("stderr = %q, want %q", got, want)
+	}
+	if b, _ := ioutil.ReadFile(filepath.Join(dir, "out.txt")); string(b) != "built\n" {
+		t.Errorf("out.txt = %q, want %q", b, "built\n")
+	}
+	if _, err := os.Stat(filepath.Join(guest, dir, "in.txt")); err != nil {
+		t.Errorf("file not copied to the guest: %v", err)
+	}
+
+	// Signals are forwarded to the command.
+	sigc := make(chan os.Signal, 1)
+	ready := make(chan bool, 1)
+	out := writerFunc(func(b []byte) (int, error) {
+		select {
+		case ready <- true:
+		default:
+		}
+		return len(b), nil
+	})
+	done := make(chan *vmExit, 1)
+	go func() {
+		exit, err := vmExec(vsock, dir, nil, []string{"sh", "-c", "echo ready; exec sleep 60"}, strings.NewReader(""), out, out, sigc)
+		if err != nil {
+			t.Errorf("vmExec error: %v", err)
+		}
+		done <- exit
+	}()
+	<-ready
+	sigc <- syscall.SIGTERM
+	select {
+	case exit := <-done:
+		if exit != nil && exit.Signal != syscall.SIGTERM {
+			t.Errorf("exit = %+v, want terminated by SIGTERM", exit)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("command did not stop")
+	}
+
+	// Commands that cannot start are errors.
+	if _, err := vmExec(vsock, dir, nil, []string{"no-such-command"}, strings.NewReader(""), out, out, nil); err == nil {
+		t.Error("vmExec of a missing command succeeded")
+	}
+	if _, err := vmExec("", dir, nil, []string{"true"}, strings.NewReader(""), out, out, nil); err == nil {
+		t.Error("vmExec without a VM succeeded")
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// errOIDCForbidden indicates that the user authenticated with the provider,
+// but is not allowed to access the playground.
+var errOIDCForbidden = errors.New("user is not allowed")
+
+// oidcProvider authenticates users with an OpenID Connect provider using the
+// authorization code flow. The identity of the user is obtained from the
+// userinfo endpoint using the access token, which is received directly from
+// the provider over HTTPS, such that the ID token need not be verified.
+type oidcProvider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	allowed      []string // Lower-case email addresses or "@domain" suffixes
+	client       *http.Client
+
+	mu   sync.Mutex
+	meta *oidcMetadata // Lazily fetched by discover
+}
+
+// oidcMetadata is the subset of the provider metadata that is needed.
+// See https://openid.net/specs/openid-connect-discovery-1_0.html.
+type oidcMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+func newOIDCProvider(issuer, clientID, clientSecret string, allowed []string) *oidcProvider {
+	p := &oidcProvider{
+		issuer:       strings.TrimSuffix(issuer, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		client:       http.DefaultClient,
+	}
+	for _, s := range allowed {
+		p.allowed = append(p.allowed, strings.ToLower(strings.TrimSpace(s)))
+	}
+	return p
+}
+
+// discover fetches the provider metadata.
+// The metadata is cached once it is successfully fetched.
+func (p *oidcProvider) discover(ctx context.Context) (*oidcMetadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	var meta oidcMetadata
+	if err := p.getJSON(ctx, p.issuer+"/.well-known/openid-configuration", "", &meta); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(meta.Issuer, "/") != p.issuer {
+		return nil, fmt.Errorf("OIDC error: mismatching issuer: %q", meta.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.UserinfoEndpoint == "" {
+		return nil, errors.New("OIDC error: incomplete provider metadata")
+	}
+	p.meta = &meta
+	return p.meta, nil
+}
+
+// AuthURL returns the URL of the provider to redirect the user to.
+// Upon authentication, the provider redirects the user to redirectURL
+// with the given state and an authorization code.
+func (p *oidcProvider) AuthURL(ctx context.Context, redirectURL, state string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{
+		"response_type": {"code"},
+		"client_id":     {p.clientID},
+		"redirect_uri":  {redirectURL},
+		"scope":         {"openid email"},
+		"state":         {state},
+	}
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+// Authenticate exchanges the authorization code for an access token and
+// returns the email address of the user. It reports errOIDCForbidden if the
+// email address is unverified or not allowed to access the playground.
+func (p *oidcProvider) Authenticate(ctx context.Context, redirectURL, code string) (email string, err error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// Exchange the code for an access token.
+	q := url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {redirectURL},
+	}
+	r, err := http.NewRequest("POST", meta.TokenEndpoint, strings.NewReader(q.Encode()))
+	if err != nil {
+		return "", err
+	}
+	r = r.WithContext(ctx)
+	r.SetBasicAuth(url.QueryEscape(p.clientID), url.QueryEscape(p.clientSecret))
+	r.Header.Set("Accept", "application/json")
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var token struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+	}
+	if err := p.doJSON(r, &token); err != nil {
+		return "", err
+	}
+	if token.AccessToken == "" || !strings.EqualFold(token.TokenType, "bearer") {
+		return "", errors.New("OIDC error: invalid access token")
+	}
+
+	// Obtain the identity of the user.
+	var user struct {
+		Email         string `json:"email"`
+		EmailVerified *bool  `json:"email_verified"`
+	}
+	if err := p.getJSON(ctx, meta.UserinfoEndpoint, token.AccessToken, &user); err != nil {
+		return "", err
+	}
+	if user.Email == "" || (user.EmailVerified != nil && !*user.EmailVerified) {
+		return user.Email, errOIDCForbidden
+	}
+	if !p.isAllowed(user.Email) {
+		return user.Email, errOIDCForbidden
+	}
+	return user.Email, nil
+}
+
+// isAllowed reports whether the email address matches an allowed address
+// or an allowed "@domain" suffix.
+func (p *oidcProvider) isAllowed(email string) bool {
+	email = strings.ToLower(email)
+	for _, s := range p.allowed {
+		if email == s || (strings.HasPrefix(s, "@") && strings.HasSuffix(email, s)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, url, token string, v interface{}) error {
+	r, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	r = r.WithContext(ctx)
+	r.Header.Set("Accept", "application/json")
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	return p.doJSON(r, v)
+}
+
+func (p *oidcProvider) doJSON(r *http.Request, v interface{}) error {
+	resp, err := p.client.Do(r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("OIDC error: %s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("OIDC error: %v", err)
+	}
+	return nil
+}
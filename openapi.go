@@ -0,0 +1,256 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// openAPIJSON is the OpenAPI specification of the HTTP API.
+// Requests to the operations it describes are validated against it.
+//
+//go:embed openapi.json
+var openAPIJSON []byte
+
+// apiRoutes are the operations of openAPIJSON by path.
+var apiRoutes = mustParseOpenAPI(openAPIJSON)
+
+// apiRoute is a path of the OpenAPI specification.
+type apiRoute struct {
+	re    *regexp.Regexp // Matches the path, with a submatch for each parameter
+	names []string       // Names of the path parameters
+	ops   map[string]apiOperation
+}
+
+// apiOperation is the subset of an OpenAPI operation used for validation.
+type apiOperation struct {
+	Parameters  []apiParameter  `json:"parameters"`
+	RequestBody *apiRequestBody `json:"requestBody"`
+}
+
+type apiParameter struct {
+	Name     string    `json:"name"`
+	In       string    `json:"in"` // Either "path", "query", or "header"
+	Required bool      `json:"required"`
+	Schema   apiSchema `json:"schema"`
+}
+
+type apiRequestBody struct {
+	Ref      string `json:"$ref"`
+	Required bool   `json:"required"`
+}
+
+type apiSchema struct {
+	Type    string     `json:"type"`
+	Enum    []string   `json:"enum"`
+	Pattern string     `json:"pattern"`
+	Items   *apiSchema `json:"items"`
+
+	re *regexp.Regexp // Compiled Pattern
+}
+
+// mustParseOpenAPI parses the paths of an OpenAPI specification.
+// Path-level parameters are merged into each operation, and references to
+// request bodies are resolved.
+func mustParseOpenAPI(b []byte) []apiRoute {
+	var spec struct {
+		Paths      map[string]map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			RequestBodies map[string]*apiRequestBody `json:"requestBodies"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(b, &spec); err != nil {
+		panic(fmt.Sprintf("invalid OpenAPI specification: %v", err))
+	}
+	var compile func(*apiSchema)
+	compile = func(s *apiSchema) {
+		if s.Pattern != "" {
+			s.re = regexp.MustCompile(s.Pattern)
+		}
+		if s.Items != nil {
+			compile(s.Items)
+		}
+	}
+
+	var routes []apiRoute
+	for p, item := range spec.Paths {
+		rt := apiRoute{ops: make(map[string]apiOperation)}
+		var pattern strings.Builder
+		for _, seg := range strings.Split(p, "/")[1:] {
+			pattern.WriteString("/")
+			if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+				rt.names = append(rt.names, seg[1:len(seg)-1])
+				pattern.WriteString("([^/]+)")
+			} else {
+				pattern.WriteString(regexp.QuoteMeta(seg))
+			}
+		}
+		rt.re = regexp.MustCompile("^" + pattern.String() + "$")
+
+		var common []apiParameter
+		if raw, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(raw, &common); err != nil {
+				panic(fmt.Sprintf("invalid parameters of %s: %v", p, err))
+			}
+		}
+		for method, raw := range item {
+			if method == "parameters" {
+				continue
+			}
+			var op apiOperation
+			if err := json.Unmarshal(raw, &op); err != nil {
+				panic(fmt.Sprintf("invalid operation %s %s: %v", method, p, err))
+			}
+			op.Parameters = append(append([]apiParameter(nil), common...), op.Parameters...)
+			for i := range op.Parameters {
+				compile(&op.Parameters[i].Schema)
+			}
+			if rb := op.RequestBody; rb != nil && rb.Ref != "" {
+				name := strings.TrimPrefix(rb.Ref, "#/components/requestBodies/")
+				if spec.Components.RequestBodies[name] == nil {
+					panic(fmt.Sprintf("unknown request body of %s %s: %v", method, p, rb.Ref))
+				}
+				op.RequestBody = spec.Components.RequestBodies[name]
+			}
+			rt.ops[strings.ToUpper(method)] = op
+		}
+		routes = append(routes, rt)
+	}
+	return routes
+}
+
+// serveOpenAPI serves the OpenAPI specification of the HTTP API,
+// with the server URL set to that of the playground.
+func (pg *playground) serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	var spec map[string]interface{}
+	json.Unmarshal(openAPIJSON, &spec)
+	u := pg.externalURL + pg.basePath
+	if u == "" {
+		u = "/"
+	}
+	spec["servers"] = []interface{}{map[string]string{"url": u}}
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.MarshalIndent(spec, "", "\t")
+	w.Write(b)
+}
+
+// isValidRequest reports whether the request conforms to the operation
+// of the OpenAPI specification that it is for, if any.
+// Otherwise, it responds with an error describing the violation.
+func (pg *playground) isValidRequest(w http.ResponseWriter, r *http.Request) bool {
+	if err := validateRequest(r); err != nil {
+		httpError(w, r, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// validateRequest validates the parameters and presence of the body of
+// the request against the OpenAPI specification. Requests for operations
+// not in the specification are always valid.
+func validateRequest(r *http.Request) error {
+	for _, rt := range apiRoutes {
+		m := rt.re.FindStringSubmatch(r.URL.Path)
+		if m == nil {
+			continue
+		}
+		op, ok := rt.ops[r.Method]
+		if !ok {
+			continue
+		}
+
+		// Paths whose parameters do not match belong to other routes
+		// (e.g., "/snippets/export" is not "/snippets/{id}").
+		path := make(map[string]string)
+		for i, name := range rt.names {
+			path[name] = m[i+1]
+		}
+		if !op.matchesPath(path) {
+			continue
+		}
+		query := r.URL.Query()
+		known := make(map[string]bool)
+		for _, p := range op.Parameters {
+			var vals []string
+			switch p.In {
+			case "path":
+				continue // Already matched
+			case "query":
+				vals = query[p.Name]
+				known[p.Name] = true
+			case "header":
+				vals = r.Header.Values(p.Name)
+			}
+			if len(vals) == 0 {
+				if p.Required {
+					return fmt.Errorf("missing %s field: %v", p.In, p.Name)
+				}
+				continue
+			}
+			s := p.Schema
+			if s.Type == "array" && s.Items != nil {
+				s = *s.Items
+			} else {
+				vals = vals[:1]
+			}
+			for _, v := range vals {
+				if !s.matches(v) {
+					return fmt.Errorf("invalid %s value: %v", p.Name, v)
+				}
+			}
+		}
+		for k := range query {
+			if !known[k] {
+				return fmt.Errorf("unknown query field: %v", k)
+			}
+		}
+		if rb := op.RequestBody; rb != nil && rb.Required && r.ContentLength == 0 {
+			return fmt.Errorf("missing request body")
+		}
+		return nil
+	}
+	return nil
+}
+
+// matchesPath reports whether the path parameters conform to the operation.
+func (op *apiOperation) matchesPath(path map[string]string) bool {
+	for _, p := range op.Parameters {
+		if p.In == "path" && !p.Schema.matches(path[p.Name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether the string form of a value conforms to the schema.
+func (s *apiSchema) matches(v string) bool {
+	var err error
+	switch s.Type {
+	case "integer":
+		_, err = strconv.ParseInt(v, 10, 64)
+	case "number":
+		_, err = strconv.ParseFloat(v, 64)
+	case "boolean":
+		_, err = strconv.ParseBool(v)
+	}
+	if err != nil || (s.re != nil && !s.re.MatchString(v)) {
+		return false
+	}
+	if len(s.Enum) == 0 {
+		return true
+	}
+	for _, e := range s.Enum {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
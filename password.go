@@ -0,0 +1,160 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Parameters used when hashing new passwords with argon2id.
+// These are the recommended settings from RFC 9106, section 4.
+const (
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024 // KiB
+	argon2Threads = 4
+	argon2SaltLen = 16
+	argon2KeyLen  = 32
+)
+
+// passwordHash verifies passwords against the PasswordHash from the
+// configuration, which may be in one of the following formats:
+//
+//   - argon2id in the PHC string format
+//     (e.g., "$argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>")
+//   - bcrypt (e.g., "$2b$12$<salt+hash>")
+//   - legacy: the hex-encoded SHA256(PasswordSalt+password)
+//
+// The legacy format is weak against brute force and is only supported so
+// that existing configurations continue to work.
+type passwordHash struct {
+	encoded string
+
+	// Used by the legacy format.
+	legacy     bool
+	legacyHash [sha256.Size]byte
+	legacySalt [sha256.Size]byte
+
+	// Used by the argon2id format.
+	argon2                bool
+	salt, key             []byte
+	time, memory, threads uint32
+
+	// mu serializes verification since each argon2id verification
+	// allocates a large amount of memory.
+	mu sync.Mutex
+}
+
+// parsePasswordHash parses the hash (and the salt for the legacy format).
+// It returns nil if hash is empty.
+func parsePasswordHash(hash, salt string) (*passwordHash, error) {
+	ph := &passwordHash{encoded: hash}
+	switch {
+	case hash == "":
+		if salt != "" {
+			return nil, errors.New("PasswordSalt set without PasswordHash")
+		}
+		return nil, nil
+	case strings.HasPrefix(hash, "$argon2id$"):
+		var version int
+		ss := strings.Split(hash, "$")
+		if len(ss) != 6 {
+			return nil, errors.New("invalid argon2id hash")
+		}
+		if _, err := fmt.Sscanf(ss[2], "v=%d", &version); err != nil || version != argon2.Version {
+			return nil, fmt.Errorf("unsupported argon2id version: %q", ss[2])
+		}
+		if _, err := fmt.Sscanf(ss[3], "m=%d,t=%d,p=%d", &ph.memory, &ph.time, &ph.threads); err != nil {
+			return nil, fmt.Errorf("invalid argon2id parameters: %q", ss[3])
+		}
+		if ph.time == 0 || ph.threads == 0 || ph.threads > 255 {
+			return nil, fmt.Errorf("invalid argon2id parameters: %q", ss[3])
+		}
+		var err1, err2 error
+		ph.salt, err1 = base64.RawStdEncoding.DecodeString(ss[4])
+		ph.key, err2 = base64.RawStdEncoding.DecodeString(ss[5])
+		if err1 != nil || err2 != nil || len(ph.key) == 0 {
+			return nil, errors.New("invalid argon2id hash")
+		}
+		ph.argon2 = true
+		if salt != "" {
+			return nil, errors.New("PasswordSalt must not be set with an argon2id hash")
+		}
+	case strings.HasPrefix(hash, "$2"):
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			return nil, fmt.Errorf("invalid bcrypt hash: %v", err)
+		}
+		if salt != "" {
+			return nil, errors.New("PasswordSalt must not be set with a bcrypt hash")
+		}
+	default:
+		h, err1 := hex.DecodeString(hash)
+		s, err2 := hex.DecodeString(salt)
+		if err1 != nil || err2 != nil || len(h) != sha256.Size || len(s) != sha256.Size {
+			return nil, errors.New("invalid PasswordHash or PasswordSalt")
+		}
+		copy(ph.legacyHash[:], h)
+		copy(ph.legacySalt[:], s)
+		ph.legacy = true
+	}
+	return ph, nil
+}
+
+// newPasswordHash hashes the password using argon2id and
+// returns it in the PHC string format.
+func newPasswordHash(password []byte) (string, error) {
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey(password, salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version,
+		argon2Memory, argon2Time, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify reports whether the password matches the hash.
+func (ph *passwordHash) Verify(password []byte) bool {
+	switch {
+	case ph.legacy:
+		h := sha256.Sum256(append(ph.legacySalt[:len(ph.legacySalt):len(ph.legacySalt)], password...))
+		return subtle.ConstantTimeCompare(h[:], ph.legacyHash[:]) == 1
+	case ph.argon2:
+		ph.mu.Lock()
+		defer ph.mu.Unlock()
+		key := argon2.IDKey(password, ph.salt, ph.time, ph.memory, uint8(ph.threads), uint32(len(ph.key)))
+		return subtle.ConstantTimeCompare(key, ph.key) == 1
+	default:
+		ph.mu.Lock()
+		defer ph.mu.Unlock()
+		return bcrypt.CompareHashAndPassword([]byte(ph.encoded), password) == nil
+	}
+}
+
+// IsLegacy reports whether the hash uses the legacy SHA-256 format.
+func (ph *passwordHash) IsLegacy() bool {
+	return ph.legacy
+}
+
+// AuthKey returns the key used to sign authentication tokens.
+// Changing the password invalidates all tokens.
+func (ph *passwordHash) AuthKey() []byte {
+	if ph.legacy {
+		return ph.legacyHash[:] // Preserve tokens issued by prior versions
+	}
+	k := sha256.Sum256([]byte("playground-auth:" + ph.encoded))
+	return k[:]
+}
@@ -2,21 +2,29 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE.md file.
 
-//go:generate go run staticfs_gen.go
-
 package main
 
 import (
+	"archive/zip"
+	"bufio"
+	"bytes"
 	"context"
 	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"path"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -24,6 +32,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"golang.org/x/net/webdav"
 )
 
 type logger interface {
@@ -31,18 +40,61 @@ type logger interface {
 }
 
 type playground struct {
-	// Password values used to authenticate each HTTP request.
-	pwHash [sha256.Size]byte // Must be SHA256(pwSalt+password)
-	pwSalt [sha256.Size]byte
+	// Password used to authenticate each HTTP request.
+	// It may be nil if no password is set.
+	pw        *passwordHash
+	pwUpgrade sync.Once // Logs the upgraded legacy password hash once
+
+	// basePath is the URL path prefix that the playground is served under.
+	// It is empty if served at the root, and otherwise has a leading slash
+	// but no trailing slash (e.g., "/playground").
+	basePath string
+
+	// staticDir is a directory that static files are read from upon every
+	// request instead of staticFS. It is empty unless developing the frontend.
+	staticDir string
+
+	// branding customizes the appearance of the HTML pages. It may be nil.
+	branding *branding
+
+	// htmlCache caches the HTML pages after rewriteHTML.
+	htmlMu    sync.Mutex // Protects htmlCache
+	htmlCache map[string]*staticFile
+
+	// trustedProxies are the networks of reverse proxies whose
+	// X-Real-IP and X-Forwarded-For headers identify the client.
+	trustedProxies []*net.IPNet
+
+	// externalURL is the scheme and host that clients use to reach the
+	// playground. If empty, it is derived from each request (see baseURL).
+	externalURL string
+
+	// oidc authenticates users with an OpenID Connect provider as an
+	// alternative to the password. It may be nil.
+	oidc *oidcProvider
 
 	// Arguments to the code executor.
-	gcBin  string
-	fmtBin string
-	gcBins map[string]string
+	exConf execConfig
 
-	bs  *blobStore
-	sdb *database
-	log logger
+	bs    *blobStore
+	sdb   snippetStore
+	gist  *gistClient // May be nil if Gist export is not configured
+	gopls *gopls      // May be nil if code intelligence is not configured
+	log   logger
+
+	// playURL is the URL of the upstream Go playground used to fetch shared
+	// snippets, which are located at playURL+"/_/share?id="+hash.
+	playURL string
+
+	// Backups of the snippet database are stored in backupPath.
+	// Only the newest backupKeep backups are retained.
+	// Each backup is also copied to the backupTargets, encrypted with
+	// backupPassphrase if set.
+	backupMu         sync.Mutex
+	backupPath       string
+	backupKeep       int
+	backupTargets    []backupTarget
+	backupPassphrase string
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -51,44 +103,133 @@ type playground struct {
 	// clientID and numActive are atomically incremented by serveWebsocket.
 	clientID  int64 // Some unique ID number for connections
 	numActive int64 // Number of currently active connections
+
+	// enablePprof exposes the profiles of the server process itself.
+	enablePprof bool
+
+	// clients are the currently connected websocket clients by ID.
+	clientsMu sync.Mutex
+	clients   map[int64]*wsClient
+
+	// sessions are the websocket sessions by token, including those whose
+	// connection was lost but that may still be resumed.
+	sessionsMu sync.Mutex
+	sessions   map[string]*wsSession
+
+	// rooms are the collaborative editing sessions by snippet ID.
+	roomsMu sync.Mutex
+	rooms   map[int64]*collabRoom
+
+	// updateMu serializes updates of snippets so that the precondition of
+	// a conditional update holds until the update is applied.
+	updateMu sync.Mutex
+
+	// webhooks are fired when a run finishes (see notifyRun).
+	webhooks []webhookConfig
+
+	// templates are the code that new snippets may start out with,
+	// sorted by name.
+	templates []snippetTemplate
+
+	// davLocks are the locks held by WebDAV clients on the snippets.
+	davLocks webdav.LockSystem
+
+	// workers are the remote workers that runs are dispatched to, which
+	// may only connect if workerToken is set.
+	workers     *workerPool
+	workerToken string
 }
 
-func newPlayground(pwHash, pwSalt [sha256.Size]byte, dbPath, gcBin, fmtBin string, gcBins map[string]string, log logger) (*playground, error) {
-	db, err := openDatabase(dbPath)
+func newPlayground(pw *passwordHash, dbBackend, dbPath string, exConf execConfig, log logger) (*playground, error) {
+	db, err := openStore(dbBackend, dbPath)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := openBlobStore(filepath.Join(dbPath, blobDir))
 	if err != nil {
+		db.Close()
 		return nil, err
 	}
 	ctx, cancel := context.WithCancel(context.Background())
-	return &playground{
-		pwHash: pwHash,
-		pwSalt: pwSalt,
-		gcBin:  gcBin,
-		fmtBin: fmtBin,
-		gcBins: gcBins,
-
-		bs:  newBlobStore(),
+	pg := &playground{
+		pw:     pw,
+		exConf: exConf,
+
+		bs:  bs,
 		sdb: db,
 		log: log,
 
+		playURL: "https://go.dev",
+
+		backupPath: filepath.Join(dbPath, backupDir),
+		backupKeep: defaultBackupRetention,
+
+		clients:  make(map[int64]*wsClient),
+		sessions: make(map[string]*wsSession),
+		rooms:    make(map[int64]*collabRoom),
+
+		templates: builtinTemplates,
+		davLocks:  webdav.NewMemLS(),
+		workers:   newWorkerPool(),
+
 		ctx:    ctx,
 		cancel: cancel,
-	}, nil
+	}
+	pg.exConf.recordBench = pg.recordBenchmarks
+	pg.exConf.snippetFiles = pg.snippetFiles
+	pg.exConf.runDone = pg.notifyRun
+	pg.exConf.workers = pg.workers
+	return pg, nil
 }
 
 func (pg *playground) Close() error {
 	pg.cancel()
+	pg.closeSessions()
 	pg.wg.Wait()
+	pg.exConf.pprofUI.Close()
+	if pg.gopls != nil {
+		pg.gopls.Close()
+	}
 	return pg.sdb.Close()
 }
 
 var (
 	reStatic     = regexp.MustCompile(`^/static/`)
 	reLogin      = regexp.MustCompile(`^/login$`)
+	reLoginOIDC  = regexp.MustCompile(`^/login/oidc(/callback)?$`)
 	reRoot       = regexp.MustCompile(`^/[0-9]*$`)
 	reSnippets   = regexp.MustCompile(`^/snippets$`)
 	reSnippetsID = regexp.MustCompile(`^/snippets/[0-9]+$`)
+	reExport     = regexp.MustCompile(`^/snippets/export$`)
+	reImport     = regexp.MustCompile(`^/snippets/import$`)
+	reBatch      = regexp.MustCompile(`^/snippets/batch$`)
+	reGist       = regexp.MustCompile(`^/snippets/[0-9]+/gist$`)
+	rePlayShare  = regexp.MustCompile(`^/snippets/play/[-_a-zA-Z0-9]+$`)
+	reStar       = regexp.MustCompile(`^/snippets/[0-9]+/star$`)
+	reBench      = regexp.MustCompile(`^/snippets/[0-9]+/benchmarks$`)
+	reHTML       = regexp.MustCompile(`^/snippets/[0-9]+/html$`)
+	reTemplates  = regexp.MustCompile(`^/templates$`)
+	reTemplate   = regexp.MustCompile(`^/templates/[-_a-zA-Z0-9]+$`)
+	reDAV        = regexp.MustCompile(`^/dav(/.*)?$`)
+	reBackup     = regexp.MustCompile(`^/admin/backup$`)
+	reCompact    = regexp.MustCompile(`^/admin/compact$`)
+	reTokens     = regexp.MustCompile(`^/admin/tokens$`)
+	reTokensID   = regexp.MustCompile(`^/admin/tokens/[0-9]+$`)
+	reSessions   = regexp.MustCompile(`^/admin/sessions$`)
+	reSessionsID = regexp.MustCompile(`^/admin/sessions/[0-9a-f]+$`)
+	reAudit      = regexp.MustCompile(`^/admin/audit$`)
+	reStatus     = regexp.MustCompile(`^/admin/status$`)
+	reClientStop = regexp.MustCompile(`^/admin/clients/[0-9]+/stop$`)
+	rePprof      = regexp.MustCompile(`^/debug/pprof(/[a-z]*)?$`)
+	reComplete   = regexp.MustCompile(`^/complete$`)
+	reRun        = regexp.MustCompile(`^/run$`)
+	reOpenAPI    = regexp.MustCompile(`^/openapi\.json$`)
+	reWorkers    = regexp.MustCompile(`^/workers$`)
+	reHover      = regexp.MustCompile(`^/hover$`)
 	reWebsocket  = regexp.MustCompile(`^/websocket$`)
+	reBlobs      = regexp.MustCompile(`^/dynamic$`)
 	reDynamic    = regexp.MustCompile(`^/dynamic/[-_a-zA-Z0-9]+$`)
+	rePprofUI    = regexp.MustCompile(`^/pprof/[0-9a-f]+(/.*)?$`)
 )
 
 func (pg *playground) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -97,11 +238,42 @@ func (pg *playground) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	select {
 	case <-pg.ctx.Done():
-		http.Error(w, "server shutting down", http.StatusServiceUnavailable)
+		httpError(w, r, "server shutting down", http.StatusServiceUnavailable)
 		return
 	default:
 	}
 
+	// Tag the request with an ID that is reported back to the client
+	// so that problems can be correlated with the server logs.
+	id := newRequestID()
+	r = r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id))
+	w.Header().Set("X-Request-Id", id)
+
+	if tr := pg.exConf.tracer; tr != nil {
+		ctx, sp := tr.StartRequest(r, "HTTP "+r.Method)
+		sp.SetAttr("request.id", id)
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			sp.SetAttr("http.status_code", sw.status)
+			sp.End()
+		}()
+		w, r = sw, r.WithContext(ctx)
+	}
+
+	if pg.basePath != "" {
+		switch p := r.URL.Path; {
+		case p == pg.basePath:
+			http.Redirect(w, r, pg.basePath+"/", http.StatusMovedPermanently)
+			return
+		case strings.HasPrefix(p, pg.basePath+"/"):
+			r.URL.Path = p[len(pg.basePath):]
+			r.URL.RawPath = ""
+		default:
+			httpError(w, r, "not found", http.StatusNotFound)
+			return
+		}
+	}
+
 	if r.URL.Path == "/favicon.ico" {
 		r.URL.Path = "/static/img/favicon.ico" // Server-side redirect
 	}
@@ -111,10 +283,22 @@ func (pg *playground) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		r.URL.Path = strings.TrimPrefix(r.URL.Path, "/static")
 		pg.serveStatic(w, r)
 		return
-	case !pg.isAuthenticated(w, r) || reLogin.MatchString(r.URL.Path):
+	case matchRequest(r, reOpenAPI, "GET"):
+		// The specification is available without authentication so that
+		// clients can be generated from it.
+		pg.serveOpenAPI(w, r)
+		return
+	case matchRequest(r, reWorkers, "GET"):
+		// Workers authenticate with the worker token instead.
+		pg.serveWorkers(w, r)
+		return
+	case !pg.isAuthenticated(w, r) || reLogin.MatchString(r.URL.Path) || reLoginOIDC.MatchString(r.URL.Path):
 		// Perform authentication check prior to serving any other content.
 		pg.serveLogin(w, r)
 		return
+	case !pg.isValidRequest(w, r):
+		// Reject requests that do not conform to the OpenAPI specification.
+		return
 	case matchRequest(r, reRoot, "GET"):
 		r.URL.Path = "/html/playground.html"
 		pg.serveStatic(w, r)
@@ -126,14 +310,85 @@ func (pg *playground) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		matchRequest(r, reSnippetsID, "GET", "PUT", "DELETE"):
 		pg.serveSnippet(w, r)
 		return
+	case matchRequest(r, reExport, "GET"):
+		pg.serveExport(w, r)
+		return
+	case matchRequest(r, reImport, "POST"):
+		pg.serveImport(w, r)
+		return
+	case matchRequest(r, reBatch, "POST"):
+		pg.serveBatch(w, r)
+		return
+	case matchRequest(r, reGist, "POST"):
+		pg.serveGist(w, r)
+		return
+	case matchRequest(r, reRun, "POST"):
+		pg.serveRun(w, r)
+		return
+	case matchRequest(r, rePlayShare, "POST"):
+		pg.servePlayShare(w, r)
+		return
+	case matchRequest(r, reStar, "POST"):
+		pg.serveStar(w, r)
+		return
+	case matchRequest(r, reBench, "GET"):
+		pg.serveBenchmarks(w, r)
+		return
+	case matchRequest(r, reHTML, "GET"):
+		pg.serveSnippetHTML(w, r)
+		return
+	case matchRequest(r, reTemplates, "GET") ||
+		matchRequest(r, reTemplate, "GET", "POST"):
+		pg.serveTemplates(w, r)
+		return
+	case matchRequest(r, reDAV, "OPTIONS", "GET", "HEAD", "PUT", "DELETE",
+		"PROPFIND", "PROPPATCH", "MKCOL", "COPY", "MOVE", "LOCK", "UNLOCK"):
+		pg.serveDAV(w, r)
+		return
+	case matchRequest(r, reBackup, "POST"):
+		pg.serveBackup(w, r)
+		return
+	case matchRequest(r, reCompact, "POST"):
+		pg.serveCompact(w, r)
+		return
+	case matchRequest(r, reTokens, "GET", "POST") ||
+		matchRequest(r, reTokensID, "DELETE"):
+		pg.serveTokens(w, r)
+		return
+	case matchRequest(r, reSessions, "GET", "DELETE") ||
+		matchRequest(r, reSessionsID, "DELETE"):
+		pg.serveSessions(w, r)
+		return
+	case matchRequest(r, reAudit, "GET"):
+		pg.serveAudit(w, r)
+		return
+	case matchRequest(r, reStatus, "GET") ||
+		matchRequest(r, reClientStop, "POST"):
+		pg.serveStatus(w, r)
+		return
+	case pg.enablePprof && matchRequest(r, rePprof, "GET", "POST"):
+		pg.servePprof(w, r)
+		return
+	case matchRequest(r, reComplete, "POST"):
+		pg.serveComplete(w, r)
+		return
+	case matchRequest(r, reHover, "POST"):
+		pg.serveHover(w, r)
+		return
 	case matchRequest(r, reWebsocket, "GET", "CONNECT"):
 		pg.serveWebsocket(w, r)
 		return
+	case matchRequest(r, reBlobs, "GET"):
+		pg.serveBlobs(w, r)
+		return
 	case matchRequest(r, reDynamic, "GET"):
 		pg.serveDynamic(w, r)
 		return
+	case matchRequest(r, rePprofUI, "GET", "POST"):
+		pg.servePprofUI(w, r)
+		return
 	default:
-		http.Redirect(w, r, "/", http.StatusTemporaryRedirect)
+		http.Redirect(w, r, pg.basePath+"/", http.StatusTemporaryRedirect)
 		return
 	}
 }
@@ -150,44 +405,140 @@ func matchRequest(r *http.Request, re *regexp.Regexp, methods ...string) bool {
 	return false
 }
 
+// statusWriter records the status code of the response.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Hijack allows websocket connections to take over the connection.
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("connection does not support hijacking")
+	}
+	w.status = http.StatusSwitchingProtocols
+	return h.Hijack()
+}
+
+// store returns the snippet store for use by the request, which records
+// database operations as part of the trace of the request.
+func (pg *playground) store(r *http.Request) snippetStore {
+	if pg.exConf.tracer == nil {
+		return pg.sdb
+	}
+	return tracedStore{pg.sdb, pg.exConf.tracer, r.Context()}
+}
+
+type requestIDKey struct{}
+
+func newRequestID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// requestID returns the ID assigned to the request by ServeHTTP.
+func requestID(r *http.Request) string {
+	id, _ := r.Context().Value(requestIDKey{}).(string)
+	return id
+}
+
+// logf logs a message prefixed with the ID of the request.
+func (pg *playground) logf(r *http.Request, f string, x ...interface{}) {
+	msg := fmt.Sprintf(f, x...)
+	if id := requestID(r); id != "" {
+		msg = "[" + id + "] " + msg
+	}
+	if l, ok := pg.log.(interface{ Output(int, string) error }); ok {
+		l.Output(2, msg) // Report the location of the caller
+		return
+	}
+	pg.log.Printf("%s", msg)
+}
+
+// httpError replies to the request with the error message,
+// which includes the ID of the request.
+func httpError(w http.ResponseWriter, r *http.Request, msg string, code int) {
+	if id := requestID(r); id != "" {
+		msg += " (request " + id + ")"
+	}
+	http.Error(w, msg, code)
+}
+
 const (
 	authRefreshPeriod = 1 * 24 * time.Hour // 1 day
 	authExpirePeriod  = 7 * 24 * time.Hour // 1 week
+	oidcStatePeriod   = 10 * time.Minute
 )
 
-// formatAuthToken formats the Time as a signed string using HMAC.
-func formatAuthToken(key []byte, t time.Time) string {
+// formatAuthToken formats the session ID and Time as a signed string
+// using HMAC.
+func formatAuthToken(key []byte, sid string, t time.Time) string {
 	bt, _ := t.UTC().MarshalBinary()
+	b := append(append([]byte{byte(len(bt))}, bt...), byte(len(sid)))
+	b = append(b, sid...)
 	mac := hmac.New(sha256.New, key)
-	mac.Write(bt)
-	return fmt.Sprintf("%02x%x%x", len(bt), bt, mac.Sum(nil))
+	mac.Write(b)
+	return fmt.Sprintf("%x%x", b, mac.Sum(nil))
 }
 
-// parseAuthToken parses and validates an encoded Time.
+// parseAuthToken parses and validates an encoded session ID and Time.
 // If this is an invalid token, then a zero time is returned.
-func parseAuthToken(key []byte, s string) time.Time {
+func parseAuthToken(key []byte, s string) (sid string, t time.Time) {
 	b, err := hex.DecodeString(s)
-	if len(b) == 0 || int(b[0]) > len(b[1:]) || err != nil {
-		return time.Time{}
+	if len(b) == 0 || int(b[0]) >= len(b[1:]) || err != nil {
+		return "", time.Time{}
+	}
+	n := 1 + int(b[0])
+	m := n + 1 + int(b[n])
+	if m > len(b) {
+		return "", time.Time{}
 	}
-	bt, bmac := b[1:1+b[0]], b[1+b[0]:]
+	bt, bsid, bmac := b[1:n], b[n+1:m], b[m:]
 	mac := hmac.New(sha256.New, key)
-	mac.Write(bt)
-	var t time.Time
+	mac.Write(b[:m])
 	err = t.UnmarshalBinary(bt)
 	if !hmac.Equal(mac.Sum(nil), bmac) || err != nil {
-		return time.Time{}
+		return "", time.Time{}
+	}
+	return string(bsid), t
+}
+
+// authKey returns the key used to sign authentication tokens.
+// It returns nil if authentication is disabled.
+func (pg *playground) authKey() []byte {
+	switch {
+	case pg.pw != nil:
+		return pg.pw.AuthKey()
+	case pg.oidc != nil:
+		// Derive the key from the client secret so that tokens remain
+		// valid across restarts without having a password set.
+		k := sha256.Sum256([]byte("playground-auth:" + pg.oidc.clientSecret))
+		return k[:]
+	default:
+		return nil
 	}
-	return t
 }
 
 func (pg *playground) isAuthenticated(w http.ResponseWriter, r *http.Request) bool {
-	if pg.pwHash == [sha256.Size]byte{} {
-		return true // No password set
+	key := pg.authKey()
+	if key == nil {
+		return true // No password or OIDC provider set
+	}
+	if r.Header.Get("Authorization") != "" {
+		return pg.isTokenAuthenticated(r)
 	}
 	for _, c := range r.Cookies() {
 		if c.Name == "auth" {
-			t := parseAuthToken(pg.pwHash[:], c.Value)
+			sid, t := parseAuthToken(key, c.Value)
 			if t.IsZero() {
 				return false
 			}
@@ -195,8 +546,19 @@ func (pg *playground) isAuthenticated(w http.ResponseWriter, r *http.Request) bo
 			if d > authExpirePeriod {
 				return false
 			}
+
+			// Ensure that the session has not been revoked.
+			s, err := pg.store(r).LookupSession(sid)
+			if err != nil {
+				if err != errNotFound {
+					pg.logf(r, "session lookup error: %v", err)
+				}
+				return false
+			}
 			if d > authRefreshPeriod {
-				pg.refreshAuth(w, r)
+				if err := pg.refreshAuth(w, r, s); err != nil {
+					pg.logf(r, "session refresh error: %v", err)
+				}
 			}
 			return true
 		}
@@ -204,29 +566,57 @@ func (pg *playground) isAuthenticated(w http.ResponseWriter, r *http.Request) bo
 	return false
 }
 
-func (pg *playground) refreshAuth(w http.ResponseWriter, r *http.Request) {
+// refreshAuth records that the session was refreshed and
+// issues a new auth cookie for the session.
+func (pg *playground) refreshAuth(w http.ResponseWriter, r *http.Request, s session) error {
+	now := time.Now().UTC()
+	s.Refreshed = now
+	if err := pg.store(r).PutSession(s); err != nil {
+		return err
+	}
 	http.SetCookie(w, &http.Cookie{
 		Name:    "auth",
-		Value:   formatAuthToken(pg.pwHash[:], time.Now().UTC()),
-		Path:    "/",
-		Expires: time.Now().Add(authExpirePeriod),
+		Value:   formatAuthToken(pg.authKey(), s.ID, now),
+		Path:    pg.basePath + "/",
+		Expires: now.Add(authExpirePeriod),
 		MaxAge:  int(authExpirePeriod / time.Second),
 		Secure:  r.TLS != nil,
 	})
+	return nil
 }
 
 func (pg *playground) serveLogin(w http.ResponseWriter, r *http.Request) {
 	switch {
 	case matchRequest(r, reLogin, "POST"):
 		b, _ := ioutil.ReadAll(r.Body)
-		if h := sha256.Sum256(append(pg.pwSalt[:], b...)); h == pg.pwHash {
-			pg.refreshAuth(w, r)
+		if pg.pw != nil && pg.pw.Verify(b) {
+			if err := pg.login(w, r, ""); err != nil {
+				httpError(w, r, err.Error(), http.StatusInternalServerError)
+				pg.logf(r, "session creation error: %v", err)
+				return
+			}
 			w.WriteHeader(http.StatusOK)
-			pg.log.Printf("authentication success for client at %s", remoteAddr(r))
+			pg.logf(r, "authentication success for client at %s", pg.remoteAddr(r))
+			if pg.pw.IsLegacy() {
+				pg.pwUpgrade.Do(func() {
+					if h, err := newPasswordHash(b); err == nil {
+						pg.logf(r, "PasswordHash uses the legacy SHA-256 format; "+
+							"replace it with %q and remove PasswordSalt", h)
+					}
+				})
+			}
 			return
 		}
-		http.Error(w, "unauthorized", http.StatusUnauthorized)
-		pg.log.Printf("authentication failure for client at %s", remoteAddr(r))
+		httpError(w, r, "unauthorized", http.StatusUnauthorized)
+		pg.logf(r, "authentication failure for client at %s", pg.remoteAddr(r))
+		return
+	case matchRequest(r, reLoginOIDC, "GET") && pg.oidc != nil:
+		pg.serveLoginOIDC(w, r)
+		return
+	case (matchRequest(r, reLogin, "GET") || matchRequest(r, reRoot, "GET")) &&
+		pg.pw == nil && pg.oidc != nil:
+		// Without a password, the OIDC provider is the only way to login.
+		http.Redirect(w, r, pg.basePath+"/login/oidc", http.StatusFound)
 		return
 	case matchRequest(r, reLogin, "GET") ||
 		matchRequest(r, reRoot, "GET"):
@@ -234,9 +624,76 @@ func (pg *playground) serveLogin(w http.ResponseWriter, r *http.Request) {
 		pg.serveStatic(w, r)
 		return
 	default:
-		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		httpError(w, r, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+}
+
+// serveLoginOIDC performs authentication using the OIDC provider.
+// A request to "/login/oidc" redirects the user to the provider, which later
+// redirects the user back to "/login/oidc/callback" with an authorization code.
+func (pg *playground) serveLoginOIDC(w http.ResponseWriter, r *http.Request) {
+	baseURL := pg.baseURL(r)
+	redirectURL := baseURL + pg.basePath + "/login/oidc/callback"
+
+	if !strings.HasSuffix(r.URL.Path, "/callback") {
+		var b [16]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			httpError(w, r, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		state := hex.EncodeToString(b[:])
+		authURL, err := pg.oidc.AuthURL(r.Context(), redirectURL, state)
+		if err != nil {
+			pg.logf(r, "OIDC discovery error: %v", err)
+			httpError(w, r, err.Error(), http.StatusBadGateway)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{
+			Name:     "oidc_state",
+			Value:    state,
+			Path:     pg.basePath + "/login/oidc",
+			MaxAge:   int(oidcStatePeriod / time.Second),
+			Secure:   strings.HasPrefix(baseURL, "https:"),
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+		http.Redirect(w, r, authURL, http.StatusFound)
+		return
+	}
+
+	// Verify that the callback belongs to a login initiated by this client.
+	c, err := r.Cookie("oidc_state")
+	q := r.URL.Query()
+	if err != nil || c.Value == "" || !hmac.Equal([]byte(c.Value), []byte(q.Get("state"))) {
+		httpError(w, r, "invalid OIDC state", http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{Name: "oidc_state", Path: pg.basePath + "/login/oidc", MaxAge: -1})
+	if e := q.Get("error"); e != "" {
+		httpError(w, r, "OIDC error: "+e, http.StatusUnauthorized)
+		pg.logf(r, "OIDC authentication failure for client at %s: %s", pg.remoteAddr(r), e)
+		return
+	}
+
+	email, err := pg.oidc.Authenticate(r.Context(), redirectURL, q.Get("code"))
+	switch {
+	case err == errOIDCForbidden:
+		httpError(w, r, "forbidden", http.StatusForbidden)
+		pg.logf(r, "OIDC authentication denied for %q at %s", email, pg.remoteAddr(r))
+		return
+	case err != nil:
+		httpError(w, r, "unauthorized", http.StatusUnauthorized)
+		pg.logf(r, "OIDC authentication failure for client at %s: %v", pg.remoteAddr(r), err)
+		return
+	}
+	if err := pg.login(w, r, email); err != nil {
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		pg.logf(r, "session creation error: %v", err)
 		return
 	}
+	pg.logf(r, "OIDC authentication success for %q at %s", email, pg.remoteAddr(r))
+	http.Redirect(w, r, pg.basePath+"/", http.StatusFound)
 }
 
 // serveListing provides an endpoint to return information about snippets.
@@ -246,11 +703,28 @@ func (pg *playground) serveLogin(w http.ResponseWriter, r *http.Request) {
 //	* query: string - The query value to use. This a JSON representation of
 //		a snippet. The fields that matter is dependent on the queryBy mode.
 //	* queryBy: string - Determines the type of query to perform
-//		(must be of "id", "modified", of "name") and defaults to "id".
+//		(must be of "id", "modified", "created", "name", "starred", or "regex")
+//		and defaults to "id". The "created" mode is like the "modified" mode,
+//		but for the creation time of snippets. The "starred" mode lists starred snippets first and
+//		ignores the query. The "regex" mode lists snippets in ascending order
+//		by ID whose code has lines matching the regular expression in the
+//		"code" field of the query. Each snippet has a "matches" field with
+//		the "line" number and "text" of up to 10 matching lines.
+//	* sort: string - Sorts the results of the query by "id", "name",
+//		"created", or "modified" in ascending order, or in descending order
+//		if prefixed by "-" (e.g., "-modified"). Snippets with equal values
+//		are sorted by ID. By default, the results are in the order of
+//		the queryBy mode.
 //	* limit: int - Determines the maximum number of snippet records to return.
 //		Default value is 100.
 //	* allFields: bool - Controls whether all snippets fields are shown.
 //		Default is false; which means, the "code" field will be absent.
+//	* cursor: string - An opaque token used to page through the results.
+//		It cannot be combined with query, queryBy, or sort since the token
+//		encapsulates them. If present (even if empty), the response is a
+//		JSON dict with a "snippets" field holding the list of snippets and
+//		a "next_cursor" field holding the token for the next page.
+//		The "next_cursor" field is absent on the last page.
 //
 // To get a JSON dump of all snippets, use the following query:
 //	?queryBy=id&limit=-1&allFields=true
@@ -258,61 +732,254 @@ func (pg *playground) serveListing(w http.ResponseWriter, r *http.Request) {
 	// Parse out the query parameters.
 	var query snippet
 	queryBy := "id"
+	var sortBy string
 	limit := 100
 	allFields := false
+	var cursor string
+	var hasQuery, hasCursor bool
 	for k, v := range r.URL.Query() {
 		var err error
 		switch k {
 		case "query":
 			err = json.Unmarshal([]byte(v[0]), &query)
+			hasQuery = true
 		case "queryBy":
 			queryBy = v[0]
-			if queryBy != "modified" && queryBy != "id" && queryBy != "name" {
+			if !isValidQueryBy(queryBy) {
 				err = fmt.Errorf("invalid queryBy value: %v", queryBy)
 			}
+			hasQuery = true
+		case "sort":
+			sortBy = v[0]
+			if !isValidSort(sortBy) {
+				err = fmt.Errorf("invalid sort value: %v", sortBy)
+			}
+			hasQuery = true
 		case "limit":
 			limit, err = strconv.Atoi(v[0])
 		case "allFields":
 			allFields, err = strconv.ParseBool(v[0])
+		case "cursor":
+			cursor, hasCursor = v[0], true
 		default:
 			err = fmt.Errorf("unknown query field: %v", k)
 		}
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			httpError(w, r, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Resume the query from the cursor.
+	var offset int
+	if cursor != "" {
+		if hasQuery {
+			httpError(w, r, "cursor cannot be combined with query, queryBy, or sort", http.StatusBadRequest)
+			return
+		}
+		c, err := decodeListCursor(cursor)
+		if err != nil {
+			httpError(w, r, err.Error(), http.StatusBadRequest)
 			return
 		}
+		queryBy, sortBy, offset = c.QueryBy, c.Sort, c.Offset
+		query = snippet{ID: c.ID, Created: c.Created, Modified: c.Modified, Name: c.Name, Code: c.Code}
 	}
 
 	// Perform the query operation upon the snippet database.
+	// The name and starred modes have no natural continuation point,
+	// so results from prior pages are skipped over instead.
+	// Sorted results need all results of the query, so are paged likewise.
+	n := limit
+	if offset > 0 && limit >= 0 {
+		n = offset + limit
+	}
+	if sortBy != "" {
+		n = -1
+	}
 	var ss []snippet
+	var matches [][]lineMatch
 	var err error
 	switch queryBy {
 	case "modified":
-		ss, err = pg.sdb.QueryByModified(query.Modified, query.ID, limit)
+		ss, err = pg.store(r).QueryByModified(query.Modified, query.ID, n)
+	case "created":
+		ss, err = pg.store(r).QueryByCreated(query.Created, query.ID, n)
 	case "id":
-		ss, err = pg.sdb.QueryByID(query.ID, limit)
+		ss, err = pg.store(r).QueryByID(query.ID, n)
 	case "name":
-		ss, err = pg.sdb.QueryByName(query.Name, limit)
+		ss, err = pg.store(r).QueryByName(query.Name, n)
+	case "starred":
+		ss, err = pg.store(r).QueryByStarred(n)
+	case "regex":
+		ss, matches, err = searchCode(r.Context(), pg.store(r), query.Code, query.ID, n)
 	}
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		status := http.StatusInternalServerError
+		if _, ok := err.(requestError); ok {
+			status = http.StatusBadRequest
+		} else if err == errSearchTimeout {
+			status = http.StatusServiceUnavailable
+		}
+		httpError(w, r, err.Error(), status)
 		return
 	}
+	if sortBy != "" {
+		sortSnippets(ss, matches, sortBy)
+	}
+	if offset > len(ss) {
+		offset = len(ss)
+	}
+	ss = ss[offset:]
+	if matches != nil {
+		matches = matches[offset:]
+	}
+	if limit >= 0 && len(ss) > limit {
+		ss = ss[:limit]
+	}
 
 	// Apply fields filter.
 	if !allFields {
 		for i := range ss {
-			ss[i].Code = ""
+			ss[i].Code, ss[i].Files = "", nil
 		}
 	}
 
 	// Compose and write the JSON snippets.
+	if ss == nil && hasCursor {
+		ss = []snippet{}
+	}
+	var results interface{} = ss
+	if queryBy == "regex" {
+		rs := make([]searchResult, len(ss))
+		for i := range ss {
+			rs[i] = searchResult{ss[i], matches[i]}
+		}
+		results = rs
+	}
 	w.Header().Set("Content-Type", "application/json")
-	b, _ := json.Marshal(ss)
+	if !hasCursor {
+		b, _ := json.Marshal(results)
+		w.Write(b)
+		return
+	}
+	var next string
+	if limit > 0 && len(ss) == limit {
+		c := listCursor{QueryBy: queryBy, Sort: sortBy}
+		switch last := ss[len(ss)-1]; {
+		case sortBy != "":
+			c.ID, c.Created, c.Modified = query.ID, query.Created, query.Modified
+			c.Name, c.Code = query.Name, query.Code
+			c.Offset = offset + len(ss)
+		case queryBy == "modified":
+			c.ID, c.Modified = last.ID, last.Modified
+		case queryBy == "created":
+			c.ID, c.Created = last.ID, last.Created
+		case queryBy == "id":
+			c.ID = last.ID
+		case queryBy == "name":
+			c.Name, c.Offset = query.Name, offset+len(ss)
+		case queryBy == "starred":
+			c.Offset = offset + len(ss)
+		case queryBy == "regex":
+			c.ID, c.Code = last.ID, query.Code
+		}
+		next = c.encode()
+	}
+	b, _ := json.Marshal(struct {
+		Snippets   interface{} `json:"snippets"`
+		NextCursor string      `json:"next_cursor,omitempty"`
+	}{results, next})
 	w.Write(b)
 }
 
+func isValidQueryBy(s string) bool {
+	return s == "modified" || s == "created" || s == "id" || s == "name" || s == "starred" || s == "regex"
+}
+
+func isValidSort(s string) bool {
+	switch strings.TrimPrefix(s, "-") {
+	case "id", "name", "created", "modified":
+		return true
+	}
+	return false
+}
+
+// sortSnippets sorts ss in the order given by the sort URL query parameter
+// of serveListing. If non-nil, the matches of each snippet are sorted along.
+func sortSnippets(ss []snippet, matches [][]lineMatch, order string) {
+	desc := strings.HasPrefix(order, "-")
+	sort.Stable(snippetSorter{ss, matches, func(a, b *snippet) bool {
+		if desc {
+			a, b = b, a
+		}
+		switch strings.TrimPrefix(order, "-") {
+		case "name":
+			if a.Name != b.Name {
+				return a.Name < b.Name
+			}
+		case "created":
+			if !a.Created.Equal(b.Created) {
+				return a.Created.Before(b.Created)
+			}
+		case "modified":
+			if !a.Modified.Equal(b.Modified) {
+				return a.Modified.Before(b.Modified)
+			}
+		}
+		return a.ID < b.ID
+	}})
+}
+
+type snippetSorter struct {
+	ss      []snippet
+	matches [][]lineMatch
+	less    func(a, b *snippet) bool
+}
+
+func (s snippetSorter) Len() int           { return len(s.ss) }
+func (s snippetSorter) Less(i, j int) bool { return s.less(&s.ss[i], &s.ss[j]) }
+func (s snippetSorter) Swap(i, j int) {
+	s.ss[i], s.ss[j] = s.ss[j], s.ss[i]
+	if s.matches != nil {
+		s.matches[i], s.matches[j] = s.matches[j], s.matches[i]
+	}
+}
+
+// listCursor is the state needed to resume a listing of snippets.
+// It is encoded as an opaque token for clients.
+type listCursor struct {
+	QueryBy  string    `json:"b"`
+	ID       int64     `json:"i,omitempty"`
+	Created  time.Time `json:"t"`
+	Modified time.Time `json:"m"`
+	Name     string    `json:"n,omitempty"`
+	Code     string    `json:"c,omitempty"`
+	Sort     string    `json:"s,omitempty"`
+	Offset   int       `json:"o,omitempty"`
+}
+
+func (c listCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeListCursor(s string) (c listCursor, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err == nil {
+		err = json.Unmarshal(b, &c)
+	}
+	if err != nil || !isValidQueryBy(c.QueryBy) || (c.Sort != "" && !isValidSort(c.Sort)) || c.Offset < 0 {
+		return listCursor{}, fmt.Errorf("invalid cursor: %v", s)
+	}
+	return c, nil
+}
+
 // serveSnippet provides an endpoint to perform CRUD operations on a snippet.
+//
+// Retrieving or updating a snippet reports its entity tag in the ETag header.
+// An update with an If-Match header fails with status 412 if the snippet
+// was modified since, so that concurrent editors do not clobber each other.
 func (pg *playground) serveSnippet(w http.ResponseWriter, r *http.Request) {
 	var err error
 
@@ -322,7 +989,7 @@ func (pg *playground) serveSnippet(w http.ResponseWriter, r *http.Request) {
 		ss := strings.Split(r.URL.Path, "/")
 		id, err = strconv.ParseInt(ss[len(ss)-1], 10, 64)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			httpError(w, r, err.Error(), http.StatusBadRequest)
 			return
 		}
 	}
@@ -332,11 +999,11 @@ func (pg *playground) serveSnippet(w http.ResponseWriter, r *http.Request) {
 	if r.Method == "PUT" || r.Method == "POST" {
 		b, err := ioutil.ReadAll(r.Body)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			httpError(w, r, err.Error(), http.StatusInternalServerError)
 			return
 		}
 		if err := json.Unmarshal(b, &s); err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			httpError(w, r, err.Error(), http.StatusBadRequest)
 			return
 		}
 	}
@@ -344,17 +1011,19 @@ func (pg *playground) serveSnippet(w http.ResponseWriter, r *http.Request) {
 	// Perform the CRUD operation.
 	switch r.Method {
 	case "POST":
-		s.ID, err = pg.sdb.Create(s)
-		pg.log.Printf("created snippet %d", s.ID)
+		s.ID, err = pg.store(r).Create(s)
+		pg.logf(r, "created snippet %d", s.ID)
 	case "GET":
-		s, err = pg.sdb.Retrieve(id)
-		pg.log.Printf("retrieved snippet %d", id)
+		s, err = pg.store(r).Retrieve(id)
+		pg.logf(r, "retrieved snippet %d", id)
 	case "PUT":
-		err = pg.sdb.Update(s, id)
-		pg.log.Printf("updated snippet %d", id)
+		pg.updateMu.Lock()
+		err = pg.updateSnippet(w, r, s, id)
+		pg.updateMu.Unlock()
+		pg.logf(r, "updated snippet %d", id)
 	case "DELETE":
-		err = pg.sdb.Delete(id)
-		pg.log.Printf("deleted snippet %d", id)
+		err = pg.store(r).Delete(id)
+		pg.logf(r, "deleted snippet %d", id)
 	}
 	if err != nil {
 		status := http.StatusInternalServerError
@@ -362,26 +1031,544 @@ func (pg *playground) serveSnippet(w http.ResponseWriter, r *http.Request) {
 			status = http.StatusBadRequest
 		} else if err == errNotFound {
 			status = http.StatusNotFound
+		} else if err == errPreconditionFailed {
+			status = http.StatusPreconditionFailed
 		}
-		http.Error(w, err.Error(), status)
+		httpError(w, r, err.Error(), status)
 		return
 	}
+	switch r.Method {
+	case "POST":
+		pg.audit(r, auditCreate, s.ID, "")
+	case "PUT":
+		pg.audit(r, auditUpdate, id, "")
+	case "DELETE":
+		pg.audit(r, auditDelete, id, "")
+	}
 
 	// Compose and write the JSON snippet.
 	if r.Method == "POST" || r.Method == "GET" {
+		if r.Method == "GET" {
+			w.Header().Set("ETag", snippetETag(s))
+		}
 		w.Header().Set("Content-Type", "application/json")
 		b, _ := json.Marshal(s)
 		w.Write(b)
 	}
 }
 
+// errPreconditionFailed indicates that the If-Match header of a request
+// does not match the current entity tag of a snippet.
+// This error can be converted to an HTTP status 412 code.
+var errPreconditionFailed = errors.New("snippet was modified concurrently")
+
+// updateSnippet updates the snippet at the given ID if it matches the
+// If-Match header of the request, if any, and sets the ETag header of the
+// response to the entity tag of the updated snippet.
+// It must be called with updateMu held.
+func (pg *playground) updateSnippet(w http.ResponseWriter, r *http.Request, s snippet, id int64) error {
+	db := pg.store(r)
+	if im := r.Header.Get("If-Match"); im != "" {
+		s2, err := db.Retrieve(id)
+		if err != nil {
+			return err
+		}
+		if !matchETag(im, snippetETag(s2)) {
+			return errPreconditionFailed
+		}
+	}
+	if err := db.Update(s, id); err != nil {
+		return err
+	}
+	s2, err := db.Retrieve(id)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", snippetETag(s2))
+	return nil
+}
+
+// snippetFiles returns the data files attached to the snippet with the
+// given ID. A snippet that no longer exists has none.
+func (pg *playground) snippetFiles(snippetID int64) (map[string][]byte, error) {
+	s, err := pg.sdb.Retrieve(snippetID)
+	if err == errNotFound {
+		return nil, nil
+	}
+	return s.Files, err
+}
+
+// snippetETag returns the strong entity tag of a snippet, which changes
+// whenever the name or code of the snippet is updated.
+func snippetETag(s snippet) string {
+	return `"` + strconv.FormatInt(s.Modified.UnixNano(), 36) + `"`
+}
+
+// matchETag reports whether the value of an If-Match header matches etag.
+func matchETag(header, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		if v = strings.TrimSpace(v); v == "*" || v == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// exportBatchSize is the number of snippets read from the database at a time
+// when exporting all snippets.
+const exportBatchSize = 100
+
+// serveExport provides an endpoint to export all snippets with all fields.
+//
+// The endpoint supports the "format" URL query parameter, which must be
+// either "json" (the default) or "zip". The JSON format is a list of snippets.
+// The zip format is an archive containing a "snippets.json" file with the list
+// of snippets (without the "code" field) and the code of each snippet in a
+// separate "<id>.go" file.
+//
+// The output of this endpoint is suitable for the import endpoint.
+func (pg *playground) serveExport(w http.ResponseWriter, r *http.Request) {
+	format := "json"
+	for k, v := range r.URL.Query() {
+		switch k {
+		case "format":
+			format = v[0]
+			if format != "json" && format != "zip" {
+				httpError(w, r, fmt.Sprintf("invalid format value: %v", format), http.StatusBadRequest)
+				return
+			}
+		default:
+			httpError(w, r, fmt.Sprintf("unknown query field: %v", k), http.StatusBadRequest)
+			return
+		}
+	}
+
+	switch format {
+	case "json":
+		w.Header().Set("Content-Type", "application/json")
+	case "zip":
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", `attachment; filename="snippets.zip"`)
+	}
+	n, err := writeExport(w, pg.store(r), format)
+	if err != nil {
+		pg.logf(r, "unexpected export error: %v", err)
+		return // The client will see a truncated response
+	}
+	pg.logf(r, "exported %d snippets", n)
+}
+
+// writeExport writes all snippets in db to w in the given format
+// (either "json" or "zip") and reports the number of snippets written.
+// The snippets are streamed in batches to avoid holding them all in memory.
+func writeExport(w io.Writer, db snippetStore, format string) (n int, err error) {
+	var zw *zip.Writer
+	var metas []snippet
+	switch format {
+	case "json":
+		if _, err := w.Write([]byte("[")); err != nil {
+			return n, err
+		}
+	case "zip":
+		zw = zip.NewWriter(w)
+	}
+	var lastID int64
+	for {
+		ss, err := db.QueryByID(lastID, exportBatchSize)
+		if err != nil {
+			return n, err
+		}
+		for _, s := range ss {
+			switch format {
+			case "json":
+				if n > 0 {
+					w.Write([]byte(","))
+				}
+				b, _ := json.Marshal(s)
+				if _, err := w.Write(b); err != nil {
+					return n, err
+				}
+			case "zip":
+				f, err := zw.Create(fmt.Sprintf("%d.go", s.ID))
+				if err != nil {
+					return n, err
+				}
+				f.Write([]byte(s.Code))
+				s.Code = ""
+				metas = append(metas, s)
+			}
+			lastID = s.ID
+			n++
+		}
+		if len(ss) < exportBatchSize {
+			break
+		}
+	}
+	if format == "zip" {
+		f, err := zw.Create("snippets.json")
+		if err != nil {
+			return n, err
+		}
+		b, _ := json.MarshalIndent(metas, "", "\t")
+		f.Write(b)
+		return n, zw.Close()
+	}
+	_, err = w.Write([]byte("]"))
+	return n, err
+}
+
+// serveImport provides an endpoint to import snippets produced by the
+// export endpoint. If the Content-Type is "application/zip", then the body
+// is parsed as a zip archive, otherwise it is parsed as a JSON list.
+//
+// Every imported snippet is assigned a new ID, while the created and
+// modified times are preserved. The response is a JSON dict mapping the
+// old IDs to the new IDs.
+func (pg *playground) serveImport(w http.ResponseWriter, r *http.Request) {
+	b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxImportSize))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if int64(len(b)) >= maxImportSize {
+			status = http.StatusRequestEntityTooLarge
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+
+	var ss []snippet
+	if r.Header.Get("Content-Type") == "application/zip" {
+		ss, err = parseExportZip(b)
+	} else {
+		err = json.Unmarshal(b, &ss)
+	}
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ids, err := pg.store(r).Import(ss)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(requestError); ok {
+			status = http.StatusBadRequest
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+	pg.logf(r, "imported %d snippets", len(ids))
+	for _, id := range ids {
+		pg.audit(r, auditCreate, id, "")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	b, _ = json.Marshal(ids)
+	w.Write(b)
+}
+
+// serveBatch provides an endpoint to apply operations to many snippets at once.
+// The request body is a JSON list of operations, each of which is a dict with
+// an "op" field and an "ids" field holding the IDs of the snippets to apply
+// the operation to. The operation is either "delete", "star", or "unstar".
+// The operations are applied in order within a single transaction, so that
+// either all of them are applied or none are.
+func (pg *playground) serveBatch(w http.ResponseWriter, r *http.Request) {
+	b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBatchSize))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if int64(len(b)) >= maxBatchSize {
+			status = http.StatusRequestEntityTooLarge
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+	var ops []batchOp
+	if err := json.Unmarshal(b, &ops); err != nil {
+		httpError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := pg.store(r).Batch(ops); err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(requestError); ok {
+			status = http.StatusBadRequest
+		} else if err == errNotFound {
+			status = http.StatusNotFound
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+	for _, op := range ops {
+		pg.logf(r, "batch %s of %d snippets", op.Op, len(op.IDs))
+		for _, id := range op.IDs {
+			if op.Op == "delete" {
+				pg.audit(r, auditDelete, id, "")
+			} else {
+				pg.audit(r, auditStar, id, "")
+			}
+		}
+	}
+}
+
+// maxBatchSize is the maximum size of the request body of a batch operation.
+const maxBatchSize = 1 << 20
+
+// maxImportSize is the maximum size of the request body of an import and
+// maxImportDataSize is the maximum total size of the files decompressed from
+// an imported zip archive. They are variables so that tests can shrink them.
+var (
+	maxImportSize     int64 = 64 << 20
+	maxImportDataSize int64 = 256 << 20
+)
+
+// parseExportZip parses a zip archive produced by serveExport.
+// The total size of the decompressed files may not exceed maxImportDataSize.
+func parseExportZip(b []byte) ([]snippet, error) {
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]*zip.File)
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	remaining := maxImportDataSize
+	readFile := func(name string) ([]byte, error) {
+		f, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("missing file in archive: %s", name)
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		b, err := ioutil.ReadAll(io.LimitReader(rc, remaining+1))
+		if err != nil {
+			return nil, err
+		}
+		if remaining -= int64(len(b)); remaining < 0 {
+			return nil, fmt.Errorf("archive too large: exceeds %d bytes uncompressed", maxImportDataSize)
+		}
+		return b, nil
+	}
+
+	b, err = readFile("snippets.json")
+	if err != nil {
+		return nil, err
+	}
+	var ss []snippet
+	if err := json.Unmarshal(b, &ss); err != nil {
+		return nil, err
+	}
+	for i, s := range ss {
+		if s.Code != "" {
+			continue
+		}
+		b, err := readFile(fmt.Sprintf("%d.go", s.ID))
+		if err != nil {
+			return nil, err
+		}
+		ss[i].Code = string(b)
+	}
+	return ss, nil
+}
+
+// serveStar provides an endpoint to toggle whether a snippet is starred.
+// The response is the updated JSON snippet.
+func (pg *playground) serveStar(w http.ResponseWriter, r *http.Request) {
+	ss := strings.Split(r.URL.Path, "/")
+	id, err := strconv.ParseInt(ss[len(ss)-2], 10, 64)
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s, err := pg.store(r).ToggleStarred(id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err == errNotFound {
+			status = http.StatusNotFound
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+	pg.logf(r, "toggled star on snippet %d (starred: %v)", id, s.Starred)
+	pg.audit(r, auditStar, id, "")
+
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(s)
+	w.Write(b)
+}
+
+// serveCompact provides an endpoint to compact the snippet database.
+// The response is a JSON dict with the "before" and "after" sizes of the
+// database in bytes, and the number of bytes "reclaimed".
+func (pg *playground) serveCompact(w http.ResponseWriter, r *http.Request) {
+	before, after, err := pg.store(r).Compact()
+	if err != nil {
+		pg.logf(r, "compact error: %v", err)
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pg.logf(r, "compacted database from %d to %d bytes", before, after)
+
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(struct {
+		Before    int64 `json:"before"`
+		After     int64 `json:"after"`
+		Reclaimed int64 `json:"reclaimed"`
+	}{before, after, before - after})
+	w.Write(b)
+}
+
+// serveGist provides an endpoint to export a snippet to a GitHub Gist.
+// The first export of a snippet creates a new Gist, while subsequent exports
+// update the same Gist. The response is a JSON dict with the "id" and "url"
+// fields of the Gist.
+func (pg *playground) serveGist(w http.ResponseWriter, r *http.Request) {
+	if pg.gist == nil {
+		httpError(w, r, "gist export is not configured", http.StatusNotImplemented)
+		return
+	}
+
+	// Parse out the ID.
+	ss := strings.Split(r.URL.Path, "/")
+	id, err := strconv.ParseInt(ss[len(ss)-2], 10, 64)
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s, err := pg.store(r).Retrieve(id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err == errNotFound {
+			status = http.StatusNotFound
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+
+	// Upload the snippet, creating a new Gist if the old one was deleted.
+	gistID, gistURL, err := pg.gist.Upload(r.Context(), s.Gist, s)
+	if err == errGistNotFound {
+		gistID, gistURL, err = pg.gist.Upload(r.Context(), "", s)
+	}
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusBadGateway)
+		return
+	}
+	if gistID != s.Gist {
+		if err := pg.store(r).SetGist(id, gistID); err != nil {
+			httpError(w, r, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	pg.logf(r, "exported snippet %d to gist %s", id, gistID)
+
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(map[string]string{"id": gistID, "url": gistURL})
+	w.Write(b)
+}
+
+// maxPlayShareSize is the maximum size of a snippet fetched from the upstream
+// Go playground.
+const maxPlayShareSize = 1 << 20
+
+// servePlayShare provides an endpoint to create a new snippet from a snippet
+// shared on the upstream Go playground (e.g., https://go.dev/play/p/HASH).
+// The response is the JSON snippet created.
+func (pg *playground) servePlayShare(w http.ResponseWriter, r *http.Request) {
+	hash := path.Base(r.URL.Path)
+
+	// Fetch the shared snippet from the upstream playground.
+	req, err := http.NewRequest("GET", pg.playURL+"/_/share?id="+hash, nil)
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(r.Context()))
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		httpError(w, r, fmt.Sprintf("shared snippet not found: %s", hash), http.StatusNotFound)
+		return
+	default:
+		httpError(w, r, fmt.Sprintf("unexpected upstream status: %s", resp.Status), http.StatusBadGateway)
+		return
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxPlayShareSize+1))
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusBadGateway)
+		return
+	}
+	if len(b) > maxPlayShareSize {
+		httpError(w, r, "shared snippet too large", http.StatusBadGateway)
+		return
+	}
+
+	// Create the local snippet.
+	s := snippet{Name: "go.dev/play/p/" + hash, Code: string(b)}
+	s.ID, err = pg.store(r).Create(s)
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if s, err = pg.store(r).Retrieve(s.ID); err != nil {
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pg.logf(r, "created snippet %d from shared snippet %s", s.ID, hash)
+	pg.audit(r, auditCreate, s.ID, "")
+
+	w.Header().Set("Content-Type", "application/json")
+	b, _ = json.Marshal(s)
+	w.Write(b)
+}
+
+// Websocket keepalive parameters. The server pings the client every
+// websocketPingPeriod and considers the client dead if nothing is received
+// within websocketPongWait. They are variables so that tests can shorten them.
+var (
+	websocketPingPeriod = 30 * time.Second
+	websocketPongWait   = 60 * time.Second
+	websocketWriteWait  = 10 * time.Second
+)
+
+// websocketBinaryProtocol is the websocket subprotocol that a client may
+// request to receive messages as binary frames instead of JSON. Each binary
+// message is the action and the raw data separated by a NUL byte, which
+// avoids the overhead of escaping large program output as JSON.
+// Messages from the client are always JSON.
+const websocketBinaryProtocol = "playground.binary"
+
+// minCompressSize is the minimum size of a websocket message that is
+// compressed if the client supports permessage-deflate.
+const minCompressSize = 512
+
 // serveWebsocket provides an endpoint that allows the client to execute
 // arbitrary Go code via WebSocket messages.
 func (pg *playground) serveWebsocket(w http.ResponseWriter, r *http.Request) {
-	upgrader := websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: 1024}
-	conn, err := upgrader.Upgrade(w, r, nil)
+	ansi, err := newANSIFilter(r.URL.Query().Get("ansi"))
 	if err != nil {
-		pg.log.Printf("unexpected websocket error: %v", err)
+		httpError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:    1024,
+		WriteBufferSize:   1024,
+		Subprotocols:      []string{websocketBinaryProtocol},
+		EnableCompression: true,
+	}
+	conn, err := upgrader.Upgrade(w, r, http.Header{"X-Request-Id": {requestID(r)}})
+	if err != nil {
+		pg.logf(r, "unexpected websocket error: %v", err)
 		return
 	}
 
@@ -393,13 +1580,28 @@ func (pg *playground) serveWebsocket(w http.ResponseWriter, r *http.Request) {
 		conn.Close()
 	}()
 
-	// Log the websocket for debugging.
-	cid := atomic.AddInt64(&pg.clientID, 1)
-	pg.log.Printf("websocket client %d at %s connected (%d active)",
-		cid, remoteAddr(r), atomic.AddInt64(&pg.numActive, +1))
-	defer func() {
-		pg.log.Printf("websocket client %d at %s disconnected (%d active)",
-			cid, remoteAddr(r), atomic.AddInt64(&pg.numActive, -1))
+	// Periodically ping the client so that a half-open connection
+	// (e.g., due to the client sleeping or a NAT timeout) is detected
+	// by the read deadline and the executor is cleaned up.
+	conn.SetReadDeadline(time.Now().Add(websocketPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(websocketPongWait))
+	})
+	go func() {
+		t := time.NewTicker(websocketPingPeriod)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				// WriteControl may be called concurrently with WriteMessage.
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(websocketWriteWait)); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
 	}()
 
 	// Abstractions of the connection to send JSON messages.
@@ -411,37 +1613,192 @@ func (pg *playground) serveWebsocket(w http.ResponseWriter, r *http.Request) {
 	recvMessage := func() (action, data string, err error) {
 		var msg jsonMessage
 		_, b, err := conn.ReadMessage()
+		if err == nil {
+			conn.SetReadDeadline(time.Now().Add(websocketPongWait))
+		}
 		json.Unmarshal(b, &msg)
 		return msg.Action, msg.Data, err
 	}
+	binary := conn.Subprotocol() == websocketBinaryProtocol
 	sendMessage := func(action, data string) error {
 		m.Lock()
 		defer m.Unlock()
-		b, _ := json.Marshal(jsonMessage{Action: action, Data: data})
-		return conn.WriteMessage(websocket.TextMessage, b)
+		data, ok := ansi.Filter(action, data)
+		if !ok {
+			return nil
+		}
+		typ, b := websocket.TextMessage, []byte(nil)
+		if binary {
+			typ, b = websocket.BinaryMessage, []byte(action+"\x00"+data)
+		} else {
+			b, _ = json.Marshal(jsonMessage{Action: action, Data: data})
+		}
+		conn.EnableWriteCompression(len(b) >= minCompressSize)
+		conn.SetWriteDeadline(time.Now().Add(websocketWriteWait))
+		return conn.WriteMessage(typ, b)
 	}
 
+	// Resume the session of a reconnecting client or start a new one.
+	// The session outlives the connection so that it may later be resumed.
+	user := pg.requestUser(r)
+	resumed := false
+	sess := pg.lookupSession(r.URL.Query().Get("resume"), user)
+	seq, _ := strconv.Atoi(r.URL.Query().Get("seq"))
+	var gen int
+	if sess != nil {
+		gen, resumed = sess.attach(sendMessage, cancel, seq)
+	}
+	if !resumed {
+		sess = pg.newSession(atomic.AddInt64(&pg.clientID, 1), user)
+		gen, _ = sess.attach(sendMessage, cancel, 0)
+	}
+	defer sess.detach(gen, func() { pg.closeSession(sess) })
+	cid, ex := sess.cid, sess.ex
+
+	// Log the websocket for debugging.
+	verb := "connected"
+	if resumed {
+		verb = "resumed"
+	}
+	pg.logf(r, "websocket client %d at %s %s (%d active)",
+		cid, pg.remoteAddr(r), verb, atomic.AddInt64(&pg.numActive, +1))
+	defer func() {
+		pg.logf(r, "websocket client %d at %s disconnected (%d active)",
+			cid, pg.remoteAddr(r), atomic.AddInt64(&pg.numActive, -1))
+	}()
+
+	// Register the client so that administrators can monitor it.
+	client := &wsClient{
+		ID:         cid,
+		RemoteAddr: pg.remoteAddr(r),
+		User:       user,
+		Connected:  time.Now().UTC(),
+		ex:         ex,
+	}
+	pg.addClient(client)
+	defer pg.removeClient(client)
+
+	// While the client watches another session, it may not run anything
+	// so that its own output does not interleave with the watched output.
+	var watched *wsSession
+	defer func() {
+		if watched != nil {
+			watched.removeWatcher(sess)
+		}
+	}()
+
+	// While the client collaborates on a snippet, runs use the executor
+	// shared by all participants of the room.
+	var room *collabRoom
+	defer func() {
+		if room != nil {
+			pg.leaveRoom(room, cid)
+		}
+	}()
+
 	// Continually accept commands from client until socket closes.
-	ex := newExecutor(pg.bs, pg.gcBin, pg.fmtBin, pg.gcBins, sendMessage)
-	defer ex.Close()
 	for {
 		action, data, err := recvMessage()
 		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				pg.logf(r, "websocket client %d stopped responding", cid)
+			}
 			return // Treat network errors as permanent
 		}
 
-		if action != clearOutput {
-			pg.log.Printf("%s action by client %d", action, cid)
+		if action != clearOutput && action != actionCheck && action != actionEdit && action != actionCursor {
+			pg.logf(r, "%s action by client %d", action, cid)
+		}
+		rex := ex
+		if room != nil {
+			rex = room.ex
 		}
 		switch action {
-		case actionRun, actionFormat:
-			ex.Start(action, data)
+		case actionRun, actionFormat, actionFormatRun, actionLint, actionReplay, actionDebug, actionSnapshot, actionRestore, actionDownload, actionUpload:
+			if watched != nil {
+				ex.sendMsg(statusUpdate, "Cannot run while watching another session.\n")
+				break
+			}
+			if action == actionRun || action == actionFormatRun || action == actionDebug {
+				pg.audit(r, auditRun, rex.Snippet(), data)
+			}
+			rex.Start(action, data)
+		case actionBreakpoint, actionContinue, actionStep, actionInspect:
+			if watched != nil {
+				ex.sendMsg(statusUpdate, "Cannot debug while watching another session.\n")
+				break
+			}
+			rex.Debug(action, data)
+		case actionHistory:
+			rex.ListHistory()
+		case actionCheck:
+			ex.Check(data)
 		case actionStop:
-			ex.Stop()
+			rex.Stop()
+		case actionSignal:
+			if watched != nil {
+				ex.sendMsg(statusUpdate, "Cannot signal while watching another session.\n")
+				break
+			}
+			rex.Signal(data)
+		case actionJoin:
+			id, err := strconv.ParseInt(data, 10, 64)
+			if err != nil {
+				ex.sendMsg(statusUpdate, fmt.Sprintf("Invalid snippet ID: %v\n", data))
+				break
+			}
+			s, err := pg.store(r).Retrieve(id)
+			if err != nil {
+				ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to join snippet: %v\n", err))
+				break
+			}
+			if room != nil {
+				pg.leaveRoom(room, cid)
+			}
+			room = pg.joinRoom(id, s.Code, sess, user)
+		case actionOpen:
+			var id int64
+			if data != "" {
+				if id, err = strconv.ParseInt(data, 10, 64); err != nil {
+					ex.sendMsg(statusUpdate, fmt.Sprintf("Invalid snippet ID: %v\n", data))
+					break
+				}
+			}
+			rex.SetSnippet(id)
+		case actionShare:
+			sess.sendMsg(actionShare, sess.watchID)
+		case actionWatch:
+			if watched != nil {
+				watched.removeWatcher(sess)
+				watched = nil
+			}
+			if data == "" {
+				break
+			}
+			w := pg.lookupWatch(data)
+			if w == nil || w == sess || !w.addWatcher(sess) {
+				ex.sendMsg(statusUpdate, "Unable to watch session: not found\n")
+				break
+			}
+			watched = w
+			pg.logf(r, "websocket client %d watching client %d", cid, w.cid)
+		case actionLeave:
+			if room != nil {
+				pg.leaveRoom(room, cid)
+				room = nil
+			}
+		case actionEdit:
+			if room != nil {
+				room.Edit(cid, data)
+			}
+		case actionCursor:
+			if room != nil {
+				room.MoveCursor(cid, data)
+			}
 		case clearOutput:
 			// Client sends this with the expectation that it is echoed back
 			// to itself after the server has responded all preceding messages.
-			sendMessage(clearOutput, "")
+			sess.sendMsg(clearOutput, "")
 		default:
 			ex.sendMsg(statusUpdate, fmt.Sprintf("Unknown action: %v\n", action))
 		}
@@ -450,13 +1807,61 @@ func (pg *playground) serveWebsocket(w http.ResponseWriter, r *http.Request) {
 
 func (pg *playground) serveStatic(w http.ResponseWriter, r *http.Request) {
 	p := strings.TrimLeft(path.Clean(r.URL.Path), "/")
-	b := staticFS[p]
-	if b == nil {
-		http.Error(w, "file not found", http.StatusNotFound)
+	f := staticFS[p]
+	if pg.staticDir != "" {
+		// Always read from disk so that frontend changes are reflected
+		// upon reloading the page, and prevent the browser from caching.
+		f = nil
+		if b, err := ioutil.ReadFile(filepath.Join(pg.staticDir, filepath.FromSlash(p))); err == nil && mimeFromPath(p) != "" {
+			f = &staticFile{data: b}
+		}
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	if pg.branding != nil && pg.branding.files[p] != nil {
+		f = pg.branding.files[p]
+	}
+	if f == nil {
+		httpError(w, r, "file not found", http.StatusNotFound)
 		return
 	}
+	if strings.HasSuffix(p, ".html") && (pg.basePath != "" || pg.branding != nil) {
+		f = pg.renderHTML(p, f)
+	}
+
+	// Serve the pre-compressed variant if the client accepts it.
+	// The entity tag differs between variants since their bytes differ.
+	body, etag := f.data, f.etag
+	if f.gzip != nil {
+		w.Header().Add("Vary", "Accept-Encoding")
+		if acceptsGzip(r.Header.Get("Accept-Encoding")) {
+			w.Header().Set("Content-Encoding", "gzip")
+			body, etag = f.gzip, strings.TrimSuffix(f.etag, `"`)+`-gzip"`
+		}
+	}
+	if etag != "" {
+		w.Header().Set("ETag", etag)
+	}
 	w.Header().Set("Content-Type", mimeFromPath(p))
-	w.Write(b)
+	http.ServeContent(w, r, p, time.Time{}, bytes.NewReader(body))
+}
+
+// renderHTML returns the HTML page at p after applying rewriteHTML.
+// The result is cached unless the page was read from staticDir.
+func (pg *playground) renderHTML(p string, f *staticFile) *staticFile {
+	if pg.staticDir != "" {
+		return newStaticFile(pg.rewriteHTML(f.data))
+	}
+	pg.htmlMu.Lock()
+	defer pg.htmlMu.Unlock()
+	if pg.htmlCache == nil {
+		pg.htmlCache = make(map[string]*staticFile)
+	}
+	if pf := pg.htmlCache[p]; pf != nil {
+		return pf
+	}
+	pf := newStaticFile(pg.rewriteHTML(f.data))
+	pg.htmlCache[p] = pf
+	return pf
 }
 
 func (pg *playground) serveDynamic(w http.ResponseWriter, r *http.Request) {
@@ -465,20 +1870,127 @@ func (pg *playground) serveDynamic(w http.ResponseWriter, r *http.Request) {
 		id = r.URL.Path[i+1:]
 	}
 	b := pg.bs.Retrieve(id)
-	if b.data == nil || b.mime == "" {
-		http.Error(w, "blob not found", http.StatusNotFound)
+	if b.data == nil {
+		httpError(w, r, "blob not found", http.StatusNotFound)
 		return
 	}
-	w.Header().Set("Content-Type", b.mime)
+	mime := b.mime
+	if mime == "" {
+		mime = scriptlessMIME(http.DetectContentType(b.data))
+	}
+	w.Header().Set("Content-Type", mime)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
 	w.Write(b.data)
 }
 
-func remoteAddr(r *http.Request) string {
-	if addr := r.Header.Get("X-Real-IP"); addr != "" {
+// serveBlobs serves a JSON list of the blobs of the websocket sessions of
+// the requester that are yet to be deleted (e.g., the reports of their last
+// runs), so that they can be found again at "/dynamic/ID".
+func (pg *playground) serveBlobs(w http.ResponseWriter, r *http.Request) {
+	type blobInfo struct {
+		reportInfo
+		Client int64 `json:"client"` // ID of the websocket client of the session
+	}
+	bis := []blobInfo{} // Marshal as an empty list rather than null
+	for _, s := range pg.userSessions(pg.requestUser(r)) {
+		for _, ri := range s.ex.Reports() {
+			bis = append(bis, blobInfo{ri, s.cid})
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(bis)
+	w.Write(b)
+}
+
+// remoteAddr returns the address of the client that made the request.
+// The X-Real-IP and X-Forwarded-For headers are only honored if the request
+// was made by a trusted proxy, since they are otherwise trivially forged.
+func (pg *playground) remoteAddr(r *http.Request) string {
+	if !pg.isTrustedProxy(r.RemoteAddr) {
+		return r.RemoteAddr
+	}
+	if addr := strings.TrimSpace(r.Header.Get("X-Real-IP")); addr != "" {
 		return addr
 	}
-	if addr := r.Header.Get("X-Forwarded-For"); addr != "" {
-		return strings.Split(addr, ",")[0]
+
+	// Each proxy appends the address it received the request from.
+	// The client is the right-most address that is not a trusted proxy.
+	addrs := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(addrs) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(addrs[i])
+		if addr != "" && (i == 0 || !pg.isTrustedProxy(addr)) {
+			return addr
+		}
 	}
 	return r.RemoteAddr
 }
+
+// baseURL returns the scheme and host that the client used to reach the
+// playground. The X-Forwarded-Proto and X-Forwarded-Host headers are only
+// honored if the request was made by a trusted proxy, since they otherwise
+// allow the client to control absolute URLs generated by the server.
+func (pg *playground) baseURL(r *http.Request) string {
+	if pg.externalURL != "" {
+		return pg.externalURL
+	}
+	scheme, host := "http", r.Host
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if pg.isTrustedProxy(r.RemoteAddr) {
+		// Each header may hold a list if there are multiple proxies,
+		// where the first entry is from the proxy closest to the client.
+		first := func(s string) string { return strings.TrimSpace(strings.Split(s, ",")[0]) }
+		if p := first(r.Header.Get("X-Forwarded-Proto")); p == "http" || p == "https" {
+			scheme = p
+		}
+		if h := first(r.Header.Get("X-Forwarded-Host")); h != "" {
+			host = h
+		}
+	}
+	return scheme + "://" + host
+}
+
+// isTrustedProxy reports whether addr (with an optional port)
+// belongs to one of the trusted proxy networks.
+func (pg *playground) isTrustedProxy(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, n := range pg.trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxies parses a list of IP addresses and CIDR networks.
+func parseTrustedProxies(ss []string) ([]*net.IPNet, error) {
+	var ns []*net.IPNet
+	for _, s := range ss {
+		if !strings.Contains(s, "/") {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid proxy address: %q", s)
+			}
+			bits := 8 * len(ip)
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 32
+			}
+			ns = append(ns, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy network: %q", s)
+		}
+		ns = append(ns, n)
+	}
+	return ns, nil
+}
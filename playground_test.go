@@ -5,8 +5,10 @@
 package main
 
 import (
+	"archive/zip"
 	"bytes"
 	"crypto/sha256"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,14 +16,20 @@ import (
 	"net"
 	"net/http"
 	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type testLogger struct{ *testing.T }
@@ -35,15 +43,21 @@ func TestPlayground(t *testing.T) {
 	}
 	defer os.RemoveAll(tmpDir)
 
+	// Use the legacy password format to ensure it remains supported.
 	pwSalt := sha256.Sum256([]byte("salt"))
 	pwHash := sha256.Sum256(append(pwSalt[:], "pass"...))
+	pw, err := parsePasswordHash(fmt.Sprintf("%x", pwHash), fmt.Sprintf("%x", pwSalt))
+	if err != nil {
+		t.Fatalf("parsePasswordHash error: %v", err)
+	}
 
 	// Create a new playground HTTP handler.
-	pg, err := newPlayground(pwHash, pwSalt, tmpDir, "go", "gofmt", nil, testLogger{t})
+	pg, err := newPlayground(pw, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
 	if err != nil {
 		t.Fatalf("newPlayground error: %v", err)
 	}
 	defer pg.Close()
+	pg.backupKeep = 2
 
 	// Listen to TCP on some ephemeral port.
 	ln, err := net.Listen("tcp", ":0")
@@ -57,6 +71,39 @@ func TestPlayground(t *testing.T) {
 	go func() { srv.Serve(ln) }()
 	defer srv.Close()
 
+	// Mock the GitHub Gist API.
+	var numGists int32
+	gistSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token secret" {
+			http.Error(w, `{"message": "Bad credentials"}`, http.StatusUnauthorized)
+			return
+		}
+		var id string
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/gists":
+			id = fmt.Sprintf("gist%d", atomic.AddInt32(&numGists, 1))
+		case r.Method == "PATCH" && r.URL.Path == "/gists/gist1":
+			id = "gist1"
+		default:
+			http.Error(w, `{"message": "Not Found"}`, http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"id": %q, "html_url": "https://gist.github.com/%s"}`, id, id)
+	}))
+	defer gistSrv.Close()
+	pg.gist = &gistClient{apiURL: gistSrv.URL, token: "secret", client: gistSrv.Client()}
+
+	// Mock the upstream Go playground.
+	playSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_/share" || r.URL.Query().Get("id") != "abc_XYZ-123" {
+			http.Error(w, "Snippet not found", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("package main\n"))
+	}))
+	defer playSrv.Close()
+	pg.playURL = playSrv.URL
+
 	mt := newMessageTester(t)
 	jar, _ := cookiejar.New(nil)
 	cln := &http.Client{Jar: jar}
@@ -81,7 +128,7 @@ func TestPlayground(t *testing.T) {
 				mt.Errorf("json.Unmarshal error: %v", err)
 			}
 			got.Created, got.Modified = time.Time{}, time.Time{}
-			if got != want {
+			if !reflect.DeepEqual(got, want) {
 				mt.Errorf("mismatching snippet: got %v, want %v", got, want)
 			}
 		}
@@ -105,6 +152,21 @@ func TestPlayground(t *testing.T) {
 	}
 
 	sf := fmt.Sprintf
+	backupChecker := func(gotType string, gotBody []byte) {
+		if wantType := "application/json"; gotType != wantType {
+			mt.Errorf("Content-Type mismatch: got %q, want %q", gotType, wantType)
+		}
+		var got backupInfo
+		if err := json.Unmarshal(gotBody, &got); err != nil {
+			mt.Errorf("json.Unmarshal error: %v", err)
+		}
+		fi, err := os.Stat(filepath.Join(tmpDir, backupDir, got.Name))
+		if err != nil {
+			mt.Errorf("os.Stat error: %v", err)
+		} else if fi.Size() != got.Size || got.Size == 0 {
+			mt.Errorf("backup size mismatch: got %d, want %d", got.Size, fi.Size())
+		}
+	}
 	httpTests := []struct {
 		label string
 
@@ -120,13 +182,13 @@ func TestPlayground(t *testing.T) {
 		url:        "/favicon.ico",
 		method:     "GET",
 		wantStatus: http.StatusOK,
-		checkBody:  bodyChecker(mimeTypes["ico"], staticFS["img/favicon.ico"]),
+		checkBody:  bodyChecker(mimeTypes["ico"], staticFS["img/favicon.ico"].data),
 	}, {
 		label:      "GetRootLogin",
 		url:        "/1",
 		method:     "GET",
 		wantStatus: http.StatusOK,
-		checkBody:  bodyChecker(mimeTypes["html"], staticFS["html/playground-login.html"]),
+		checkBody:  bodyChecker(mimeTypes["html"], staticFS["html/playground-login.html"].data),
 	}, {
 		label:      "UnauthorizedSnippets",
 		url:        "/snippets",
@@ -151,7 +213,7 @@ func TestPlayground(t *testing.T) {
 		url:        "/1",
 		method:     "GET",
 		wantStatus: http.StatusOK,
-		checkBody:  bodyChecker(mimeTypes["html"], staticFS["html/playground.html"]),
+		checkBody:  bodyChecker(mimeTypes["html"], staticFS["html/playground.html"].data),
 	}, {
 		label:      "GetDefaultSnippet",
 		url:        sf("/snippets/%d", defaultID),
@@ -279,6 +341,269 @@ func TestPlayground(t *testing.T) {
 			{ID: defaultID + 3, Name: sf("snippet%d", defaultID+3), Code: sf("code%d", defaultID+3)},
 			{ID: defaultID, Name: "Default snippet", Code: defaultCode},
 		}),
+	}, {
+		label:      "ExportInvalidFormat",
+		url:        "/snippets/export?format=tar",
+		method:     "GET",
+		wantStatus: http.StatusBadRequest,
+	}, {
+		label:      "ExportJSON",
+		url:        "/snippets/export",
+		method:     "GET",
+		wantStatus: http.StatusOK,
+		checkBody: snippetsChecker([]snippet{
+			{ID: defaultID, Name: "Default snippet", Code: defaultCode},
+			{ID: defaultID + 2, Name: sf("snippet%d", defaultID+2), Code: sf("code%da", defaultID+2)},
+			{ID: defaultID + 3, Name: sf("snippet%d", defaultID+3), Code: sf("code%d", defaultID+3)},
+		}),
+	}, {
+		label:      "ExportZip",
+		url:        "/snippets/export?format=zip",
+		method:     "GET",
+		wantStatus: http.StatusOK,
+		checkBody: func(gotType string, gotBody []byte) {
+			if wantType := "application/zip"; gotType != wantType {
+				mt.Errorf("Content-Type mismatch: got %q, want %q", gotType, wantType)
+			}
+			ss, err := parseExportZip(gotBody)
+			if err != nil {
+				mt.Errorf("parseExportZip error: %v", err)
+			}
+			if len(ss) != 3 || ss[0].Code != defaultCode {
+				mt.Errorf("mismatching snippets: got %v", ss)
+			}
+		},
+	}, {
+		label:      "ImportInvalidJSON",
+		url:        "/snippets/import",
+		method:     "POST",
+		ctype:      "application/json",
+		body:       []byte("bad JSON"),
+		wantStatus: http.StatusBadRequest,
+	}, {
+		label:      "ImportEmptyName",
+		url:        "/snippets/import",
+		method:     "POST",
+		ctype:      "application/json",
+		body:       []byte(`[{"id": 100, "name": ""}]`),
+		wantStatus: http.StatusBadRequest,
+	}, {
+		label:      "ImportJSON",
+		url:        "/snippets/import",
+		method:     "POST",
+		ctype:      "application/json",
+		body:       []byte(`[{"id": 100, "name": "imported1", "code": "code100"}, {"id": 101, "name": "imported2", "code": "code101"}]`),
+		wantStatus: http.StatusOK,
+		checkBody:  bodyChecker("application/json", []byte(sf(`{"100":%d,"101":%d}`, defaultID+4, defaultID+5))),
+	}, {
+		label:  "ImportZip",
+		url:    "/snippets/import",
+		method: "POST",
+		ctype:  "application/zip",
+		body: func() []byte {
+			var bb bytes.Buffer
+			zw := zip.NewWriter(&bb)
+			f, _ := zw.Create("snippets.json")
+			f.Write([]byte(`[{"id": 100, "name": "zipped"}]`))
+			f, _ = zw.Create("100.go")
+			f.Write([]byte("code100z"))
+			zw.Close()
+			return bb.Bytes()
+		}(),
+		wantStatus: http.StatusOK,
+		checkBody:  bodyChecker("application/json", []byte(sf(`{"100":%d}`, defaultID+6))),
+	}, {
+		label:      "GetImported",
+		url:        sf("/snippets/%d", defaultID+6),
+		method:     "GET",
+		wantStatus: http.StatusOK,
+		checkBody:  snippetChecker(snippet{ID: defaultID + 6, Name: "zipped", Code: "code100z"}),
+	}, {
+		label:      "GistNotFound",
+		url:        sf("/snippets/%d/gist", defaultID+500),
+		method:     "POST",
+		wantStatus: http.StatusNotFound,
+	}, {
+		label:      "GistCreate",
+		url:        sf("/snippets/%d/gist", defaultID+6),
+		method:     "POST",
+		wantStatus: http.StatusOK,
+		checkBody:  bodyChecker("application/json", []byte(`{"id":"gist1","url":"https://gist.github.com/gist1"}`)),
+	}, {
+		label:      "GistUpdate",
+		url:        sf("/snippets/%d/gist", defaultID+6),
+		method:     "POST",
+		wantStatus: http.StatusOK,
+		checkBody:  bodyChecker("application/json", []byte(`{"id":"gist1","url":"https://gist.github.com/gist1"}`)),
+	}, {
+		label:      "GetGist",
+		url:        sf("/snippets/%d", defaultID+6),
+		method:     "GET",
+		wantStatus: http.StatusOK,
+		checkBody:  snippetChecker(snippet{ID: defaultID + 6, Gist: "gist1", Name: "zipped", Code: "code100z"}),
+	}, {
+		label:      "PlayShareNotFound",
+		url:        "/snippets/play/missing",
+		method:     "POST",
+		wantStatus: http.StatusNotFound,
+	}, {
+		label:      "PlayShare",
+		url:        "/snippets/play/abc_XYZ-123",
+		method:     "POST",
+		wantStatus: http.StatusOK,
+		checkBody:  snippetChecker(snippet{ID: defaultID + 7, Name: "go.dev/play/p/abc_XYZ-123", Code: "package main\n"}),
+	}, {
+		label:      "StarNotFound",
+		url:        sf("/snippets/%d/star", defaultID+500),
+		method:     "POST",
+		wantStatus: http.StatusNotFound,
+	}, {
+		label:      "StarSnippet1",
+		url:        sf("/snippets/%d/star", defaultID+2),
+		method:     "POST",
+		wantStatus: http.StatusOK,
+		checkBody:  snippetChecker(snippet{ID: defaultID + 2, Starred: true, Name: sf("snippet%d", defaultID+2), Code: sf("code%da", defaultID+2)}),
+	}, {
+		label:      "StarSnippet2",
+		url:        sf("/snippets/%d/star", defaultID+3),
+		method:     "POST",
+		wantStatus: http.StatusOK,
+		checkBody:  snippetChecker(snippet{ID: defaultID + 3, Starred: true, Name: sf("snippet%d", defaultID+3), Code: sf("code%d", defaultID+3)}),
+	}, {
+		label:      "UnstarSnippet2",
+		url:        sf("/snippets/%d/star", defaultID+3),
+		method:     "POST",
+		wantStatus: http.StatusOK,
+		checkBody:  snippetChecker(snippet{ID: defaultID + 3, Name: sf("snippet%d", defaultID+3), Code: sf("code%d", defaultID+3)}),
+	}, {
+		label:      "QueryByStarred",
+		url:        `/snippets?queryBy=starred&limit=3`,
+		method:     "GET",
+		wantStatus: http.StatusOK,
+		checkBody: snippetsChecker([]snippet{
+			{ID: defaultID + 2, Starred: true, Name: sf("snippet%d", defaultID+2)},
+			{ID: defaultID + 7, Name: "go.dev/play/p/abc_XYZ-123"},
+			{ID: defaultID + 6, Gist: "gist1", Name: "zipped"},
+		}),
+	}, {
+		label:      "QueryByRegex",
+		url:        "/snippets?queryBy=regex&query=" + url.QueryEscape(`{"code":"^code[0-9]+[az]$"}`),
+		method:     "GET",
+		wantStatus: http.StatusOK,
+		checkBody: func(gotType string, gotBody []byte) {
+			var got []searchResult
+			if err := json.Unmarshal(gotBody, &got); err != nil {
+				mt.Errorf("json.Unmarshal error: %v", err)
+			}
+			for i := range got {
+				got[i].Created, got[i].Modified = time.Time{}, time.Time{}
+			}
+			want := []searchResult{
+				{snippet{ID: defaultID + 2, Starred: true, Name: sf("snippet%d", defaultID+2)}, []lineMatch{{1, sf("code%da", defaultID+2)}}},
+				{snippet{ID: defaultID + 6, Gist: "gist1", Name: "zipped"}, []lineMatch{{1, "code100z"}}},
+			}
+			if !reflect.DeepEqual(got, want) {
+				mt.Errorf("mismatching search results:\ngot  %+v\nwant %+v", got, want)
+			}
+		},
+	}, {
+		label:      "QueryByRegexSorted",
+		url:        "/snippets?queryBy=regex&sort=-name&limit=3&query=" + url.QueryEscape(`{"code":"^code"}`),
+		method:     "GET",
+		wantStatus: http.StatusOK,
+		checkBody: snippetsChecker([]snippet{
+			{ID: defaultID + 6, Gist: "gist1", Name: "zipped"},
+			{ID: defaultID + 3, Name: sf("snippet%d", defaultID+3)},
+			{ID: defaultID + 2, Starred: true, Name: sf("snippet%d", defaultID+2)},
+		}),
+	}, {
+		label:      "QueryInvalidSort",
+		url:        "/snippets?sort=size",
+		method:     "GET",
+		wantStatus: http.StatusBadRequest,
+	}, {
+		label:      "QueryByRegexInvalid",
+		url:        "/snippets?queryBy=regex&query=" + url.QueryEscape(`{"code":"("}`),
+		method:     "GET",
+		wantStatus: http.StatusBadRequest,
+	}, {
+		label:      "Backup1",
+		url:        "/admin/backup",
+		method:     "POST",
+		wantStatus: http.StatusOK,
+		checkBody:  backupChecker,
+	}, {
+		label:      "Backup2",
+		url:        "/admin/backup",
+		method:     "POST",
+		wantStatus: http.StatusOK,
+		checkBody:  backupChecker,
+	}, {
+		label:      "Backup3",
+		url:        "/admin/backup",
+		method:     "POST",
+		wantStatus: http.StatusOK,
+		checkBody:  backupChecker,
+	}, {
+		label:      "Compact",
+		url:        "/admin/compact",
+		method:     "POST",
+		wantStatus: http.StatusOK,
+		checkBody: func(gotType string, gotBody []byte) {
+			var got struct{ Before, After, Reclaimed int64 }
+			if err := json.Unmarshal(gotBody, &got); err != nil {
+				mt.Errorf("json.Unmarshal error: %v", err)
+			}
+			if got.After <= 0 || got.Before-got.After != got.Reclaimed {
+				mt.Errorf("invalid compaction result: %+v", got)
+			}
+		},
+	}, {
+		label:      "QueryAfterCompact",
+		url:        `/snippets?queryBy=starred&limit=1`,
+		method:     "GET",
+		wantStatus: http.StatusOK,
+		checkBody: snippetsChecker([]snippet{
+			{ID: defaultID + 2, Starred: true, Name: sf("snippet%d", defaultID+2)},
+		}),
+	}, {
+		label:      "BatchInvalid",
+		url:        "/snippets/batch",
+		method:     "POST",
+		body:       []byte(`[{"op": "tag", "ids": [3]}]`),
+		wantStatus: http.StatusBadRequest,
+	}, {
+		label:      "BatchDeleteDefault",
+		url:        "/snippets/batch",
+		method:     "POST",
+		body:       []byte(sf(`[{"op": "delete", "ids": [%d]}]`, defaultID)),
+		wantStatus: http.StatusBadRequest,
+	}, {
+		label:      "BatchNotFound",
+		url:        "/snippets/batch",
+		method:     "POST",
+		body:       []byte(sf(`[{"op": "unstar", "ids": [%d]}, {"op": "delete", "ids": [%d, %d]}]`, defaultID+2, defaultID+2, defaultID+500)),
+		wantStatus: http.StatusNotFound,
+	}, {
+		label:      "RetrieveAfterBatchNotFound",
+		url:        sf("/snippets/%d", defaultID+2),
+		method:     "GET",
+		wantStatus: http.StatusOK,
+		checkBody:  snippetChecker(snippet{ID: defaultID + 2, Starred: true, Name: sf("snippet%d", defaultID+2), Code: sf("code%da", defaultID+2)}),
+	}, {
+		label:      "Batch",
+		url:        "/snippets/batch",
+		method:     "POST",
+		body:       []byte(sf(`[{"op": "unstar", "ids": [%d]}, {"op": "star", "ids": [%d]}]`, defaultID+2, defaultID+3)),
+		wantStatus: http.StatusOK,
+	}, {
+		label:      "QueryAfterBatch",
+		url:        `/snippets?queryBy=starred&limit=1`,
+		method:     "GET",
+		wantStatus: http.StatusOK,
+		checkBody: snippetsChecker([]snippet{
+			{ID: defaultID + 3, Starred: true, Name: sf("snippet%d", defaultID+3)},
+		}),
 	}}
 
 	for _, tt := range httpTests {
@@ -317,6 +642,161 @@ func TestPlayground(t *testing.T) {
 		}
 	}
 
+	// Ensure that API tokens authenticate requests until revoked.
+	resp, err := cln.Post(sf("http://%v/admin/tokens", ln.Addr()), "application/json", strings.NewReader(`{"name": "ci"}`))
+	if err != nil {
+		t.Fatalf("client.Post error: %v", err)
+	}
+	var tok struct {
+		ID    int64
+		Name  string
+		Token string
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		t.Fatalf("json.Decode error: %v", err)
+	}
+	resp.Body.Close()
+	if tok.Name != "ci" || !strings.HasPrefix(tok.Token, apiTokenPrefix) {
+		t.Fatalf("unexpected token: %+v", tok)
+	}
+	getWithToken := func(token string) int {
+		req, _ := http.NewRequest("GET", sf("http://%v/snippets", ln.Addr()), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req) // Client without the auth cookie
+		if err != nil {
+			t.Fatalf("client.Do error: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	if got, want := getWithToken(tok.Token), http.StatusOK; got != want {
+		t.Fatalf("Response.StatusCode = %d, want %d", got, want)
+	}
+	if got, want := getWithToken(tok.Token+"x"), http.StatusUnauthorized; got != want {
+		t.Fatalf("Response.StatusCode = %d, want %d", got, want)
+	}
+	req, _ := http.NewRequest("DELETE", sf("http://%v/admin/tokens/%d", ln.Addr(), tok.ID), nil)
+	if resp, err = cln.Do(req); err != nil {
+		t.Fatalf("client.Do error: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := resp.StatusCode, http.StatusOK; got != want {
+		t.Fatalf("Response.StatusCode = %d, want %d", got, want)
+	}
+	if got, want := getWithToken(tok.Token), http.StatusUnauthorized; got != want {
+		t.Fatalf("Response.StatusCode = %d, want %d", got, want)
+	}
+
+	// Ensure that sessions on other devices can be listed and revoked.
+	jar2, _ := cookiejar.New(nil)
+	cln2 := &http.Client{Jar: jar2}
+	if resp, err = cln2.Post(sf("http://%v/login", ln.Addr()), "text/plain", strings.NewReader("pass")); err != nil {
+		t.Fatalf("client.Post error: %v", err)
+	}
+	resp.Body.Close()
+	var sessions []struct {
+		ID      string
+		Current bool
+	}
+	getJSONWith := func(cln *http.Client, url string, v interface{}) int {
+		resp, err := cln.Get(sf("http://%v%s", ln.Addr(), url))
+		if err != nil {
+			t.Fatalf("client.Get error: %v", err)
+		}
+		defer resp.Body.Close()
+		if v != nil && resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+				t.Fatalf("json.Decode error: %v", err)
+			}
+		}
+		return resp.StatusCode
+	}
+	getJSONWith(cln, "/admin/sessions", &sessions)
+	if len(sessions) != 2 || sessions[0].Current == sessions[1].Current {
+		t.Fatalf("unexpected sessions: %+v", sessions)
+	}
+	req, _ = http.NewRequest("DELETE", sf("http://%v/admin/sessions", ln.Addr()), nil)
+	if resp, err = cln.Do(req); err != nil {
+		t.Fatalf("client.Do error: %v", err)
+	}
+	resp.Body.Close()
+	if got, want := getJSONWith(cln2, "/snippets", nil), http.StatusUnauthorized; got != want {
+		t.Fatalf("Response.StatusCode = %d, want %d", got, want)
+	}
+	sessions = nil
+	if got, want := getJSONWith(cln, "/admin/sessions", &sessions), http.StatusOK; got != want {
+		t.Fatalf("Response.StatusCode = %d, want %d", got, want)
+	}
+	if len(sessions) != 1 || !sessions[0].Current {
+		t.Fatalf("unexpected sessions: %+v", sessions)
+	}
+
+	// Ensure that old backups were pruned.
+	fis, err := ioutil.ReadDir(filepath.Join(tmpDir, backupDir))
+	if err != nil {
+		t.Fatalf("ioutil.ReadDir error: %v", err)
+	}
+	if len(fis) != pg.backupKeep {
+		t.Fatalf("got %d backups, want %d", len(fis), pg.backupKeep)
+	}
+
+	// Ensure that paging through snippets with a cursor yields the same
+	// results as a single unlimited query.
+	getJSON := func(url string, v interface{}) {
+		resp, err := cln.Get(fmt.Sprintf("http://%v%s", ln.Addr(), url))
+		if err != nil {
+			t.Fatalf("client.Get error: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Response.StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatalf("json.Decode error: %v", err)
+		}
+	}
+	for _, queryBy := range []string{
+		"id", "modified", "created", "name", "starred", "regex&query=" + url.QueryEscape(`{"code":"code"}`),
+		"id&sort=-name", "starred&sort=created", "modified&sort=-id", "regex&sort=modified&query=" + url.QueryEscape(`{"code":"code"}`),
+	} {
+		var want, got []snippet
+		getJSON(sf(`/snippets?queryBy=%s&limit=-1`, queryBy), &want)
+		var page struct {
+			Snippets   []snippet `json:"snippets"`
+			NextCursor string    `json:"next_cursor"`
+		}
+		getJSON(sf(`/snippets?queryBy=%s&limit=2&cursor=`, queryBy), &page)
+		got = append(got, page.Snippets...)
+		for i := 0; page.NextCursor != ""; i++ {
+			if i > len(want) {
+				t.Fatalf("%s: too many pages", queryBy)
+			}
+			url := sf(`/snippets?limit=2&cursor=%s`, page.NextCursor)
+			page.Snippets, page.NextCursor = nil, ""
+			getJSON(url, &page)
+			got = append(got, page.Snippets...)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: mismatching snippets:\ngot  %v\nwant %v", queryBy, got, want)
+		}
+	}
+	for _, url := range []string{
+		"/snippets?cursor=bad",
+		"/snippets?queryBy=id&cursor=" + listCursor{QueryBy: "id"}.encode(),
+		"/snippets?cursor=" + listCursor{QueryBy: "bad"}.encode(),
+		"/snippets?cursor=" + listCursor{QueryBy: "id", Sort: "bad"}.encode(),
+		"/snippets?sort=name&cursor=" + listCursor{QueryBy: "id"}.encode(),
+	} {
+		resp, err := cln.Get(fmt.Sprintf("http://%v%s", ln.Addr(), url))
+		if err != nil {
+			t.Fatalf("client.Get error: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Get(%q).StatusCode = %d, want %d", url, resp.StatusCode, http.StatusBadRequest)
+		}
+	}
+
 	// Ensure that websockets require authentication as well.
 	dl := websocket.Dialer{}
 	_, _, err = dl.Dial(fmt.Sprintf("ws://%v/websocket", ln.Addr()), nil)
@@ -333,6 +813,12 @@ func TestPlayground(t *testing.T) {
 	defer conn.Close()
 	defer atomic.StoreInt32(&done, 1)
 
+	// The first message is always the session token.
+	var first map[string]string
+	if err := conn.ReadJSON(&first); err != nil || first["action"] != sessionToken || first["data"] == "" {
+		t.Fatalf("first message = %v (error %v), want session token", first, err)
+	}
+
 	// Message reader loop.
 	go func() {
 		for {
@@ -387,6 +873,7 @@ func TestPlayground(t *testing.T) {
 			{appendStderr, "RE> main.go:3.*\n"},
 			{statusUpdate, "RE> Unexpected error: .*\n"},
 			{markLines, "[3]"},
+			{markNotes, `RE> ^\[{"line":3,"column":13,"kind":"error","message":"undefined: invalid"}\]$`},
 			{statusStopped, ""},
 		},
 	}, {
@@ -400,8 +887,9 @@ func TestPlayground(t *testing.T) {
 			{clearOutput, ""},
 			{appendStdout, "Hello\n"},
 			{statusUpdate, "Program exited.\n"},
+			{statusUpdate, runSummary},
 			{statusUpdate, "\n"},
-			{statusStopped, ""},
+			{statusStopped, exitOK},
 		},
 	}, {
 		label:  "PragmaPProfArgs",
@@ -511,16 +999,1880 @@ func TestPlayground(t *testing.T) {
 	}
 }
 
+func TestImportLimits(t *testing.T) {
+	defer func(size, dataSize int64) {
+		maxImportSize, maxImportDataSize = size, dataSize
+	}(maxImportSize, maxImportDataSize)
+	maxImportSize, maxImportDataSize = 1<<10, 1<<12
+
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	// makeZip returns an archive with a snippet of the given code size,
+	// which compresses well below maxImportSize.
+	makeZip := func(n int) []byte {
+		var bb bytes.Buffer
+		zw := zip.NewWriter(&bb)
+		f, _ := zw.Create("snippets.json")
+		f.Write([]byte(`[{"id": 100, "name": "zipped"}]`))
+		f, _ = zw.Create("100.go")
+		f.Write(bytes.Repeat([]byte("/"), n))
+		zw.Close()
+		if int64(bb.Len()) > maxImportSize {
+			t.Fatalf("archive too large: %d bytes", bb.Len())
+		}
+		return bb.Bytes()
+	}
+
+	tests := []struct {
+		label      string
+		ctype      string
+		body       []byte
+		wantStatus int
+	}{
+		{"JSONWithinLimit", "application/json", []byte(`[{"id": 100, "name": "small", "code": "code"}]`), http.StatusOK},
+		{"JSONTooLarge", "application/json", bytes.Repeat([]byte(" "), int(maxImportSize)+1), http.StatusRequestEntityTooLarge},
+		{"ZipWithinLimit", "application/zip", makeZip(int(maxImportDataSize) / 2), http.StatusOK},
+		{"ZipTooLarge", "application/zip", makeZip(int(maxImportDataSize) + 1), http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			resp, err := http.Post(srv.URL+"/snippets/import", tt.ctype, bytes.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("http.Post error: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Response.StatusCode = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
+
 func TestAuthToken(t *testing.T) {
 	pw1 := sha256.Sum256([]byte("password1"))
 	pw2 := sha256.Sum256([]byte("password2"))
 
 	now := time.Now().UTC()
-	s := formatAuthToken(pw1[:], now)
-	if got := parseAuthToken(pw1[:], s); !now.Equal(got) {
-		t.Errorf("parseAuthToken: got %v, want %v", got, now)
+	s := formatAuthToken(pw1[:], "session1", now)
+	if sid, got := parseAuthToken(pw1[:], s); !now.Equal(got) || sid != "session1" {
+		t.Errorf("parseAuthToken: got (%q, %v), want (%q, %v)", sid, got, "session1", now)
 	}
-	if got := parseAuthToken(pw2[:], s); now.Equal(got) {
+	if _, got := parseAuthToken(pw2[:], s); now.Equal(got) {
 		t.Errorf("unexpected parseAuthToken success with bad password")
 	}
+	for _, s := range []string{"", "00", "0f00", s[:len(s)-2], s[:20]} {
+		if _, got := parseAuthToken(pw1[:], s); !got.IsZero() {
+			t.Errorf("unexpected parseAuthToken success with truncated token %q", s)
+		}
+	}
+}
+
+func TestPasswordHash(t *testing.T) {
+	salt := sha256.Sum256([]byte("salt"))
+	legacy := sha256.Sum256(append(salt[:], "password"...))
+	argon2id, err := newPasswordHash([]byte("password"))
+	if err != nil {
+		t.Fatalf("newPasswordHash error: %v", err)
+	}
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt.GenerateFromPassword error: %v", err)
+	}
+
+	tests := []struct {
+		label      string
+		hash, salt string
+		wantErr    bool
+		wantLegacy bool
+	}{
+		{label: "Legacy", hash: fmt.Sprintf("%x", legacy), salt: fmt.Sprintf("%x", salt), wantLegacy: true},
+		{label: "Argon2id", hash: argon2id},
+		{label: "Bcrypt", hash: string(bcryptHash)},
+		{label: "LegacyMissingSalt", hash: fmt.Sprintf("%x", legacy), wantErr: true},
+		{label: "Argon2idWithSalt", hash: argon2id, salt: fmt.Sprintf("%x", salt), wantErr: true},
+		{label: "Argon2idBadParams", hash: strings.Replace(argon2id, "t=3", "t=0", 1), wantErr: true},
+		{label: "Argon2idTruncated", hash: argon2id[:strings.LastIndexByte(argon2id, '$')], wantErr: true},
+		{label: "BcryptInvalid", hash: "$2b$", wantErr: true},
+		{label: "SaltOnly", salt: fmt.Sprintf("%x", salt), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			ph, err := parsePasswordHash(tt.hash, tt.salt)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("parsePasswordHash error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !ph.Verify([]byte("password")) {
+				t.Errorf("Verify rejected the correct password")
+			}
+			if ph.Verify([]byte("passw0rd")) {
+				t.Errorf("Verify accepted an incorrect password")
+			}
+			if got := ph.IsLegacy(); got != tt.wantLegacy {
+				t.Errorf("IsLegacy = %v, want %v", got, tt.wantLegacy)
+			}
+		})
+	}
+
+	// Tokens signed with the legacy hash must remain valid.
+	ph, _ := parsePasswordHash(fmt.Sprintf("%x", legacy), fmt.Sprintf("%x", salt))
+	if !bytes.Equal(ph.AuthKey(), legacy[:]) {
+		t.Errorf("AuthKey changed for the legacy format")
+	}
+	if ph, _ := parsePasswordHash("", ""); ph != nil {
+		t.Errorf("parsePasswordHash returned non-nil hash for empty password")
+	}
+}
+
+func TestOIDC(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Mock an OpenID Connect provider, where the authorization code is the
+	// name of the user and the access token is derived from the code.
+	users := map[string]struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+	}{
+		"alice":   {"Alice@example.com", true},
+		"bob":     {"bob@example.com", false},
+		"mallory": {"mallory@evil.com", true},
+	}
+	var provider *httptest.Server
+	provider = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(map[string]string{
+				"issuer":                 provider.URL,
+				"authorization_endpoint": provider.URL + "/authorize",
+				"token_endpoint":         provider.URL + "/token",
+				"userinfo_endpoint":      provider.URL + "/userinfo",
+			})
+		case "/token":
+			if id, secret, _ := r.BasicAuth(); id != "client" || secret != "secret" {
+				http.Error(w, `{"error": "invalid_client"}`, http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{
+				"access_token": "token-" + r.FormValue("code"),
+				"token_type":   "Bearer",
+			})
+		case "/userinfo":
+			u, ok := users[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer token-")]
+			if !ok {
+				http.Error(w, `{"error": "invalid_token"}`, http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(w).Encode(u)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer provider.Close()
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	pg.oidc = newOIDCProvider(provider.URL, "client", "secret", []string{"alice@example.com", "@example.com"})
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	// login performs the login flow as the given user and
+	// returns the final HTTP status and the authenticated client.
+	login := func(user string) (int, *http.Client) {
+		jar, _ := cookiejar.New(nil)
+		cln := &http.Client{
+			Jar:           jar,
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		}
+		resp, err := cln.Get(srv.URL + "/")
+		if err != nil {
+			t.Fatalf("client.Get error: %v", err)
+		}
+		resp.Body.Close()
+		if got, want := resp.Header.Get("Location"), "/login/oidc"; got != want {
+			t.Fatalf("redirect mismatch: got %q, want %q", got, want)
+		}
+		resp, err = cln.Get(srv.URL + "/login/oidc")
+		if err != nil {
+			t.Fatalf("client.Get error: %v", err)
+		}
+		resp.Body.Close()
+		u, err := url.Parse(resp.Header.Get("Location"))
+		if err != nil {
+			t.Fatalf("url.Parse error: %v", err)
+		}
+		q := u.Query()
+		if got, want := u.Path, "/authorize"; got != want {
+			t.Fatalf("authorization path mismatch: got %q, want %q", got, want)
+		}
+		if got, want := q.Get("redirect_uri"), srv.URL+"/login/oidc/callback"; got != want {
+			t.Fatalf("redirect_uri mismatch: got %q, want %q", got, want)
+		}
+
+		// A callback with a forged state must be rejected.
+		resp, err = cln.Get(srv.URL + "/login/oidc/callback?state=forged&code=" + user)
+		if err != nil {
+			t.Fatalf("client.Get error: %v", err)
+		}
+		resp.Body.Close()
+		if got, want := resp.StatusCode, http.StatusBadRequest; got != want {
+			t.Fatalf("Response.StatusCode = %d, want %d", got, want)
+		}
+
+		resp, err = cln.Get(srv.URL + "/login/oidc/callback?" + url.Values{"state": {q.Get("state")}, "code": {user}}.Encode())
+		if err != nil {
+			t.Fatalf("client.Get error: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode, cln
+	}
+
+	tests := []struct {
+		user       string
+		wantStatus int
+	}{
+		{"alice", http.StatusFound},
+		{"bob", http.StatusForbidden},
+		{"mallory", http.StatusForbidden},
+		{"unknown", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.user, func(t *testing.T) {
+			status, cln := login(tt.user)
+			if status != tt.wantStatus {
+				t.Fatalf("login status = %d, want %d", status, tt.wantStatus)
+			}
+
+			wantStatus := http.StatusUnauthorized
+			if status == http.StatusFound {
+				wantStatus = http.StatusOK
+			}
+			resp, err := cln.Get(srv.URL + "/snippets")
+			if err != nil {
+				t.Fatalf("client.Get error: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != wantStatus {
+				t.Fatalf("Response.StatusCode = %d, want %d", resp.StatusCode, wantStatus)
+			}
+		})
+	}
+}
+
+func TestBasePath(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("pass"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt.GenerateFromPassword error: %v", err)
+	}
+	pw, err := parsePasswordHash(string(hash), "")
+	if err != nil {
+		t.Fatalf("parsePasswordHash error: %v", err)
+	}
+	pg, err := newPlayground(pw, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	pg.basePath = "/playground"
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	jar, _ := cookiejar.New(nil)
+	cln := &http.Client{
+		Jar:           jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	tests := []struct {
+		label        string
+		method       string
+		url          string
+		body         string
+		wantStatus   int
+		wantLocation string
+		wantBody     string
+	}{
+		{label: "Unprefixed", method: "GET", url: "/snippets", wantStatus: http.StatusNotFound},
+		{label: "PartialPrefix", method: "GET", url: "/playgrounds/", wantStatus: http.StatusNotFound},
+		{label: "BaseRedirect", method: "GET", url: "/playground", wantStatus: http.StatusMovedPermanently, wantLocation: "/playground/"},
+		{label: "Static", method: "GET", url: "/playground/static/css/playground.css", wantStatus: http.StatusOK},
+		{label: "LoginPage", method: "GET", url: "/playground/", wantStatus: http.StatusOK, wantBody: `<base href="/playground/">`},
+		{label: "Unauthorized", method: "GET", url: "/playground/snippets", wantStatus: http.StatusUnauthorized},
+		{label: "Login", method: "POST", url: "/playground/login", body: "pass", wantStatus: http.StatusOK},
+		{label: "Authorized", method: "GET", url: "/playground/snippets", wantStatus: http.StatusOK},
+		{label: "MainPage", method: "GET", url: "/playground/5", wantStatus: http.StatusOK, wantBody: `<base href="/playground/">`},
+		{label: "UnknownRedirect", method: "GET", url: "/playground/unknown", wantStatus: http.StatusTemporaryRedirect, wantLocation: "/playground/"},
+	}
+	for _, tt := range tests {
+		req, err := http.NewRequest(tt.method, srv.URL+tt.url, strings.NewReader(tt.body))
+		if err != nil {
+			t.Fatalf("test %s, http.NewRequest error: %v", tt.label, err)
+		}
+		resp, err := cln.Do(req)
+		if err != nil {
+			t.Fatalf("test %s, client.Do error: %v", tt.label, err)
+		}
+		b, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != tt.wantStatus {
+			t.Errorf("test %s, Response.StatusCode = %d, want %d", tt.label, resp.StatusCode, tt.wantStatus)
+		}
+		if got := resp.Header.Get("Location"); got != tt.wantLocation {
+			t.Errorf("test %s, Location = %q, want %q", tt.label, got, tt.wantLocation)
+		}
+		if !strings.Contains(string(b), tt.wantBody) {
+			t.Errorf("test %s, body does not contain %q", tt.label, tt.wantBody)
+		}
+		if tt.label == "Login" {
+			for _, c := range resp.Cookies() {
+				if c.Name == "auth" && c.Path != "/playground/" {
+					t.Errorf("test %s, cookie path = %q, want %q", tt.label, c.Path, "/playground/")
+				}
+			}
+		}
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+
+	// Templates in DataPath and the configuration add to or replace the
+	// built-in templates.
+	dir := filepath.Join(tmpDir, templatesDir)
+	os.Mkdir(dir, 0775)
+	ioutil.WriteFile(filepath.Join(dir, "hello.go"), []byte("package main // hello\n"), 0664)
+	ioutil.WriteFile(filepath.Join(dir, "grpc.go"), []byte("package main // grpc\n"), 0664)
+	ioutil.WriteFile(filepath.Join(tmpDir, "cli.txt"), []byte("package main // cli\n"), 0664)
+	if pg.templates, err = loadTemplates(dir, map[string]string{"cli": filepath.Join(tmpDir, "cli.txt")}); err != nil {
+		t.Fatalf("loadTemplates error: %v", err)
+	}
+	if _, err := loadTemplates(dir, map[string]string{"a/b": filepath.Join(tmpDir, "cli.txt")}); err == nil {
+		t.Errorf("loadTemplates with invalid name succeeded")
+	}
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	do := func(method, url string, wantStatus int) []byte {
+		t.Helper()
+		req, _ := http.NewRequest(method, srv.URL+url, nil)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http.Do error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		if resp.StatusCode != wantStatus {
+			t.Fatalf("%s %s: status %d, want %d: %s", method, url, resp.StatusCode, wantStatus, b)
+		}
+		return b
+	}
+
+	var ts []snippetTemplate
+	if err := json.Unmarshal(do("GET", "/templates", http.StatusOK), &ts); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	var names []string
+	for _, tt := range ts {
+		names = append(names, tt.Name)
+		if tt.Code != "" {
+			t.Errorf("template %s listed with code", tt.Name)
+		}
+	}
+	if want := []string{"benchmark", "cli", "fuzz", "grpc", "hello", "http-server", "table-test"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("template names = %q, want %q", names, want)
+	}
+
+	var tmpl snippetTemplate
+	json.Unmarshal(do("GET", "/templates/hello", http.StatusOK), &tmpl)
+	if tmpl.Code != "package main // hello\n" {
+		t.Errorf("hello template code = %q", tmpl.Code)
+	}
+
+	var s snippet
+	json.Unmarshal(do("POST", "/templates/grpc?name=My+service", http.StatusOK), &s)
+	got, err := pg.sdb.Retrieve(s.ID)
+	if err != nil {
+		t.Fatalf("Retrieve error: %v", err)
+	}
+	if got.Name != "My service" || got.Code != "package main // grpc\n" {
+		t.Errorf("created snippet = %+v", got)
+	}
+	json.Unmarshal(do("POST", "/templates/table-test", http.StatusOK), &s)
+	if got, _ := pg.sdb.Retrieve(s.ID); got.Name != "table-test" || !strings.Contains(got.Code, "func TestReverse") {
+		t.Errorf("created snippet = %+v", got)
+	}
+
+	do("GET", "/templates/missing", http.StatusNotFound)
+	do("POST", "/templates/missing", http.StatusNotFound)
+	do("POST", "/templates/hello?bad=1", http.StatusBadRequest)
+}
+
+func TestSnippetETag(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	do := func(method, url, ifMatch, body string, wantStatus int) string {
+		t.Helper()
+		req, _ := http.NewRequest(method, srv.URL+url, strings.NewReader(body))
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http.Do error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		if resp.StatusCode != wantStatus {
+			t.Fatalf("%s %s: status %d, want %d: %s", method, url, resp.StatusCode, wantStatus, b)
+		}
+		return resp.Header.Get("ETag")
+	}
+
+	id, err := pg.sdb.Create(snippet{Name: "edited", Code: "package main"})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	url := fmt.Sprintf("/snippets/%d", id)
+	etag1 := do("GET", url, "", "", http.StatusOK)
+	if etag1 == "" || etag1 != do("GET", url, "", "", http.StatusOK) {
+		t.Fatalf("unstable ETag: %q", etag1)
+	}
+
+	// The first editor updates the snippet, after which the second editor
+	// that loaded the same version fails to update it.
+	etag2 := do("PUT", url, etag1, `{"code":"package main // first"}`, http.StatusOK)
+	if etag2 == "" || etag2 == etag1 {
+		t.Errorf("ETag after update = %q, want a new tag other than %q", etag2, etag1)
+	}
+	do("PUT", url, etag1, `{"code":"package main // second"}`, http.StatusPreconditionFailed)
+	if s, _ := pg.sdb.Retrieve(id); s.Code != "package main // first" {
+		t.Errorf("code after conflicting update = %q", s.Code)
+	}
+	if got := do("GET", url, "", "", http.StatusOK); got != etag2 {
+		t.Errorf("ETag of GET = %q, want %q", got, etag2)
+	}
+
+	do("PUT", url, `"stale", `+etag2, `{"code":"package main // third"}`, http.StatusOK)
+	do("PUT", url, "*", `{"code":"package main // fourth"}`, http.StatusOK)
+	do("PUT", url, "", `{"code":"package main // fifth"}`, http.StatusOK)
+	do("PUT", "/snippets/999", "*", `{"code":"package main"}`, http.StatusNotFound)
+}
+
+func TestStaticDir(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	pg.staticDir = filepath.Join(tmpDir, "public")
+	if err := os.MkdirAll(filepath.Join(pg.staticDir, "css"), 0775); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	get := func(url string) (int, string) {
+		resp, err := http.Get(srv.URL + url)
+		if err != nil {
+			t.Fatalf("http.Get error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, string(b)
+	}
+	cssPath := filepath.Join(pg.staticDir, "css", "playground.css")
+	for _, css := range []string{"body { color: red; }", "body { color: blue; }"} {
+		if err := ioutil.WriteFile(cssPath, []byte(css), 0664); err != nil {
+			t.Fatal(err)
+		}
+		if code, body := get("/static/css/playground.css"); code != http.StatusOK || body != css {
+			t.Errorf("GET playground.css = (%d, %q), want (%d, %q)", code, body, http.StatusOK, css)
+		}
+	}
+	if code, _ := get("/static/js/playground.js"); code != http.StatusNotFound {
+		t.Errorf("GET playground.js status = %d, want %d", code, http.StatusNotFound)
+	}
+}
+
+func TestBranding(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	cssFile := filepath.Join(tmpDir, "staging.css")
+	logoFile := filepath.Join(tmpDir, "logo.svg")
+	ioutil.WriteFile(cssFile, []byte("body { background: orange; }"), 0664)
+	ioutil.WriteFile(logoFile, []byte("<svg></svg>"), 0664)
+	if _, err := loadBranding(&brandingConfig{LogoFile: cssFile}); err == nil {
+		t.Errorf("unexpected loadBranding success with CSS logo")
+	}
+	pg.branding, err = loadBranding(&brandingConfig{Title: "Staging <Playground>", CSSFile: cssFile, LogoFile: logoFile})
+	if err != nil {
+		t.Fatalf("loadBranding error: %v", err)
+	}
+	pg.basePath = "/play"
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	get := func(url string) (int, string, string) {
+		resp, err := http.Get(srv.URL + url)
+		if err != nil {
+			t.Fatalf("http.Get error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, resp.Header.Get("Content-Type"), string(b)
+	}
+	_, _, body := get("/play/")
+	for _, want := range []string{
+		`<base href="/play/">`,
+		`<title>Staging &lt;Playground&gt;</title>`,
+		`<link rel="stylesheet" href="static/branding/custom.css">`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("login page does not contain %q", want)
+		}
+	}
+	if code, typ, body := get("/play/static/branding/custom.css"); code != http.StatusOK || typ != mimeTypes["css"] || body != "body { background: orange; }" {
+		t.Errorf("GET custom.css = (%d, %q, %q)", code, typ, body)
+	}
+	if code, typ, body := get("/play/static/branding/logo.svg"); code != http.StatusOK || typ != mimeTypes["svg"] || body != "<svg></svg>" {
+		t.Errorf("GET logo.svg = (%d, %q, %q)", code, typ, body)
+	}
+
+	// The heading and logo are on the main page.
+	b := pg.rewriteHTML(staticFS["html/playground.html"].data)
+	if want := `<h1 id="title"><img id="logo" src="static/branding/logo.svg" alt="">Staging &lt;Playground&gt;</h1>`; !strings.Contains(string(b), want) {
+		t.Errorf("main page does not contain %q", want)
+	}
+}
+
+func TestBaseURL(t *testing.T) {
+	proxies, err := parseTrustedProxies([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatalf("parseTrustedProxies error: %v", err)
+	}
+	forwarded := http.Header{"X-Forwarded-Proto": {"https"}, "X-Forwarded-Host": {"play.example.com, proxy.internal"}}
+
+	tests := []struct {
+		external string
+		remote   string
+		host     string
+		tls      bool
+		header   http.Header
+		want     string
+	}{
+		{remote: "1.2.3.4:1234", host: "localhost:8080", want: "http://localhost:8080"},
+		{remote: "1.2.3.4:1234", host: "localhost:8443", tls: true, want: "https://localhost:8443"},
+		{remote: "1.2.3.4:1234", host: "localhost:8080", header: forwarded, want: "http://localhost:8080"},
+		{remote: "10.1.2.3:1234", host: "localhost:8080", header: forwarded, want: "https://play.example.com"},
+		{remote: "10.1.2.3:1234", host: "localhost:8080", header: http.Header{"X-Forwarded-Proto": {"javascript"}}, want: "http://localhost:8080"},
+		{external: "https://play.example.com", remote: "1.2.3.4:1234", host: "evil.com", want: "https://play.example.com"},
+		{external: "https://play.example.com", remote: "10.1.2.3:1234", host: "localhost:8080", header: http.Header{"X-Forwarded-Host": {"evil.com"}}, want: "https://play.example.com"},
+	}
+	for _, tt := range tests {
+		pg := &playground{trustedProxies: proxies, externalURL: tt.external}
+		r := &http.Request{RemoteAddr: tt.remote, Host: tt.host, Header: tt.header}
+		if tt.tls {
+			r.TLS = &tls.ConnectionState{}
+		}
+		if got := pg.baseURL(r); got != tt.want {
+			t.Errorf("baseURL(%q, %q, %v) = %q, want %q", tt.remote, tt.host, tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestRemoteAddr(t *testing.T) {
+	proxies, err := parseTrustedProxies([]string{"127.0.0.1", "10.0.0.0/8", "::1"})
+	if err != nil {
+		t.Fatalf("parseTrustedProxies error: %v", err)
+	}
+	pg := &playground{trustedProxies: proxies}
+
+	tests := []struct {
+		remote string
+		header http.Header
+		want   string
+	}{
+		{remote: "1.2.3.4:1234", want: "1.2.3.4:1234"},
+		{remote: "1.2.3.4:1234", header: http.Header{"X-Real-Ip": {"5.6.7.8"}}, want: "1.2.3.4:1234"},
+		{remote: "1.2.3.4:1234", header: http.Header{"X-Forwarded-For": {"5.6.7.8"}}, want: "1.2.3.4:1234"},
+		{remote: "127.0.0.1:1234", want: "127.0.0.1:1234"},
+		{remote: "127.0.0.1:1234", header: http.Header{"X-Real-Ip": {"5.6.7.8"}}, want: "5.6.7.8"},
+		{remote: "[::1]:1234", header: http.Header{"X-Forwarded-For": {"5.6.7.8"}}, want: "5.6.7.8"},
+		{remote: "10.1.2.3:1234", header: http.Header{"X-Forwarded-For": {"9.9.9.9, 5.6.7.8, 10.0.0.1"}}, want: "5.6.7.8"},
+		{remote: "10.1.2.3:1234", header: http.Header{"X-Forwarded-For": {"9.9.9.9", "5.6.7.8"}}, want: "5.6.7.8"},
+		{remote: "10.1.2.3:1234", header: http.Header{"X-Forwarded-For": {"10.0.0.2, 10.0.0.1"}}, want: "10.0.0.2"},
+		{remote: "11.1.2.3:1234", header: http.Header{"X-Forwarded-For": {"5.6.7.8"}}, want: "11.1.2.3:1234"},
+	}
+	for _, tt := range tests {
+		r := &http.Request{RemoteAddr: tt.remote, Header: tt.header}
+		if got := pg.remoteAddr(r); got != tt.want {
+			t.Errorf("remoteAddr(%q, %v) = %q, want %q", tt.remote, tt.header, got, tt.want)
+		}
+	}
+
+	for _, s := range []string{"localhost", "10.0.0.0/33", ""} {
+		if _, err := parseTrustedProxies([]string{s}); err == nil {
+			t.Errorf("parseTrustedProxies(%q) succeeded, want error", s)
+		}
+	}
+}
+
+type recordLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *recordLogger) Printf(f string, x ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(f, x...))
+}
+
+func TestRequestID(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("pass"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt.GenerateFromPassword error: %v", err)
+	}
+	pw, err := parsePasswordHash(string(hash), "")
+	if err != nil {
+		t.Fatalf("parsePasswordHash error: %v", err)
+	}
+	log := new(recordLogger)
+	pg, err := newPlayground(pw, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, log)
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	// A failed login must report the request ID in the response and logs.
+	resp, err := http.Post(srv.URL+"/login", "", strings.NewReader("wrong"))
+	if err != nil {
+		t.Fatalf("http.Post error: %v", err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	id := resp.Header.Get("X-Request-Id")
+	if id == "" {
+		t.Fatalf("missing X-Request-Id header")
+	}
+	if got, want := strings.TrimSpace(string(b)), "unauthorized (request "+id+")"; got != want {
+		t.Errorf("response body = %q, want %q", got, want)
+	}
+	log.mu.Lock()
+	lines := strings.Join(log.lines, "\n")
+	log.mu.Unlock()
+	if !strings.Contains(lines, "["+id+"] authentication failure") {
+		t.Errorf("logs do not contain request ID %s:\n%s", id, lines)
+	}
+
+	// Each request must be assigned a distinct ID.
+	resp, err = http.Post(srv.URL+"/login", "", strings.NewReader("wrong"))
+	if err != nil {
+		t.Fatalf("http.Post error: %v", err)
+	}
+	resp.Body.Close()
+	if id2 := resp.Header.Get("X-Request-Id"); id2 == "" || id2 == id {
+		t.Errorf("X-Request-Id = %q, want unique ID distinct from %q", id2, id)
+	}
+
+	// The websocket handshake must report the ID of the connection.
+	pg.pw = nil
+	conn, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/websocket", nil)
+	if err != nil {
+		t.Fatalf("websocket.Dial error: %v", err)
+	}
+	conn.Close()
+	if resp.Header.Get("X-Request-Id") == "" {
+		t.Errorf("missing X-Request-Id header in websocket handshake")
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("pass"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt.GenerateFromPassword error: %v", err)
+	}
+	pw, err := parsePasswordHash(string(hash), "")
+	if err != nil {
+		t.Fatalf("parsePasswordHash error: %v", err)
+	}
+	pg, err := newPlayground(pw, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	jar, _ := cookiejar.New(nil)
+	cln := &http.Client{Jar: jar}
+	do := func(method, url, body string) []byte {
+		t.Helper()
+		req, err := http.NewRequest(method, srv.URL+url, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("http.NewRequest error: %v", err)
+		}
+		resp, err := cln.Do(req)
+		if err != nil {
+			t.Fatalf("client.Do error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s %s: status %d: %s", method, url, resp.StatusCode, b)
+		}
+		return b
+	}
+
+	do("POST", "/login", "pass")
+	var s snippet
+	json.Unmarshal(do("POST", "/snippets", `{"name":"audited","code":"package main"}`), &s)
+	do("PUT", fmt.Sprintf("/snippets/%d", s.ID), `{"name":"audited","code":"package main\n"}`)
+	do("POST", fmt.Sprintf("/snippets/%d/star", s.ID), "")
+	do("DELETE", fmt.Sprintf("/snippets/%d", s.ID), "")
+
+	// Run code over the websocket and wait until the server acknowledges it.
+	u := "ws" + strings.TrimPrefix(srv.URL, "http") + "/websocket"
+	conn, _, err := (&websocket.Dialer{Jar: jar}).Dial(u, nil)
+	if err != nil {
+		t.Fatalf("websocket.Dial error: %v", err)
+	}
+	code := "package main\n\nfunc main() {}\n"
+	conn.WriteJSON(map[string]string{"action": actionOpen, "data": fmt.Sprint(s.ID)})
+	conn.WriteJSON(map[string]string{"action": actionRun, "data": code})
+	for i := 0; i < 2; i++ { // Session token followed by the run acknowledgement
+		if _, _, err := conn.ReadMessage(); err != nil {
+			t.Fatalf("websocket.ReadMessage error: %v", err)
+		}
+	}
+	conn.Close()
+
+	var es []auditEntry
+	json.Unmarshal(do("GET", "/admin/audit", ""), &es)
+	codeHash := sha256.Sum256([]byte(code))
+	want := []auditEntry{
+		{ID: 5, Action: auditRun, SnippetID: s.ID, SourceHash: fmt.Sprintf("%x", codeHash)},
+		{ID: 4, Action: auditDelete, SnippetID: s.ID},
+		{ID: 3, Action: auditStar, SnippetID: s.ID},
+		{ID: 2, Action: auditUpdate, SnippetID: s.ID},
+		{ID: 1, Action: auditCreate, SnippetID: s.ID},
+	}
+	for i := range es {
+		if es[i].User != "password" || es[i].RemoteAddr == "" || es[i].Time.IsZero() {
+			t.Errorf("entry %d has unexpected who/when: %+v", es[i].ID, es[i])
+		}
+		es[i].User, es[i].RemoteAddr, es[i].Time = "", "", time.Time{}
+	}
+	if !reflect.DeepEqual(es, want) {
+		t.Errorf("audit log mismatch:\ngot  %+v\nwant %+v", es, want)
+	}
+
+	// Page through the log.
+	es = nil
+	json.Unmarshal(do("GET", "/admin/audit?limit=2&before=4", ""), &es)
+	if len(es) != 2 || es[0].ID != 3 || es[1].ID != 2 {
+		t.Errorf("audit log page mismatch: %+v", es)
+	}
+	req, _ := http.NewRequest("GET", srv.URL+"/admin/audit?limit=0", nil)
+	if resp, err := cln.Do(req); err != nil || resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid limit was not rejected: %v", err)
+	}
+}
+
+func TestTracing(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Mock an OpenTelemetry collector.
+	type otlpSpan struct {
+		TraceID      string `json:"traceId"`
+		SpanID       string `json:"spanId"`
+		ParentSpanID string `json:"parentSpanId"`
+		Name         string `json:"name"`
+		Attributes   []struct {
+			Key   string                 `json:"key"`
+			Value map[string]interface{} `json:"value"`
+		} `json:"attributes"`
+	}
+	var mu sync.Mutex
+	var spans []otlpSpan
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ResourceSpans []struct {
+				ScopeSpans []struct {
+					Spans []otlpSpan `json:"spans"`
+				} `json:"scopeSpans"`
+			} `json:"resourceSpans"`
+		}
+		if r.URL.Path != "/v1/traces" || json.NewDecoder(r.Body).Decode(&req) != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}))
+	defer collector.Close()
+
+	tr := newTracer(collector.URL+"/v1/traces", "playground", testLogger{t})
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt", tracer: tr}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	const traceID, parentID = "0af7651916cd43dd8448eb211c80319c", "b7ad6b7169203331"
+	req, _ := http.NewRequest("GET", srv.URL+fmt.Sprintf("/snippets/%d", defaultID), nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-"+parentID+"-01")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("client.Do error: %v", err)
+	}
+	resp.Body.Close()
+	tr.Close() // Flush all spans
+
+	mu.Lock()
+	defer mu.Unlock()
+	var server *otlpSpan
+	for i, s := range spans {
+		if s.Name == "HTTP GET" {
+			server = &spans[i]
+		}
+	}
+	if server == nil {
+		t.Fatalf("missing server span in %+v", spans)
+	}
+	if server.TraceID != traceID || server.ParentSpanID != parentID {
+		t.Errorf("server span did not continue the trace: %+v", *server)
+	}
+	var gotStatus interface{}
+	for _, a := range server.Attributes {
+		if a.Key == "http.status_code" {
+			gotStatus = a.Value["intValue"]
+		}
+	}
+	if gotStatus != "200" {
+		t.Errorf("http.status_code = %v, want 200", gotStatus)
+	}
+	var foundDB bool
+	for _, s := range spans {
+		if s.Name == "db.Retrieve" {
+			foundDB = true
+			if s.TraceID != traceID || s.ParentSpanID != server.SpanID {
+				t.Errorf("database span is not a child of the server span: %+v", s)
+			}
+		}
+	}
+	if !foundDB {
+		t.Errorf("missing database span in %+v", spans)
+	}
+
+	for _, h := range []string{"", "00-" + traceID + "-" + parentID, "01-" + traceID + "-" + parentID + "-01",
+		"00-" + strings.Repeat("0", 32) + "-" + parentID + "-01", "00-" + traceID + "-zzzzzzzzzzzzzzzz-01"} {
+		if _, ok := parseTraceParent(h); ok {
+			t.Errorf("parseTraceParent(%q) succeeded, want failure", h)
+		}
+	}
+}
+
+func TestAdminStatus(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	type status struct {
+		Clients []struct {
+			ID    int64  `json:"id"`
+			State string `json:"state"`
+		} `json:"clients"`
+		Blobs    map[string]int64 `json:"blobs"`
+		Database storeStats       `json:"database"`
+	}
+	getStatus := func() (st status) {
+		t.Helper()
+		resp, err := http.Get(srv.URL + "/admin/status")
+		if err != nil {
+			t.Fatalf("http.Get error: %v", err)
+		}
+		defer resp.Body.Close()
+		if err := json.NewDecoder(resp.Body).Decode(&st); err != nil {
+			t.Fatalf("json.Decode error: %v", err)
+		}
+		return st
+	}
+	waitState := func(want string) int64 {
+		t.Helper()
+		for deadline := time.Now().Add(time.Minute); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+			if st := getStatus(); len(st.Clients) == 1 && st.Clients[0].State == want {
+				return st.Clients[0].ID
+			}
+		}
+		t.Fatalf("client never reached %q state: %+v", want, getStatus())
+		return 0
+	}
+
+	st := getStatus()
+	if len(st.Clients) != 0 || st.Database.Backend != "bolt" || st.Database.Snippets != 1 || st.Blobs == nil {
+		t.Errorf("unexpected initial status: %+v", st)
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/websocket", nil)
+	if err != nil {
+		t.Fatalf("websocket.Dial error: %v", err)
+	}
+	defer conn.Close()
+	go func() {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	waitState(execIdle)
+
+	// Run a program that never terminates and forcibly stop it.
+	code := "package main\n\nimport \"time\"\n\nfunc main() { time.Sleep(time.Hour) }\n"
+	conn.WriteJSON(map[string]string{"action": actionRun, "data": code})
+	id := waitState(execRunning)
+	resp, err := http.Post(srv.URL+fmt.Sprintf("/admin/clients/%d/stop", id), "", nil)
+	if err != nil {
+		t.Fatalf("http.Post error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("stop status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	waitState(execIdle)
+
+	resp, err = http.Post(srv.URL+"/admin/clients/999/stop", "", nil)
+	if err != nil {
+		t.Fatalf("http.Post error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("stop status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestWebsocketKeepalive(t *testing.T) {
+	defer func(period, wait time.Duration) {
+		websocketPingPeriod, websocketPongWait = period, wait
+	}(websocketPingPeriod, websocketPongWait)
+	websocketPingPeriod, websocketPongWait = 50*time.Millisecond, 200*time.Millisecond
+
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	dial := func() *websocket.Conn {
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/websocket", nil)
+		if err != nil {
+			t.Fatalf("websocket.Dial error: %v", err)
+		}
+		return conn
+	}
+
+	// The live client reads messages and thus automatically responds to
+	// pings, while the dead client never reads and never responds.
+	live := dial()
+	defer live.Close()
+	go func() {
+		for {
+			if _, _, err := live.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	dead := dial()
+	defer dead.Close()
+
+	time.Sleep(10 * websocketPongWait)
+	if pg.lookupClient(1) == nil {
+		t.Errorf("live client was disconnected")
+	}
+	if pg.lookupClient(2) != nil {
+		t.Errorf("dead client is still connected")
+	}
+	if n := atomic.LoadInt64(&pg.numActive); n != 1 {
+		t.Errorf("numActive = %d, want 1", n)
+	}
+}
+
+func TestWebsocketResume(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	type jsonMessage struct {
+		Action string `json:"action"`
+		Data   string `json:"data"`
+	}
+	dial := func(query string) *websocket.Conn {
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/websocket"+query, nil)
+		if err != nil {
+			t.Fatalf("websocket.Dial error: %v", err)
+		}
+		conn.SetReadDeadline(time.Now().Add(time.Minute))
+		return conn
+	}
+	recv := func(conn *websocket.Conn) jsonMessage {
+		var msg jsonMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Fatalf("ReadJSON error: %v", err)
+		}
+		return msg
+	}
+
+	// Start a run and disconnect before it completes.
+	conn := dial("")
+	msg := recv(conn)
+	if msg.Action != sessionToken || msg.Data == "" {
+		t.Fatalf("first message = %+v, want session token", msg)
+	}
+	token := msg.Data
+	code := "package main\n\nimport (\"fmt\"; \"time\")\n\nfunc main() { fmt.Println(\"before\"); time.Sleep(time.Second); fmt.Println(\"after\") }\n"
+	conn.WriteJSON(jsonMessage{Action: actionRun, Data: code})
+	conn.Close()
+
+	// Resuming the session replays all output missed since the first message.
+	conn = dial("?resume=" + token + "&seq=1")
+	defer conn.Close()
+	var stdout string
+	for msg := recv(conn); msg.Action != statusStopped; msg = recv(conn) {
+		switch msg.Action {
+		case sessionToken:
+			t.Errorf("unexpected new session token upon resume")
+		case appendStdout:
+			stdout += msg.Data
+		}
+	}
+	if want := "before\nafter\n"; stdout != want {
+		t.Errorf("resumed stdout = %q, want %q", stdout, want)
+	}
+
+	// An unknown token starts a new session.
+	conn2 := dial("?resume=unknown&seq=5")
+	defer conn2.Close()
+	if msg := recv(conn2); msg.Action != sessionToken || msg.Data == token {
+		t.Errorf("first message = %+v, want new session token", msg)
+	}
+}
+
+func TestBenchmarkHistory(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	get := func(url string, wantStatus int) []byte {
+		t.Helper()
+		resp, err := http.Get(srv.URL + url)
+		if err != nil {
+			t.Fatalf("http.Get error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		if resp.StatusCode != wantStatus {
+			t.Fatalf("GET %s: status %d, want %d: %s", url, resp.StatusCode, wantStatus, b)
+		}
+		return b
+	}
+	id, err := pg.sdb.Create(snippet{Name: "bench"})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	url := fmt.Sprintf("/snippets/%d/benchmarks", id)
+	if b := get(url, http.StatusOK); string(b) != "[]" {
+		t.Errorf("initial history = %s, want []", b)
+	}
+
+	// Run a benchmark of the opened snippet.
+	type jsonMessage struct {
+		Action string `json:"action"`
+		Data   string `json:"data"`
+	}
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/websocket", nil)
+	if err != nil {
+		t.Fatalf("websocket.Dial error: %v", err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(time.Minute))
+	code := "package main\n\nimport \"testing\"\n\nfunc BenchmarkNop(b *testing.B) {}\n"
+	conn.WriteJSON(jsonMessage{Action: actionOpen, Data: fmt.Sprint(id)})
+	conn.WriteJSON(jsonMessage{Action: actionRun, Data: code})
+	for {
+		var msg jsonMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Fatalf("ReadJSON error: %v", err)
+		}
+		if msg.Action == statusStopped {
+			break
+		}
+	}
+
+	var brs []benchRun
+	if err := json.Unmarshal(get(url, http.StatusOK), &brs); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if len(brs) != 1 || brs[0].SnippetID != id || brs[0].Time.IsZero() ||
+		len(brs[0].Results) != 1 || !strings.HasPrefix(brs[0].Results[0].Name, "BenchmarkNop") {
+		t.Errorf("benchmark history mismatch: %+v", brs)
+	}
+
+	get(url+"?limit=0", http.StatusBadRequest)
+	get("/snippets/999/benchmarks", http.StatusNotFound)
+}
+
+func TestCollab(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	code := "package main\n\nfunc main() { println(\"hello\") }\n"
+	id, err := pg.sdb.Create(snippet{Name: "collab", Code: code})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+
+	type jsonMessage struct {
+		Action string `json:"action"`
+		Data   string `json:"data"`
+	}
+	dial := func() *websocket.Conn {
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/websocket", nil)
+		if err != nil {
+			t.Fatalf("websocket.Dial error: %v", err)
+		}
+		conn.SetReadDeadline(time.Now().Add(time.Minute))
+		return conn
+	}
+	// recvUntil reads messages until one with the given action arrives.
+	recvUntil := func(conn *websocket.Conn, action string) (msgs []jsonMessage) {
+		for {
+			var msg jsonMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				t.Fatalf("ReadJSON error: %v", err)
+			}
+			msgs = append(msgs, msg)
+			if msg.Action == action {
+				return msgs
+			}
+		}
+	}
+	last := func(msgs []jsonMessage) string { return msgs[len(msgs)-1].Data }
+
+	// Both clients join the room and receive the snippet code.
+	connA, connB := dial(), dial()
+	defer connA.Close()
+	defer connB.Close()
+	connA.WriteJSON(jsonMessage{Action: actionJoin, Data: fmt.Sprint(id)})
+	if got := last(recvUntil(connA, actionEdit)); got != code {
+		t.Errorf("joined code = %q, want %q", got, code)
+	}
+	recvUntil(connA, collabMembers)
+	connB.WriteJSON(jsonMessage{Action: actionJoin, Data: fmt.Sprint(id)})
+	if got := last(recvUntil(connB, actionEdit)); got != code {
+		t.Errorf("joined code = %q, want %q", got, code)
+	}
+	var members []collabMember
+	json.Unmarshal([]byte(last(recvUntil(connA, collabMembers))), &members)
+	if len(members) != 2 {
+		t.Errorf("got %d members, want 2", len(members))
+	}
+
+	// Edits and cursors by one participant are relayed to the other.
+	code2 := "package main\n\nfunc main() { println(\"world\") }\n"
+	connA.WriteJSON(jsonMessage{Action: actionEdit, Data: code2})
+	if got := last(recvUntil(connB, actionEdit)); got != code2 {
+		t.Errorf("relayed code = %q, want %q", got, code2)
+	}
+	connA.WriteJSON(jsonMessage{Action: actionCursor, Data: `{"line":2,"ch":5}`})
+	var cur collabCursor
+	json.Unmarshal([]byte(last(recvUntil(connB, actionCursor))), &cur)
+	if cur.ID != members[0].ID || cur.Line != 2 || cur.Ch != 5 {
+		t.Errorf("relayed cursor = %+v, want client %d at 2:5", cur, members[0].ID)
+	}
+
+	// A run by one participant is broadcast to all participants.
+	connA.WriteJSON(jsonMessage{Action: actionRun, Data: code2})
+	for _, conn := range []*websocket.Conn{connA, connB} {
+		var stderr string
+		for _, msg := range recvUntil(conn, statusStopped) {
+			if msg.Action == appendStderr {
+				stderr += msg.Data
+			}
+		}
+		if !strings.Contains(stderr, "world") {
+			t.Errorf("stderr = %q, want output of the run", stderr)
+		}
+	}
+
+	// Leaving the room updates the members of the remaining participant.
+	connB.WriteJSON(jsonMessage{Action: actionLeave})
+	members = nil
+	json.Unmarshal([]byte(last(recvUntil(connA, collabMembers))), &members)
+	if len(members) != 1 {
+		t.Errorf("got %d members, want 1", len(members))
+	}
+}
+
+func TestWebsocketWatch(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	type jsonMessage struct {
+		Action string `json:"action"`
+		Data   string `json:"data"`
+	}
+	dial := func() *websocket.Conn {
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/websocket", nil)
+		if err != nil {
+			t.Fatalf("websocket.Dial error: %v", err)
+		}
+		conn.SetReadDeadline(time.Now().Add(time.Minute))
+		return conn
+	}
+	recvUntil := func(conn *websocket.Conn, action string) (msgs []jsonMessage) {
+		for {
+			var msg jsonMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				t.Fatalf("ReadJSON error: %v", err)
+			}
+			msgs = append(msgs, msg)
+			if msg.Action == action {
+				return msgs
+			}
+		}
+	}
+
+	// The watcher receives the output of runs by the watched client.
+	connA, connB := dial(), dial()
+	defer connA.Close()
+	defer connB.Close()
+	connA.WriteJSON(jsonMessage{Action: actionShare})
+	msgs := recvUntil(connA, actionShare)
+	watchID := msgs[len(msgs)-1].Data
+	connB.WriteJSON(jsonMessage{Action: actionWatch, Data: watchID})
+	recvUntil(connB, clearOutput)
+
+	code := "package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Println(\"watched\") }\n"
+	connA.WriteJSON(jsonMessage{Action: actionRun, Data: code})
+	var stdout string
+	for _, msg := range recvUntil(connB, statusStopped) {
+		if msg.Action == appendStdout {
+			stdout += msg.Data
+		}
+	}
+	if want := "watched\n"; stdout != want {
+		t.Errorf("watched stdout = %q, want %q", stdout, want)
+	}
+
+	// The watcher may not run anything itself.
+	connB.WriteJSON(jsonMessage{Action: actionRun, Data: code})
+	msgs = recvUntil(connB, statusUpdate)
+	if got := msgs[len(msgs)-1].Data; !strings.Contains(got, "Cannot run") {
+		t.Errorf("statusUpdate = %q, want refusal to run", got)
+	}
+
+	// Unknown watch IDs are rejected.
+	connB.WriteJSON(jsonMessage{Action: actionWatch, Data: "unknown"})
+	msgs = recvUntil(connB, statusUpdate)
+	if got := msgs[len(msgs)-1].Data; !strings.Contains(got, "Unable to watch") {
+		t.Errorf("statusUpdate = %q, want failure to watch", got)
+	}
+}
+
+func TestWebsocketBinary(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	dl := websocket.Dialer{Subprotocols: []string{websocketBinaryProtocol}, EnableCompression: true}
+	conn, resp, err := dl.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/websocket", nil)
+	if err != nil {
+		t.Fatalf("websocket.Dial error: %v", err)
+	}
+	defer conn.Close()
+	if got := conn.Subprotocol(); got != websocketBinaryProtocol {
+		t.Errorf("Subprotocol = %q, want %q", got, websocketBinaryProtocol)
+	}
+	if got := resp.Header.Get("Sec-Websocket-Extensions"); !strings.Contains(got, "permessage-deflate") {
+		t.Errorf("Sec-Websocket-Extensions = %q, want permessage-deflate", got)
+	}
+	conn.SetReadDeadline(time.Now().Add(time.Minute))
+
+	// Output larger than minCompressSize is sent compressed and unescaped.
+	code := "package main\n\nimport (\"fmt\"; \"strings\")\n\nfunc main() { fmt.Print(strings.Repeat(\"\\\"quoted\\\"\\n\", 1000)) }\n"
+	conn.WriteJSON(map[string]string{"action": actionRun, "data": code})
+	var stdout string
+	for {
+		typ, b, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("ReadMessage error: %v", err)
+		}
+		if typ != websocket.BinaryMessage {
+			t.Fatalf("message type = %d, want %d", typ, websocket.BinaryMessage)
+		}
+		i := bytes.IndexByte(b, 0)
+		if i < 0 {
+			t.Fatalf("malformed binary message: %q", b)
+		}
+		action, data := string(b[:i]), string(b[i+1:])
+		if action == appendStdout {
+			stdout += data
+		}
+		if action == statusStopped {
+			break
+		}
+	}
+	if want := strings.Repeat("\"quoted\"\n", 1000); stdout != want {
+		t.Errorf("stdout mismatch: got %d bytes, want %d bytes", len(stdout), len(want))
+	}
+}
+
+func TestPprof(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("pass"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt.GenerateFromPassword error: %v", err)
+	}
+	pw, err := parsePasswordHash(string(hash), "")
+	if err != nil {
+		t.Fatalf("parsePasswordHash error: %v", err)
+	}
+	pg, err := newPlayground(pw, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	jar, _ := cookiejar.New(nil)
+	cln := &http.Client{
+		Jar:           jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	get := func(url string) (int, string) {
+		t.Helper()
+		resp, err := cln.Get(srv.URL + url)
+		if err != nil {
+			t.Fatalf("client.Get error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		return resp.StatusCode, string(b)
+	}
+
+	// Profiles must require authentication.
+	pg.enablePprof = true
+	if status, _ := get("/debug/pprof/"); status != http.StatusUnauthorized {
+		t.Errorf("unauthenticated status = %d, want %d", status, http.StatusUnauthorized)
+	}
+	resp, err := cln.Post(srv.URL+"/login", "", strings.NewReader("pass"))
+	if err != nil {
+		t.Fatalf("client.Post error: %v", err)
+	}
+	resp.Body.Close()
+
+	if status, body := get("/debug/pprof/"); status != http.StatusOK || !strings.Contains(body, "goroutine") {
+		t.Errorf("index status = %d, want %d with list of profiles", status, http.StatusOK)
+	}
+	if status, body := get("/debug/pprof/goroutine?debug=1"); status != http.StatusOK || !strings.Contains(body, "servePprof") {
+		t.Errorf("goroutine status = %d, want %d with stack traces", status, http.StatusOK)
+	}
+	if status, _ := get("/debug/pprof"); status != http.StatusMovedPermanently {
+		t.Errorf("redirect status = %d, want %d", status, http.StatusMovedPermanently)
+	}
+
+	// Profiles must be unavailable unless enabled.
+	pg.enablePprof = false
+	if status, _ := get("/debug/pprof/"); status != http.StatusTemporaryRedirect {
+		t.Errorf("disabled status = %d, want %d", status, http.StatusTemporaryRedirect)
+	}
+}
+
+func TestPprofUI(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	conf := execConfig{gc: "go", fmt: "gofmt", pprofUI: newPprofServers()}
+	pg, err := newPlayground(nil, "bolt", tmpDir, conf, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	pg.basePath = "/play"
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	type jsonMessage struct {
+		Action string `json:"action"`
+		Data   string `json:"data"`
+	}
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/play/websocket", nil)
+	if err != nil {
+		t.Fatalf("websocket.Dial error: %v", err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(time.Minute))
+	run := func(code string) (urls []string) {
+		t.Helper()
+		conn.WriteJSON(jsonMessage{Action: actionRun, Data: code})
+		for {
+			var msg jsonMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				t.Fatalf("ReadJSON error: %v", err)
+			}
+			switch msg.Action {
+			case reportProfile:
+				var r struct{ URL string }
+				json.Unmarshal([]byte(msg.Data), &r)
+				if r.URL != "" {
+					urls = append(urls, r.URL)
+				}
+			case statusStopped:
+				return urls
+			}
+		}
+	}
+
+	urls := run(`//playground:pprof cpu
+		package main
+		import "testing"
+		func Benchmark(b *testing.B) { for i := 0; i < b.N; i++ {} }`)
+	if len(urls) != 1 || !strings.HasPrefix(urls[0], "pprof/") {
+		t.Fatalf("interactive reports = %q, want one under pprof/", urls)
+	}
+
+	// The web UI is served under the prefix of its ID.
+	resp, err := http.Get(srv.URL + "/play/" + urls[0])
+	if err != nil {
+		t.Fatalf("http.Get error: %v", err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(b), "./flamegraph") {
+		t.Errorf("web UI status = %d, want %d with link to flame graph", resp.StatusCode, http.StatusOK)
+	}
+	if want := "/play/" + urls[0] + "ui/"; !strings.HasPrefix(resp.Request.URL.Path, want) {
+		t.Errorf("redirected to %q, want prefix %q", resp.Request.URL.Path, want)
+	}
+	cln := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err = cln.Get(srv.URL + "/play/" + strings.TrimSuffix(urls[0], "/"))
+	if err != nil {
+		t.Fatalf("client.Get error: %v", err)
+	}
+	resp.Body.Close()
+	if loc := resp.Header.Get("Location"); resp.StatusCode != http.StatusMovedPermanently || loc != "/play/"+urls[0] {
+		t.Errorf("redirect = (%d, %q), want (%d, %q)", resp.StatusCode, loc, http.StatusMovedPermanently, "/play/"+urls[0])
+	}
+
+	// Starting another run stops the web UI.
+	run("package main\n\nfunc main() {}\n")
+	if n := conf.pprofUI.Len(); n != 0 {
+		t.Errorf("number of web UIs = %d, want 0", n)
+	}
+	resp, err = http.Get(srv.URL + "/play/" + urls[0])
+	if err != nil {
+		t.Fatalf("http.Get error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("stopped web UI status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestDAV(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	pg.basePath = "/play"
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	do := func(method, url, body string, hdr map[string]string, wantStatus int) string {
+		t.Helper()
+		req, _ := http.NewRequest(method, srv.URL+"/play/dav"+url, strings.NewReader(body))
+		for k, v := range hdr {
+			req.Header.Set(k, v)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http.Do error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		if resp.StatusCode != wantStatus {
+			t.Fatalf("%s %s: status %d, want %d: %s", method, url, resp.StatusCode, wantStatus, b)
+		}
+		return string(b)
+	}
+	names := func() map[string]string {
+		t.Helper()
+		ss, err := pg.sdb.QueryByID(0, -1)
+		if err != nil {
+			t.Fatalf("QueryByID error: %v", err)
+		}
+		m := make(map[string]string)
+		for _, s := range ss {
+			m[s.Name] = s.Code
+		}
+		return m
+	}
+
+	// Writing to a new file creates a snippet and later writes update it.
+	do("PUT", "/hello.go", "package main // 1\n", nil, http.StatusCreated)
+	do("PUT", "/hello.go", "package main // 2\n", nil, http.StatusCreated)
+	if got := names()["hello"]; got != "package main // 2\n" {
+		t.Errorf("code of hello = %q, want the last write", got)
+	}
+	if got := do("GET", "/hello.go", "", nil, http.StatusOK); got != "package main // 2\n" {
+		t.Errorf("GET hello.go = %q, want the last write", got)
+	}
+	do("PUT", "/notes.txt", "notes", nil, http.StatusNotFound)
+	do("PUT", "/.hello.go.swp", "swap", nil, http.StatusNotFound)
+	do("MKCOL", "/dir", "", nil, http.StatusMethodNotAllowed)
+
+	// Snippets of the same name are listed with their IDs.
+	id, err := pg.sdb.Create(snippet{Name: "hello", Code: "package main // 3\n"})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+	list := do("PROPFIND", "/", "", map[string]string{"Depth": "1"}, http.StatusMultiStatus)
+	for _, name := range []string{"Default%20snippet.go", "hello.go", fmt.Sprintf("hello~%d.go", id)} {
+		if !strings.Contains(list, "/play/dav/"+name+"<") {
+			t.Errorf("PROPFIND listing missing %s:\n%s", name, list)
+		}
+	}
+	if got := do("GET", fmt.Sprintf("/hello~%d.go", id), "", nil, http.StatusOK); got != "package main // 3\n" {
+		t.Errorf("GET hello~%d.go = %q", id, got)
+	}
+
+	// Moving a file renames the snippet and deleting it deletes the snippet.
+	do("MOVE", "/hello.go", "", map[string]string{"Destination": srv.URL + "/play/dav/a%252Fb.go"}, http.StatusCreated)
+	if got := names()["a/b"]; got != "package main // 2\n" {
+		t.Errorf("code of a/b = %q, want the renamed snippet", got)
+	}
+	do("DELETE", "/a%252Fb.go", "", nil, http.StatusNoContent)
+	if _, ok := names()["a/b"]; ok {
+		t.Errorf("snippet a/b not deleted")
+	}
+	do("DELETE", "/a%252Fb.go", "", nil, http.StatusNotFound)
+	do("DELETE", "/Default%20snippet.go", "", nil, http.StatusMethodNotAllowed)
+}
+
+func TestRun(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	run := func(query, code string, wantStatus int) (out runResponse) {
+		t.Helper()
+		resp, err := http.Post(srv.URL+"/run"+query, "text/plain", strings.NewReader(code))
+		if err != nil {
+			t.Fatalf("http.Post error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		if resp.StatusCode != wantStatus {
+			t.Fatalf("POST /run%s: status %d, want %d: %s", query, resp.StatusCode, wantStatus, b)
+		}
+		if wantStatus == http.StatusOK {
+			if err := json.Unmarshal(b, &out); err != nil {
+				t.Fatalf("json.Unmarshal error: %v", err)
+			}
+		}
+		return out
+	}
+
+	out := run("?pragma=execargs+-x+y", `package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	fmt.Println(os.Args[1:])
+	fmt.Fprintln(os.Stderr, "oops")
+	os.Exit(3)
+}`, http.StatusOK)
+	if out.Stdout != "[-x y]\n" || out.Stderr != "oops\n" {
+		t.Errorf("output = (%q, %q), want (%q, %q)", out.Stdout, out.Stderr, "[-x y]\n", "oops\n")
+	}
+	if out.Exit == nil || out.Exit.Reason != "exit" || out.Exit.ExitCode != 3 {
+		t.Errorf("exit = %+v, want exit code 3", out.Exit)
+	}
+
+	out = run("", "package main\n\nfunc main() { undefined() }\n", http.StatusOK)
+	if out.Exit != nil || !strings.Contains(out.Stdout+out.Stderr+out.Status, "undefined") {
+		t.Errorf("build failure = %+v, want undefined error", out)
+	}
+
+	out = run("?timeout=100ms", "package main\n\nfunc main() { for {} }\n", http.StatusOK)
+	if out.Exit == nil || out.Exit.Reason != "timeout" {
+		t.Errorf("exit = %+v, want timeout", out.Exit)
+	}
+
+	run("?timeout=0", "package main", http.StatusBadRequest)
+	run("?pragma=a%0Ab", "package main", http.StatusBadRequest)
+	run("?unknown=1", "package main", http.StatusBadRequest)
+	run("", strings.Repeat(" ", maxRunSize+1), http.StatusRequestEntityTooLarge)
+}
+
+func TestOpenAPI(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	pg.basePath = "/play"
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+
+	do := func(method, url, body string, wantStatus int) string {
+		t.Helper()
+		req, _ := http.NewRequest(method, srv.URL+"/play"+url, strings.NewReader(body))
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("http.Do error: %v", err)
+		}
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		if resp.StatusCode != wantStatus {
+			t.Fatalf("%s %s: status %d, want %d: %s", method, url, resp.StatusCode, wantStatus, b)
+		}
+		return string(b)
+	}
+
+	// The specification is served with the server URL of the playground.
+	var spec struct {
+		Servers []struct{ URL string }
+		Paths   map[string]map[string]json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(do("GET", "/openapi.json", "", http.StatusOK)), &spec); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if len(spec.Servers) != 1 || spec.Servers[0].URL != "/play" {
+		t.Errorf("servers = %+v, want /play", spec.Servers)
+	}
+
+	// Every operation in the specification is routed to a handler.
+	reParam := regexp.MustCompile(`\{[^}]*\}`)
+	for p, item := range spec.Paths {
+		for method := range item {
+			if method == "parameters" {
+				continue
+			}
+			req := httptest.NewRequest(strings.ToUpper(method), reParam.ReplaceAllString(p, "1"), nil)
+			var routed bool
+			for _, re := range []*regexp.Regexp{reLogin, reSnippets, reSnippetsID, reHTML, reRun, reBlobs, reDynamic} {
+				routed = routed || matchRequest(req, re, req.Method)
+			}
+			if !routed {
+				t.Errorf("%s %s is not routed", req.Method, p)
+			}
+		}
+	}
+
+	// Requests are validated against the specification.
+	do("GET", "/snippets?limit=1", "", http.StatusOK)
+	do("GET", "/snippets?limit=many", "", http.StatusBadRequest)
+	do("GET", "/snippets?allFields=maybe", "", http.StatusBadRequest)
+	do("GET", "/snippets?sort=-modified", "", http.StatusOK)
+	do("GET", "/snippets?sort=size", "", http.StatusBadRequest)
+	do("GET", "/snippets?unknown=1", "", http.StatusBadRequest)
+	do("POST", "/snippets", "", http.StatusBadRequest)
+	do("DELETE", "/snippets/1?force=true", "", http.StatusBadRequest)
+	do("POST", "/run?timeout=forever", "package main", http.StatusBadRequest)
+	do("GET", "/snippets/export?format=json", "", http.StatusOK) // Not in the specification
 }
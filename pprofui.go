@@ -0,0 +1,252 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// pprofStartTimeout is how long a pprof web UI may take to start listening.
+var pprofStartTimeout = 30 * time.Second
+
+// pprofServers is a registry of interactive pprof web UIs, each of which is
+// a "go tool pprof -http" process listening on a loopback port.
+// A nil pprofServers is valid and never starts any UI.
+type pprofServers struct {
+	mu sync.Mutex
+	m  map[string]*pprofServer
+}
+
+type pprofServer struct {
+	cmd    *exec.Cmd
+	done   chan struct{} // Closed once the process exits
+	dir    string        // Temporary directory holding the binary and profile
+	target *url.URL      // Address of the web UI
+}
+
+func newPprofServers() *pprofServers {
+	return &pprofServers{m: make(map[string]*pprofServer)}
+}
+
+// Start launches a web UI using the go binary gc for the profile prof of
+// the binary bin, and returns its ID once it is ready to serve requests.
+// Since the binary and profile are usually overwritten by the next run,
+// they are first copied into a directory owned by the web UI.
+func (ps *pprofServers) Start(gc, bin, prof string) (string, error) {
+	dir, err := ioutil.TempDir("", "pprof")
+	if err != nil {
+		return "", err
+	}
+	for _, src := range []string{bin, prof} {
+		if err := copyFile(filepath.Join(dir, filepath.Base(src)), src); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+
+	// The web UI does not report the port it listens on.
+	addr, err := freeLoopbackAddr()
+	if err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+
+	bb := new(bytes.Buffer)
+	s := &pprofServer{done: make(chan struct{}), dir: dir, target: &url.URL{Scheme: "http", Host: addr}}
+	s.cmd = exec.Command(gc, "tool", "pprof", "-http="+addr, "-no_browser", filepath.Base(bin), filepath.Base(prof))
+	s.cmd.Dir = dir
+	s.cmd.Stdout = bb
+	s.cmd.Stderr = bb
+	s.cmd.Env = append(append([]string(nil), os.Environ()...), "PPROF_TMPDIR="+dir)
+	s.cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true} // The go command runs pprof as a child
+	if err := s.cmd.Start(); err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	go func() {
+		s.cmd.Wait()
+		close(s.done)
+	}()
+
+	// Wait until the web UI accepts connections.
+	deadline := time.Now().Add(pprofStartTimeout)
+	for {
+		c, err := net.DialTimeout("tcp", addr, time.Second)
+		if err == nil {
+			c.Close()
+			break
+		}
+		select {
+		case <-s.done:
+			os.RemoveAll(dir)
+			return "", fmt.Errorf("pprof exited: %s", strings.TrimSpace(bb.String()))
+		case <-time.After(50 * time.Millisecond):
+		}
+		if time.Now().After(deadline) {
+			s.stop()
+			return "", errors.New("pprof did not start in time")
+		}
+	}
+
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		s.stop()
+		return "", err
+	}
+	id := hex.EncodeToString(b[:])
+	ps.mu.Lock()
+	ps.m[id] = s
+	ps.mu.Unlock()
+	return id, nil
+}
+
+// Stop terminates the web UI with the given ID.
+func (ps *pprofServers) Stop(id string) {
+	if ps == nil {
+		return
+	}
+	ps.mu.Lock()
+	s := ps.m[id]
+	delete(ps.m, id)
+	ps.mu.Unlock()
+	if s != nil {
+		s.stop()
+	}
+}
+
+// Close terminates all web UIs.
+func (ps *pprofServers) Close() {
+	if ps == nil {
+		return
+	}
+	ps.mu.Lock()
+	m := ps.m
+	ps.m = make(map[string]*pprofServer)
+	ps.mu.Unlock()
+	for _, s := range m {
+		s.stop()
+	}
+}
+
+// Len reports the number of running web UIs.
+func (ps *pprofServers) Len() int {
+	if ps == nil {
+		return 0
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return len(ps.m)
+}
+
+func (ps *pprofServers) lookup(id string) *pprofServer {
+	if ps == nil {
+		return nil
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return ps.m[id]
+}
+
+func (s *pprofServer) stop() {
+	syscall.Kill(-s.cmd.Process.Pid, syscall.SIGKILL)
+	<-s.done
+	os.RemoveAll(s.dir)
+}
+
+// freeLoopbackAddr returns the address of an unused port on the loopback
+// interface for a child process to listen on. Since the port is released
+// before the child listens on it, something else could grab it in the
+// meantime, but this is unlikely.
+func freeLoopbackAddr() (string, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer ln.Close()
+	return ln.Addr().String(), nil
+}
+
+// copyFile copies the file at src to dst, preserving its permissions.
+func copyFile(dst, src string) error {
+	sf, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer sf.Close()
+	fi, err := sf.Stat()
+	if err != nil {
+		return err
+	}
+	df, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fi.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(df, sf); err != nil {
+		df.Close()
+		return err
+	}
+	return df.Close()
+}
+
+// servePprofUI reverse-proxies requests for "/pprof/{id}/..." to the
+// interactive pprof web UI with the given ID.
+func (pg *playground) servePprofUI(w http.ResponseWriter, r *http.Request) {
+	p := strings.TrimPrefix(r.URL.Path, "/pprof/")
+	id, rest := p, "/"
+	if i := strings.IndexByte(p, '/'); i >= 0 {
+		id, rest = p[:i], p[i:]
+	}
+	s := pg.exConf.pprofUI.lookup(id)
+	if s == nil {
+		httpError(w, r, "not found", http.StatusNotFound)
+		return
+	}
+	if rest == "/" && !strings.HasSuffix(r.URL.Path, "/") {
+		// Relative links within the web UI require the trailing slash.
+		http.Redirect(w, r, pg.basePath+r.URL.Path+"/", http.StatusMovedPermanently)
+		return
+	}
+
+	// The web UI uses relative links, except for redirects to "/ui/",
+	// which must be rewritten to be under the prefix of the web UI.
+	prefix := pg.basePath + "/pprof/" + id
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = s.target.Scheme
+			req.URL.Host = s.target.Host
+			req.URL.Path = rest
+			req.URL.RawPath = ""
+			req.Host = s.target.Host
+		},
+		ModifyResponse: func(resp *http.Response) error {
+			if loc := resp.Header.Get("Location"); strings.HasPrefix(loc, "/") {
+				resp.Header.Set("Location", prefix+loc)
+			}
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			pg.logf(r, "pprof proxy error: %v", err)
+			httpError(w, r, err.Error(), http.StatusBadGateway)
+		},
+	}
+	proxy.ServeHTTP(w, r)
+}
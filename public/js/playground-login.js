@@ -16,7 +16,7 @@ function loginPrompt() {
 			return new Promise(function(resolve, reject) {
 				// Verify that the authentication token.
 				var req = new XMLHttpRequest();
-				req.open("POST", "/login", false);
+				req.open("POST", "login", false);
 				req.send(password);
 				switch (req.status) {
 				case 200:
@@ -20,6 +20,92 @@ function clearOutput() {
 		node.removeChild(node.firstChild);
 	}
 	outputOffset = 0;
+	ansiStyles = {};
+}
+
+// ansiColors are the colors of the standard and bright ANSI color codes.
+var ansiColors = [
+	"#000000", "#cd3131", "#0dbc79", "#e5e510", "#2472c8", "#bc3fbc", "#11a8cd", "#e5e5e5",
+	"#666666", "#f14c4c", "#23d18b", "#f5f543", "#3b8eea", "#d670d6", "#29b8db", "#ffffff",
+];
+
+// ansi256Color returns the color of the n-th entry in the 256-color palette.
+function ansi256Color(n) {
+	if (n < 16) return ansiColors[n];
+	if (n >= 232) {
+		var v = 8 + 10*(n-232);
+		return "rgb(" + v + "," + v + "," + v + ")";
+	}
+	n -= 16;
+	var f = function(c) { return c == 0 ? 0 : 55 + 40*c; };
+	return "rgb(" + f(Math.floor(n/36)) + "," + f(Math.floor(n/6)%6) + "," + f(n%6) + ")";
+}
+
+// ansiStyles holds the current SGR state of each output stream, which
+// persists across messages until reset by the program.
+var ansiStyles = {};
+
+// applySGR updates the SGR state st with the parameters of an SGR sequence.
+function applySGR(st, params) {
+	var ps = params.split(/[;:]/).map(function(p) { return parseInt(p, 10) || 0; });
+	for (var i = 0; i < ps.length; i++) {
+		var p = ps[i];
+		if (p == 0) {
+			for (var k in st) delete st[k];
+		} else if (p == 1) {
+			st.bold = true;
+		} else if (p == 3) {
+			st.italic = true;
+		} else if (p == 4) {
+			st.underline = true;
+		} else if (p == 22) {
+			delete st.bold;
+		} else if (p == 23) {
+			delete st.italic;
+		} else if (p == 24) {
+			delete st.underline;
+		} else if ((p >= 30 && p <= 37) || (p >= 90 && p <= 97)) {
+			st.fg = ansiColors[(p%10) + (p >= 90 ? 8 : 0)];
+		} else if ((p >= 40 && p <= 47) || (p >= 100 && p <= 107)) {
+			st.bg = ansiColors[(p%10) + (p >= 100 ? 8 : 0)];
+		} else if (p == 39) {
+			delete st.fg;
+		} else if (p == 49) {
+			delete st.bg;
+		} else if (p == 38 || p == 48) {
+			var c = null;
+			if (ps[i+1] == 5) {
+				c = ansi256Color(ps[i+2] & 0xff);
+				i += 2;
+			} else if (ps[i+1] == 2) {
+				c = "rgb(" + ps[i+2] + "," + ps[i+3] + "," + ps[i+4] + ")";
+				i += 4;
+			}
+			if (c) st[p == 38 ? "fg" : "bg"] = c;
+		}
+	}
+}
+
+// appendANSI appends msg to node, rendering the SGR sequences in it
+// according to the SGR state of the stream.
+function appendANSI(node, msg, cls) {
+	var st = ansiStyles[cls] = ansiStyles[cls] || {};
+	var parts = msg.split(/\x1b\[([0-9;:]*)m/);
+	for (var i = 0; i < parts.length; i++) {
+		if (i%2 == 1) {
+			applySGR(st, parts[i]);
+			continue;
+		}
+		if (parts[i] == "") continue;
+		var span = document.createElement("span");
+		span.style.color = st.fg || "";
+		span.style.backgroundColor = st.bg || "";
+		span.style.fontWeight = st.bold ? "bold" : "";
+		span.style.fontStyle = st.italic ? "italic" : "";
+		span.style.textDecoration = st.underline ? "underline" : "";
+		span.innerHTML = escapeHTML(parts[i]);
+		node.appendChild(span);
+	}
 }
 
 // doAutoscroll scrolls the page automatically if viewpoint is already really
@@ -62,7 +148,11 @@ function appendOutput(msg, cls) {
 
 		var node = document.createElement("span");
 		node.className = cls;
-		node.innerHTML = escapeHTML(msg);
+		if (cls == "stdout" || cls == "stderr") {
+			appendANSI(node, msg, cls);
+		} else {
+			node.innerHTML = escapeHTML(msg);
+		}
 		document.getElementById("outputPane").appendChild(node);
 	});
 }
@@ -72,7 +162,7 @@ var snippetDB = {
 	"queryByName": function(q) {
 		var req = new XMLHttpRequest();
 		q = (q) ? "&query="+encodeURIComponent(JSON.stringify(q)) : "";
-		req.open("GET", "/snippets?queryBy=name&limit=100" + q, false);
+		req.open("GET", "snippets?queryBy=name&limit=100" + q, false);
 		req.send();
 		switch (req.status) {
 		case 200:
@@ -84,10 +174,27 @@ var snippetDB = {
 			return {"ok": false};
 		}
 	},
+	"queryByRegex": function(q) {
+		var req = new XMLHttpRequest();
+		q = "&query="+encodeURIComponent(JSON.stringify(q));
+		req.open("GET", "snippets?queryBy=regex&sort=-modified&limit=100" + q, false);
+		req.send();
+		switch (req.status) {
+		case 200:
+			var ss = JSON.parse(req.responseText);
+			return {"snippets": ss || [], "ok": true};
+		case 400:
+			return {"snippets": [], "ok": true}; // Incomplete regular expression
+		default:
+			var msg = "Status " + req.status.toString() + ": " + req.responseText;
+			swal("Something went wrong:", msg, "error");
+			return {"ok": false};
+		}
+	},
 	"queryByModified": function(q) {
 		var req = new XMLHttpRequest();
 		q = (q) ? "&query="+encodeURIComponent(JSON.stringify(q)) : "";
-		req.open("GET", "/snippets?queryBy=modified&limit=10" + q, false);
+		req.open("GET", "snippets?queryBy=modified&limit=10" + q, false);
 		req.send();
 		switch (req.status) {
 		case 200:
@@ -101,7 +208,7 @@ var snippetDB = {
 	},
 	"create": function(s) {
 		var req = new XMLHttpRequest();
-		req.open("POST", "/snippets", false);
+		req.open("POST", "snippets", false);
 		req.send(JSON.stringify(s));
 		switch (req.status) {
 		case 200:
@@ -113,14 +220,42 @@ var snippetDB = {
 			return {"ok": false};
 		}
 	},
+	"createFromTemplate": function(tmpl, name) {
+		var req = new XMLHttpRequest();
+		req.open("POST", "templates/" + encodeURIComponent(tmpl) + "?name=" + encodeURIComponent(name), false);
+		req.send();
+		switch (req.status) {
+		case 200:
+			var s = JSON.parse(req.responseText);
+			return {"snippet": s, "ok": true};
+		default:
+			var msg = "Status " + req.status.toString() + ": " + req.responseText;
+			swal("Something went wrong:", msg, "error");
+			return {"ok": false};
+		}
+	},
+	"templates": function() {
+		var req = new XMLHttpRequest();
+		req.open("GET", "templates", false);
+		req.send();
+		switch (req.status) {
+		case 200:
+			return {"templates": JSON.parse(req.responseText) || [], "ok": true};
+		default:
+			var msg = "Status " + req.status.toString() + ": " + req.responseText;
+			swal("Something went wrong:", msg, "error");
+			return {"ok": false};
+		}
+	},
 	"retrieve": function(id) {
 		var req = new XMLHttpRequest();
-		req.open("GET", "/snippets/"+id.toString(), false);
+		req.open("GET", "snippets/"+id.toString(), false);
 		req.send();
 		switch (req.status) {
 		case 200:
 			var s = JSON.parse(req.responseText);
 			s.code = s.code || "";
+			s.etag = req.getResponseHeader("ETag");
 			return {"snippet": s, "ok": true};
 		case 404:
 			return {"ok": false, "notFound": true};
@@ -132,11 +267,19 @@ var snippetDB = {
 	},
 	"update": function(s) {
 		var req = new XMLHttpRequest();
-		req.open("PUT", "/snippets/" + s.id.toString(), false);
+		req.open("PUT", "snippets/" + s.id.toString(), false);
+		if (s.etag) {
+			req.setRequestHeader("If-Match", s.etag);
+		}
+		delete s.etag;
 		req.send(JSON.stringify(s));
 		switch (req.status) {
 		case 200:
-			return {"ok": true};
+			return {"etag": req.getResponseHeader("ETag"), "ok": true};
+		case 412:
+			swal("Snippet Modified",
+				"The snippet was modified elsewhere since it was loaded. Reload the snippet to see the changes.", "error");
+			return {"ok": false};
 		default:
 			var msg = "Status " + req.status.toString() + ": " + req.responseText;
 			swal("Something went wrong:", msg, "error");
@@ -145,7 +288,7 @@ var snippetDB = {
 	},
 	"delete": function(id) {
 		var req = new XMLHttpRequest();
-		req.open("DELETE", "/snippets/" + id.toString(), false);
+		req.open("DELETE", "snippets/" + id.toString(), false);
 		req.send();
 		switch (req.status) {
 		case 200:
@@ -168,7 +311,7 @@ function setupCodeMirror() {
 		indentUnit: 4,
 		indentWithTabs: true,
 		autofocus: true,
-		gutters: ["CodeMirror-linenumbers", "issues"],
+		gutters: ["CodeMirror-linenumbers", "breakpoints", "issues"],
 	});
 	editor.setSize("100%", "70%");
 }
@@ -178,19 +321,44 @@ function setupCodeMirror() {
 var websock;
 var running = false; // Are we currently executing something on the server?
 var connected = false;
+var sessionToken = null; // Token to resume the session with upon reconnecting
+var sessionSeq = 0; // Number of messages received in the session
 function setupWebsocket() {
-	var scheme = (window.location.protocol === "https:") ? "wss:" : "ws:";
-	var url = scheme + "//" + window.location.host + "/websocket";
-	websock = new WebSocket(url);
+	// The document base URL holds the path prefix the server is deployed at.
+	// The server only passes through the ANSI escape sequences for colors.
+	var url = document.baseURI.replace(/^http/, "ws") + "websocket?ansi=color";
+	var resuming = sessionToken != null;
+	if (resuming) {
+		url += "&resume=" + encodeURIComponent(sessionToken) + "&seq=" + sessionSeq;
+	}
+	websock = new WebSocket(url, ["playground.binary"]);
+	websock.binaryType = "arraybuffer";
 
 	websock.onopen = function() {
-		clearOutput();
+		if (resuming) {
+			appendOutput("Reconnected to server.\n", "status");
+		} else {
+			clearOutput();
+		}
 		connected = true;
+		handleCheck();
+		openSnippet();
+		if (collabID != null) {
+			joinCollab(); // The server leaves the room upon disconnection
+		}
+		if (watchID != null) {
+			websock.send(JSON.stringify({action: "watch", data: watchID}));
+		}
 	}
 
 	// Register callback for receiving messages from the server.
 	websock.onmessage = function(event) {
-		var msg = JSON.parse(event.data);
+		var msg;
+		if (event.data instanceof ArrayBuffer) {
+			msg = decodeBinaryMessage(new Uint8Array(event.data));
+		} else {
+			msg = JSON.parse(event.data);
+		}
 		processMessage(msg);
 	}
 
@@ -204,28 +372,102 @@ function setupWebsocket() {
 	}
 }
 
+// decodeBinaryMessage decodes a message in the "playground.binary" protocol,
+// which is the action and the data separated by a NUL byte. The output
+// streams are decoded incrementally since a chunk of output may end in the
+// middle of a multi-byte character.
+var streamDecoders = {"appendStdout": new TextDecoder(), "appendStderr": new TextDecoder()};
+function decodeBinaryMessage(b) {
+	var i = b.indexOf(0);
+	var action = new TextDecoder().decode(b.subarray(0, i));
+	var dec = streamDecoders[action];
+	var data = dec ? dec.decode(b.subarray(i+1), {stream: true}) : new TextDecoder().decode(b.subarray(i+1));
+	return {action: action, data: data};
+}
+
+// clearLineMarks removes the error, note, and coverage marks of the last run.
+var coverLines = []; // Line handles painted with coverage
+var noteMarks = []; // Text markers and line widgets of notes
+function clearLineMarks() {
+	editor.clearGutter("issues");
+	for (var i = 0; i < noteMarks.length; i++) {
+		noteMarks[i].clear();
+	}
+	noteMarks = [];
+	for (var i = 0; i < coverLines.length; i++) {
+		editor.removeLineClass(coverLines[i], "background", "coveredLine");
+		editor.removeLineClass(coverLines[i], "background", "uncoveredLine");
+	}
+	coverLines = [];
+}
+
+// showNotes underlines the token at the position of each note, and shows
+// the message of errors inline below the offending line.
+function showNotes(notes) {
+	for (var i = 0; i < notes.length; i++) {
+		var n = notes[i];
+		var from = {line: n.line-1, ch: Math.max(n.column-1, 0)};
+		var to = editor.findWordAt(from).head;
+		if (n.column == 0) {
+			to = {line: from.line, ch: editor.getLine(from.line).length}; // Column is unknown
+		} else if (to.line != from.line || to.ch <= from.ch) {
+			to = {line: from.line, ch: from.ch+1};
+		}
+		var cls = (n.kind == "error") ? "checkError" : "optNote";
+		noteMarks.push(editor.markText(from, to, {className: cls, title: n.message}));
+		if (n.kind == "error") {
+			var div = document.createElement("div");
+			div.className = "noteMessage";
+			div.appendChild(document.createTextNode(n.message));
+			noteMarks.push(editor.addLineWidget(from.line, div));
+		}
+	}
+}
+
 // processMessage handles event messages coming from the server.
 function processMessage(msg) {
+	sessionSeq++;
 	switch (msg.action) {
+	case "sessionToken":
+		if (sessionToken != null && sessionToken != msg.data) {
+			appendOutput("Unable to resume the previous session.\n", "status");
+		}
+		sessionToken = msg.data;
+		sessionSeq = 1;
+		break;
 	case "clearOutput":
 		clearOutput();
 		break;
 	case "markLines":
-		var lines = JSON.parse(msg.data);
-		for (var i = 0; i < lines.length; i++) {
+		var marks = JSON.parse(msg.data);
+		if (!Array.isArray(marks)) {
+			// Coverage is painted as the background of each line.
+			var cls = (marks.mode == "covered") ? "coveredLine" : "uncoveredLine";
+			for (var i = 0; i < marks.lines.length; i++) {
+				coverLines.push(editor.addLineClass(marks.lines[i]-1, "background", cls));
+			}
+			break;
+		}
+		for (var i = 0; i < marks.length; i++) {
 			var div = document.createElement("div");
 			div.className = "gutterMarker";
 			div.innerHTML = "&nbsp;";
-			editor.setGutterMarker(lines[i]-1, "issues", div);
+			editor.setGutterMarker(marks[i]-1, "issues", div);
 		}
 		break;
 	case "statusStarted":
 		running = true;
-		document.getElementById("buttonStop").disabled = false;
+		document.getElementById("buttonStop").disabled = (watchID != null);
+		document.getElementById("signalSelect").disabled = (watchID != null);
 		break;
 	case "statusStopped":
 		running = false;
 		document.getElementById("buttonStop").disabled = true;
+		document.getElementById("signalSelect").disabled = true;
+		stopDebugging();
+		break;
+	case "debugState":
+		showDebugState(JSON.parse(msg.data));
 		break;
 	case "statusUpdate":
 		appendOutput(msg.data, "status");
@@ -240,7 +482,7 @@ function processMessage(msg) {
 		doAutoscroll(function() {
 			var report = JSON.parse(msg.data);
 			var a = document.createElement("a");
-			a.href = "/dynamic/" + report.id;
+			a.href = report.url || ("dynamic/" + report.id);
 			a.target = "_blank";
 			a.className = "status";
 			a.appendChild(document.createTextNode(report.name));
@@ -250,9 +492,65 @@ function processMessage(msg) {
 			span.appendChild(document.createTextNode("\tGenerated report: "));
 			span.appendChild(a);
 			span.appendChild(document.createTextNode("\n"));
+			if (/^image\/(png|jpeg|gif)$/.test(report.mime)) {
+				// Display images (e.g., charts rendered by the program) inline.
+				var img = document.createElement("img");
+				img.src = a.href;
+				img.alt = report.name;
+				img.className = "report";
+				span.appendChild(img);
+				span.appendChild(document.createTextNode("\n"));
+			}
 			document.getElementById("outputPane").appendChild(span);
 		});
 		break;
+	case "markNotes":
+		showNotes(JSON.parse(msg.data));
+		break;
+	case "check":
+		showCheckNotes(JSON.parse(msg.data));
+		break;
+	case "edit":
+		if (editor.getValue() != msg.data) {
+			// Preserve the local cursor when applying a remote edit.
+			var cursor = editor.getCursor();
+			var top = editor.getScrollInfo().top;
+			applyingEdit = true;
+			editor.setValue(msg.data);
+			applyingEdit = false;
+			editor.setCursor({"line": cursor.line, "ch": cursor.ch});
+			editor.scrollTo(0, top);
+		}
+		break;
+	case "share":
+		var url = document.baseURI + "?watch=" + encodeURIComponent(msg.data);
+		swal({
+			title: "Share Output",
+			html: "Others may watch the output of your runs at:<br><code>" + escapeHTML(url) + "</code>",
+			confirmButtonClass: "blueButton",
+		});
+		break;
+	case "snapshot":
+		swal({
+			title: "Workspace Snapshot",
+			html: "The workspace may be restored in a later session with the snapshot ID:<br><code>" + escapeHTML(msg.data) + "</code>",
+			confirmButtonClass: "blueButton",
+		});
+		break;
+	case "download":
+		var link = document.createElement("a");
+		link.href = "dynamic/" + msg.data;
+		link.download = "workspace.zip";
+		document.body.appendChild(link);
+		link.click();
+		document.body.removeChild(link);
+		break;
+	case "cursor":
+		showCollabCursor(JSON.parse(msg.data));
+		break;
+	case "collabMembers":
+		showCollabMembers(JSON.parse(msg.data));
+		break;
 	case "format":
 		if (editor.getValue() != msg.data) {
 			// When formatting, try to preserve the original cursor.
@@ -271,6 +569,9 @@ function processMessage(msg) {
 var snippet = {};
 function loadSnippet(id) {
 	if (id != null && snippet.id == id) return true;
+	if (collabID != null) {
+		handleCollab(); // Leave the room of the previous snippet
+	}
 
 	var ret = snippetDB.retrieve(id || defaultID);
 	if (!ret.ok) return false;
@@ -279,16 +580,16 @@ function loadSnippet(id) {
 		// Load the default snippet as a new template.
 		delete ret.snippet.id;
 		delete ret.snippet.name;
-		window.history.pushState(null, "", "/");
+		window.history.pushState(null, "", "./");
 		document.getElementById("snippetName").value = "";
 	} else {
-		window.history.pushState(null, "", "/" + id.toString());
+		window.history.pushState(null, "", id.toString());
 		document.getElementById("snippetName").value = ret.snippet.name;
 	}
 	document.getElementById("buttonDelete").disabled = (id == null || id == defaultID);
 
 	editor.setValue(ret.snippet.code);
-	editor.clearGutter("issues");
+	clearLineMarks();
 	editor.clearHistory();
 	clearOutput();
 	if (running) {
@@ -297,9 +598,18 @@ function loadSnippet(id) {
 	}
 
 	snippet = ret.snippet;
+	openSnippet();
 	return true;
 }
 
+// openSnippet informs the server of the loaded snippet so that the results
+// of benchmarks run on it are recorded in its history.
+function openSnippet() {
+	if (!connected) return;
+	var id = (snippet.id != null) ? snippet.id.toString() : "";
+	websock.send(JSON.stringify({action: "open", data: id}));
+}
+
 function saveSnippet() {
 	if (snippet.id == null) {
 		return true;
@@ -310,13 +620,17 @@ function saveSnippet() {
 		return true; // No change, so return success
 	}
 
-	var ret = snippetDB.update({id: snippet.id, name: name, code: code});
+	// Snippets in a collaborative editing session are edited concurrently
+	// by design, so only the changes of others outside the session conflict.
+	var etag = (collabID == null) ? snippet.etag : null;
+	var ret = snippetDB.update({id: snippet.id, name: name, code: code, etag: etag});
 	if (!ret.ok) return false;
 	snippet.name = name;
 	snippet.code = code;
+	snippet.etag = ret.etag;
 
 	document.getElementById("buttonDelete").disabled = (snippet.id == null || snippet.id == defaultID);
-	window.history.pushState(null, "", "/" + snippet.id.toString());
+	window.history.pushState(null, "", snippet.id.toString());
 	return true;
 }
 
@@ -328,6 +642,10 @@ function reloadListing() {
 		ret = snippetDB.queryByModified();
 		if (!ret.ok) return false;
 		hideID = defaultID;
+	} else if (val.length > 1 && val[0] == "/") {
+		// Search the code of snippets with a regular expression.
+		ret = snippetDB.queryByRegex({code: val.substring(1)});
+		if (!ret.ok) return false;
 	} else {
 		ret = snippetDB.queryByName({name: val});
 		if (!ret.ok) return false;
@@ -403,6 +721,11 @@ function appendListing(snippets, hideID) {
 		var li = document.createElement("li");
 		li.onclick = handleLoad;
 		li.appendChild(document.createTextNode(s.name));
+		if (s.matches) {
+			li.title = s.matches.map(function(m) {
+				return m.line.toString() + ": " + m.text;
+			}).join("\n");
+		}
 		li.dataset.id = s.id;
 		li.dataset.modified = s.modified;
 		li.className = "listItem";
@@ -483,16 +806,47 @@ function handleNew() {
 			});
 		},
 	}).then(function(name) {
-		if (!saveSnippet()) return false;
-		var ret = snippetDB.retrieve(defaultID);
+		var ret = snippetDB.templates();
 		if (!ret.ok) return false;
-		var ret = snippetDB.create({name: name, code: ret.snippet.code});
-		if (!ret.ok) return false;
-		if (!loadSnippet(ret.snippet.id)) return false;
-		if (!reloadListing()) return false;
+		if (ret.templates.length == 0) return newSnippet(name, "");
+
+		// Offer to start out with a template instead of the default snippet.
+		var options = {"": "Default snippet"};
+		for (var i = 0; i < ret.templates.length; i++) {
+			var t = ret.templates[i];
+			options[t.name] = t.description || t.name;
+		}
+		return swal({
+			title: "New Snippet",
+			text: "Start from:",
+			input: "select",
+			inputOptions: options,
+			showCancelButton: true,
+			confirmButtonClass: "blueButton",
+		}).then(function(tmpl) {
+			return newSnippet(name, tmpl);
+		}, function() {});
 	}, function() {});
 }
 
+// newSnippet creates and loads a snippet with the given name, which starts
+// out with the code of the given template or of the default snippet.
+function newSnippet(name, tmpl) {
+	if (!saveSnippet()) return false;
+	var ret;
+	if (tmpl) {
+		ret = snippetDB.createFromTemplate(tmpl, name);
+	} else {
+		ret = snippetDB.retrieve(defaultID);
+		if (!ret.ok) return false;
+		ret = snippetDB.create({name: name, code: ret.snippet.code});
+	}
+	if (!ret.ok) return false;
+	if (!loadSnippet(ret.snippet.id)) return false;
+	if (!reloadListing()) return false;
+	return true;
+}
+
 function handleSave() {
 	swal({
 		title: "Save Snippet",
@@ -538,23 +892,458 @@ function handleDelete() {
 
 function handleRun() {
 	running = true;
-	editor.clearGutter("issues");
+	clearLineMarks();
 	var msg = {action: "run", data: editor.getValue()};
 	websock.send(JSON.stringify(msg));
 }
 
 function handleFormat() {
 	running = true;
-	editor.clearGutter("issues");
+	clearLineMarks();
 	var msg = {action: "format", data: editor.getValue()};
 	websock.send(JSON.stringify(msg));
 }
 
+function handleFormatRun() {
+	running = true;
+	clearLineMarks();
+	var msg = {action: "formatRun", data: editor.getValue()};
+	websock.send(JSON.stringify(msg));
+}
+
+// handleDebug runs the program under the debugger, which halts it until
+// the user continues once the breakpoints in the gutter are set.
+function handleDebug() {
+	running = true;
+	clearLineMarks();
+	var msg = {action: "debug", data: editor.getValue()};
+	websock.send(JSON.stringify(msg));
+}
+
+var debugging = false; // Whether the server is debugging the program
+var debugLine = null; // Line handle where the debugged program stopped
+function setupDebug() {
+	editor.on("gutterClick", function(cm, line, gutter) {
+		if (gutter == "issues") return;
+		if (cm.lineInfo(line).gutterMarkers && cm.lineInfo(line).gutterMarkers.breakpoints) {
+			cm.setGutterMarker(line, "breakpoints", null); // Takes effect on the next debug
+			return;
+		}
+		var div = document.createElement("div");
+		div.className = "breakpointMarker";
+		div.innerHTML = "&#x25cf;";
+		cm.setGutterMarker(line, "breakpoints", div);
+		if (debugging) {
+			setBreakpoint(line);
+		}
+	});
+}
+
+function setBreakpoint(line) {
+	var msg = {action: "setBreakpoint", data: String(line+1)};
+	websock.send(JSON.stringify(msg));
+}
+
+// showDebugState highlights the line where the debugged program stopped.
+// The first state is sent once the debugger starts, which is when the
+// breakpoints in the gutter are set.
+function showDebugState(st) {
+	if (!debugging) {
+		debugging = true;
+		document.getElementById("buttonContinue").disabled = false;
+		document.getElementById("buttonStep").disabled = false;
+		document.getElementById("buttonInspect").disabled = false;
+		editor.eachLine(function(lh) {
+			var info = editor.lineInfo(lh);
+			if (info.gutterMarkers && info.gutterMarkers.breakpoints) {
+				setBreakpoint(info.line);
+			}
+		});
+	}
+	if (debugLine != null) {
+		editor.removeLineClass(debugLine, "background", "debugLine");
+		debugLine = null;
+	}
+	if (st.line > 0) {
+		debugLine = editor.addLineClass(st.line-1, "background", "debugLine");
+		editor.scrollIntoView({line: st.line-1, ch: 0});
+	}
+}
+
+function stopDebugging() {
+	debugging = false;
+	document.getElementById("buttonContinue").disabled = true;
+	document.getElementById("buttonStep").disabled = true;
+	document.getElementById("buttonInspect").disabled = true;
+	showDebugState({});
+}
+
+function handleContinue() {
+	var msg = {action: "continue"};
+	websock.send(JSON.stringify(msg));
+}
+
+function handleStep() {
+	var msg = {action: "step"};
+	websock.send(JSON.stringify(msg));
+}
+
+function handleInspect() {
+	swal({
+		title: "Inspect",
+		text: "Provide a Go expression to evaluate:",
+		input: "text",
+		showCancelButton: true,
+		confirmButtonClass: "blueButton",
+	}).then(function(expr) {
+		if (expr == "") return;
+		var msg = {action: "inspect", data: expr};
+		websock.send(JSON.stringify(msg));
+	}, function() {});
+}
+
+// completionList is the popup of completion candidates, if shown.
+var completionList = null;
+
+// handleComplete requests completion candidates at the cursor from gopls.
+function handleComplete() {
+	var req = new XMLHttpRequest();
+	req.open("POST", "complete", true);
+	req.onload = function() {
+		if (req.status != 200) {
+			appendOutput("Code completion failed: " + req.responseText + "\n", "status");
+			return;
+		}
+		showCompletions(JSON.parse(req.responseText));
+	};
+	var offset = editor.indexFromPos(editor.getCursor());
+	req.send(JSON.stringify({code: editor.getValue(), offset: offset}));
+}
+
+function applyCompletion(c) {
+	editor.replaceRange(c.text, editor.posFromIndex(c.from), editor.posFromIndex(c.to));
+}
+
+// showCompletions displays a popup list of completion candidates below the
+// cursor, where the candidate is chosen with the arrow and enter keys.
+function showCompletions(cs) {
+	hideCompletions();
+	if (cs.length == 0) return;
+	if (cs.length == 1) {
+		applyCompletion(cs[0]);
+		return;
+	}
+
+	var selected = 0;
+	var ul = document.createElement("ul");
+	ul.id = "completionList";
+	for (var i = 0; i < cs.length; i++) {
+		var li = document.createElement("li");
+		li.appendChild(document.createTextNode(cs[i].label));
+		if (cs[i].detail) {
+			var span = document.createElement("span");
+			span.className = "completionDetail";
+			span.appendChild(document.createTextNode(" " + cs[i].detail));
+			li.appendChild(span);
+		}
+		li.title = cs[i].documentation || "";
+		li.onmousedown = (function(c) {
+			return function(event) {
+				hideCompletions(); applyCompletion(c); event.preventDefault();
+			};
+		})(cs[i]);
+		ul.appendChild(li);
+	}
+	var select = function(i) {
+		ul.childNodes[selected].className = "";
+		selected = (i + cs.length) % cs.length;
+		ul.childNodes[selected].className = "selected";
+		ul.childNodes[selected].scrollIntoView({block: "nearest"});
+	};
+	var pos = editor.cursorCoords(true, "page");
+	ul.style.left = pos.left + "px";
+	ul.style.top = pos.bottom + "px";
+	document.body.appendChild(ul);
+	select(0);
+
+	var choose = function() { hideCompletions(); applyCompletion(cs[selected]); };
+	completionList = {node: ul, keyMap: {
+		"Up":    function() { select(selected-1); },
+		"Down":  function() { select(selected+1); },
+		"Enter": choose,
+		"Tab":   choose,
+		"Esc":   hideCompletions,
+	}};
+	editor.addKeyMap(completionList.keyMap);
+	editor.on("blur", hideCompletions);
+}
+
+function hideCompletions() {
+	if (completionList == null) return;
+	editor.removeKeyMap(completionList.keyMap);
+	editor.off("blur", hideCompletions);
+	completionList.node.parentNode.removeChild(completionList.node);
+	completionList = null;
+}
+
+// hoverTip is the tooltip showing the documentation of the identifier under
+// the mouse, which is requested once the mouse rests over the editor.
+var hoverTip = null;
+var hoverTimer = null;
+var hoverEnabled = true; // Cleared if the server does not support hovering
+function setupHover() {
+	var node = editor.getWrapperElement();
+	node.addEventListener("mousemove", function(event) {
+		hideHover();
+		clearTimeout(hoverTimer);
+		if (!hoverEnabled) return;
+		hoverTimer = setTimeout(function() {
+			handleHover(event.pageX, event.pageY);
+		}, 600);
+	});
+	node.addEventListener("mouseleave", function() {
+		clearTimeout(hoverTimer);
+		hideHover();
+	});
+	editor.on("change", hideHover);
+}
+
+function handleHover(x, y) {
+	var pos = editor.coordsChar({left: x, top: y}, "page");
+	if (pos.outside) return;
+	var req = new XMLHttpRequest();
+	req.open("POST", "hover", true);
+	req.onload = function() {
+		if (req.status == 501) hoverEnabled = false;
+		if (req.status != 200) return;
+		var h = JSON.parse(req.responseText);
+		if (!h.documentation && !h.signature) return;
+
+		hideHover();
+		hoverTip = document.createElement("pre");
+		hoverTip.id = "hoverTip";
+		if (h.signature) {
+			var span = document.createElement("span");
+			span.className = "hoverSignature";
+			span.appendChild(document.createTextNode(h.signature));
+			hoverTip.appendChild(span);
+			if (h.documentation) hoverTip.appendChild(document.createTextNode("\n\n"));
+		}
+		hoverTip.appendChild(document.createTextNode(h.documentation));
+		hoverTip.style.left = x + "px";
+		hoverTip.style.top = (y + 16) + "px";
+		document.body.appendChild(hoverTip);
+	};
+	req.send(JSON.stringify({code: editor.getValue(), offset: editor.indexFromPos(pos)}));
+}
+
+function hideHover() {
+	if (hoverTip == null) return;
+	hoverTip.parentNode.removeChild(hoverTip);
+	hoverTip = null;
+}
+
+// checkMarks are the marked spans of the errors found by type-checking the
+// code, which is requested once the user pauses typing.
+var checkMarks = [];
+var checkTimer = null;
+var checkCode = null; // Code of the last check request
+function setupCheck() {
+	editor.on("change", function() {
+		clearTimeout(checkTimer);
+		checkTimer = setTimeout(handleCheck, 500);
+	});
+}
+
+function handleCheck() {
+	if (!connected) return;
+	checkCode = editor.getValue();
+	var msg = {action: "check", data: checkCode};
+	websock.send(JSON.stringify(msg));
+}
+
+function showCheckNotes(notes) {
+	if (editor.getValue() != checkCode) return; // Stale results
+	for (var i = 0; i < checkMarks.length; i++) {
+		checkMarks[i].clear();
+	}
+	checkMarks = [];
+	for (var i = 0; i < notes.length; i++) {
+		var n = notes[i];
+		var from = {line: n.line-1, ch: n.column-1};
+		var to = editor.findWordAt(from).head;
+		if (to.line != from.line || to.ch <= from.ch) {
+			to = {line: from.line, ch: from.ch+1};
+		}
+		checkMarks.push(editor.markText(from, to, {className: "checkError", title: n.message}));
+	}
+}
+
+// collabID is the ID of the snippet being collaborated on, if any.
+// Local edits are sent to the other participants once the user pauses typing,
+// and the last edit received by the server wins.
+var collabID = null;
+var collabCursors = {}; // Bookmarks of remote cursors by client ID
+var collabTimer = null;
+var applyingEdit = false; // Whether the change is a remote edit
+function setupCollab() {
+	editor.on("change", function() {
+		if (collabID == null || applyingEdit) return;
+		clearTimeout(collabTimer);
+		collabTimer = setTimeout(function() {
+			if (!connected) return;
+			var msg = {action: "edit", data: editor.getValue()};
+			websock.send(JSON.stringify(msg));
+		}, 200);
+	});
+	editor.on("cursorActivity", function() {
+		if (collabID == null || !connected) return;
+		var cursor = editor.getCursor();
+		var msg = {action: "cursor", data: JSON.stringify({line: cursor.line, ch: cursor.ch})};
+		websock.send(JSON.stringify(msg));
+	});
+}
+
+function handleCollab() {
+	if (collabID != null) {
+		if (connected) {
+			websock.send(JSON.stringify({action: "leave"}));
+		}
+		collabID = null;
+		clearCollabCursors();
+		document.getElementById("buttonCollab").textContent = "Collaborate";
+		return;
+	}
+	if (snippet.id == null) {
+		swal({title: "Save the snippet before collaborating on it.", type: "info", confirmButtonClass: "blueButton"});
+		return;
+	}
+	collabID = snippet.id;
+	joinCollab();
+}
+
+function joinCollab() {
+	saveSnippet(); // The room starts with the saved code if it is new
+	var msg = {action: "join", data: collabID.toString()};
+	websock.send(JSON.stringify(msg));
+}
+
+function showCollabCursor(c) {
+	if (collabCursors[c.id]) {
+		collabCursors[c.id].clear();
+	}
+	var span = document.createElement("span");
+	span.className = "collabCursor";
+	span.title = c.user || ("client " + c.id);
+	collabCursors[c.id] = editor.setBookmark({line: c.line, ch: c.ch}, {widget: span, insertLeft: true});
+}
+
+function showCollabMembers(members) {
+	if (collabID == null) return;
+	var ids = {};
+	for (var i = 0; i < members.length; i++) {
+		ids[members[i].id] = true;
+	}
+	for (var id in collabCursors) {
+		if (!ids[id]) {
+			collabCursors[id].clear();
+			delete collabCursors[id];
+		}
+	}
+	document.getElementById("buttonCollab").textContent = "Leave (" + members.length + ")";
+}
+
+function clearCollabCursors() {
+	for (var id in collabCursors) {
+		collabCursors[id].clear();
+	}
+	collabCursors = {};
+}
+
+// watchID is the ID of the session whose output is being watched, if any.
+// While watching, the page is read-only and only shows the watched output.
+var watchID = null;
+function setupWatch() {
+	var m = /[?&]watch=([^&]*)/.exec(window.location.search);
+	if (m == null) return;
+	watchID = decodeURIComponent(m[1]);
+	editor.setOption("readOnly", true);
+	var ids = ["buttonRun", "buttonFormat", "buttonStop", "buttonCollab", "buttonShare"];
+	for (var i = 0; i < ids.length; i++) {
+		document.getElementById(ids[i]).disabled = true;
+	}
+}
+
+function handleShare() {
+	var msg = {action: "share"};
+	websock.send(JSON.stringify(msg));
+}
+
+// handleSnapshot saves the files of the workspace on the server (e.g.,
+// generated files and testdata) along with the snippet.
+function handleSnapshot() {
+	var msg = {action: "snapshot", data: editor.getValue()};
+	websock.send(JSON.stringify(msg));
+}
+
+// handleDownload downloads the files of the workspace on the server along
+// with the snippet as a zip file, which can be built locally.
+function handleDownload() {
+	var msg = {action: "download", data: editor.getValue()};
+	websock.send(JSON.stringify(msg));
+}
+
+function handleUpload() {
+	document.getElementById("uploadInput").click();
+}
+
+// uploadFiles uploads the selected files into the workspace on the server
+// (e.g., input files for the program), where they are kept across runs.
+function uploadFiles(input) {
+	Array.prototype.forEach.call(input.files, function(file) {
+		var reader = new FileReader();
+		reader.onload = function() {
+			var data = reader.result.substring(reader.result.indexOf(",") + 1); // Strip the data URL prefix
+			var msg = {action: "upload", data: JSON.stringify({name: file.name, data: data})};
+			websock.send(JSON.stringify(msg));
+		};
+		reader.readAsDataURL(file);
+	});
+	input.value = "";
+}
+
+function handleRestore() {
+	swal({
+		title: "Restore Workspace",
+		text: "Provide the snapshot ID:",
+		input: "text",
+		showCancelButton: true,
+		confirmButtonClass: "blueButton",
+	}).then(function(id) {
+		if (id == "") return;
+		running = true;
+		clearLineMarks();
+		var msg = {action: "restore", data: id.trim()};
+		websock.send(JSON.stringify(msg));
+	}, function() {});
+}
+
 function handleStop() {
 	var msg = {action: "stop"};
 	websock.send(JSON.stringify(msg));
 }
 
+// handleSignal sends the selected signal to the running program
+// (e.g., SIGQUIT to dump the stacks of all goroutines).
+function handleSignal() {
+	var sel = document.getElementById("signalSelect");
+	if (sel.value != "") {
+		var msg = {action: "signal", data: sel.value};
+		websock.send(JSON.stringify(msg));
+	}
+	sel.value = "";
+}
+
 function handleHelp() {
 	var msg = "";
 	msg += "<div style=\"text-align: left; overflow-y: auto; max-height: 20em;\">";
@@ -568,7 +1357,8 @@ function handleHelp() {
 		Any modifications to a given snippet is automatically saved.\
 		New snippets can be created by clicking the <code>New</code> or <code>Save As</code> buttons.\
 		When creating new snippets, they will be initialized with some default code.\
-		This default can be changed by searching for and directly altering the snippet labeled <code>\"Default snippet\"</code>.";
+		This default can be changed by searching for and directly altering the snippet labeled <code>\"Default snippet\"</code>.\
+		Searching for text starting with a <code>/</code> lists the snippets whose code matches the regular expression that follows.";
 	msg += "<br>";
 	msg += "<br>";
 	msg += "Each code snippet is an individual Go program and will be executed as either an executable or a test suite.\
@@ -588,6 +1378,11 @@ function handleHelp() {
 	msg += "//playground:execargs -test.v -test.run Encode\n//playground:pprof cpu mem\n";
 	msg += "</pre>";
 	msg += "These magic comments allow the playground to build and execute the program with specific parameters.";
+	msg += "<br>";
+	msg += "<br>";
+	msg += "A snippet may consist of multiple files and packages by appending each file after the main source,\
+		starting with a line of the form <code>-- path/to/file.go --</code>.\
+		The files are built as a module named <code>playground</code> unless a <code>go.mod</code> file is provided.";
 	msg += "</div>";
 	swal({title: "Playground Help", html: msg, confirmButtonClass: "blueButton"});
 }
@@ -596,7 +1391,9 @@ function handleHelp() {
 // is triggered by UI button presses.
 document.onkeydown = function(event) {
 	if (event.code == "Enter" || event.keyCode == 13) { // Enter Key
-		if (event.shiftKey) { // Run snippet
+		if (event.shiftKey && (event.altKey || event.ctrlKey)) { // Format and run snippet
+			handleFormatRun(); event.preventDefault(); return;
+		} else if (event.shiftKey) { // Run snippet
 			handleRun();    event.preventDefault(); return;
 		} else if (event.altKey || event.ctrlKey) { // Format snippet
 			handleFormat(); event.preventDefault(); return;
@@ -604,6 +1401,7 @@ document.onkeydown = function(event) {
 	}
 	if (event.altKey || event.ctrlKey) {
 		switch (event.keyCode) {
+		case 32: handleComplete(); event.preventDefault(); return; // Space
 		case 75: handleStop();   event.preventDefault(); return; // H
 		case 72: handleHelp();   event.preventDefault(); return; // K
 		case 78: handleNew();    event.preventDefault(); return; // N
@@ -680,6 +1478,11 @@ function init() {
 	}
 
 	setupCodeMirror();
+	setupHover();
+	setupCheck();
+	setupCollab();
+	setupDebug();
+	setupWatch();
 	setupWebsocket();
 	if (!loadSnippet(id)) {
 		loadSnippet(null);
@@ -0,0 +1,174 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+// Exit codes of the run command when the program did not exit by itself.
+const (
+	remoteExitError   = 1   // The program was killed or the run failed
+	remoteExitNoRun   = 2   // No program ran (e.g., the build failed)
+	remoteExitTimeout = 124 // The program exceeded RunTimeout
+)
+
+// runRemote runs a local Go file on a remote playground server, streaming
+// the output to the terminal, and exits with the exit code of the program.
+// Flags may precede or follow the file name.
+func runRemote(args []string) {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	server := fs.String("server", os.Getenv("PLAYGROUND_SERVER"), "URL of the playground server (default $PLAYGROUND_SERVER)")
+	token := fs.String("token", os.Getenv("PLAYGROUND_TOKEN"), "API token to authenticate with (default $PLAYGROUND_TOKEN)")
+	quiet := fs.Bool("quiet", false, "do not print status updates (e.g., compile times) to stderr")
+	var files []string
+	for {
+		fs.Parse(args)
+		if fs.NArg() == 0 {
+			break
+		}
+		files, args = append(files, fs.Arg(0)), fs.Args()[1:]
+	}
+	if len(files) != 1 || *server == "" {
+		fmt.Fprintf(os.Stderr, "Usage: %s run [flags] FILE\n", os.Args[0])
+		fs.PrintDefaults()
+		os.Exit(remoteExitNoRun)
+	}
+
+	var code []byte
+	var err error
+	if files[0] == "-" {
+		code, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		code, err = ioutil.ReadFile(files[0])
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(remoteExitNoRun)
+	}
+
+	// Stop the program on the server upon an interrupt, which then
+	// reports the exit status as usual.
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	var status io.Writer = os.Stderr
+	if *quiet {
+		status = nil
+	}
+	exitCode, err := remoteRun(*server, *token, string(code), os.Stdout, os.Stderr, status, interrupt)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	}
+	os.Exit(exitCode)
+}
+
+// remoteRun runs the Go source on the playground server at serverURL over
+// the websocket protocol. The output of the program is written to stdout and
+// stderr as it arrives, and status updates are written to status if non-nil.
+// The program is stopped when a value is received from stop.
+// It returns the exit code of the program, or one of the remoteExit codes.
+func remoteRun(serverURL, token, code string, stdout, stderr, status io.Writer, stop <-chan os.Signal) (int, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return remoteExitNoRun, err
+	}
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	default:
+		return remoteExitNoRun, fmt.Errorf("invalid server URL: %q", serverURL)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/websocket"
+	u.RawQuery = ""
+	hdr := make(http.Header)
+	if token != "" {
+		hdr.Set("Authorization", "Bearer "+token)
+	}
+	conn, resp, err := websocket.DefaultDialer.Dial(u.String(), hdr)
+	if err != nil {
+		if resp != nil {
+			err = fmt.Errorf("%v: %s", err, resp.Status)
+		}
+		return remoteExitNoRun, err
+	}
+	defer conn.Close()
+
+	type jsonMessage struct {
+		Action string `json:"action"`
+		Data   string `json:"data"`
+	}
+	msgs := make(chan jsonMessage)
+	errc := make(chan error, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			var msg jsonMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				errc <- err
+				return
+			}
+			select {
+			case msgs <- msg:
+			case <-done:
+				return
+			}
+		}
+	}()
+	if err := conn.WriteJSON(jsonMessage{Action: actionRun, Data: code}); err != nil {
+		return remoteExitNoRun, err
+	}
+
+	for {
+		select {
+		case <-stop:
+			if err := conn.WriteJSON(jsonMessage{Action: actionStop}); err != nil {
+				return remoteExitError, err
+			}
+		case err := <-errc:
+			return remoteExitError, fmt.Errorf("connection lost: %v", err)
+		case msg := <-msgs:
+			switch msg.Action {
+			case appendStdout:
+				io.WriteString(stdout, msg.Data)
+			case appendStderr:
+				io.WriteString(stderr, msg.Data)
+			case statusUpdate:
+				if status != nil {
+					io.WriteString(status, msg.Data)
+				}
+			case statusStopped:
+				if msg.Data == "" {
+					return remoteExitNoRun, nil
+				}
+				var st exitStatus
+				if err := json.Unmarshal([]byte(msg.Data), &st); err != nil {
+					return remoteExitError, err
+				}
+				switch st.Reason {
+				case "exit":
+					return st.ExitCode, nil
+				case "timeout":
+					return remoteExitTimeout, nil
+				default:
+					return remoteExitError, nil
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRemoteRun(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt", runTimeout: 500 * time.Millisecond}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	pg.basePath = "/play"
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	tests := []struct {
+		code       string
+		wantCode   int
+		wantStdout string
+		wantStderr string
+		wantStatus string
+	}{{
+		code:       "package main\n\nimport (\"fmt\"; \"os\")\n\nfunc main() { fmt.Println(\"hello\"); fmt.Fprintln(os.Stderr, \"oops\"); os.Exit(7) }\n",
+		wantCode:   7,
+		wantStdout: "hello\n",
+		wantStderr: "oops\n",
+		wantStatus: "Compiling",
+	}, {
+		code:       "package main\n\nfunc main() { undefined() }\n",
+		wantCode:   remoteExitNoRun,
+		wantStderr: "undefined",
+	}, {
+		code:     "package main\n\nfunc main() { for {} }\n",
+		wantCode: remoteExitTimeout,
+	}}
+	for _, tt := range tests {
+		var stdout, stderr, status bytes.Buffer
+		got, err := remoteRun(srv.URL+"/play/", "", tt.code, &stdout, &stderr, &status, nil)
+		if err != nil {
+			t.Errorf("remoteRun error: %v", err)
+		}
+		if got != tt.wantCode {
+			t.Errorf("remoteRun exit code = %d, want %d", got, tt.wantCode)
+		}
+		if !strings.Contains(stdout.String(), tt.wantStdout) || !strings.Contains(stderr.String(), tt.wantStderr) || !strings.Contains(status.String(), tt.wantStatus) {
+			t.Errorf("remoteRun output = (%q, %q, %q), want (%q, %q, %q)", &stdout, &stderr, &status, tt.wantStdout, tt.wantStderr, tt.wantStatus)
+		}
+	}
+
+	// A program stopped once it starts is reported as an error.
+	stop := make(chan os.Signal, 1)
+	started := stopWriter(func() {
+		select {
+		case stop <- os.Interrupt:
+		default:
+		}
+	})
+	got, err := remoteRun(srv.URL+"/play", "", "package main\n\nimport \"time\"\n\nfunc main() { println(); time.Sleep(time.Hour) }\n", ioutil.Discard, started, nil, stop)
+	if err != nil || got != remoteExitError {
+		t.Errorf("remoteRun of stopped program = (%d, %v), want (%d, nil)", got, err, remoteExitError)
+	}
+
+	if _, err := remoteRun("ftp://example.com", "", "", nil, nil, nil, nil); err == nil {
+		t.Errorf("remoteRun with invalid URL succeeded")
+	}
+}
+
+// stopWriter is an io.Writer that calls itself upon every write.
+type stopWriter func()
+
+func (f stopWriter) Write(b []byte) (int, error) {
+	f()
+	return len(b), nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxRunSize is the maximum size of the Go source of a run request.
+	maxRunSize = 1 << 20
+
+	// maxRunOutput is the maximum size of the output of a run request
+	// if MaxOutputSize is not set.
+	maxRunOutput = 1 << 20
+
+	// defaultRunTimeout is how long the program of a run request may run
+	// by default, or RunTimeout if it is shorter.
+	defaultRunTimeout = 30 * time.Second
+)
+
+// runResponse is the result of a run request.
+type runResponse struct {
+	Stdout string      `json:"stdout"`
+	Stderr string      `json:"stderr"`
+	Status string      `json:"status"` // Status updates, including build errors
+	Exit   *exitStatus `json:"exit"`   // Null if no program ran
+}
+
+// serveRun provides an endpoint to build and run the Go source in the request
+// body without using the websocket protocol, such as from curl or CI:
+//
+//	curl --data-binary @main.go https://play.example.com/run
+//
+// The response is a JSON dict with the "stdout" and "stderr" of the program,
+// the "status" updates (e.g., build errors), and the "exit" status of the
+// program, which is null if no program ran. Reports (e.g., profiles) are not
+// returned. The response status is OK even if the program failed.
+//
+// The endpoint supports several URL query parameters:
+//
+//   - pragma: string - A magic comment to add to the source without the
+//     "//playground:" prefix (e.g., "execargs -v"). It may be repeated.
+//   - timeout: string - How long the program may run (e.g., "10s").
+//     Default value is 30s or the RunTimeout of the server if shorter,
+//     which it may not exceed.
+func (pg *playground) serveRun(w http.ResponseWriter, r *http.Request) {
+	conf := pg.exConf
+	conf.runTimeout = defaultRunTimeout
+	if pg.exConf.runTimeout > 0 && pg.exConf.runTimeout < conf.runTimeout {
+		conf.runTimeout = pg.exConf.runTimeout
+	}
+	if conf.maxOutput <= 0 {
+		conf.maxOutput = maxRunOutput
+	}
+	var pragmas []string
+	for k, v := range r.URL.Query() {
+		switch k {
+		case "pragma":
+			for _, p := range v {
+				if strings.ContainsAny(p, "\r\n") {
+					httpError(w, r, fmt.Sprintf("invalid pragma: %q", p), http.StatusBadRequest)
+					return
+				}
+				pragmas = append(pragmas, magicComment+p)
+			}
+		case "timeout":
+			d, err := time.ParseDuration(v[0])
+			if err != nil || d <= 0 {
+				httpError(w, r, fmt.Sprintf("invalid timeout: %q", v[0]), http.StatusBadRequest)
+				return
+			}
+			if pg.exConf.runTimeout > 0 && d > pg.exConf.runTimeout {
+				httpError(w, r, fmt.Sprintf("timeout exceeds %v", pg.exConf.runTimeout), http.StatusBadRequest)
+				return
+			}
+			conf.runTimeout = d
+		default:
+			httpError(w, r, fmt.Sprintf("unknown query field: %v", k), http.StatusBadRequest)
+			return
+		}
+	}
+
+	b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRunSize))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if len(b) >= maxRunSize {
+			status = http.StatusRequestEntityTooLarge
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+
+	// Magic comments are only parsed from the header of the main file.
+	code := string(b)
+	if len(pragmas) > 0 {
+		code = strings.Join(pragmas, "\n") + "\n" + code
+	}
+	pg.logf(r, "run request")
+	pg.audit(r, auditRun, 0, code)
+
+	var mu sync.Mutex
+	var resp runResponse
+	var stdout, stderr, status strings.Builder
+	stopped := make(chan struct{})
+	ex := newExecutor(pg.bs, conf, func(action, data string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		switch action {
+		case clearOutput:
+			stdout.Reset()
+			stderr.Reset()
+			status.Reset()
+		case appendStdout:
+			stdout.WriteString(data)
+		case appendStderr:
+			stderr.WriteString(data)
+		case statusUpdate:
+			status.WriteString(data)
+		case statusStopped:
+			if data != "" {
+				resp.Exit = new(exitStatus)
+				json.Unmarshal([]byte(data), resp.Exit)
+			}
+			close(stopped)
+		}
+		return nil
+	})
+	defer ex.Close()
+	ex.Start(actionRun, code)
+	select {
+	case <-stopped:
+	case <-r.Context().Done():
+		ex.Stop() // The client is gone
+		<-stopped
+		return
+	}
+
+	mu.Lock()
+	resp.Stdout, resp.Stderr, resp.Status = stdout.String(), stderr.String(), status.String()
+	mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	b, _ = json.Marshal(resp)
+	w.Write(b)
+}
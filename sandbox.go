@@ -0,0 +1,214 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"syscall"
+)
+
+// Without DockerImage or MicroVM, the programs of snippets run directly on
+// the host. A security profile hardens them with a seccomp filter that
+// denies system calls that programs have no use for (e.g., ptrace and mount)
+// and with landlock rules that restrict filesystem access to the temporary
+// directory and a few read-only paths. Builds are not restricted.
+// Programs without network access run in a network namespace of their own,
+// whose only interface is the loopback one. Programs may also run with a
+// lower priority and in a cgroup of their own that limits their CPU usage.
+//
+// Restrictions of both kinds and the priority are inherited across execve
+// but can only be applied by a process to itself, and the loopback interface
+// of a new namespace is down. Thus, the executor runs the sandbox-exec command of this
+// binary, which sets them up and then executes the program in place, so that
+// the process is otherwise handled like any other.
+
+// sandboxExitError is the exit code of sandbox-exec if it fails to apply the
+// restrictions or to execute the program, like that of shells.
+const sandboxExitError = 126
+
+type securityProfile struct {
+	Seccomp     bool     `json:",omitempty"`
+	DenyNetwork bool     `json:",omitempty"`
+	Landlock    bool     `json:",omitempty"`
+	ReadPaths   []string `json:",omitempty"`
+}
+
+// defaultReadPaths are the paths that programs may read with landlock
+// if ReadPaths is not set: devices that are commonly opened, time zones,
+// TLS certificates, and shared libraries for programs built with cgo.
+var defaultReadPaths = []string{
+	"/dev/null", "/dev/zero", "/dev/urandom",
+	"/etc/localtime", "/usr/share/zoneinfo", "/etc/ssl", "/etc/pki",
+	"/lib", "/lib64", "/usr/lib", "/usr/lib64",
+}
+
+// sandboxCommand returns the arguments that run the program in args through
+// the sandbox-exec command with the restrictions of ex.security, if any,
+// and with the priority and CPU limit of programs. If noNet is set, the
+// loopback interface is brought up first, which is the only one in the
+// network namespace of the program (see isolateNetwork).
+func (ex *executor) sandboxCommand(args []string, noNet bool) []string {
+	self, _ := os.Executable()
+	sargs := []string{self, "sandbox-exec"}
+	if ex.cpuLimit > 0 && ex.cgroupDir != "" {
+		// The cgroup must come first for removeCgroup.
+		sargs = append(sargs, "--cgroup="+newCgroupPath(ex.cgroupDir), fmt.Sprintf("--cpu-limit=%g", ex.cpuLimit))
+	}
+	if ex.niceness != 0 {
+		sargs = append(sargs, fmt.Sprintf("--nice=%d", ex.niceness))
+	}
+	if noNet {
+		sargs = append(sargs, "--loopback")
+	}
+	if sp := ex.security; sp != nil && sp.Seccomp {
+		sargs = append(sargs, "--seccomp")
+		if sp.DenyNetwork {
+			sargs = append(sargs, "--deny-network")
+		}
+	}
+	if sp := ex.security; sp != nil && sp.Landlock {
+		sargs = append(sargs, "--landlock="+ex.tmpDir)
+		paths := sp.ReadPaths
+		if paths == nil {
+			paths = defaultReadPaths
+		}
+		for _, p := range paths {
+			sargs = append(sargs, "--read="+p)
+		}
+	}
+	return append(append(sargs, "--"), args...)
+}
+
+// programCommand is like command, but for the program of a snippet, which is
+// confined by the security profile, if any, unless it is already isolated
+// within a container or a VM. If noNet is set, the program has no network
+// access, which VMs never have. The priority and CPU usage of programs are
+// limited on the host and CPU usage in containers, while VMs have their
+// own CPUs.
+func (ex *executor) programCommand(w io.Writer, noNet bool, args ...string) *exec.Cmd {
+	onHost := ex.dockerImage == "" && ex.vms == nil
+	if onHost && (ex.security != nil || noNet || ex.niceness != 0 || ex.cpuLimit > 0) {
+		args = ex.sandboxCommand(args, noNet)
+	}
+	ex.progArgs = nil
+	if noNet {
+		ex.progArgs = append(ex.progArgs, "--network=none")
+	}
+	if ex.cpuLimit > 0 {
+		ex.progArgs = append(ex.progArgs, fmt.Sprintf("--cpus=%g", ex.cpuLimit))
+	}
+	cmd := ex.command(w, args...)
+	ex.progArgs = nil
+	if onHost && noNet {
+		isolateNetwork(cmd)
+	}
+	return cmd
+}
+
+// runSandboxExec is the sandbox-exec command, which restricts itself
+// and then executes the program in its place.
+func runSandboxExec(args []string) {
+	fs := flag.NewFlagSet("sandbox-exec", flag.ExitOnError)
+	cgroup := fs.String("cgroup", "", "cgroup to create and join")
+	cpuLimit := fs.Float64("cpu-limit", 0, "number of CPUs that the cgroup may use")
+	nice := fs.Int("nice", 0, "nice value")
+	loopback := fs.Bool("loopback", false, "bring up the loopback interface")
+	seccomp := fs.Bool("seccomp", false, "deny system calls with a seccomp filter")
+	denyNetwork := fs.Bool("deny-network", false, "deny sockets other than Unix domain sockets with seccomp")
+	dir := fs.String("landlock", "", "restrict filesystem access to this directory with landlock")
+	var readPaths []string
+	fs.Func("read", "path that may be read with landlock", func(p string) error {
+		readPaths = append(readPaths, p)
+		return nil
+	})
+	fs.Parse(args)
+	if *cgroup != "" {
+		if err := joinCgroup(*cgroup, *cpuLimit); err != nil {
+			fmt.Fprintf(os.Stderr, "sandbox error: cgroup: %v\n", err)
+			os.Exit(sandboxExitError)
+		}
+	}
+	if err := sandboxExec(*nice, *loopback, *seccomp, *denyNetwork, *dir, readPaths, fs.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "sandbox error: %v\n", err)
+		os.Exit(sandboxExitError)
+	}
+}
+
+// sandboxExec applies the restrictions and executes args, returning only
+// upon failure. If dir is empty, landlock is not used.
+func sandboxExec(nice int, loopback, seccomp, denyNetwork bool, dir string, readPaths, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no command")
+	}
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return err
+	}
+	var env []string
+	for _, kv := range os.Environ() {
+		if dir == "" || !strings.HasPrefix(kv, "TMPDIR=") {
+			env = append(env, kv)
+		}
+	}
+	if dir != "" {
+		env = append(env, "TMPDIR="+dir) // The only writable directory
+	}
+
+	// The restrictions apply to the calling thread,
+	// which must thus be the one that executes the program.
+	runtime.LockOSThread()
+	if nice != 0 {
+		// On Linux, the nice value is that of the calling thread,
+		// which the threads of the program then inherit.
+		if err := syscall.Setpriority(syscall.PRIO_PROCESS, 0, nice); err != nil {
+			return os.NewSyscallError("setpriority", err)
+		}
+	}
+	if loopback {
+		if err := setupLoopback(); err != nil {
+			return fmt.Errorf("loopback: %v", err)
+		}
+	}
+	if err := setNoNewPrivs(); err != nil {
+		return err
+	}
+	if dir != "" {
+		if err := applyLandlock(dir, readPaths); err != nil {
+			return fmt.Errorf("landlock: %v", err)
+		}
+	}
+	if seccomp {
+		if err := applySeccomp(denyNetwork); err != nil {
+			return fmt.Errorf("seccomp: %v", err)
+		}
+	}
+	return syscall.Exec(path, args, env)
+}
+
+// checkSecurityProfile reports whether the restrictions of the profile
+// are supported on this system.
+func checkSecurityProfile(sp securityProfile) (string, error) {
+	var parts []string
+	if sp.Seccomp {
+		if err := checkSeccomp(); err != nil {
+			return "", fmt.Errorf("seccomp: %v", err)
+		}
+		parts = append(parts, fmt.Sprintf("seccomp (%d denied system calls)", len(deniedSyscalls)))
+	}
+	if sp.Landlock {
+		abi, err := landlockABI()
+		if err != nil {
+			return "", fmt.Errorf("landlock: %v", err)
+		}
+		parts = append(parts, fmt.Sprintf("landlock (ABI version %d)", abi))
+	}
+	return strings.Join(parts, ", "), nil
+}
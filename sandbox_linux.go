@@ -0,0 +1,284 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// deniedSyscalls are the system calls denied by the seccomp filter with EPERM.
+// They administer the system, or escape or inspect the sandbox.
+var deniedSyscalls = map[string]uintptr{
+	"ptrace":            unix.SYS_PTRACE,
+	"process_vm_readv":  unix.SYS_PROCESS_VM_READV,
+	"process_vm_writev": unix.SYS_PROCESS_VM_WRITEV,
+	"mount":             unix.SYS_MOUNT,
+	"umount2":           unix.SYS_UMOUNT2,
+	"pivot_root":        unix.SYS_PIVOT_ROOT,
+	"chroot":            unix.SYS_CHROOT,
+	"unshare":           unix.SYS_UNSHARE,
+	"setns":             unix.SYS_SETNS,
+	"open_by_handle_at": unix.SYS_OPEN_BY_HANDLE_AT,
+	"name_to_handle_at": unix.SYS_NAME_TO_HANDLE_AT,
+	"bpf":               unix.SYS_BPF,
+	"perf_event_open":   unix.SYS_PERF_EVENT_OPEN,
+	"userfaultfd":       unix.SYS_USERFAULTFD,
+	"keyctl":            unix.SYS_KEYCTL,
+	"add_key":           unix.SYS_ADD_KEY,
+	"request_key":       unix.SYS_REQUEST_KEY,
+	"init_module":       unix.SYS_INIT_MODULE,
+	"finit_module":      unix.SYS_FINIT_MODULE,
+	"delete_module":     unix.SYS_DELETE_MODULE,
+	"kexec_load":        unix.SYS_KEXEC_LOAD,
+	"reboot":            unix.SYS_REBOOT,
+	"swapon":            unix.SYS_SWAPON,
+	"swapoff":           unix.SYS_SWAPOFF,
+	"acct":              unix.SYS_ACCT,
+	"quotactl":          unix.SYS_QUOTACTL,
+	"settimeofday":      unix.SYS_SETTIMEOFDAY,
+	"clock_settime":     unix.SYS_CLOCK_SETTIME,
+	"syslog":            unix.SYS_SYSLOG,
+	"vhangup":           unix.SYS_VHANGUP,
+}
+
+// seccompArches are the audit architectures of the little-endian platforms
+// that the seccomp filter supports, on which socket is a system call.
+var seccompArches = map[string]uint32{
+	"amd64":   unix.AUDIT_ARCH_X86_64,
+	"arm64":   unix.AUDIT_ARCH_AARCH64,
+	"riscv64": unix.AUDIT_ARCH_RISCV64,
+}
+
+// Constants of seccomp filters and landlock missing from the unix package.
+const (
+	seccompRetKillProcess = 0x80000000
+	seccompRetErrno       = 0x00050000
+	seccompRetAllow       = 0x7fff0000
+
+	x32SyscallBit = 0x40000000 // Set for the x32 ABI on amd64
+
+	// Offsets in struct seccomp_data.
+	seccompDataNR   = 0
+	seccompDataArch = 4
+	seccompDataArg0 = 16 // Lower half on little-endian platforms
+
+	bpfLdAbs = unix.BPF_LD | unix.BPF_W | unix.BPF_ABS
+	bpfJeq   = unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K
+	bpfJge   = unix.BPF_JMP | unix.BPF_JGE | unix.BPF_K
+	bpfRet   = unix.BPF_RET | unix.BPF_K
+
+	landlockAccessFSTruncate = 1 << 14 // Since version 3 of the ABI
+)
+
+func setNoNewPrivs() error {
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return os.NewSyscallError("prctl", err)
+	}
+	return nil
+}
+
+// seccompFilter returns the BPF program of the seccomp filter, which kills
+// the process upon system calls of other architectures and denies those
+// in deniedSyscalls, as well as sockets other than Unix domain sockets
+// if denyNetwork is set.
+func seccompFilter(denyNetwork bool) ([]unix.SockFilter, error) {
+	arch, ok := seccompArches[runtime.GOARCH]
+	if !ok {
+		return nil, fmt.Errorf("unsupported architecture: %s", runtime.GOARCH)
+	}
+	var nrs []int
+	for _, nr := range deniedSyscalls {
+		nrs = append(nrs, int(nr))
+	}
+	sort.Ints(nrs)
+
+	stmt := func(code uint16, k uint32) unix.SockFilter { return unix.SockFilter{Code: code, K: k} }
+	jump := func(code uint16, k uint32, jt, jf uint8) unix.SockFilter {
+		return unix.SockFilter{Code: code, K: k, Jt: jt, Jf: jf}
+	}
+	prog := []unix.SockFilter{
+		stmt(bpfLdAbs, seccompDataArch),
+		jump(bpfJeq, arch, 1, 0),
+		stmt(bpfRet, seccompRetKillProcess),
+		stmt(bpfLdAbs, seccompDataNR),
+		jump(bpfJge, x32SyscallBit, 0, 1),
+		stmt(bpfRet, seccompRetKillProcess),
+	}
+	// Each denied system call jumps to the EPERM return at the end.
+	for i, nr := range nrs {
+		n := len(nrs) - i - 1 // Remaining comparisons
+		if denyNetwork {
+			n += 4
+		}
+		prog = append(prog, jump(bpfJeq, uint32(nr), uint8(n+1), 0))
+	}
+	if denyNetwork {
+		prog = append(prog,
+			jump(bpfJeq, uint32(unix.SYS_SOCKET), 0, 3),
+			stmt(bpfLdAbs, seccompDataArg0),
+			jump(bpfJeq, unix.AF_UNIX, 1, 0),
+			stmt(bpfRet, seccompRetErrno|uint32(unix.EACCES)),
+		)
+	}
+	prog = append(prog,
+		stmt(bpfRet, seccompRetAllow),
+		stmt(bpfRet, seccompRetErrno|uint32(unix.EPERM)),
+	)
+	return prog, nil
+}
+
+// applySeccomp installs the seccomp filter on the calling thread, which
+// requires no_new_privs to be set.
+func applySeccomp(denyNetwork bool) error {
+	prog, err := seccompFilter(denyNetwork)
+	if err != nil {
+		return err
+	}
+	fprog := unix.SockFprog{Len: uint16(len(prog)), Filter: &prog[0]}
+	if err := unix.Prctl(unix.PR_SET_SECCOMP, unix.SECCOMP_MODE_FILTER, uintptr(unsafe.Pointer(&fprog)), 0, 0); err != nil {
+		return os.NewSyscallError("prctl", err)
+	}
+	return nil
+}
+
+func checkSeccomp() error {
+	if _, err := seccompFilter(false); err != nil {
+		return err
+	}
+	// Seccomp is available if querying the mode of the thread succeeds.
+	if _, err := unix.PrctlRetInt(unix.PR_GET_SECCOMP, 0, 0, 0, 0); err != nil {
+		return os.NewSyscallError("prctl", err)
+	}
+	return nil
+}
+
+// landlockABI returns the version of the landlock ABI of the kernel.
+func landlockABI() (int, error) {
+	abi, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, 0, 0, unix.LANDLOCK_CREATE_RULESET_VERSION)
+	if errno != 0 {
+		if errno == unix.ENOSYS || errno == unix.EOPNOTSUPP {
+			return 0, errors.New("not supported by the kernel")
+		}
+		return 0, os.NewSyscallError("landlock_create_ruleset", errno)
+	}
+	return int(abi), nil
+}
+
+// applyLandlock restricts the calling thread to full access to dir and read
+// access to readPaths, which may be files. Paths that do not exist are
+// skipped. It requires no_new_privs to be set.
+func applyLandlock(dir string, readPaths []string) error {
+	abi, err := landlockABI()
+	if err != nil {
+		return err
+	}
+	const readAccess = unix.LANDLOCK_ACCESS_FS_EXECUTE | unix.LANDLOCK_ACCESS_FS_READ_FILE | unix.LANDLOCK_ACCESS_FS_READ_DIR
+	all := uint64(readAccess | unix.LANDLOCK_ACCESS_FS_WRITE_FILE |
+		unix.LANDLOCK_ACCESS_FS_REMOVE_DIR | unix.LANDLOCK_ACCESS_FS_REMOVE_FILE |
+		unix.LANDLOCK_ACCESS_FS_MAKE_CHAR | unix.LANDLOCK_ACCESS_FS_MAKE_DIR |
+		unix.LANDLOCK_ACCESS_FS_MAKE_REG | unix.LANDLOCK_ACCESS_FS_MAKE_SOCK |
+		unix.LANDLOCK_ACCESS_FS_MAKE_FIFO | unix.LANDLOCK_ACCESS_FS_MAKE_BLOCK |
+		unix.LANDLOCK_ACCESS_FS_MAKE_SYM)
+	if abi >= 2 {
+		all |= unix.LANDLOCK_ACCESS_FS_REFER
+	}
+	if abi >= 3 {
+		all |= landlockAccessFSTruncate
+	}
+	attr := unix.LandlockRulesetAttr{Access_fs: all}
+	fd, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr), 0)
+	if errno != 0 {
+		return os.NewSyscallError("landlock_create_ruleset", errno)
+	}
+	defer unix.Close(int(fd))
+
+	addRule := func(p string, access uint64) error {
+		f, err := unix.Open(p, unix.O_PATH|unix.O_CLOEXEC, 0)
+		if err != nil {
+			if err == unix.ENOENT {
+				return nil
+			}
+			return &os.PathError{Op: "open", Path: p, Err: err}
+		}
+		defer unix.Close(f)
+		var st unix.Stat_t
+		if err := unix.Fstat(f, &st); err != nil {
+			return &os.PathError{Op: "stat", Path: p, Err: err}
+		}
+		if st.Mode&unix.S_IFMT != unix.S_IFDIR {
+			// Only the access rights of files apply to them.
+			access &= unix.LANDLOCK_ACCESS_FS_EXECUTE | unix.LANDLOCK_ACCESS_FS_READ_FILE | unix.LANDLOCK_ACCESS_FS_WRITE_FILE | landlockAccessFSTruncate
+		}
+		rule := unix.LandlockPathBeneathAttr{Allowed_access: access, Parent_fd: int32(f)}
+		if _, _, errno := unix.Syscall6(unix.SYS_LANDLOCK_ADD_RULE, fd, unix.LANDLOCK_RULE_PATH_BENEATH, uintptr(unsafe.Pointer(&rule)), 0, 0, 0); errno != 0 {
+			return &os.PathError{Op: "landlock_add_rule", Path: p, Err: errno}
+		}
+		return nil
+	}
+	if err := addRule(dir, all); err != nil {
+		return err
+	}
+	for _, p := range readPaths {
+		if err := addRule(p, readAccess); err != nil {
+			return err
+		}
+	}
+	if _, _, errno := unix.Syscall(unix.SYS_LANDLOCK_RESTRICT_SELF, fd, 0, 0); errno != 0 {
+		return os.NewSyscallError("landlock_restrict_self", errno)
+	}
+	return nil
+}
+
+// isolateNetwork makes cmd start in a new network namespace, whose only
+// interface is a loopback one that is down. Unprivileged users need a user
+// namespace as well, in which they are mapped to root so that sandbox-exec
+// keeps the capabilities to bring the loopback interface up.
+func isolateNetwork(cmd *exec.Cmd) {
+	attr := &syscall.SysProcAttr{Cloneflags: syscall.CLONE_NEWNET}
+	if uid, gid := os.Geteuid(), os.Getegid(); uid != 0 {
+		attr.Cloneflags |= syscall.CLONE_NEWUSER
+		attr.UidMappings = []syscall.SysProcIDMap{{ContainerID: 0, HostID: uid, Size: 1}}
+		attr.GidMappings = []syscall.SysProcIDMap{{ContainerID: 0, HostID: gid, Size: 1}}
+	}
+	cmd.SysProcAttr = attr
+}
+
+// checkIsolateNetwork reports whether commands can be started
+// in a new network namespace.
+func checkIsolateNetwork() error {
+	cmd := exec.Command("/bin/true")
+	isolateNetwork(cmd)
+	return cmd.Run()
+}
+
+// setupLoopback brings the loopback interface up.
+func setupLoopback() error {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return os.NewSyscallError("socket", err)
+	}
+	defer unix.Close(fd)
+	ifr, err := unix.NewIfreq("lo")
+	if err != nil {
+		return err
+	}
+	if err := unix.IoctlIfreq(fd, unix.SIOCGIFFLAGS, ifr); err != nil {
+		return os.NewSyscallError("ioctl", err)
+	}
+	ifr.SetUint16(ifr.Uint16() | unix.IFF_UP)
+	if err := unix.IoctlIfreq(fd, unix.SIOCSIFFLAGS, ifr); err != nil {
+		return os.NewSyscallError("ioctl", err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+// TestSandboxHelper is not a test, but the sandbox-exec command and the
+// program that it runs when the test binary is run by TestSandbox.
+func TestSandboxHelper(t *testing.T) {
+	if os.Getenv("PLAYGROUND_TEST_SANDBOX") == "" {
+		t.Skip("only run by TestSandbox")
+	}
+	var args []string
+	for i, arg := range os.Args {
+		if arg == "--" {
+			args = os.Args[i+1:]
+			break
+		}
+	}
+	if len(args) == 0 || args[0] != "probe" {
+		runSandboxExec(args)
+		return
+	}
+
+	// Report what the program may do.
+	report := func(name string, err error) {
+		if err != nil {
+			fmt.Printf("%s: %v\n", name, err)
+		} else {
+			fmt.Printf("%s: ok\n", name)
+		}
+	}
+	prio, _ := syscall.Getpriority(syscall.PRIO_PROCESS, 0)
+	fmt.Printf("nice: %d\n", 20-prio) // The system call returns 20 - nice
+	report("unshare", syscall.Unshare(syscall.CLONE_NEWUTS))
+	fd, err := syscall.Socket(syscall.AF_INET, syscall.SOCK_STREAM, 0)
+	report("socket inet", err)
+	syscall.Close(fd)
+	fd, err = syscall.Socket(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	report("socket unix", err)
+	syscall.Close(fd)
+	_, err = ioutil.ReadDir("/etc")
+	report("read /etc", err)
+	report("write tmpdir", ioutil.WriteFile(filepath.Join(os.TempDir(), "out.txt"), nil, 0644))
+	if addr := os.Getenv("PLAYGROUND_TEST_ADDR"); addr != "" {
+		c, err := net.Dial("tcp", addr)
+		report("dial host", err)
+		if err == nil {
+			c.Close()
+		}
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err == nil {
+		var c net.Conn
+		c, err = net.Dial("tcp", ln.Addr().String())
+		if err == nil {
+			c.Close()
+		}
+		ln.Close()
+	}
+	report("loopback", err)
+	os.Exit(0)
+}
+
+func TestSandbox(t *testing.T) {
+	if err := checkSeccomp(); err != nil {
+		t.Skipf("seccomp not supported: %v", err)
+	}
+
+	// Landlock restricts execution, so the program must be in the directory.
+	dir := t.TempDir()
+	b, err := ioutil.ReadFile(os.Args[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	probe := filepath.Join(dir, "probe")
+	if err := ioutil.WriteFile(probe, b, 0755); err != nil {
+		t.Fatal(err)
+	}
+	run := func(flags ...string) string {
+		t.Helper()
+		args := append([]string{"-test.run=^TestSandboxHelper$", "--"}, flags...)
+		args = append(args, "--", probe, "-test.run=^TestSandboxHelper$", "--", "probe")
+		cmd := exec.Command(os.Args[0], args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "PLAYGROUND_TEST_SANDBOX=1", "TMPDIR="+t.TempDir())
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("sandbox-exec %v error: %v\n%s", flags, err, out)
+		}
+		return string(out)
+	}
+	check := func(out string, want map[string]bool) {
+		t.Helper()
+		for name, allowed := range want {
+			got := strings.Contains(out, name+": ok\n")
+			if got != allowed {
+				t.Errorf("%s allowed = %v, want %v; output:\n%s", name, got, allowed, out)
+			}
+		}
+	}
+
+	check(run(), map[string]bool{"unshare": true, "socket inet": true, "read /etc": true})
+	if out := run("--nice=5"); !strings.Contains(out, "nice: 5\n") {
+		t.Errorf("program does not run with nice value 5; output:\n%s", out)
+	}
+	check(run("--seccomp"), map[string]bool{"unshare": false, "socket inet": true, "socket unix": true})
+	check(run("--seccomp", "--deny-network"), map[string]bool{"unshare": false, "socket inet": false, "socket unix": true})
+	if _, err := landlockABI(); err != nil {
+		t.Skipf("landlock not supported: %v", err)
+	}
+	flags := []string{"--landlock=" + dir}
+	for _, p := range defaultReadPaths {
+		flags = append(flags, "--read="+p) // The shared libraries of the test binary
+	}
+	check(run(flags...), map[string]bool{"unshare": true, "read /etc": false, "write tmpdir": true})
+}
+
+func TestSandboxNoNetwork(t *testing.T) {
+	if err := checkIsolateNetwork(); err != nil {
+		t.Skipf("network namespaces not supported: %v", err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			c.Close()
+		}
+	}()
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestSandboxHelper$", "--", "--loopback",
+		"--", os.Args[0], "-test.run=^TestSandboxHelper$", "--", "probe")
+	cmd.Env = append(os.Environ(), "PLAYGROUND_TEST_SANDBOX=1", "PLAYGROUND_TEST_ADDR="+ln.Addr().String())
+	isolateNetwork(cmd)
+	b, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("sandbox-exec error: %v\n%s", err, b)
+	}
+	out := string(b)
+	if strings.Contains(out, "dial host: ok\n") || !strings.Contains(out, "loopback: ok\n") {
+		t.Errorf("isolated program can reach the host or not its loopback interface; output:\n%s", out)
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+//go:build !linux
+// +build !linux
+
+package main
+
+import (
+	"errors"
+	"os/exec"
+)
+
+var errNoSandbox = errors.New("only supported on Linux")
+
+var deniedSyscalls map[string]uintptr
+
+func setNoNewPrivs() error                               { return errNoSandbox }
+func applySeccomp(denyNetwork bool) error                { return errNoSandbox }
+func checkSeccomp() error                                { return errNoSandbox }
+func landlockABI() (int, error)                          { return 0, errNoSandbox }
+func applyLandlock(dir string, readPaths []string) error { return errNoSandbox }
+func checkIsolateNetwork() error                         { return errNoSandbox }
+func setupLoopback() error                               { return errNoSandbox }
+
+// isolateNetwork does nothing, but sandbox-exec then fails to set up the
+// loopback interface, so that programs without network access do not run.
+func isolateNetwork(cmd *exec.Cmd) {}
@@ -0,0 +1,109 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Limits on searches of the code of snippets with a regular expression.
+const (
+	maxSearchPattern = 1 << 10 // Maximum length of the pattern in bytes
+	maxSearchMatches = 10      // Maximum number of matched lines per snippet
+	maxSearchLine    = 200     // Maximum length of a matched line in bytes
+)
+
+// searchTimeout is how long a search may scan snippets before giving up.
+var searchTimeout = 10 * time.Second
+
+// errSearchTimeout indicates that a search did not complete in time.
+var errSearchTimeout = errors.New("regex search timed out")
+
+// lineMatch is a line of the code of a snippet matched by a search.
+type lineMatch struct {
+	Line int    `json:"line"` // 1-based line number
+	Text string `json:"text"` // Truncated to maxSearchLine bytes
+}
+
+// searchResult is a snippet matched by a search along with the matched lines.
+type searchResult struct {
+	snippet
+	Matches []lineMatch `json:"matches"`
+}
+
+// searchCode returns up to limit snippets with IDs greater than the last ID
+// that have lines of code matching the regular expression pattern,
+// along with the matched lines of each snippet.
+// The list is sorted in ascending order by ID.
+func searchCode(ctx context.Context, db snippetStore, pattern string, lastID int64, limit int) ([]snippet, [][]lineMatch, error) {
+	if pattern == "" {
+		return nil, nil, requestError{errors.New("empty regex pattern")}
+	}
+	if len(pattern) > maxSearchPattern {
+		return nil, nil, requestError{fmt.Errorf("regex pattern exceeds %d bytes", maxSearchPattern)}
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, nil, requestError{err}
+	}
+
+	// The regexp package matches in linear time, but there may be many
+	// snippets to scan, so the search is bounded in time instead.
+	ctx, cancel := context.WithTimeout(ctx, searchTimeout)
+	defer cancel()
+	var ss []snippet
+	var ms [][]lineMatch
+	for limit < 0 || len(ss) < limit {
+		batch, err := db.QueryByID(lastID, exportBatchSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, s := range batch {
+			if ctx.Err() != nil {
+				return nil, nil, errSearchTimeout
+			}
+			lastID = s.ID
+			if m := matchLines(re, s.Code); len(m) > 0 {
+				ss = append(ss, s)
+				ms = append(ms, m)
+				if len(ss) == limit {
+					break
+				}
+			}
+		}
+		if len(batch) < exportBatchSize {
+			break
+		}
+	}
+	return ss, ms, nil
+}
+
+// matchLines returns up to maxSearchMatches lines of code matching re.
+func matchLines(re *regexp.Regexp, code string) []lineMatch {
+	var ms []lineMatch
+	for i, line := range strings.Split(code, "\n") {
+		if !re.MatchString(line) {
+			continue
+		}
+		line = strings.TrimRight(line, "\r")
+		if n := maxSearchLine; len(line) > n {
+			for n > 0 && !utf8.RuneStart(line[n]) {
+				n-- // Avoid splitting a multi-byte character
+			}
+			line = line[:n]
+		}
+		ms = append(ms, lineMatch{Line: i + 1, Text: line})
+		if len(ms) == maxSearchMatches {
+			break
+		}
+	}
+	return ms
+}
@@ -0,0 +1,161 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// session is a browser login tracked by the server so that it can be revoked.
+// The auth cookie of the browser holds the signed session ID.
+type session struct {
+	ID         string    `json:"id"`
+	User       string    `json:"user,omitempty"` // Email address if logged in with OIDC
+	Created    time.Time `json:"created"`
+	Refreshed  time.Time `json:"refreshed"` // Time the auth cookie was last issued
+	RemoteAddr string    `json:"remote_addr"`
+	UserAgent  string    `json:"user_agent"`
+}
+
+func (s *session) MarshalBinary() ([]byte, error) {
+	type st session
+	bb := new(bytes.Buffer)
+	enc := gob.NewEncoder(bb)
+	err := enc.Encode((*st)(s))
+	return bb.Bytes(), err
+}
+
+func (s *session) UnmarshalBinary(b []byte) error {
+	type st session
+	br := bytes.NewReader(b)
+	dec := gob.NewDecoder(br)
+	return dec.Decode((*st)(s))
+}
+
+// isExpired reports whether the auth cookie of the session has expired.
+func (s session) isExpired(now time.Time) bool {
+	return now.Sub(s.Refreshed) > authExpirePeriod
+}
+
+// login starts a new session for the user and issues the auth cookie.
+func (pg *playground) login(w http.ResponseWriter, r *http.Request, user string) error {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	s := session{
+		ID:         hex.EncodeToString(b[:]),
+		User:       user,
+		Created:    now,
+		RemoteAddr: pg.remoteAddr(r),
+		UserAgent:  r.UserAgent(),
+	}
+	pg.pruneSessions(now)
+	return pg.refreshAuth(w, r, s)
+}
+
+// pruneSessions deletes all expired sessions.
+func (pg *playground) pruneSessions(now time.Time) {
+	ss, err := pg.sdb.ListSessions()
+	if err != nil {
+		pg.log.Printf("session listing error: %v", err)
+		return
+	}
+	for _, s := range ss {
+		if s.isExpired(now) {
+			if err := pg.sdb.DeleteSession(s.ID); err != nil && err != errNotFound {
+				pg.log.Printf("session deletion error: %v", err)
+			}
+		}
+	}
+}
+
+// currentSession returns the ID of the session authenticated by the
+// auth cookie of the request. It returns an empty string if there is none.
+func (pg *playground) currentSession(r *http.Request) string {
+	key := pg.authKey()
+	if key == nil {
+		return ""
+	}
+	c, err := r.Cookie("auth")
+	if err != nil {
+		return ""
+	}
+	sid, _ := parseAuthToken(key, c.Value)
+	return sid
+}
+
+// serveSessions provides endpoints to manage login sessions.
+//
+// A GET request to "/admin/sessions" returns a JSON list of all active
+// sessions, where the session of the requester has the "current" field set.
+//
+// A DELETE request to "/admin/sessions/ID" revokes the session with that ID,
+// while a DELETE request to "/admin/sessions" revokes all sessions other than
+// the current session (i.e., logs out all other devices).
+func (pg *playground) serveSessions(w http.ResponseWriter, r *http.Request) {
+	current := pg.currentSession(r)
+
+	var err error
+	var v interface{}
+	switch {
+	case r.Method == "GET":
+		pg.pruneSessions(time.Now())
+		var ss []session
+		ss, err = pg.store(r).ListSessions()
+		type sessionInfo struct {
+			session
+			Current bool `json:"current,omitempty"`
+		}
+		sis := []sessionInfo{} // Marshal as an empty list rather than null
+		for _, s := range ss {
+			sis = append(sis, sessionInfo{s, s.ID == current})
+		}
+		v = sis
+	case r.Method == "DELETE" && reSessionsID.MatchString(r.URL.Path):
+		id := r.URL.Path[strings.LastIndexByte(r.URL.Path, '/')+1:]
+		err = pg.store(r).DeleteSession(id)
+		pg.logf(r, "revoked session %s", id)
+	case r.Method == "DELETE":
+		var ss []session
+		if ss, err = pg.store(r).ListSessions(); err != nil {
+			break
+		}
+		var n int
+		for _, s := range ss {
+			if s.ID == current {
+				continue
+			}
+			if err = pg.store(r).DeleteSession(s.ID); err != nil && err != errNotFound {
+				break
+			}
+			err = nil
+			n++
+		}
+		pg.logf(r, "revoked %d other sessions", n)
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err == errNotFound {
+			status = http.StatusNotFound
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+
+	if v != nil {
+		w.Header().Set("Content-Type", "application/json")
+		b, _ := json.Marshal(v)
+		w.Write(b)
+	}
+}
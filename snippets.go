@@ -6,11 +6,14 @@ package main
 
 import (
 	"bytes"
+	"crypto/subtle"
 	"encoding/binary"
 	"encoding/gob"
 	"errors"
 	"fmt"
+	"io"
 	"math"
+	"os"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -22,9 +25,14 @@ import (
 )
 
 const (
-	boltFile     = "snippets.boltdb"
-	bucketByID   = "SnippetsByID"
-	bucketByDate = "SnippetsByModified"
+	boltFile        = "snippets.boltdb"
+	bucketByID      = "SnippetsByID"
+	bucketByDate    = "SnippetsByModified"
+	bucketByCreated = "SnippetsByCreated"
+	bucketTokens    = "APITokens"
+	bucketSessions  = "Sessions"
+	bucketAudit     = "AuditLog"
+	bucketBench     = "Benchmarks"
 
 	defaultID   = 1
 	defaultName = "Default snippet"
@@ -49,9 +57,26 @@ type snippet struct {
 	ID       int64     `json:"id"`
 	Created  time.Time `json:"created"`
 	Modified time.Time `json:"modified"`
+	Gist     string    `json:"gist,omitempty"` // ID of the GitHub Gist exported to
+	Starred  bool      `json:"starred"`
 
 	Name string `json:"name"`
 	Code string `json:"code,omitempty"`
+
+	// Files are the data files attached to the snippet by their
+	// slash-separated names relative to the testdata directory,
+	// where they are written for every run of the snippet.
+	Files map[string][]byte `json:"files,omitempty"`
+}
+
+// maxSnippetFilesSize is the maximum total number of bytes of the data files
+// attached to a snippet.
+const maxSnippetFilesSize = 4 << 20
+
+// batchOp is an operation applied to many snippets by Batch.
+type batchOp struct {
+	Op  string  `json:"op"` // Either "delete", "star", or "unstar"
+	IDs []int64 `json:"ids"`
 }
 
 func (s *snippet) MarshalBinary() ([]byte, error) {
@@ -69,6 +94,190 @@ func (s *snippet) UnmarshalBinary(b []byte) error {
 	return dec.Decode((*st)(s))
 }
 
+// snippetStore is the persistent storage for snippets.
+// See database for the documentation of each method.
+type snippetStore interface {
+	QueryByModified(lastTime time.Time, lastID int64, limit int) ([]snippet, error)
+	QueryByCreated(lastTime time.Time, lastID int64, limit int) ([]snippet, error)
+	QueryByID(lastID int64, limit int) ([]snippet, error)
+	QueryByName(name string, limit int) ([]snippet, error)
+	QueryByStarred(limit int) ([]snippet, error)
+	Create(s snippet) (int64, error)
+	Import(ss []snippet) (map[int64]int64, error)
+	Retrieve(id int64) (snippet, error)
+	Update(s snippet, id int64) error
+	Delete(id int64) error
+	Batch(ops []batchOp) error
+	SetGist(id int64, gist string) error
+	ToggleStarred(id int64) (snippet, error)
+	CreateToken(t apiToken) (int64, error)
+	LookupToken(hash []byte) (apiToken, error)
+	ListTokens() ([]apiToken, error)
+	DeleteToken(id int64) error
+	PutSession(s session) error
+	LookupSession(id string) (session, error)
+	ListSessions() ([]session, error)
+	DeleteSession(id string) error
+	AppendAudit(e auditEntry) (int64, error)
+	QueryAudit(beforeID int64, limit int) ([]auditEntry, error)
+	AppendBenchmarks(br benchRun) error
+	QueryBenchmarks(snippetID int64, limit int) ([]benchRun, error)
+	Stats() (storeStats, error)
+	Backup(path string) error
+	Compact() (before, after int64, err error)
+	Close() error
+}
+
+// storeStats reports statistics about the snippet storage.
+type storeStats struct {
+	Backend  string `json:"backend"`
+	Snippets int64  `json:"snippets"`
+	Size     int64  `json:"size"` // Size of the database file in bytes
+}
+
+// openStore opens the snippet storage of the given backend
+// (either "bolt" or "sqlite") located in the directory path.
+func openStore(backend, path string) (snippetStore, error) {
+	switch backend {
+	case "", "bolt":
+		return openDatabase(path)
+	case "sqlite":
+		return openSQLiteDatabase(path)
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %v", backend)
+	}
+}
+
+func checkCreate(s snippet) error {
+	switch {
+	case strings.TrimSpace(s.Name) == "":
+		return requestError{errors.New("snippet name cannot be empty")}
+	case s.ID != 0:
+		return requestError{errors.New("cannot assign ID when creating snippet")}
+	}
+	return checkFiles(s.Files)
+}
+
+func checkImport(ss []snippet) error {
+	for _, s := range ss {
+		if strings.TrimSpace(s.Name) == "" {
+			return requestError{fmt.Errorf("snippet name cannot be empty (ID: %d)", s.ID)}
+		}
+		if err := checkFiles(s.Files); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkFiles(files map[string][]byte) error {
+	var size int
+	for name, b := range files {
+		if !validFileName(name) {
+			return requestError{fmt.Errorf("invalid file name: %q", name)}
+		}
+		size += len(b)
+	}
+	if size > maxSnippetFilesSize {
+		return requestError{fmt.Errorf("files too large: %d bytes", size)}
+	}
+	return nil
+}
+
+func checkUpdate(s snippet, id int64) error {
+	switch {
+	case s.ID == 0 && id == 0:
+		return requestError{errors.New("cannot update snippet with ID: 0")}
+	case s.ID > 0 && s.ID != id:
+		return requestError{fmt.Errorf("snippet IDs do not match: %d != %d", id, s.ID)}
+	case s.ID == defaultID && s.Name != "" && s.Name != defaultName:
+		return requestError{errors.New("cannot change default snippet name")}
+	case s.Name != "" && strings.TrimSpace(s.Name) == "":
+		return requestError{errors.New("name cannot be blank")}
+	case !s.Modified.IsZero() || !s.Created.IsZero():
+		return requestError{errors.New("cannot set modified or created times")}
+	}
+	return checkFiles(s.Files)
+}
+
+func checkDelete(id int64) error {
+	if id == 0 || id == defaultID {
+		return requestError{fmt.Errorf("cannot delete snippet (ID: %d)", id)}
+	}
+	return nil
+}
+
+func checkBatch(ops []batchOp) error {
+	for _, op := range ops {
+		switch op.Op {
+		case "delete":
+			for _, id := range op.IDs {
+				if err := checkDelete(id); err != nil {
+					return err
+				}
+			}
+		case "star", "unstar":
+		default:
+			return requestError{fmt.Errorf("invalid batch operation: %q", op.Op)}
+		}
+	}
+	return nil
+}
+
+// matchNames returns the IDs of the snippets whose lower-case names match
+// the provided query. The most relevant snippets are at the front of the list.
+func matchNames(names map[int64]string, query string, limit int) []int64 {
+	type queryMatch struct {
+		id, n int64
+		name  string
+	}
+
+	// Convert query into a list of lower-case search tokens.
+	qss := strings.Split(strings.ToLower(query), " ")
+	qs := qss[:0]
+	for _, s := range qss {
+		if s != "" {
+			qs = append(qs, s)
+		}
+	}
+	if query == "" {
+		qs = []string{""} // Find everything
+	}
+
+	// Search for all snippets that have a match with the query.
+	// Assume that the number of snippets is small enough that this is fast.
+	var ms []queryMatch
+	for id, name := range names {
+		m := queryMatch{id: id, name: name}
+		for _, s := range qs {
+			m.n += int64(strings.Count(name, s))
+		}
+		if m.n > 0 {
+			ms = append(ms, m)
+		}
+	}
+
+	// Sort by ranking and apply limit.
+	sort.Slice(ms, func(i, j int) bool {
+		if ms[i].n == ms[j].n {
+			if ms[i].name == ms[j].name {
+				return ms[i].id > ms[j].id
+			}
+			return ms[i].name < ms[j].name
+		}
+		return ms[i].n > ms[j].n
+	})
+	for len(ms) > limit && limit >= 0 {
+		ms = ms[:limit]
+	}
+
+	ids := make([]int64, len(ms))
+	for i, m := range ms {
+		ids[i] = m.id
+	}
+	return ids
+}
+
 func idKey(id int64) []byte {
 	// Offset the int64 values sort that they sort nicely as uint64.
 	var k [8]byte
@@ -86,11 +295,13 @@ func dualKey(id int64, mod time.Time) []byte {
 }
 
 type database struct {
+	dbMu   sync.RWMutex // Protects db from being swapped by Compact
 	db     *bolt.DB
 	lastID int64
 
-	mu      sync.Mutex // Protects names
+	mu      sync.Mutex // Protects names and starred
 	names   map[int64]string
+	starred map[int64]bool
 	timeNow func() time.Time
 }
 
@@ -106,6 +317,7 @@ func openDatabase(path string) (*database, error) {
 	// Get the last snippet ID and all names.
 	lastID := int64(-1)
 	names := make(map[int64]string)
+	starred := make(map[int64]bool)
 	if err := db.View(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket([]byte(bucketByID))
 		if bkt == nil {
@@ -118,6 +330,9 @@ func openDatabase(path string) (*database, error) {
 				return err
 			}
 			names[s.ID] = strings.ToLower(s.Name)
+			if s.Starred {
+				starred[s.ID] = true
+			}
 			lastID = s.ID
 		}
 		return nil
@@ -153,20 +368,72 @@ func openDatabase(path string) (*database, error) {
 		lastID = s.ID
 	}
 
+	// Create buckets which may be absent in older databases.
+	if err := db.Update(func(tx *bolt.Tx) error {
+		for _, name := range []string{bucketTokens, bucketSessions, bucketAudit, bucketBench} {
+			if _, err := tx.CreateBucketIfNotExists([]byte(name)); err != nil {
+				return err
+			}
+		}
+
+		// Index the creation times of existing snippets.
+		if tx.Bucket([]byte(bucketByCreated)) != nil {
+			return nil
+		}
+		bktByCreated, err := tx.CreateBucket([]byte(bucketByCreated))
+		if err != nil {
+			return err
+		}
+		return tx.Bucket([]byte(bucketByID)).ForEach(func(k, v []byte) error {
+			var s snippet
+			if err := s.UnmarshalBinary(v); err != nil {
+				return err
+			}
+			return bktByCreated.Put(dualKey(s.ID, s.Created), nil)
+		})
+	}); err != nil {
+		return nil, err
+	}
+
 	once.Do(func() {}) // Avoid closing database
-	return &database{db: db, lastID: lastID, names: names, timeNow: time.Now}, nil
+	return &database{db: db, lastID: lastID, names: names, starred: starred, timeNow: time.Now}, nil
+}
+
+// view runs f within a read-only transaction.
+func (db *database) view(f func(*bolt.Tx) error) error {
+	db.dbMu.RLock()
+	defer db.dbMu.RUnlock()
+	return db.db.View(f)
+}
+
+// update runs f within a read-write transaction.
+func (db *database) update(f func(*bolt.Tx) error) error {
+	db.dbMu.RLock()
+	defer db.dbMu.RUnlock()
+	return db.db.Update(f)
 }
 
 // QueryByModified returns a list of snippets younger than the last time.
 // The list is sorted in descending order by time (and by ID on equal times).
 func (db *database) QueryByModified(lastTime time.Time, lastID int64, limit int) ([]snippet, error) {
+	return db.queryByDate(bucketByDate, lastTime, lastID, limit)
+}
+
+// QueryByCreated is like QueryByModified, but for the creation time.
+func (db *database) QueryByCreated(lastTime time.Time, lastID int64, limit int) ([]snippet, error) {
+	return db.queryByDate(bucketByCreated, lastTime, lastID, limit)
+}
+
+// queryByDate queries snippets using the index in the given bucket,
+// which is keyed by the dualKey of each snippet.
+func (db *database) queryByDate(bucket string, lastTime time.Time, lastID int64, limit int) ([]snippet, error) {
 	if lastTime.IsZero() && lastID == 0 {
 		lastTime, lastID = maxTime, maxID // Find everything
 	}
 	var ss []snippet
-	err := db.db.View(func(tx *bolt.Tx) error {
+	err := db.view(func(tx *bolt.Tx) error {
 		// Seek to the latest value that is immediately before the search key.
-		bktByDate := tx.Bucket([]byte(bucketByDate))
+		bktByDate := tx.Bucket([]byte(bucket))
 		c := bktByDate.Cursor()
 		sk := dualKey(lastID, lastTime)
 		k, _ := c.Seek(sk)
@@ -200,7 +467,7 @@ func (db *database) QueryByModified(lastTime time.Time, lastID int64, limit int)
 // The list is sorted in ascending order by ID.
 func (db *database) QueryByID(lastID int64, limit int) ([]snippet, error) {
 	var ss []snippet
-	err := db.db.View(func(tx *bolt.Tx) error {
+	err := db.view(func(tx *bolt.Tx) error {
 		// Iterate through all results.
 		ss = nil
 		bktByID := tx.Bucket([]byte(bucketByID))
@@ -221,59 +488,56 @@ func (db *database) QueryByID(lastID int64, limit int) ([]snippet, error) {
 	return ss, err
 }
 
-// QueryByName returns a list of snippets that match the provided query.
-// The most relevant snippets are at the front of the list.
-func (db *database) QueryByName(name string, limit int) ([]snippet, error) {
-	type queryMatch struct {
-		id, n int64
-		name  string
-	}
-
-	// Convert query into a list of lower-case search tokens.
-	qss := strings.Split(strings.ToLower(name), " ")
-	qs := qss[:0]
-	for _, s := range qss {
-		if s != "" {
-			qs = append(qs, s)
-		}
-	}
-	if name == "" {
-		qs = []string{""} // Find everything
-	}
-
-	// Search for all snippets that have a match with the query.
-	// Assume that the number of snippets is small enough that this is fast.
-	var ms []queryMatch
+// QueryByStarred returns a list of all snippets with the starred snippets
+// at the front of the list. Both the starred and the remaining snippets
+// are sorted in descending order by modified time (and by ID on equal times).
+func (db *database) QueryByStarred(limit int) ([]snippet, error) {
 	db.mu.Lock()
-	for id, name := range db.names {
-		m := queryMatch{id: id, name: name}
-		for _, s := range qs {
-			m.n += int64(strings.Count(name, s))
-		}
-		if m.n > 0 {
-			ms = append(ms, m)
-		}
-	}
+	numStarred := len(db.starred)
 	db.mu.Unlock()
 
-	// Sort by ranking and apply limit.
-	sort.Slice(ms, func(i, j int) bool {
-		if ms[i].n == ms[j].n {
-			if ms[i].name == ms[j].name {
-				return ms[i].id > ms[j].id
+	// Assume that the number of snippets is small enough that this is fast.
+	var ss []snippet
+	err := db.view(func(tx *bolt.Tx) error {
+		var starred, others []snippet
+		bktByDate := tx.Bucket([]byte(bucketByDate))
+		bktByID := tx.Bucket([]byte(bucketByID))
+		c := bktByDate.Cursor()
+		for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+			if len(starred)+len(others) >= limit && len(starred) >= numStarred && limit >= 0 {
+				break
+			}
+			var s snippet
+			v := bktByID.Get(k[12:20]) // Extract ID from dual key
+			if err := s.UnmarshalBinary(v); err != nil {
+				return err
+			}
+			if s.Starred {
+				starred = append(starred, s)
+			} else {
+				others = append(others, s)
 			}
-			return ms[i].name < ms[j].name
 		}
-		return ms[i].n > ms[j].n
+		ss = append(starred, others...)
+		return nil
 	})
-	for len(ms) > limit && limit >= 0 {
-		ms = ms[:limit]
+	for len(ss) > limit && limit >= 0 {
+		ss = ss[:limit]
 	}
+	return ss, err
+}
+
+// QueryByName returns a list of snippets that match the provided query.
+// The most relevant snippets are at the front of the list.
+func (db *database) QueryByName(name string, limit int) ([]snippet, error) {
+	db.mu.Lock()
+	ids := matchNames(db.names, name, limit)
+	db.mu.Unlock()
 
 	// Retrieve all snippets for the remaining IDs.
 	var ss []snippet
-	for _, m := range ms {
-		s, err := db.Retrieve(m.id)
+	for _, id := range ids {
+		s, err := db.Retrieve(id)
 		if err == errNotFound {
 			continue
 		}
@@ -288,16 +552,15 @@ func (db *database) QueryByName(name string, limit int) ([]snippet, error) {
 // Create a new snippet. The ID must not be set and the name must not be empty.
 // If successful, this will return the ID of the new snippet.
 func (db *database) Create(s snippet) (int64, error) {
-	switch {
-	case strings.TrimSpace(s.Name) == "":
-		return 0, requestError{errors.New("snippet name cannot be empty")}
-	case s.ID != 0:
-		return 0, requestError{errors.New("cannot assign ID when creating snippet")}
+	if err := checkCreate(s); err != nil {
+		return 0, err
 	}
 	s.ID = atomic.AddInt64(&db.lastID, 1)
-	err := db.db.Update(func(tx *bolt.Tx) error {
+	err := db.update(func(tx *bolt.Tx) error {
 		s.Created = db.timeNow().UTC().AddDate(0, 0, 0)
 		s.Modified = s.Created
+		s.Gist = ""       // Only set by SetGist
+		s.Starred = false // Only set by ToggleStarred
 
 		// Store the snippet.
 		v, _ := s.MarshalBinary()
@@ -309,6 +572,10 @@ func (db *database) Create(s snippet) (int64, error) {
 		if err := bktByDate.Put(dualKey(s.ID, s.Modified), nil); err != nil {
 			return err
 		}
+		bktByCreated := tx.Bucket([]byte(bucketByCreated))
+		if err := bktByCreated.Put(dualKey(s.ID, s.Created), nil); err != nil {
+			return err
+		}
 		return nil
 	})
 	if s.ID > 0 && err == nil {
@@ -319,11 +586,128 @@ func (db *database) Create(s snippet) (int64, error) {
 	return s.ID, err
 }
 
+// Import creates new snippets from ss in a single transaction.
+// The IDs in ss are ignored and each snippet is assigned a new ID.
+// The created and modified times are preserved if set, otherwise they
+// default to the current time. Every name must not be empty.
+// If successful, this returns a mapping of the old IDs to the new IDs.
+func (db *database) Import(ss []snippet) (map[int64]int64, error) {
+	if err := checkImport(ss); err != nil {
+		return nil, err
+	}
+	if len(ss) == 0 {
+		return map[int64]int64{}, nil
+	}
+	lastID := atomic.AddInt64(&db.lastID, int64(len(ss)))
+	ids := make(map[int64]int64)
+	ss = append([]snippet(nil), ss...)
+	err := db.update(func(tx *bolt.Tx) error {
+		now := db.timeNow().UTC().AddDate(0, 0, 0)
+		bktByID := tx.Bucket([]byte(bucketByID))
+		bktByDate := tx.Bucket([]byte(bucketByDate))
+		bktByCreated := tx.Bucket([]byte(bucketByCreated))
+		for i := range ss {
+			s := &ss[i]
+			id := lastID - int64(len(ss)-1-i)
+			ids[s.ID], s.ID = id, id
+			if s.Created.IsZero() {
+				s.Created = now
+			}
+			if s.Modified.IsZero() {
+				s.Modified = s.Created
+			}
+			s.Created, s.Modified = s.Created.UTC(), s.Modified.UTC()
+			s.Gist = "" // Gists belong to the exporting instance
+
+			// Store the snippet.
+			v, _ := s.MarshalBinary()
+			if err := bktByID.Put(idKey(s.ID), v); err != nil {
+				return err
+			}
+			if err := bktByDate.Put(dualKey(s.ID, s.Modified), nil); err != nil {
+				return err
+			}
+			if err := bktByCreated.Put(dualKey(s.ID, s.Created), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	db.mu.Lock()
+	for _, s := range ss {
+		db.names[s.ID] = strings.ToLower(s.Name)
+		if s.Starred {
+			db.starred[s.ID] = true
+		}
+	}
+	db.mu.Unlock()
+	return ids, nil
+}
+
+// SetGist sets the ID of the GitHub Gist that the snippet was exported to.
+// Unlike Update, this does not change the modified time of the snippet.
+// If the snippet does not exist, this returns errNotFound.
+func (db *database) SetGist(id int64, gist string) error {
+	return db.modify(id, func(s *snippet) { s.Gist = gist })
+}
+
+// ToggleStarred toggles whether the snippet is starred and returns the
+// updated snippet. This does not change the modified time of the snippet.
+// If the snippet does not exist, this returns errNotFound.
+func (db *database) ToggleStarred(id int64) (snippet, error) {
+	var s2 snippet
+	err := db.modify(id, func(s *snippet) {
+		s.Starred = !s.Starred
+		s2 = *s
+	})
+	if err == nil {
+		db.mu.Lock()
+		if s2.Starred {
+			db.starred[id] = true
+		} else {
+			delete(db.starred, id)
+		}
+		db.mu.Unlock()
+	}
+	return s2, err
+}
+
+// modify applies f to the snippet at the given ID without changing
+// the modified time of the snippet.
+// If the snippet does not exist, this returns errNotFound.
+func (db *database) modify(id int64, f func(*snippet)) error {
+	return db.update(func(tx *bolt.Tx) error {
+		return modifySnippet(tx, id, f)
+	})
+}
+
+// modifySnippet is modify within the transaction tx.
+func modifySnippet(tx *bolt.Tx, id int64, f func(*snippet)) error {
+	bktByID := tx.Bucket([]byte(bucketByID))
+	v := bktByID.Get(idKey(id))
+	if v == nil {
+		return errNotFound
+	}
+	var s snippet
+	if err := s.UnmarshalBinary(v); err != nil {
+		return err
+	}
+	f(&s)
+	v, err := s.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return bktByID.Put(idKey(id), v)
+}
+
 // Retrieves a snippet by the specified ID.
 // If the snippet does not exist, this returns errNotFound.
 func (db *database) Retrieve(id int64) (snippet, error) {
 	var s snippet
-	err := db.db.View(func(tx *bolt.Tx) error {
+	err := db.view(func(tx *bolt.Tx) error {
 		bktByID := tx.Bucket([]byte(bucketByID))
 		v := bktByID.Get(idKey(id))
 		if v == nil {
@@ -336,22 +720,14 @@ func (db *database) Retrieve(id int64) (snippet, error) {
 
 // Update updates the provided snippet at the given ID.
 // The ID field in the snippet is optional as long as id is valid.
-// Only the Name and Code of a snippet may be changed.
-// If the snippet does not exist, this returns errNotFound.
+// Only the Name, Code, and Files of a snippet may be changed. Empty names
+// and code are left unchanged, as are nil files, while empty files are
+// removed. If the snippet does not exist, this returns errNotFound.
 func (db *database) Update(s snippet, id int64) error {
-	switch {
-	case s.ID == 0 && id == 0:
-		return requestError{errors.New("cannot update snippet with ID: 0")}
-	case s.ID > 0 && s.ID != id:
-		return requestError{fmt.Errorf("snippet IDs do not match: %d != %d", id, s.ID)}
-	case s.ID == defaultID && s.Name != "" && s.Name != defaultName:
-		return requestError{errors.New("cannot change default snippet name")}
-	case s.Name != "" && strings.TrimSpace(s.Name) == "":
-		return requestError{errors.New("name cannot be blank")}
-	case !s.Modified.IsZero() || !s.Created.IsZero():
-		return requestError{errors.New("cannot set modified or created times")}
+	if err := checkUpdate(s, id); err != nil {
+		return err
 	}
-	err := db.db.Update(func(tx *bolt.Tx) error {
+	err := db.update(func(tx *bolt.Tx) error {
 		// Locate the snippet associated with s.ID.
 		bktByID := tx.Bucket([]byte(bucketByID))
 		v := bktByID.Get(idKey(id))
@@ -370,6 +746,9 @@ func (db *database) Update(s snippet, id int64) error {
 		if s.Code != "" {
 			s2.Code = s.Code
 		}
+		if s.Files != nil {
+			s2.Files = s.Files
+		}
 		oldKey := dualKey(s2.ID, s2.Modified)
 		s2.Modified = db.timeNow().UTC().AddDate(0, 0, 0)
 		newKey := dualKey(s2.ID, s2.Modified)
@@ -400,36 +779,402 @@ func (db *database) Update(s snippet, id int64) error {
 // If the snippet does not exist, this returns errNotFound.
 // The default snippet cannot be deleted.
 func (db *database) Delete(id int64) error {
-	if id == 0 || id == defaultID {
-		return requestError{fmt.Errorf("cannot delete snippet (ID: %d)", id)}
+	if err := checkDelete(id); err != nil {
+		return err
 	}
-	err := db.db.Update(func(tx *bolt.Tx) error {
-		// Locate and delete key from bucketsByID.
-		bktByID := tx.Bucket([]byte(bucketByID))
-		v := bktByID.Get(idKey(id))
-		if v == nil {
-			return errNotFound
-		}
-		if err := bktByID.Delete(idKey(id)); err != nil {
+	err := db.update(func(tx *bolt.Tx) error {
+		return deleteSnippet(tx, id)
+	})
+	if err == nil {
+		db.mu.Lock()
+		delete(db.names, id)
+		delete(db.starred, id)
+		db.mu.Unlock()
+	}
+	return err
+}
+
+// deleteSnippet is Delete within the transaction tx.
+func deleteSnippet(tx *bolt.Tx, id int64) error {
+	// Locate and delete key from bucketsByID.
+	bktByID := tx.Bucket([]byte(bucketByID))
+	v := bktByID.Get(idKey(id))
+	if v == nil {
+		return errNotFound
+	}
+	if err := bktByID.Delete(idKey(id)); err != nil {
+		return err
+	}
+
+	// Delete keys from bucketsByDate and bucketsByCreated.
+	var s snippet
+	if err := s.UnmarshalBinary(v); err != nil {
+		return err
+	}
+	k := dualKey(s.ID, s.Modified)
+	if err := tx.Bucket([]byte(bucketByDate)).Delete(k); err != nil {
+		return err
+	}
+	k = dualKey(s.ID, s.Created)
+	if err := tx.Bucket([]byte(bucketByCreated)).Delete(k); err != nil {
+		return err
+	}
+
+	// Delete the benchmark history of the snippet.
+	c := tx.Bucket([]byte(bucketBench)).Cursor()
+	prefix := idKey(id)
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Seek(prefix) {
+		if err := c.Delete(); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		// Delete key from bucketsByDate.
-		var s snippet
-		if err := s.UnmarshalBinary(v); err != nil {
-			return err
+// Batch applies the operations in order within a single transaction.
+// Either all operations are applied or, if any fails, none are.
+// If any of the snippets does not exist, this returns errNotFound.
+func (db *database) Batch(ops []batchOp) error {
+	if err := checkBatch(ops); err != nil {
+		return err
+	}
+	err := db.update(func(tx *bolt.Tx) error {
+		for _, op := range ops {
+			for _, id := range op.IDs {
+				var err error
+				switch op.Op {
+				case "delete":
+					err = deleteSnippet(tx, id)
+				case "star", "unstar":
+					err = modifySnippet(tx, id, func(s *snippet) { s.Starred = op.Op == "star" })
+				}
+				if err != nil {
+					return err
+				}
+			}
 		}
-		k := dualKey(s.ID, s.Modified)
-		return tx.Bucket([]byte(bucketByDate)).Delete(k)
+		return nil
 	})
 	if err == nil {
 		db.mu.Lock()
-		delete(db.names, id)
+		for _, op := range ops {
+			for _, id := range op.IDs {
+				switch op.Op {
+				case "delete":
+					delete(db.names, id)
+					delete(db.starred, id)
+				case "star":
+					db.starred[id] = true
+				case "unstar":
+					delete(db.starred, id)
+				}
+			}
+		}
 		db.mu.Unlock()
 	}
 	return err
 }
 
+// CreateToken stores a new API token and returns its ID.
+// Only the Name and Hash of the token are used.
+func (db *database) CreateToken(t apiToken) (int64, error) {
+	if err := checkCreateToken(t); err != nil {
+		return 0, err
+	}
+	err := db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(bucketTokens))
+		id, err := bkt.NextSequence()
+		if err != nil {
+			return err
+		}
+		t = apiToken{ID: int64(id), Name: t.Name, Created: db.timeNow().UTC(), Hash: t.Hash}
+		v, err := t.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		return bkt.Put(idKey(t.ID), v)
+	})
+	return t.ID, err
+}
+
+// LookupToken retrieves an API token by the hash of its value.
+// If the token does not exist, this returns errNotFound.
+func (db *database) LookupToken(hash []byte) (apiToken, error) {
+	ts, err := db.ListTokens()
+	if err != nil {
+		return apiToken{}, err
+	}
+	for _, t := range ts {
+		if subtle.ConstantTimeCompare(t.Hash, hash) == 1 {
+			return t, nil
+		}
+	}
+	return apiToken{}, errNotFound
+}
+
+// ListTokens returns all API tokens sorted by ID.
+// Since there are few tokens, this is also used to lookup tokens.
+func (db *database) ListTokens() ([]apiToken, error) {
+	var ts []apiToken
+	err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(bucketTokens)).ForEach(func(k, v []byte) error {
+			var t apiToken
+			if err := t.UnmarshalBinary(v); err != nil {
+				return err
+			}
+			ts = append(ts, t)
+			return nil
+		})
+	})
+	return ts, err
+}
+
+// DeleteToken revokes the API token with the given ID.
+// If the token does not exist, this returns errNotFound.
+func (db *database) DeleteToken(id int64) error {
+	return db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(bucketTokens))
+		if bkt.Get(idKey(id)) == nil {
+			return errNotFound
+		}
+		return bkt.Delete(idKey(id))
+	})
+}
+
+// PutSession creates or replaces the session with the same ID.
+func (db *database) PutSession(s session) error {
+	v, err := s.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(bucketSessions)).Put([]byte(s.ID), v)
+	})
+}
+
+// LookupSession retrieves a session by ID.
+// If the session does not exist, this returns errNotFound.
+func (db *database) LookupSession(id string) (session, error) {
+	var s session
+	err := db.view(func(tx *bolt.Tx) error {
+		v := tx.Bucket([]byte(bucketSessions)).Get([]byte(id))
+		if v == nil {
+			return errNotFound
+		}
+		return s.UnmarshalBinary(v)
+	})
+	return s, err
+}
+
+// ListSessions returns all sessions sorted by creation time.
+func (db *database) ListSessions() ([]session, error) {
+	var ss []session
+	err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(bucketSessions)).ForEach(func(k, v []byte) error {
+			var s session
+			if err := s.UnmarshalBinary(v); err != nil {
+				return err
+			}
+			ss = append(ss, s)
+			return nil
+		})
+	})
+	sort.SliceStable(ss, func(i, j int) bool { return ss[i].Created.Before(ss[j].Created) })
+	return ss, err
+}
+
+// DeleteSession revokes the session with the given ID.
+// If the session does not exist, this returns errNotFound.
+func (db *database) DeleteSession(id string) error {
+	return db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(bucketSessions))
+		if bkt.Get([]byte(id)) == nil {
+			return errNotFound
+		}
+		return bkt.Delete([]byte(id))
+	})
+}
+
+// AppendAudit adds an entry to the audit log and returns its ID.
+// The ID and time of the entry are assigned by the database.
+func (db *database) AppendAudit(e auditEntry) (int64, error) {
+	err := db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket([]byte(bucketAudit))
+		id, err := bkt.NextSequence()
+		if err != nil {
+			return err
+		}
+		e.ID, e.Time = int64(id), db.timeNow().UTC()
+		v, err := e.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		return bkt.Put(idKey(e.ID), v)
+	})
+	return e.ID, err
+}
+
+// QueryAudit returns up to limit audit log entries with the newest first.
+// If beforeID is positive, only entries with a smaller ID are returned.
+func (db *database) QueryAudit(beforeID int64, limit int) ([]auditEntry, error) {
+	var es []auditEntry
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(bucketAudit)).Cursor()
+		k, v := c.Last()
+		if beforeID > 0 {
+			if k, _ = c.Seek(idKey(beforeID)); k == nil {
+				k, v = c.Last()
+			} else {
+				k, v = c.Prev()
+			}
+		}
+		for ; k != nil && len(es) < limit; k, v = c.Prev() {
+			var e auditEntry
+			if err := e.UnmarshalBinary(v); err != nil {
+				return err
+			}
+			es = append(es, e)
+		}
+		return nil
+	})
+	return es, err
+}
+
+// benchKey is the key of a benchmark run, which sorts the runs by snippet ID
+// and then chronologically.
+func benchKey(id int64, t time.Time) []byte {
+	var k [20]byte
+	copy(k[:8], idKey(id))
+	binary.BigEndian.PutUint64(k[8:16], uint64(t.Unix()+math.MaxInt64+1))
+	binary.BigEndian.PutUint32(k[16:], uint32(t.Nanosecond()))
+	return k[:]
+}
+
+// AppendBenchmarks adds a benchmark run to the history of its snippet.
+// The time of the run is assigned by the database.
+func (db *database) AppendBenchmarks(br benchRun) error {
+	br.Time = db.timeNow().UTC()
+	v, err := br.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(bucketBench)).Put(benchKey(br.SnippetID, br.Time), v)
+	})
+}
+
+// QueryBenchmarks returns up to limit benchmark runs of the snippet with the
+// given ID with the newest first.
+func (db *database) QueryBenchmarks(snippetID int64, limit int) ([]benchRun, error) {
+	var brs []benchRun
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(bucketBench)).Cursor()
+		prefix := idKey(snippetID)
+		k, v := c.Seek(benchKey(snippetID, maxTime))
+		if k == nil {
+			k, v = c.Last()
+		} else {
+			k, v = c.Prev()
+		}
+		for ; k != nil && bytes.HasPrefix(k, prefix) && len(brs) < limit; k, v = c.Prev() {
+			var br benchRun
+			if err := br.UnmarshalBinary(v); err != nil {
+				return err
+			}
+			brs = append(brs, br)
+		}
+		return nil
+	})
+	return brs, err
+}
+
+// Stats reports statistics about the database.
+func (db *database) Stats() (storeStats, error) {
+	st := storeStats{Backend: "bolt"}
+	err := db.view(func(tx *bolt.Tx) error {
+		st.Snippets = int64(tx.Bucket([]byte(bucketByID)).Stats().KeyN)
+		st.Size = tx.Size()
+		return nil
+	})
+	return st, err
+}
+
+// Backup writes a consistent snapshot of the database to a new file at path.
+func (db *database) Backup(path string) error {
+	return db.view(func(tx *bolt.Tx) error {
+		return writeFileAtomic(path, func(w io.Writer) error {
+			_, err := tx.WriteTo(w)
+			return err
+		})
+	})
+}
+
+// Compact copies the database into a fresh file, which then atomically
+// replaces the current file. Since BoltDB never shrinks its file, this is
+// the only way to reclaim space left behind by deleted snippets.
+// It reports the size of the file before and after compaction.
+func (db *database) Compact() (before, after int64, err error) {
+	db.dbMu.Lock()
+	defer db.dbMu.Unlock()
+
+	path := db.db.Path()
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	before = fi.Size()
+
+	// Copy every bucket into a new database.
+	tmpPath := path + ".compact"
+	os.Remove(tmpPath)
+	defer os.Remove(tmpPath) // No-op after a successful rename
+	ndb, err := bolt.Open(tmpPath, 0644, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	err = db.db.View(func(tx *bolt.Tx) error {
+		return ndb.Update(func(ntx *bolt.Tx) error {
+			return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+				nb, err := ntx.CreateBucket(name)
+				if err != nil {
+					return err
+				}
+				nb.FillPercent = 1.0 // Keys are inserted in sorted order
+				if err := nb.SetSequence(b.Sequence()); err != nil {
+					return err
+				}
+				return b.ForEach(nb.Put)
+			})
+		})
+	})
+	if err1 := ndb.Close(); err == nil {
+		err = err1
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	if fi, err = os.Stat(tmpPath); err != nil {
+		return 0, 0, err
+	}
+	after = fi.Size()
+
+	// Swap the database files. The database is reopened even if the rename
+	// failed so that it remains usable.
+	if err := db.db.Close(); err != nil {
+		return 0, 0, err
+	}
+	errRename := os.Rename(tmpPath, path)
+	bdb, err := bolt.Open(path, 0644, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	db.db = bdb
+	if errRename != nil {
+		return 0, 0, errRename
+	}
+	return before, after, nil
+}
+
 func (db *database) Close() error {
 	return db.db.Close()
 }
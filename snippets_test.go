@@ -5,10 +5,17 @@
 package main
 
 import (
+	"database/sql"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/boltdb/bolt"
 )
 
 func equalSnippet(x, y snippet) bool {
@@ -16,7 +23,8 @@ func equalSnippet(x, y snippet) bool {
 		x.Created.Equal(y.Created) &&
 		x.Modified.Equal(y.Modified) &&
 		x.Name == y.Name &&
-		x.Code == y.Code
+		x.Code == y.Code &&
+		x.Starred == y.Starred
 }
 
 func equalSnippets(x, y []snippet) bool {
@@ -31,7 +39,23 @@ func equalSnippets(x, y []snippet) bool {
 	return true
 }
 
+// setTimeNow overrides the source of the current time used by db.
+func setTimeNow(db snippetStore, f func() time.Time) {
+	switch db := db.(type) {
+	case *database:
+		db.timeNow = f
+	case *sqliteDatabase:
+		db.timeNow = f
+	}
+}
+
 func TestDatabase(t *testing.T) {
+	for _, backend := range []string{"bolt", "sqlite"} {
+		t.Run(backend, func(t *testing.T) { testDatabase(t, backend) })
+	}
+}
+
+func testDatabase(t *testing.T, backend string) {
 	tmpDir, err := ioutil.TempDir("", "")
 	if err != nil {
 		t.Fatal(err)
@@ -44,12 +68,12 @@ func TestDatabase(t *testing.T) {
 	// Open the database.
 	base := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
 	now := base
-	db, err := openDatabase(tmpDir)
+	db, err := openStore(backend, tmpDir)
 	if err != nil {
-		t.Fatalf("openDatabase error: %v", err)
+		t.Fatalf("openStore error: %v", err)
 	}
 	closer = db.Close
-	db.timeNow = func() time.Time { return now }
+	setTimeNow(db, func() time.Time { return now })
 
 	// Types of expected response errors.
 	errFuncs := map[string]func(error) bool{
@@ -87,6 +111,12 @@ func TestDatabase(t *testing.T) {
 			limit    int
 			out      []snippet
 		}
+		TestQueryByCreated struct {
+			created time.Time
+			id      int64
+			limit   int
+			out     []snippet
+		}
 		TestQueryByID struct {
 			id    int64
 			limit int
@@ -97,6 +127,18 @@ func TestDatabase(t *testing.T) {
 			limit int
 			out   []snippet
 		}
+		TestImport struct {
+			in  []snippet
+			ids map[int64]int64
+		}
+		TestToggleStarred struct {
+			id      int64
+			starred bool
+		}
+		TestQueryByStarred struct {
+			limit int
+			out   []snippet
+		}
 		TestReopen struct{}
 	)
 
@@ -312,6 +354,68 @@ func TestDatabase(t *testing.T) {
 		TestCreate{in: snippet{Name: "\n"}}, "IsRequestError", step,
 	}, {
 		TestUpdate{in: snippet{Name: "\n"}, id: defaultID + 5}, "IsRequestError", step,
+	}, {
+		TestImport{in: []snippet{{ID: 5, Name: "imported"}, {ID: 6, Name: " "}}}, "IsRequestError", step,
+	}, {
+		TestImport{in: []snippet{}, ids: map[int64]int64{}}, "", step,
+	}, {
+		TestImport{in: []snippet{
+			{ID: 5, Created: base.Add(-2 * step), Modified: base.Add(-1 * step), Name: "imported five", Code: "code5i"},
+			{ID: 1, Name: "imported one", Code: "code1i"},
+		}, ids: map[int64]int64{5: defaultID + 18, 1: defaultID + 19}}, "", step,
+	}, {
+		TestRetrieve{id: defaultID + 18, out: snippet{ID: defaultID + 18, Created: base.Add(-2 * step), Modified: base.Add(-1 * step), Name: "imported five", Code: "code5i"}}, "", step,
+	}, {
+		TestRetrieve{id: defaultID + 19, out: snippet{ID: defaultID + 19, Created: base.Add(61 * step), Modified: base.Add(61 * step), Name: "imported one", Code: "code1i"}}, "", step,
+	}, {
+		TestQueryByName{name: "imported", limit: -1, out: []snippet{
+			{ID: defaultID + 18, Created: base.Add(-2 * step), Modified: base.Add(-1 * step), Name: "imported five", Code: "code5i"},
+			{ID: defaultID + 19, Created: base.Add(61 * step), Modified: base.Add(61 * step), Name: "imported one", Code: "code1i"},
+		}}, "", step,
+	}, {
+		TestReopen{}, "", step,
+	}, {
+		TestCreate{in: snippet{Name: "after import"}, id: defaultID + 20}, "", step,
+	}, {
+		TestToggleStarred{id: defaultID + 500}, "IsNotFound", step,
+	}, {
+		TestToggleStarred{id: defaultID + 18, starred: true}, "", step,
+	}, {
+		TestToggleStarred{id: defaultID + 3, starred: true}, "", step,
+	}, {
+		TestToggleStarred{id: defaultID + 3, starred: false}, "", step,
+	}, {
+		TestToggleStarred{id: defaultID + 2, starred: true}, "", step,
+	}, {
+		TestReopen{}, "", step,
+	}, {
+		TestQueryByStarred{limit: 3, out: []snippet{
+			{ID: defaultID + 2, Created: base.Add(9 * step), Modified: base.Add(14 * step), Name: "gordon freeman", Code: "code3a", Starred: true},
+			{ID: defaultID + 18, Created: base.Add(-2 * step), Modified: base.Add(-1 * step), Name: "imported five", Code: "code5i", Starred: true},
+			{ID: defaultID + 20, Created: base.Add(66 * step), Modified: base.Add(66 * step), Name: "after import"},
+		}}, "", step,
+	}, {
+		TestQueryByStarred{limit: 1, out: []snippet{
+			{ID: defaultID + 2, Created: base.Add(9 * step), Modified: base.Add(14 * step), Name: "gordon freeman", Code: "code3a", Starred: true},
+		}}, "", step,
+	}, {
+		TestQueryByCreated{limit: 3, out: []snippet{
+			{ID: defaultID + 20, Created: base.Add(66 * step), Modified: base.Add(66 * step), Name: "after import"},
+			{ID: defaultID + 19, Created: base.Add(61 * step), Modified: base.Add(61 * step), Name: "imported one", Code: "code1i"},
+			{ID: defaultID + 17, Created: base.Add(42 * step), Modified: base.Add(44 * step), Name: "ice cubes in the hot sun", Code: "code18a"},
+		}}, "", step,
+	}, {
+		TestQueryByCreated{created: base.Add(30 * step), id: defaultID + 6, limit: 3, out: []snippet{
+			{ID: defaultID + 5, Created: base.Add(29 * step), Modified: base.Add(43 * step), Name: "duplicate clone", Code: "code6a"},
+			{ID: defaultID + 4, Created: base.Add(28 * step), Modified: base.Add(28 * step), Name: "joshua tree", Code: "code5"},
+			{ID: defaultID + 3, Created: base.Add(10 * step), Modified: base.Add(10 * step), Name: "live free die hard", Code: "code4"},
+		}}, "", step,
+	}, {
+		TestQueryByCreated{created: base.Add(10 * step), id: defaultID + 3, limit: -1, out: []snippet{
+			{ID: defaultID + 2, Created: base.Add(9 * step), Modified: base.Add(14 * step), Name: "gordon freeman", Code: "code3a", Starred: true},
+			{ID: defaultID + 18, Created: base.Add(-2 * step), Modified: base.Add(-1 * step), Name: "imported five", Code: "code5i", Starred: true},
+			{ID: defaultID + 0, Modified: base.Add(45 * step), Name: "Default snippet", Code: "code0a"},
+		}}, "", step,
 	}}
 
 	for i, tt := range tests {
@@ -339,6 +443,12 @@ func TestDatabase(t *testing.T) {
 			if err == nil && !equalSnippets(out, tc.out) {
 				t.Fatalf("test %d, QueryByModified(%v, %d):\ngot  %v\nwant %v", i, tc.modified, tc.id, out, tc.out)
 			}
+		case TestQueryByCreated:
+			var out []snippet
+			out, err = db.QueryByCreated(tc.created, tc.id, tc.limit)
+			if err == nil && !equalSnippets(out, tc.out) {
+				t.Fatalf("test %d, QueryByCreated(%v, %d):\ngot  %v\nwant %v", i, tc.created, tc.id, out, tc.out)
+			}
 		case TestQueryByID:
 			var out []snippet
 			out, err = db.QueryByID(tc.id, tc.limit)
@@ -351,18 +461,36 @@ func TestDatabase(t *testing.T) {
 			if err == nil && !equalSnippets(out, tc.out) {
 				t.Fatalf("test %d, QueryByName(%v):\ngot  %v\nwant %v", i, tc.name, out, tc.out)
 			}
+		case TestImport:
+			var ids map[int64]int64
+			ids, err = db.Import(tc.in)
+			if err == nil && !reflect.DeepEqual(ids, tc.ids) {
+				t.Fatalf("test %d, Import(%v) = %v, want %v", i, tc.in, ids, tc.ids)
+			}
+		case TestToggleStarred:
+			var out snippet
+			out, err = db.ToggleStarred(tc.id)
+			if err == nil && out.Starred != tc.starred {
+				t.Fatalf("test %d, ToggleStarred(%d).Starred = %v, want %v", i, tc.id, out.Starred, tc.starred)
+			}
+		case TestQueryByStarred:
+			var out []snippet
+			out, err = db.QueryByStarred(tc.limit)
+			if err == nil && !equalSnippets(out, tc.out) {
+				t.Fatalf("test %d, QueryByStarred(%d):\ngot  %v\nwant %v", i, tc.limit, out, tc.out)
+			}
 		case TestReopen:
 			err = db.Close()
 			closer = func() error { return nil }
 			if err != nil {
 				t.Fatalf("test %d, Close error: %v", i, err)
 			}
-			db, err = openDatabase(tmpDir)
+			db, err = openStore(backend, tmpDir)
 			if err != nil {
-				t.Fatalf("test %d, openDatabase error: %v", i, err)
+				t.Fatalf("test %d, openStore error: %v", i, err)
 			}
 			closer = db.Close
-			db.timeNow = func() time.Time { return now }
+			setTimeNow(db, func() time.Time { return now })
 		default:
 			t.Fatalf("test %d, unknown test type: %T", i, tt.test)
 		}
@@ -374,3 +502,629 @@ func TestDatabase(t *testing.T) {
 		now = now.Add(tt.add)
 	}
 }
+
+func TestCreatedIndexMigration(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	db, err := openDatabase(tmpDir)
+	if err != nil {
+		t.Fatalf("openDatabase error: %v", err)
+	}
+	id, err := db.Create(snippet{Name: "name", Code: "code"})
+	if err != nil {
+		t.Fatalf("Create error: %v", err)
+	}
+
+	// Older databases do not have the index of creation times.
+	if err := db.update(func(tx *bolt.Tx) error {
+		return tx.DeleteBucket([]byte(bucketByCreated))
+	}); err != nil {
+		t.Fatalf("DeleteBucket error: %v", err)
+	}
+	db.Close()
+	db, err = openDatabase(tmpDir)
+	if err != nil {
+		t.Fatalf("openDatabase error: %v", err)
+	}
+	defer db.Close()
+	ss, err := db.QueryByCreated(time.Time{}, 0, -1)
+	if err != nil {
+		t.Fatalf("QueryByCreated error: %v", err)
+	}
+	if len(ss) != 2 || ss[0].ID != id || ss[1].ID != defaultID {
+		t.Errorf("QueryByCreated after reopening = %v, want snippets %d and %d", ss, id, defaultID)
+	}
+}
+
+func TestSnippetFiles(t *testing.T) {
+	for _, backend := range []string{"bolt", "sqlite"} {
+		t.Run(backend, func(t *testing.T) {
+			db, err := openStore(backend, t.TempDir())
+			if err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			defer db.Close()
+			files := func(id int64) map[string][]byte {
+				t.Helper()
+				s, err := db.Retrieve(id)
+				if err != nil {
+					t.Fatalf("Retrieve error: %v", err)
+				}
+				return s.Files
+			}
+
+			want := map[string][]byte{"golden/out.txt": []byte("hello"), "in.bin": {0, 1, 2}}
+			id, err := db.Create(snippet{Name: "name", Code: "code", Files: want})
+			if err != nil {
+				t.Fatalf("Create error: %v", err)
+			}
+			if got := files(id); !reflect.DeepEqual(got, want) {
+				t.Errorf("files after Create = %q, want %q", got, want)
+			}
+			if err := db.Update(snippet{Code: "code2"}, id); err != nil {
+				t.Fatalf("Update error: %v", err)
+			}
+			if got := files(id); !reflect.DeepEqual(got, want) {
+				t.Errorf("files after Update without files = %q, want %q", got, want)
+			}
+			want = map[string][]byte{"in.txt": []byte("input")}
+			if err := db.Update(snippet{Files: want}, id); err != nil {
+				t.Fatalf("Update error: %v", err)
+			}
+			if got := files(id); !reflect.DeepEqual(got, want) {
+				t.Errorf("files after Update = %q, want %q", got, want)
+			}
+			if err := db.Update(snippet{Files: map[string][]byte{}}, id); err != nil {
+				t.Fatalf("Update error: %v", err)
+			}
+			if got := files(id); len(got) != 0 {
+				t.Errorf("files after Update with empty files = %q, want none", got)
+			}
+
+			for _, fs := range []map[string][]byte{
+				{"../escape.txt": nil},
+				{"/etc/passwd": nil},
+				{"big.bin": make([]byte, maxSnippetFilesSize+1)},
+			} {
+				if _, err := db.Create(snippet{Name: "name", Files: fs}); err == nil {
+					t.Errorf("Create with invalid files succeeded")
+				} else if _, ok := err.(requestError); !ok {
+					t.Errorf("Create with invalid files error = %v, want request error", err)
+				}
+			}
+		})
+	}
+}
+
+func TestSQLiteFilesMigration(t *testing.T) {
+	// Older databases do not have the files column.
+	dir := t.TempDir()
+	sdb, err := sql.Open("sqlite3", filepath.Join(dir, sqliteFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sdb.Exec(`CREATE TABLE snippets (
+		id       INTEGER PRIMARY KEY AUTOINCREMENT,
+		created  TEXT NOT NULL,
+		modified TEXT NOT NULL,
+		gist     TEXT NOT NULL DEFAULT '',
+		starred  INTEGER NOT NULL DEFAULT 0,
+		name     TEXT NOT NULL,
+		code     TEXT NOT NULL DEFAULT ''
+	)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sdb.Exec("INSERT INTO snippets (created, modified, name, code) VALUES (?, ?, 'old', 'code')",
+		formatSQLiteTime(time.Now()), formatSQLiteTime(time.Now())); err != nil {
+		t.Fatal(err)
+	}
+	sdb.Close()
+
+	db, err := openSQLiteDatabase(dir)
+	if err != nil {
+		t.Fatalf("openSQLiteDatabase error: %v", err)
+	}
+	defer db.Close()
+	if s, err := db.Retrieve(1); err != nil || s.Name != "old" || s.Files != nil {
+		t.Errorf("Retrieve of old snippet = (%+v, %v), want it without files", s, err)
+	}
+	files := map[string][]byte{"in.txt": []byte("input")}
+	if err := db.Update(snippet{Files: files}, 1); err != nil {
+		t.Fatalf("Update error: %v", err)
+	}
+	if s, err := db.Retrieve(1); err != nil || !reflect.DeepEqual(s.Files, files) {
+		t.Errorf("Retrieve after Update = (%+v, %v), want files %q", s, err, files)
+	}
+}
+
+func TestBackup(t *testing.T) {
+	for _, backend := range []string{"bolt", "sqlite"} {
+		t.Run(backend, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			db, err := openStore(backend, tmpDir)
+			if err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			defer db.Close()
+			id, err := db.Create(snippet{Name: "name", Code: "code"})
+			if err != nil {
+				t.Fatalf("Create error: %v", err)
+			}
+			want, err := db.QueryByID(0, -1)
+			if err != nil {
+				t.Fatalf("QueryByID error: %v", err)
+			}
+
+			// Restore the backup into a new data directory and reopen it.
+			restoreDir := filepath.Join(tmpDir, "restore")
+			if err := os.Mkdir(restoreDir, 0775); err != nil {
+				t.Fatal(err)
+			}
+			file := map[string]string{"bolt": boltFile, "sqlite": sqliteFile}[backend]
+			if err := db.Backup(filepath.Join(restoreDir, file)); err != nil {
+				t.Fatalf("Backup error: %v", err)
+			}
+			if err := db.Delete(id); err != nil {
+				t.Fatalf("Delete error: %v", err)
+			}
+			rdb, err := openStore(backend, restoreDir)
+			if err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			defer rdb.Close()
+			got, err := rdb.QueryByID(0, -1)
+			if err != nil {
+				t.Fatalf("QueryByID error: %v", err)
+			}
+			if !equalSnippets(got, want) {
+				t.Errorf("mismatching snippets:\ngot  %v\nwant %v", got, want)
+			}
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	for _, backend := range []string{"bolt", "sqlite"} {
+		t.Run(backend, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			db, err := openStore(backend, tmpDir)
+			if err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			defer func() { db.Close() }()
+
+			// Create many large snippets and delete most of them.
+			var ids []int64
+			code := strings.Repeat("x", 1<<14)
+			for i := 0; i < 256; i++ {
+				id, err := db.Create(snippet{Name: "name", Code: code})
+				if err != nil {
+					t.Fatalf("Create error: %v", err)
+				}
+				ids = append(ids, id)
+			}
+			for i, id := range ids {
+				if i%16 != 0 {
+					if err := db.Delete(id); err != nil {
+						t.Fatalf("Delete error: %v", err)
+					}
+				}
+			}
+			want, err := db.QueryByID(0, -1)
+			if err != nil {
+				t.Fatalf("QueryByID error: %v", err)
+			}
+
+			before, after, err := db.Compact()
+			if err != nil {
+				t.Fatalf("Compact error: %v", err)
+			}
+			if after >= before {
+				t.Errorf("Compact did not reclaim space: before %d, after %d", before, after)
+			}
+			got, err := db.QueryByID(0, -1)
+			if err != nil {
+				t.Fatalf("QueryByID error: %v", err)
+			}
+			if !equalSnippets(got, want) {
+				t.Errorf("mismatching snippets:\ngot  %v\nwant %v", got, want)
+			}
+
+			// The compacted database must remain writable and persistent.
+			id, err := db.Create(snippet{Name: "name", Code: "code"})
+			if err != nil {
+				t.Fatalf("Create error: %v", err)
+			}
+			if err := db.Close(); err != nil {
+				t.Fatalf("Close error: %v", err)
+			}
+			if db, err = openStore(backend, tmpDir); err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			if _, err := db.Retrieve(id); err != nil {
+				t.Errorf("Retrieve error: %v", err)
+			}
+		})
+	}
+}
+
+func TestTokens(t *testing.T) {
+	for _, backend := range []string{"bolt", "sqlite"} {
+		t.Run(backend, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			db, err := openStore(backend, tmpDir)
+			if err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			defer db.Close()
+			now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+			setTimeNow(db, func() time.Time { return now })
+
+			if _, err := db.CreateToken(apiToken{Hash: hashAPIToken("x")}); err == nil {
+				t.Errorf("unexpected CreateToken success with empty name")
+			}
+			var want []apiToken
+			for _, name := range []string{"ci", "laptop"} {
+				tok := apiToken{Name: name, Hash: hashAPIToken(name)}
+				if tok.ID, err = db.CreateToken(tok); err != nil {
+					t.Fatalf("CreateToken error: %v", err)
+				}
+				tok.Created = now
+				want = append(want, tok)
+			}
+			got, err := db.ListTokens()
+			if err != nil {
+				t.Fatalf("ListTokens error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ListTokens mismatch:\ngot  %v\nwant %v", got, want)
+			}
+			if tok, err := db.LookupToken(hashAPIToken("laptop")); err != nil || tok.ID != want[1].ID {
+				t.Errorf("LookupToken = (%v, %v), want (%v, nil)", tok, err, want[1])
+			}
+			if _, err := db.LookupToken(hashAPIToken("unknown")); err != errNotFound {
+				t.Errorf("LookupToken error = %v, want %v", err, errNotFound)
+			}
+
+			if err := db.DeleteToken(want[1].ID); err != nil {
+				t.Fatalf("DeleteToken error: %v", err)
+			}
+			if err := db.DeleteToken(want[1].ID); err != errNotFound {
+				t.Errorf("DeleteToken error = %v, want %v", err, errNotFound)
+			}
+			if _, err := db.LookupToken(hashAPIToken("laptop")); err != errNotFound {
+				t.Errorf("LookupToken error = %v, want %v", err, errNotFound)
+			}
+
+			// Token IDs must not be reused, even after compaction.
+			if _, _, err := db.Compact(); err != nil {
+				t.Fatalf("Compact error: %v", err)
+			}
+			id, err := db.CreateToken(apiToken{Name: "new", Hash: hashAPIToken("new")})
+			if err != nil {
+				t.Fatalf("CreateToken error: %v", err)
+			}
+			if id <= want[1].ID {
+				t.Errorf("CreateToken reused ID: got %d, want > %d", id, want[1].ID)
+			}
+		})
+	}
+}
+
+func TestSessions(t *testing.T) {
+	for _, backend := range []string{"bolt", "sqlite"} {
+		t.Run(backend, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			db, err := openStore(backend, tmpDir)
+			if err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			defer db.Close()
+
+			now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+			want := []session{
+				{ID: "a1", Created: now, Refreshed: now, RemoteAddr: "127.0.0.1", UserAgent: "curl"},
+				{ID: "b2", User: "gopher@example.com", Created: now.Add(time.Hour), Refreshed: now.Add(time.Hour)},
+			}
+			for _, s := range []session{want[1], want[0]} {
+				if err := db.PutSession(s); err != nil {
+					t.Fatalf("PutSession error: %v", err)
+				}
+			}
+			want[0].Refreshed = now.Add(2 * time.Hour)
+			if err := db.PutSession(want[0]); err != nil {
+				t.Fatalf("PutSession error: %v", err)
+			}
+
+			got, err := db.ListSessions()
+			if err != nil {
+				t.Fatalf("ListSessions error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ListSessions mismatch:\ngot  %v\nwant %v", got, want)
+			}
+			if s, err := db.LookupSession("b2"); err != nil || !reflect.DeepEqual(s, want[1]) {
+				t.Errorf("LookupSession = (%v, %v), want (%v, nil)", s, err, want[1])
+			}
+			if err := db.DeleteSession("b2"); err != nil {
+				t.Fatalf("DeleteSession error: %v", err)
+			}
+			if err := db.DeleteSession("b2"); err != errNotFound {
+				t.Errorf("DeleteSession error = %v, want %v", err, errNotFound)
+			}
+			if _, err := db.LookupSession("b2"); err != errNotFound {
+				t.Errorf("LookupSession error = %v, want %v", err, errNotFound)
+			}
+		})
+	}
+}
+
+func TestAudit(t *testing.T) {
+	for _, backend := range []string{"bolt", "sqlite"} {
+		t.Run(backend, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			db, err := openStore(backend, tmpDir)
+			if err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			defer db.Close()
+
+			now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+			setTimeNow(db, func() time.Time { now = now.Add(time.Second); return now })
+
+			if es, err := db.QueryAudit(0, 10); err != nil || len(es) != 0 {
+				t.Errorf("QueryAudit = (%v, %v), want (nil, nil)", es, err)
+			}
+
+			var want []auditEntry
+			for i, e := range []auditEntry{
+				{User: "password", RemoteAddr: "127.0.0.1", Action: auditCreate, SnippetID: 5},
+				{User: "token:ci", RemoteAddr: "127.0.0.1", Action: auditUpdate, SnippetID: 5},
+				{RemoteAddr: "::1", Action: auditRun, SourceHash: "abcd"},
+				{User: "gopher@example.com", RemoteAddr: "::1", Action: auditDelete, SnippetID: 5},
+			} {
+				id, err := db.AppendAudit(e)
+				if err != nil {
+					t.Fatalf("AppendAudit error: %v", err)
+				}
+				if id != int64(i+1) {
+					t.Errorf("AppendAudit ID = %d, want %d", id, i+1)
+				}
+				e.ID, e.Time = id, now
+				want = append([]auditEntry{e}, want...) // Newest first
+			}
+
+			tests := []struct {
+				before int64
+				limit  int
+				want   []auditEntry
+			}{
+				{0, 10, want},
+				{0, 2, want[:2]},
+				{3, 10, want[2:]},
+				{3, 1, want[2:3]},
+				{1, 10, nil},
+				{100, 10, want},
+			}
+			for _, tt := range tests {
+				got, err := db.QueryAudit(tt.before, tt.limit)
+				if err != nil {
+					t.Fatalf("QueryAudit error: %v", err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("QueryAudit(%d, %d) mismatch:\ngot  %v\nwant %v", tt.before, tt.limit, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBenchmarks(t *testing.T) {
+	for _, backend := range []string{"bolt", "sqlite"} {
+		t.Run(backend, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			db, err := openStore(backend, tmpDir)
+			if err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			defer db.Close()
+
+			now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+			setTimeNow(db, func() time.Time { now = now.Add(time.Second); return now })
+
+			id1, err1 := db.Create(snippet{Name: "first"})
+			id2, err2 := db.Create(snippet{Name: "second"})
+			if err1 != nil || err2 != nil {
+				t.Fatalf("Create error: %v, %v", err1, err2)
+			}
+			if brs, err := db.QueryBenchmarks(id1, 10); err != nil || len(brs) != 0 {
+				t.Errorf("QueryBenchmarks = (%v, %v), want (nil, nil)", brs, err)
+			}
+
+			var want1, want2 []benchRun
+			for i, br := range []benchRun{
+				{SnippetID: id1, Results: []benchResult{{Name: "BenchmarkA-8", N: 1000, NsPerOp: 1052.5}}},
+				{SnippetID: id2, Results: []benchResult{{Name: "BenchmarkB-8", N: 10, NsPerOp: 3, BytesPerOp: 128, AllocsPerOp: 2}}},
+				{SnippetID: id1, GoVersion: "go1.9", Results: []benchResult{{Name: "BenchmarkA-8", N: 2000, NsPerOp: 980, MBPerSec: 97.31}}},
+				{SnippetID: id1, Results: []benchResult{{Name: "BenchmarkA-8", N: 3000, NsPerOp: 512}, {Name: "BenchmarkC-8", N: 1, NsPerOp: 1e9}}},
+			} {
+				if err := db.AppendBenchmarks(br); err != nil {
+					t.Fatalf("AppendBenchmarks %d error: %v", i, err)
+				}
+				br.Time = now
+				if br.SnippetID == id1 {
+					want1 = append([]benchRun{br}, want1...) // Newest first
+				} else {
+					want2 = append([]benchRun{br}, want2...)
+				}
+			}
+
+			tests := []struct {
+				id    int64
+				limit int
+				want  []benchRun
+			}{
+				{id1, 10, want1},
+				{id1, 2, want1[:2]},
+				{id2, 10, want2},
+				{id2 + 1, 10, nil},
+			}
+			for _, tt := range tests {
+				got, err := db.QueryBenchmarks(tt.id, tt.limit)
+				if err != nil {
+					t.Fatalf("QueryBenchmarks error: %v", err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("QueryBenchmarks(%d, %d) mismatch:\ngot  %v\nwant %v", tt.id, tt.limit, got, tt.want)
+				}
+			}
+
+			// Deleting a snippet deletes its history.
+			if err := db.Delete(id1); err != nil {
+				t.Fatalf("Delete error: %v", err)
+			}
+			if brs, err := db.QueryBenchmarks(id1, 10); err != nil || len(brs) != 0 {
+				t.Errorf("QueryBenchmarks after Delete = (%v, %v), want (nil, nil)", brs, err)
+			}
+			if brs, err := db.QueryBenchmarks(id2, 10); err != nil || !reflect.DeepEqual(brs, want2) {
+				t.Errorf("QueryBenchmarks of other snippet = (%v, %v), want (%v, nil)", brs, err, want2)
+			}
+		})
+	}
+}
+
+func TestBatch(t *testing.T) {
+	for _, backend := range []string{"bolt", "sqlite"} {
+		t.Run(backend, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			db, err := openStore(backend, tmpDir)
+			if err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			defer db.Close()
+
+			var ids []int64
+			for _, name := range []string{"one", "two", "three"} {
+				id, err := db.Create(snippet{Name: name})
+				if err != nil {
+					t.Fatalf("Create error: %v", err)
+				}
+				ids = append(ids, id)
+			}
+			if err := db.AppendBenchmarks(benchRun{SnippetID: ids[0]}); err != nil {
+				t.Fatalf("AppendBenchmarks error: %v", err)
+			}
+			names := func() (names []string) {
+				ss, err := db.QueryByID(0, -1)
+				if err != nil {
+					t.Fatalf("QueryByID error: %v", err)
+				}
+				for _, s := range ss {
+					if s.Starred {
+						s.Name += "*"
+					}
+					names = append(names, s.Name)
+				}
+				sort.Strings(names)
+				return names
+			}
+
+			// A failed operation rolls back all prior operations.
+			if err := db.Batch([]batchOp{{Op: "star", IDs: ids[:2]}, {Op: "delete", IDs: []int64{ids[0], 999}}}); err != errNotFound {
+				t.Errorf("Batch with missing snippet error = %v, want %v", err, errNotFound)
+			}
+			if err := db.Batch([]batchOp{{Op: "tag", IDs: ids}}); err == nil {
+				t.Errorf("Batch with invalid operation succeeded")
+			}
+			if err := db.Batch([]batchOp{{Op: "delete", IDs: []int64{defaultID}}}); err == nil {
+				t.Errorf("Batch deleting the default snippet succeeded")
+			}
+			if got, want := names(), []string{"Default snippet", "one", "three", "two"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("names after failed batches = %q, want %q", got, want)
+			}
+
+			if err := db.Batch([]batchOp{{Op: "star", IDs: ids}, {Op: "delete", IDs: ids[:2]}, {Op: "unstar", IDs: ids[2:]}}); err != nil {
+				t.Fatalf("Batch error: %v", err)
+			}
+			if got, want := names(), []string{"Default snippet", "three"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("names after batch = %q, want %q", got, want)
+			}
+			if ss, err := db.QueryByStarred(-1); err != nil || len(ss) != 2 || ss[0].Starred || ss[1].Starred {
+				t.Errorf("QueryByStarred after batch = (%v, %v), want no starred snippets", ss, err)
+			}
+			if brs, err := db.QueryBenchmarks(ids[0], 10); err != nil || len(brs) != 0 {
+				t.Errorf("QueryBenchmarks after batch delete = (%v, %v), want (nil, nil)", brs, err)
+			}
+		})
+	}
+}
+
+func TestStats(t *testing.T) {
+	for _, backend := range []string{"bolt", "sqlite"} {
+		t.Run(backend, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			db, err := openStore(backend, tmpDir)
+			if err != nil {
+				t.Fatalf("openStore error: %v", err)
+			}
+			defer db.Close()
+
+			for i := 0; i < 3; i++ {
+				if _, err := db.Create(snippet{Name: "name" + strings.Repeat("x", i), Code: "code"}); err != nil {
+					t.Fatalf("Create error: %v", err)
+				}
+			}
+			st, err := db.Stats()
+			if err != nil {
+				t.Fatalf("Stats error: %v", err)
+			}
+			if st.Backend != backend || st.Snippets != 4 || st.Size <= 0 { // Includes the default snippet
+				t.Errorf("Stats = %+v, want {Backend: %v, Snippets: 4, Size: >0}", st, backend)
+			}
+		})
+	}
+}
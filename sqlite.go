@@ -0,0 +1,593 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	sqliteFile = "snippets.sqlite3"
+
+	// sqliteTimeFormat is a fixed-width format such that the lexicographical
+	// order of the formatted timestamps matches their chronological order.
+	// All timestamps are stored in UTC.
+	sqliteTimeFormat = "2006-01-02T15:04:05.000000000Z"
+
+	sqliteSchema = `
+		CREATE TABLE IF NOT EXISTS snippets (
+			id       INTEGER PRIMARY KEY AUTOINCREMENT,
+			created  TEXT NOT NULL,
+			modified TEXT NOT NULL,
+			gist     TEXT NOT NULL DEFAULT '',
+			starred  INTEGER NOT NULL DEFAULT 0,
+			name     TEXT NOT NULL,
+			code     TEXT NOT NULL DEFAULT '',
+			files    TEXT NOT NULL DEFAULT ''
+		);
+		CREATE INDEX IF NOT EXISTS snippets_by_modified ON snippets (modified, id);
+		CREATE INDEX IF NOT EXISTS snippets_by_created ON snippets (created, id);
+		CREATE TABLE IF NOT EXISTS api_tokens (
+			id      INTEGER PRIMARY KEY AUTOINCREMENT,
+			created TEXT NOT NULL,
+			name    TEXT NOT NULL,
+			hash    BLOB NOT NULL UNIQUE
+		);
+		CREATE TABLE IF NOT EXISTS sessions (
+			id          TEXT PRIMARY KEY,
+			user        TEXT NOT NULL,
+			created     TEXT NOT NULL,
+			refreshed   TEXT NOT NULL,
+			remote_addr TEXT NOT NULL,
+			user_agent  TEXT NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS audit_log (
+			id          INTEGER PRIMARY KEY AUTOINCREMENT,
+			time        TEXT NOT NULL,
+			user        TEXT NOT NULL,
+			remote_addr TEXT NOT NULL,
+			action      TEXT NOT NULL,
+			snippet_id  INTEGER NOT NULL,
+			source_hash TEXT NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS benchmarks (
+			id         INTEGER PRIMARY KEY AUTOINCREMENT,
+			snippet_id INTEGER NOT NULL,
+			time       TEXT NOT NULL,
+			go_version TEXT NOT NULL,
+			results    TEXT NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS benchmarks_by_snippet ON benchmarks (snippet_id, time);`
+
+	sqliteColumns = "id, created, modified, gist, starred, name, code, files"
+)
+
+// sqliteDatabase is an implementation of snippetStore backed by SQLite.
+// It has the same semantics as database, which is backed by BoltDB.
+type sqliteDatabase struct {
+	db      *sql.DB
+	path    string // Path to the SQLite file
+	timeNow func() time.Time
+}
+
+func openSQLiteDatabase(path string) (*sqliteDatabase, error) {
+	file := filepath.Join(path, sqliteFile)
+	db, err := sql.Open("sqlite3", file+"?_foreign_keys=1&_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1) // SQLite only supports a single writer
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	// Add the columns missing from databases created by prior versions.
+	var n int
+	if err := db.QueryRow("SELECT COUNT(*) FROM pragma_table_info('snippets') WHERE name = 'files'").Scan(&n); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if n == 0 {
+		if _, err := db.Exec("ALTER TABLE snippets ADD COLUMN files TEXT NOT NULL DEFAULT ''"); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+
+	// Create default snippet.
+	if err := db.QueryRow("SELECT COUNT(*) FROM snippets").Scan(&n); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if n == 0 {
+		var zero time.Time
+		_, err := db.Exec("INSERT INTO snippets (id, created, modified, name, code) VALUES (?, ?, ?, ?, ?)",
+			defaultID, formatSQLiteTime(zero), formatSQLiteTime(zero), defaultName, defaultCode)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+	return &sqliteDatabase{db: db, path: file, timeNow: time.Now}, nil
+}
+
+func formatSQLiteTime(t time.Time) string {
+	return t.UTC().Format(sqliteTimeFormat)
+}
+
+// formatSQLiteFiles returns the JSON of the files of a snippet,
+// or the empty string if it has none.
+func formatSQLiteFiles(files map[string][]byte) string {
+	if len(files) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(files)
+	return string(b)
+}
+
+// scanSnippets reads all snippets from rows, which must select sqliteColumns.
+func scanSnippets(rows *sql.Rows) ([]snippet, error) {
+	defer rows.Close()
+	var ss []snippet
+	for rows.Next() {
+		var s snippet
+		var created, modified, files string
+		if err := rows.Scan(&s.ID, &created, &modified, &s.Gist, &s.Starred, &s.Name, &s.Code, &files); err != nil {
+			return nil, err
+		}
+		var err1, err2 error
+		s.Created, err1 = time.Parse(sqliteTimeFormat, created)
+		s.Modified, err2 = time.Parse(sqliteTimeFormat, modified)
+		if err1 != nil {
+			return nil, err1
+		}
+		if err2 != nil {
+			return nil, err2
+		}
+		if files != "" {
+			if err := json.Unmarshal([]byte(files), &s.Files); err != nil {
+				return nil, err
+			}
+		}
+		ss = append(ss, s)
+	}
+	return ss, rows.Err()
+}
+
+func (db *sqliteDatabase) query(q string, args ...interface{}) ([]snippet, error) {
+	rows, err := db.db.Query("SELECT "+sqliteColumns+" FROM snippets "+q, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanSnippets(rows)
+}
+
+func (db *sqliteDatabase) QueryByModified(lastTime time.Time, lastID int64, limit int) ([]snippet, error) {
+	if lastTime.IsZero() && lastID == 0 {
+		return db.query("ORDER BY modified DESC, id DESC LIMIT ?", limit)
+	}
+	t := formatSQLiteTime(lastTime)
+	return db.query("WHERE modified < ? OR (modified = ? AND id < ?) ORDER BY modified DESC, id DESC LIMIT ?", t, t, lastID, limit)
+}
+
+func (db *sqliteDatabase) QueryByCreated(lastTime time.Time, lastID int64, limit int) ([]snippet, error) {
+	if lastTime.IsZero() && lastID == 0 {
+		return db.query("ORDER BY created DESC, id DESC LIMIT ?", limit)
+	}
+	t := formatSQLiteTime(lastTime)
+	return db.query("WHERE created < ? OR (created = ? AND id < ?) ORDER BY created DESC, id DESC LIMIT ?", t, t, lastID, limit)
+}
+
+func (db *sqliteDatabase) QueryByID(lastID int64, limit int) ([]snippet, error) {
+	return db.query("WHERE id > ? ORDER BY id LIMIT ?", lastID, limit)
+}
+
+func (db *sqliteDatabase) QueryByName(name string, limit int) ([]snippet, error) {
+	rows, err := db.db.Query("SELECT id, name FROM snippets")
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int64]string)
+	for rows.Next() {
+		var id int64
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		names[id] = strings.ToLower(name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var ss []snippet
+	for _, id := range matchNames(names, name, limit) {
+		s, err := db.Retrieve(id)
+		if err == errNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, s)
+	}
+	return ss, nil
+}
+
+func (db *sqliteDatabase) QueryByStarred(limit int) ([]snippet, error) {
+	return db.query("ORDER BY starred DESC, modified DESC, id DESC LIMIT ?", limit)
+}
+
+func (db *sqliteDatabase) Create(s snippet) (int64, error) {
+	if err := checkCreate(s); err != nil {
+		return 0, err
+	}
+	now := formatSQLiteTime(db.timeNow())
+	res, err := db.db.Exec("INSERT INTO snippets (created, modified, name, code, files) VALUES (?, ?, ?, ?, ?)",
+		now, now, s.Name, s.Code, formatSQLiteFiles(s.Files))
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+func (db *sqliteDatabase) Import(ss []snippet) (map[int64]int64, error) {
+	if err := checkImport(ss); err != nil {
+		return nil, err
+	}
+	tx, err := db.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	now := db.timeNow()
+	ids := make(map[int64]int64)
+	for _, s := range ss {
+		if s.Created.IsZero() {
+			s.Created = now
+		}
+		if s.Modified.IsZero() {
+			s.Modified = s.Created
+		}
+		res, err := tx.Exec("INSERT INTO snippets (created, modified, starred, name, code, files) VALUES (?, ?, ?, ?, ?, ?)",
+			formatSQLiteTime(s.Created), formatSQLiteTime(s.Modified), s.Starred, s.Name, s.Code, formatSQLiteFiles(s.Files))
+		if err != nil {
+			return nil, err
+		}
+		if ids[s.ID], err = res.LastInsertId(); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (db *sqliteDatabase) Retrieve(id int64) (snippet, error) {
+	ss, err := db.query("WHERE id = ?", id)
+	if err != nil {
+		return snippet{}, err
+	}
+	if len(ss) == 0 {
+		return snippet{}, errNotFound
+	}
+	return ss[0], nil
+}
+
+func (db *sqliteDatabase) Update(s snippet, id int64) error {
+	if err := checkUpdate(s, id); err != nil {
+		return err
+	}
+	res, err := db.db.Exec(`UPDATE snippets SET
+		name = CASE WHEN ? = '' THEN name ELSE ? END,
+		code = CASE WHEN ? = '' THEN code ELSE ? END,
+		files = CASE WHEN ? THEN ? ELSE files END,
+		modified = ? WHERE id = ?`,
+		s.Name, s.Name, s.Code, s.Code, s.Files != nil, formatSQLiteFiles(s.Files), formatSQLiteTime(db.timeNow()), id)
+	return checkAffected(res, err)
+}
+
+func (db *sqliteDatabase) Delete(id int64) error {
+	if err := checkDelete(id); err != nil {
+		return err
+	}
+	res, err := db.db.Exec("DELETE FROM snippets WHERE id = ?", id)
+	if err := checkAffected(res, err); err != nil {
+		return err
+	}
+	_, err = db.db.Exec("DELETE FROM benchmarks WHERE snippet_id = ?", id)
+	return err
+}
+
+func (db *sqliteDatabase) Batch(ops []batchOp) error {
+	if err := checkBatch(ops); err != nil {
+		return err
+	}
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, op := range ops {
+		for _, id := range op.IDs {
+			switch op.Op {
+			case "delete":
+				res, err := tx.Exec("DELETE FROM snippets WHERE id = ?", id)
+				if err := checkAffected(res, err); err != nil {
+					return err
+				}
+				if _, err := tx.Exec("DELETE FROM benchmarks WHERE snippet_id = ?", id); err != nil {
+					return err
+				}
+			case "star", "unstar":
+				res, err := tx.Exec("UPDATE snippets SET starred = ? WHERE id = ?", op.Op == "star", id)
+				if err := checkAffected(res, err); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+func (db *sqliteDatabase) SetGist(id int64, gist string) error {
+	res, err := db.db.Exec("UPDATE snippets SET gist = ? WHERE id = ?", gist, id)
+	return checkAffected(res, err)
+}
+
+func (db *sqliteDatabase) ToggleStarred(id int64) (snippet, error) {
+	res, err := db.db.Exec("UPDATE snippets SET starred = NOT starred WHERE id = ?", id)
+	if err := checkAffected(res, err); err != nil {
+		return snippet{}, err
+	}
+	return db.Retrieve(id)
+}
+
+func (db *sqliteDatabase) CreateToken(t apiToken) (int64, error) {
+	if err := checkCreateToken(t); err != nil {
+		return 0, err
+	}
+	res, err := db.db.Exec("INSERT INTO api_tokens (created, name, hash) VALUES (?, ?, ?)",
+		formatSQLiteTime(db.timeNow()), t.Name, t.Hash)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+func (db *sqliteDatabase) LookupToken(hash []byte) (apiToken, error) {
+	ts, err := db.queryTokens("WHERE hash = ?", hash)
+	if err != nil {
+		return apiToken{}, err
+	}
+	if len(ts) == 0 {
+		return apiToken{}, errNotFound
+	}
+	return ts[0], nil
+}
+
+func (db *sqliteDatabase) ListTokens() ([]apiToken, error) {
+	return db.queryTokens("ORDER BY id")
+}
+
+func (db *sqliteDatabase) queryTokens(q string, args ...interface{}) ([]apiToken, error) {
+	rows, err := db.db.Query("SELECT id, created, name, hash FROM api_tokens "+q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ts []apiToken
+	for rows.Next() {
+		var t apiToken
+		var created string
+		if err := rows.Scan(&t.ID, &created, &t.Name, &t.Hash); err != nil {
+			return nil, err
+		}
+		if t.Created, err = time.Parse(sqliteTimeFormat, created); err != nil {
+			return nil, err
+		}
+		ts = append(ts, t)
+	}
+	return ts, rows.Err()
+}
+
+func (db *sqliteDatabase) DeleteToken(id int64) error {
+	res, err := db.db.Exec("DELETE FROM api_tokens WHERE id = ?", id)
+	return checkAffected(res, err)
+}
+
+func (db *sqliteDatabase) PutSession(s session) error {
+	_, err := db.db.Exec("INSERT OR REPLACE INTO sessions (id, user, created, refreshed, remote_addr, user_agent) VALUES (?, ?, ?, ?, ?, ?)",
+		s.ID, s.User, formatSQLiteTime(s.Created), formatSQLiteTime(s.Refreshed), s.RemoteAddr, s.UserAgent)
+	return err
+}
+
+func (db *sqliteDatabase) LookupSession(id string) (session, error) {
+	ss, err := db.querySessions("WHERE id = ?", id)
+	if err != nil {
+		return session{}, err
+	}
+	if len(ss) == 0 {
+		return session{}, errNotFound
+	}
+	return ss[0], nil
+}
+
+func (db *sqliteDatabase) ListSessions() ([]session, error) {
+	return db.querySessions("ORDER BY created")
+}
+
+func (db *sqliteDatabase) querySessions(q string, args ...interface{}) ([]session, error) {
+	rows, err := db.db.Query("SELECT id, user, created, refreshed, remote_addr, user_agent FROM sessions "+q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ss []session
+	for rows.Next() {
+		var s session
+		var created, refreshed string
+		if err := rows.Scan(&s.ID, &s.User, &created, &refreshed, &s.RemoteAddr, &s.UserAgent); err != nil {
+			return nil, err
+		}
+		var err1, err2 error
+		s.Created, err1 = time.Parse(sqliteTimeFormat, created)
+		s.Refreshed, err2 = time.Parse(sqliteTimeFormat, refreshed)
+		if err1 != nil {
+			return nil, err1
+		}
+		if err2 != nil {
+			return nil, err2
+		}
+		ss = append(ss, s)
+	}
+	return ss, rows.Err()
+}
+
+func (db *sqliteDatabase) DeleteSession(id string) error {
+	res, err := db.db.Exec("DELETE FROM sessions WHERE id = ?", id)
+	return checkAffected(res, err)
+}
+
+func (db *sqliteDatabase) AppendAudit(e auditEntry) (int64, error) {
+	res, err := db.db.Exec("INSERT INTO audit_log (time, user, remote_addr, action, snippet_id, source_hash) VALUES (?, ?, ?, ?, ?, ?)",
+		formatSQLiteTime(db.timeNow()), e.User, e.RemoteAddr, e.Action, e.SnippetID, e.SourceHash)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+func (db *sqliteDatabase) QueryAudit(beforeID int64, limit int) ([]auditEntry, error) {
+	if beforeID <= 0 {
+		beforeID = math.MaxInt64
+	}
+	rows, err := db.db.Query("SELECT id, time, user, remote_addr, action, snippet_id, source_hash FROM audit_log WHERE id < ? ORDER BY id DESC LIMIT ?", beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var es []auditEntry
+	for rows.Next() {
+		var e auditEntry
+		var t string
+		if err := rows.Scan(&e.ID, &t, &e.User, &e.RemoteAddr, &e.Action, &e.SnippetID, &e.SourceHash); err != nil {
+			return nil, err
+		}
+		if e.Time, err = time.Parse(sqliteTimeFormat, t); err != nil {
+			return nil, err
+		}
+		es = append(es, e)
+	}
+	return es, rows.Err()
+}
+
+func (db *sqliteDatabase) AppendBenchmarks(br benchRun) error {
+	results, err := json.Marshal(br.Results)
+	if err != nil {
+		return err
+	}
+	_, err = db.db.Exec("INSERT INTO benchmarks (snippet_id, time, go_version, results) VALUES (?, ?, ?, ?)",
+		br.SnippetID, formatSQLiteTime(db.timeNow()), br.GoVersion, string(results))
+	return err
+}
+
+func (db *sqliteDatabase) QueryBenchmarks(snippetID int64, limit int) ([]benchRun, error) {
+	rows, err := db.db.Query("SELECT snippet_id, time, go_version, results FROM benchmarks WHERE snippet_id = ? ORDER BY time DESC, id DESC LIMIT ?", snippetID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var brs []benchRun
+	for rows.Next() {
+		var br benchRun
+		var t, results string
+		if err := rows.Scan(&br.SnippetID, &t, &br.GoVersion, &results); err != nil {
+			return nil, err
+		}
+		if br.Time, err = time.Parse(sqliteTimeFormat, t); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(results), &br.Results); err != nil {
+			return nil, err
+		}
+		brs = append(brs, br)
+	}
+	return brs, rows.Err()
+}
+
+func (db *sqliteDatabase) Stats() (storeStats, error) {
+	st := storeStats{Backend: "sqlite"}
+	if err := db.db.QueryRow("SELECT COUNT(*) FROM snippets").Scan(&st.Snippets); err != nil {
+		return st, err
+	}
+	fi, err := os.Stat(db.path)
+	if err != nil {
+		return st, err
+	}
+	st.Size = fi.Size()
+	return st, nil
+}
+
+func (db *sqliteDatabase) Backup(path string) error {
+	tmp := path + ".tmp"
+	os.Remove(tmp)
+	if _, err := db.db.Exec("VACUUM INTO ?", tmp); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+func (db *sqliteDatabase) Compact() (before, after int64, err error) {
+	fi, err := os.Stat(db.path)
+	if err != nil {
+		return 0, 0, err
+	}
+	before = fi.Size()
+	if _, err := db.db.Exec("VACUUM"); err != nil {
+		return 0, 0, err
+	}
+	if fi, err = os.Stat(db.path); err != nil {
+		return 0, 0, err
+	}
+	return before, fi.Size(), nil
+}
+
+func (db *sqliteDatabase) Close() error {
+	return db.db.Close()
+}
+
+// checkAffected returns errNotFound if the statement did not affect any rows.
+func checkAffected(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errNotFound
+	}
+	return nil
+}
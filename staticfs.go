@@ -2,24 +2,43 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE.md file.
 
-// Code generated by staticfs_gen.go with go1.8.1. DO NOT EDIT.
-
 package main
 
 import (
+	"bytes"
 	"compress/gzip"
-	"encoding/base64"
-	"io/ioutil"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"io/fs"
+	"strconv"
 	"strings"
 )
 
+// publicFS holds the static files in the public directory.
+//
+//go:embed public
+var publicFS embed.FS
+
 // mimeTypes is a mapping from file extensions to MIME types.
+// Only files with these extensions are served.
 var mimeTypes = map[string]string{
 	"css":  "text/css; charset=utf-8",
+	"csv":  "text/csv; charset=utf-8",
+	"gif":  "image/gif",
 	"html": "text/html; charset=utf-8",
 	"ico":  "image/x-icon",
+	"jpeg": "image/jpeg",
+	"jpg":  "image/jpeg",
 	"js":   "application/javascript",
+	"json": "application/json",
+	"md":   "text/markdown; charset=utf-8",
+	"pdf":  "application/pdf",
+	"png":  "image/png",
 	"svg":  "image/svg+xml",
+	"txt":  "text/plain; charset=utf-8",
+	"wasm": "application/wasm",
+	"webp": "image/webp",
 	"woff": "font/woff",
 }
 
@@ -31,30 +50,67 @@ func mimeFromPath(p string) string {
 	return ""
 }
 
-// staticFS is a mapping from file paths to the contents of a file.
-var staticFS = map[string][]byte{
-	"css/codemirror-play.css":     decompressBase64("H4sIAAAAAAAC/5yT3WrcOhSF7/0UC+YuHDk+NoxnPFBIp6EU2t6kfQBZ2rZFZMlsydO4oe9eMk2LMz8pU3xnf9/aS3jr+irZ+mFi03YRefZ/iS8d4b3HzRg7zyHFjbXYfw5gCsQ70mnyNRB8g9iZgOBHVgTlNcEEtH5H7EijniDx9u6dCHGylFijyAVC7GSEkg41ofGj0zAOsSN8/LC9/Xx3m/YajbGUJlfXSZKqXgQxWDkh3XpNnwyzZzxCeeu5wiLLsg1qqe5bfgoTv983TbPBj7mvzW4WIQJZUpE0Hmd6hYWmQ3FuWeOoqn65xrv/8AqHNwiDdBfix9a/NBS9/y4urHm5c0Y9U/hc43aMkTgcWs3q6dn8+dNFUfzl2G7sa5ovh5Ryg0FqbVwrLDWxwmp4eCVFjRz261V71sTPTj48IHhrNBZ5nh8dRfXinqZvnjVms4ulOhykevFccQ7SsjgB7iQbWVs6XPUj0A/EMr64E8tydQJUvu/Jxfno9Xp9AgyRjWtfdMzK8gRYj8ZG4+ZgWewTfwYAAP//2jKD3VcEAAA="),
-	"css/codemirror.css":          decompressBase64("H4sIAAAAAAAC/6xZbW/jNhL+7l8xh+Cw3cBK5Lw0jQMc2utuuwWu16KbLXAfKWlkEaZIlaTsOEH++4FDypZkyk2BYoFgTY5mhvPGZ4aX5/Dv7z7/9P1nOL+czS6+VwX+zLVWGl5mAJfn8BktVMhXlZ3Dlhe2mkOmdIHazIHJAlZCZUxAqaSFRqsGteVooEKNjiXQTlKymovdEmollWlYjg8zCGyXcJ2mzZNbyJVQegmZYPn6YfY6m12ew6/fffjw039/HKuXCC7RkJINKwouV0u4aZ4gfXBK/+60yJno9oBp1coCciUtSuuYvQ4O22gc8kodN+L1SWn+rKTtcVPlkNNAMZNrJUTGdFJyIVDPob+7aq3FbotEZixfr0i9JJx/W3GLJPuxQhDcWoFg/miZRsjQbhElfCLj/w57aSaocnkOP355fPz425HFvGhvM+/CRHsHLJonMErwAs6KoniIKnVW3rl/bpP0S8iNS5Bqq1nzMLQneUe2dUbyprbGFr92/oNbHws1lwnF2xKuQnhYfLIJE3wll0Ca92Lm7P7+/oRuEUPUTK+dDsOog9dJ0sS0mfPEy0AmeJt//+W3z78c2zxvtQm5FEwusBxYPAT72CVSScqRYILUHcJlY6W2ErYVSqjVxsUil5DxpOAac8uVZILM5BTpx3fBN4MYxVzJgundn+tnuNigJv/mdVIy251p4phBYdZadTiV8+4/eN0obZm0wwBbwtkd3kUEjHT2qz58nxMuC3xawsI7N68TJnnNLPYZnFLH/Ui2mK25DZ9yJV0QcLmGxUX6tQFjsTFfLd4DlyWXLiXdN7V6/ksf/BXaSNYF03xLcte4KzWr0QRW7oDpP12CAdy6/0Q4WM2kaZhGaV2sAixS/4ljGgzwd/P9m/lRfjEJxu4Ewj46yhIdtcsAtUG91dwifCWVTLg0qO17qFWBo0xIDqSxAA7BZFkGL1Bw0wi2WwKXrmglmVCuQlAZKjBXOviVywo1J7UHonQrunrbKMM9McuMEq33t8+19AFC0qcPYFWzhOTWVTzIlLWqXkLSFUCneynUdgkVLwqU46pLAk8l81me51RJnZT0IIHSIaajN/6Hjz989+U/j/D46ePPH32Ny+vEJAWWrBUW3K8KWeGE76tpiw+vEbo/WmVxT3aW3qeBTOKKWb7p7RU3N2HPq9bfu7q/Cnte8JyYG6uVXMELYY5tgBeZEkWgxTrsUSgtgVsmeB42fageebeVBWrn/+44VvM12kqrdlVF6ClUwvbDa8xUa9xtlS4Oh7lLv4naillV9468uI9SdZdpR7f4+iZKV2DZs3taRok2THOWCZxH9ppW5ralY0a3PfrbxfbcDrM+w6aFJlc9BW/ZSQWT6x7tN7dR2lzVtasQe0J2m0YJnU9d3OzpFosTdH01ywmONVp2oLq9vZ1IBiZ4yfvemyLNWi4slwfC6zRuoEyzfI29Q9/f30UJLeudeHGXToSg1TxrBymb5lHSSvdlxmPV59iQU4QMfQeyN3LaKcflhgle5BU72h7iLlW7muF82tXCrtZdddWwg24fgmCqCQZKpcEFjpLAikJJAtazIRgB0zDZl1czm1dcro6Mn5ZOtz/5Wio5yaC8okIXkUX+GwApvcrYV1e3t3NY3KZzSOdwcf1+DGlZ7iqpv9D238LLAJDhN+VV6QoEYc7HX34lG4SGRKOxrgWyFTdQcnclu/aIS9OZUKNgFguwCmyFUGNeMclzA6qcAdAaFtwqfQH/U61rGzOWiR2YSrWikO8sWNXmlSOsL6KN6eGuIll8g/H7cQg0fWMVb9iI7YFBWOyBVt+PcbkysOVCQKaRrYEHO3BDH2uS6xvfy3O4Tpsnt0VWYCueQ830iktojTdPxQv09hDoStU7ZzwmRm1daMTx6IAO+/RNQ7RexD7ek2sCE2E1IA2/eGi/9tTdetebOxRGpm2tC5nQmDh9ftW4ccW10Gy1cplWalVDxVeVcJ+6ld7BvGoxtw2j0/BnPOXiYZdE9vXQpo8fA2xxwVqyNc5hww3PXBO9N+sFfAkuKJXOXVAXmm2haOki8HRcrly4ZlgqjcDc3ScOW1CxpkFp5mCr1kDjzUFfV2xNQwdZuO9LwfM1aj+GsLxkuTUXY1i62as2nBdUE+t/dcoQB6D7Pupr92uPd33z+TqhIPEbIda0n37JUz8B96u7LqvGrKsh6wMo3QPkEZsI86cp5mNDjbTvIeBY59//aI/WB99MjlgiFj+wOBit5jIZJ9veLddxrXxnO5x06JqJWOLGm5gZwCbMyLqJilXNw1T18BXoE8vXLmNqtkb46eMdZFixTRjznT8rVS8XjvS8k+lFTlh2q13+vCk6b8aF3AXoeKAQOvujrajw/s03KT/aJfWVirJGYU/w9I3mEgLaeRPHvqW6lr01qBODAvPesIiGBNGdyOLr1Dy1U9F1NkcRGoaiodoZyJUQrCGkFepkybWhW2E7PWa9PIff0KAFo2rsUIOtmKUr4wAwEBq2QqidcKBYcx9RvNFZu9uAFbw15KvOPsc7RyuH2+QwZBuG2eBOGU2yu6a/W3cX12DVJ1LgOkjWRvvRntIF+bafvpSjnbV73AIc7K3sw+bq4Q2AKNyA/bGXZU2yv65jY5geLR2RWi9pE8FXzLYazSG+Tu8Po9odeR8JPSMQoErcQsxiiR/lhi+I9mC3SCy/KcOnBjCTSZ9GZW15sUJ7ArUMfXXwih9Ljjh23I5amsKbbAjFAtb5gVBMeJVIMvUEhj8TKlO6j8MMbOlpZouATw3mFvh4WByuzPkxLptHqlNszcxPTfxD5j4lXsNlX2uflBNbI4PUyEzbvdtE3RvyursKu7RKp3oFShIuuN31J2xTA/24zEZxSdcA1cd4BnSaUxL0+BjLLM+pLz0x/I5p+aeBR0hixNQh9ynG+3oxOn+pcupd3qjfFBt/FWExamDPinv3b9yydkLfxOGuuCnTMYdcK2Mqxum9J9xv+7UxMWXX0gvgSg6htduEf1EL/xaaY9K3Kex1oFR5iyJvJJygn1bJz2aQaZo4jN4rl3BWlmx8aR6GEPQnncPFzfuuTDnUWAUYGTCEK0DUOmq0rZZUs1opd6DK0qB9VI0BJamGOd3N+GmLzvUC50d4Fp9sgLHdpGfY8rFw/dMzgaBCyUB2TwZ5nRBZQBIuT0OrHO6KC8JCr7PZtzUWnEGjubQduvnU9fWhXtCLHVG48xGAOfE818+mqXzvHkY+IwI3pkU4u7pPF53ulmV0YybO2ktW2vDUSeV0Ce/edTb5hKIheGjcX4da1oe4sAoMK1HswtsLPS32btbzy9l4ltXlJZG+HKF2J/b/AQAA///VVSEGdCAAAA=="),
-	"css/playground.css":          decompressBase64("H4sIAAAAAAAC/7RYe2/bthb/2/oUBIwhyWC5smc3rowBty90BbqhuOm9uPdPmjqyiNAkQVKN3cHffSApypIlu06Tjmub6JDnxfP4Hb74NXor5E7RdWHQNJncoi8FoA8CvS5NIZQeo9eMIUfWSIEG9RWycfQfDUjkyBRUIy1KRQARkQGiGq3FV1AcMrTaIYze3L2LtdkxiBglwDUgU2CDCOZoBSgXJc8Q5cgUgD59fPv+r7v3402GcspgHP36IopWItuNUGE2DP0dDTZYrSlPUbKMBhJnGeVr/0sBVsMUTZLkl2W09+fsiRUm92tlxaRomC/sWja/xkQwoSzN/beMBrngJs7xhrJdiq7uvHF3mGv0WYmrEfoD2FcwlOAReq0oZiOkMdexBkXtcUY5xLU647nT5l8VUwJWp/MSggrOayniQm0wCx8fKsazxFqtFUkREwSz6w6bm1GbYgmflYj/DeuSYWXppWLXV+PxC8v5hb/F2NkilRg/iDy/ukG5FW+ur/yvzpjxBlP+pjRG8I41P+Awbyz9BimazOT22NKVYNkyGojSWM9af3BYRgNSKm3vTQrKDahlNMiolgzvUkS5u4MVE+S+GSe/yS2aTJ0EA1sTZ0CEwoYKXnNdCZWBStFEbpEWjGZouEjsWkaBGCuc0VKnyOsab8S3uJ/yAKt7anqJ0SCE3fzWrqCSLnAmHlKUWFXl1v1bR6bVYFtvoVyDaW0Mmw9aXbq71vSyA+2sCqfX1kLg5tp6H6sRYpAbZISsfloJY8RmhJzlsTZCXifjZD4KBt60SJMRGpKpXTc3yyOB1jgv5CDUyak4ofkv9WlXETocKpWfxkQ88fxGP41B93Dt43MscsqMjXGpxJpm6bv/fdzgNXxRmGub7OM/KVFCi9yMa87aYGXe2svRRv1+VXG/GiHgWeOzF3Q1+lCd+7KT8Htyc77e7pv1JC1s83B1+/uxCACPCPVq9+Wh7g48d6hXId0J9Tyx6zGhXt2qu2F/+gdC/VIm4onnT4b6hQzOhfoZFs8Q6iGmj0LdC7ow1D2P41DHxNCvDg9IoalvQwoYtl9tPxDS9aHjYxnVeMUgsweDgNCjzjSRLLHrwiaSZdkjMqvafXlmuQPPnlmJXd3Mwoldj8osx8kHlD/9I5l1IRPxxPOnM+syBmcz6zSL58gsx72TWV7QpZnleDhUOrTx8RlzaKP+eiskdnVGhcEDzUyRouks8diTCWxSF2zLZmZqgw0lTZiZM/AHYBvnzIY7Eazc8GU0+BZTnsE2RR79DzWnUoLxGfxBiVL6FueAoXd3C3N6H9R4NA61oEMP89Ak5Fb4oYl75xVtXpNcmcCMrnmKCHj87PQErEhxgYKvXr1atiuJFTCVWwtvkVqv8HUyQtX/4+n85vv4/CDG61jdS3VLfSVyHxT+hFfgxsMjEa0hsVGAvIR9fS93jsv3J7NHjTLzgw0EM3JtDUExWiRye3OIMzdY1xcZqxCaXsFoaKhh0Jp823eboJfWKPt3K9T+wht4ZoMW/ZNZX7IZWwckVsBNY6jyqluA59OloFkG/KQ1PkoP24ExKjXV1q8FNRBriYkbCB+wkscRs2+5Ik1DtaZcliaWDBMoBMs82Oz00eYATg1mLvNbxoexvCPH9pWfxt6W+59swbMbULP/RLWhfH2iPtdvM633APegohuPIS6rQ1DEuxTh0ojlyfxwuMOuqlKnaOIAlWX6fiONeyPqK4cNFp7lRT6oOH80sDlfQAMcq9lOE7uOK9bLUylzeW4oLPueSw6AzYiSFDHBjInS1C4O1FKDijUwIA3afWE2rJ9ko6efoHu/93xrOLE5DzpX2r7s3lCCI2fTxSSfNdqI3zGtgHNgdOckHAqpb6nx5Ew1PdV8J2S+ILfLc7d72NKN81rhnkE4aPsOclwyg/5GPUGGbEplCq/fYPXfE8mEMe7pz/bPoqc//+b6c8vDzQewxeJADQ3qmBziiwgWK7DZ20Va1c1UQOvQ0XO6hexQvxeOXEOol76GOMEB3h0xrq604j/3/C/AaftoaIQ8gxn9ZPMYGGQj6js4qKifcJ2eG8rjgEFfHrumMQ/WDpk5xWELpDRwBCmP0GsrJaqnz9PD5tw50wQMX7Vxhg38/zqeO9y/j4YFMNkvNWCZIDbEyvPIrSL+j0uuqoOXq3R4Hjjr0yVEvBIP3YifdSHsPorG69IYUH9idV8Vta4di+m05Sy80oKVBur8iSfJMUD2TUJiQs0utXHmU4aIDN6IbQsYhwobDUVpZHkYmJrJn/T0qXZ/PXSeqv3WJXRWOW3ma429WLyq2vnsuD1JBXHVoI7f95vNWpuM8iYOSW6TQBClaVGS20AB1cIut0k4g02pm5R6mupBt/vonwAAAP//Md8DsyEbAAA="),
-	"css/sweetalert2-play.css":    decompressBase64("H4sIAAAAAAAC/+xZXW/iPBa+Jr/i6K2qlleEN6HQ0KBXms6HZkfaXa3U2dVKq71wnBOwGuLINqXMiP++spPwlQQSymguZpuLguPz4ePHz3NC/vjd+sDTlWDTmYKB43rwdYbwmcPjQs24kH14jGMwtyUIlCheMOxb/5QIPAI1YxIkXwiKQHmIwCRM+QuKBEMIVkDg/dNHW6pVjFbMKCYSQc2IAkoSCBAivkhCYAmoGcJfv3z49PenT/15CBGLsW/9/odl9eWSxANb+4zJCr5bnYDQ56nQhj6IaUBuh24Phl4PRm4PnP5Dd2KtN3ZzHpJYW0U8UXZE5ixe+XDzlKX8RBIJ/xD8pgd/wfgFFaOkB4+CkbgHkiTSlihYNLE6KQlDlkx9cEfp6/a7rXjqg5eNKXxVNonZNPGzgk2sTsBFiMIWJGQL6cO9nrjeTy7/QnmiMFE615DJNCYrH4KY0+cDzxQThWJidSiPufDhajgcTvL1SfYNdYoTqzMnYsqSPL8s6n7Y2WC/mHbhLxrrS6+RS6YYT3wggeTxQqFORTt0JlYnxkhln8xas48zzD7fO6YiMUvQPhjbSXQw3CSame8vKa9dwJXicx/c9BUkj1kIV+jiGMNSJVOBm63OS7EpzTLPIuBxuBPJGwclNwbI5/kpHDHKE+1CCZLIiIu5D5KSGG+dvjfobrenWJs9MLXZ3TTbdbJte2cvMXhmyn7GVSTIHCWQhM2JQhuF4GITzLkGgO/AU0KZWumKwtrquI5zvTvq6tG19e6i3ioRzZJ0oS5w9jIcVALg8Hzd7R5OH0bpawb+DTDJQnFj9mrLGQn50oeEJ1h7gHwYOOmrBue6fo1+xOlCmuOUpVOg4mH46D7eH8RjiUQFjrn0kgyHeV4PHh564DqeJjGvuxMwi5FwdfsftUrxz980O/72327fbFivSEWTBBFIsuFtNnuFi5x7HHknMtrM2m7sC4lZSDQbbLzvHYd7x6moYSVvFVnldS9qNXgYRHd3k2pKCjCkzqRmRegGD+UjZai2U5ydDOOGzOSML/+1Wc4nsxynfycnVqfJrOz0lM9klcH2GBXzS4Tg5tsNUH9rbXWGo+tTbty+M+pO4MittdUZO9en03mo85Pf2vLA0Xz0oqqX5XZLJPT/8rUv3wEjBQuleKL7CMISFHXy7uhrS51GbKqotSBAe7/lKVqgw/BZdB2UL5QW/uKEr6vm+YQq9mJkdttmCIyJHi3aDLeIE8QLfF/nv4oUxo6+dthlNNJ8RBdC6q8pZzkZbVotlphmpei49iTrDM3a6x22fc9B97Ap6V36Cu5eD5C1V+5OZxki5SJnp3zlZrxgca1TugT6/xUNo4lVkscsE3vOv9nVd3I8Vt2slIydkCYsRtE2QNPZm6DNDPafAArrqU4WE3Wrt5GIHugCguJp/imThR4YQNhS8fTW6TujHlwFYdTdG3d7cOUR2u1ODkLpZWXut+FMBO0DRteZHejDXbLN0zzXnJ9tOZfnmpbNNlWsNo5YrPRBTAWfstD/+O8vczLFrwWD9f/GqOCSR6q/8SkVEeqDrr1U4s8b7femB5iEO2MeoTe9z7nFV90BOd3qPiHQqF/v8oU/00+OWTN0El4BxRbozWc3R68xuDR6NVBL6NWQboFevYdmM/WutkfvaXN+tmUtek+aHkNvpfEF0GuQeoBeg+hG6PUIzeROYPg2tcuen38dtYuC4GeoXYRt+CKf3ZwvjMGl+SIKgjJfUM9rwxdREGTHh3reGXxx2pyfbVnLFydNj/FFpfEF+CIKghJfUM9ryBcG9esdumgldiGlLcCbz24OXmNwafDS8bgMXo3oFuCl43G2l3pT24P3tDk/27IWvCdNj4G30vgC4KXjcQm8BtCNwEvH40zspoKstmrX6FnO09evJXDm76doXKsnuqjtE130I57osmpVyNxAX62Uzvzl3G+sz9G7hk74G+3rta+Zg6MKWO/iEjpo/spSaAI1VcP8iKx3KaWVImKrdg7btnP4I9q5HNJlUTS/8rXSxWxXM6Uw1ueoY0Mn/I329UrZzMFRvax3cQnVzDF9KJwmUFPtND7yn2BnfGmb31c1ymtedzwtEdVjjELVvOkoTah9xbEz8w2vHN/oyerPWIhHl60n7K3KHUmIuFgSER4U4MTUylIc2FQswK1cgFMqxRs9/S8AAP//5TyZGcAhAAA="),
-	"css/sweetalert2.css":         decompressBase64("H4sIAAAAAAAC/+xbW2/juPV/z6fgZjDYZBEp1M12FPz/6L71qS2wLwss9oGWqJgILaoSPXam6HcvRJESSVGXzCaddjrjXcCmDslzfufw3Kj4zRnR0GOfcE3RC/jHFQB7lD0/1exU5l7GKKtTUD/t0Q28A/I/P759vAKgYg3hhJUpKMgF5+0QxQVPAWy/1uTpoL5zVslve8Y5O8ofOWkqil5SULIStwOfPVLm+JKCAEL4CP55dSXZO7Ic0QnmPhRF0U4uWMm9Ah0JfUnB9Z8x/YQ5yRD4Cz7h6zvQD9yBn2uC6B1oUNl4Da5J0XFW57j2apSTU5OCpLp0oxevIZ9J+ZQqij0TTzi+cA9R8lSmIMMlx/UsJgn82CMhvx9R/URKTwx5IYTdjkd08Q64A++hI2yVU1B29i4pOJA8x6Ux+pICdOJsHtFQIgqADmlasOzUCGABYCdOSYnl5BGtTxnKSfmkqLXtJVOjKeAQSmqlq2Tbfh7FmFBYQz7jFERS+ClYJfFZ4rKBUKPmNSqbgtXHQWxdETWmiJNPcrwDXRogABXKc6Fc+bsFoId/03PV47qnLHt2CVpLQdXcoJ8rRRdcVqjGJe/GO3Nqdx4vtz9xzkq/4S8U5xaE0tz1BeTPi9ccUM7OOg4ayMFWsWSAmUCor9efgEhR9xi1MoEoVOM9lOqwAJCd6qZlsmJEqE5INidbWjJ+oyzr9recNGhPcf67lBkAVqGM8JcU+PGjHFKblMzLa1Ytb2JZ7typ1nGNqwtoGCX5WHc9WFPKBeBMcn5IQdzbwWAb+phtgAOsXtiirZF+wnXrv6g6HpxVPTcjr6hxBH4gx4rVHA3MTbOtwM1xgU7UFlfZRgA7z9T+8854/0y4h0pyRPLIMY449hTsgZ804mShGsAGkLIgJeEYlKw+IqrWUf++eJ2pY9TZWGcKt8oWUlRwrA5tf7xJKRxAd8rlqSs5LnkKrq91q/ekV1fasXXjBepJ70zUgDSM/rcyt6g3tw8PDw/mkWyX8OYdyRC5lGreVjFvqBb5gxzRE5YaUEYfti5GRbMuGEq8hMlNrpVR1qi19LjSo2w49mHYSBr6XEAPH2jfMHri0p128VvNljlOEE35P8NxZ0X7MXUjlKl2ohRAP2gARo3abvKxy+npWKSHNkD3Hk/xkCeJA8T/71VSVid+N/m4DbeoxmiaosEUZzMrtEbKph9nB5w979nFPpcqI1H5oDyVgkyPcLtqJjczol7URT13mlBQhvgQRY2cwXTYhlkNx6ZPdzq4FdskY6XgWdr0Tnp3tYD6PY5AH3aw/bgSVT2Z1A5QHzpMht0C6wFRYqsioiMg3P8EDpxX6f19w1H2rDJBP2PHe3Qfx3AbRcl9EEVBHCbgp/srzd7ZKTt4GaKUnTSE1eNTg2tpQ9rDI/s89aRxPxgP6kbfqkF+xXXN1CkxQ/qHItzG29g6aKOp/sU7ovq5P2hTeac7h5xZt7Usx6qmOxpMJxnyBJV5bIchV9XUiedmTo4Kbxfp65i216aCUo4FSfw2XvbiWP6vy927qHITJzl+urWTAukKZym7kCyy3JVMCf+9iitvPVsjUhUlNoqxMVNnVJdDgvoHK1mtVNjt97vNo9O6tUeaBx1KHsOz7ebqswmZSFkwp0B/rXAJfkFlcz3NeVRkEcZOzvVH78P530+4aU/bW6sje8gRDpxC6Y/eR6jmlGW4ady+DiV5tttM+yQ5OU33uGA1vpsj0RNrLX/+8ccJJ5IMpcS0m1NJs6OaCkLocnR6pfx6fzNJtxYirdY0KycRoCGAJt9dE0jztJ0z86JoGHq1d1okHK3osZqINKIF2kB7tKaDdBkb0zYsaCQkCiITmiCwsdFL438nNHAlLnAdKMCvKMrwgdFcg8ZKEHV718fGiaJo1Qab5A6EIbwDQRTfAeiHt8uHbyYHnD+b0lRj25q1gaENuQKPglxsHLZjGB7gfHoznP0lzsOdxfnOwXjwDqa2DIWRBDpSPYfg0pXP5nWONG4Rq7EKFzn3Oam07ErqMkz00yOTtlgfE0qIN/rQG+aLq7mnTGsZOtPqPrfbjdiPduvY/4LEUi8ou3p9VJ5b1bhZfLtq7XH/xd5FdNF+4y8V/r/rglB8/fvtnd0X0Gtb1SKc7rW6+83KpQVD7Ztv28+ju9bX296kbDAHIobI/+2bK7i51evNcX8lakQDwTVuJFYTkPiivBgB48/VmXCDk63epF2zUXdzM9po5kJndEkgomx1EY3HD1mMN0VidiQ1FezjfI9z22PN8pamonDXotsEt2PC/tS59CRvBIROWo1GesPMVN0CaX+3AP3d60Tzjk1HskY8J/F/vIipYmy1nNMzvpqwS6KuMNEp41THd5+3n3XbrTSbOYP5km1fo8pFJY4ZWAoTYmJ/9xXZXUkQyDaSsY6xhN3nDaE5w4g/ww3sztrK3qgLj2KS3rA19yLlAdeEGwslbXRR9/Wk9Pq7vo/qBt+6tDAicPceg/P2t3tO0R5TAWRakLrhXnYgNL81rkrkBVQIraZSt0KXF5hjTYVUR8O+rTqSPKd4ah3rigaKgAGB8YKGkUxY3W8w6u6b7PXDqzhUO35ClOTiQmqIra46IGg/RhcfeKryVp1r/bUK47Zb7+VvH9qPnYXILNV1tzC6veiBsHi3egbDleMP149L95MqqY7t+8ZhZKrkc6CFUZtqGV2r84GoIsDoQQ3rT72yIQ211l6IaPX3J+VhnvFLUaMjbkBzYOdfzhjznymuO3uDHyUajtS5yRDFN9DfWmmzm0QAHyeL6wU+TJYW7GjEirs1HD4kyyw+qBVbZ7HI5CKH7WIC5u/wviu8Yys+kBy/GuY1O14ZyU2wXhzorwAosTeAYwv61kTz2yPhCWcsNnK8KmEdGlUZOl6HcFFKb9/v4pdsWNySTVtqKNQc+wyX4K065rm3FAb9IGlAweozqvMpOebndBINO7+pRI7T1JFh1ZPxVC+pNw0Z+dSLe10fqY9JrEpB8KCSoyT+kllbe69kuI7pep47Y2bUXz3u7P2Gd+9k3mbuGe/UTM32R/0yu1fWTU3EVOvAfkfv9egt22DfEZyAUaY6Q+uye10oVuxtki+bNwIksV9BgjqQyTQcQ/fS7lwOXctFY/qfgmFsFV079m9WZTwXsuZ6vQvXA8n7LBuEa9aFKxeG+soQvsvSlk1+V8LXUoI/FVsc0d9FCv1tMpWCTNM7d+4d0YqtBe1r9tYmWK/wmbdFiiv9dnvy1VvdYGM/TLoGpkfKCaaWZi0ELtFeGF47XDaKX28CCFcYhUVnJNgr7e/Xm3UbubYJpoPUty6yPyHozBHQKKG/aP829YKJae8/rqna4uWqTZHofx8V9jmBjXvyVXZd0Z4I/GBFw6OnMf4abONIXf54na1vAdefqO8a/qY07Dv0OuM8JNUKx6FTTjiN6kQbbLzt2gs/ej81j9UbiRpE7rdYR6b7rvv449Ud+JlEIo8Y/jQGUY7rEnE8AenKybMFiqf/1duKdHBNaJJE63PMaLNqWUU2keb/14ryrwAAAP//UVIv7149AAA="),
-	"font/source-sans-pro.woff":   decompressBase64("H4sIAAAAAAAC/6z7U5BmTdc1Cl/l6nJ12bZtdNm2bduuLqPLNrts27Z1lW37j/t+3uf94t/xHeyDvSJHzoiMWGvlzDnGmEfpLi8mBgABAAAAZ2gA0j/xBRoA8p+V/+cjJqYiCwCA2AAAANx/YDoiSCSvzMAMAICEAwAAdQAAYKDV2RJvbGvoAACAAAEAkHQAABRUJbjs0djNhRAAAPMBAACU/wA+HfBm5mBuCwCAFQEAIKAAAIgzpGYWh7mhswMAAF4GAACg/8H3NwDM3MbTDAAArwUAGBsAAG3Sq7rsTQtTQxMAgM0EAACwAgAA9nfcrAwLC1NDAIAtDQAAEAAAAFJIVTA8C1sXDwCArRUAAKUHAMAaC0rIg61NnewAAI4TAIBkGABQOoJwgGW3sTc2BAC0awEAkL1/QAWKEm5r6OEAAOgmAQAAwn8ABgXCYWdoawoA6DYCAD9PAAAeQQjaS0MHe2cXAMAhCwAAxQcAwKhgjB6kHJxMHQAAp04AACD9D1LA384BgP+c66Rtneq/Mf8Y8t/YUjrkkWVsYOYzyBkYmBwYWB5FFRQY2IcjxDLMhMlEDRBt00xP10xLT9MIwHXL4yUBQ+Vs52xj5mS8oY5g7nqfGe7t9QlWVAgMdGSWiL3FWgAnhDgABUAlw6oC/vmugYFZgAEWpBgk0gvYAVPQKy0cYkDjj2DQeDJQk1vmRaYPc1YTODPuYZeoUwQWWBYoFoyG9soGm/ig8KCkoKyghKDkoCihiH5sMiKyue/vvqNb0EcGwBlM0JmR4DxZJWE+IdiiaURB1Ov387f8N8GrwKv/rcBwz/VX96f32fVtz8hXtdfmd+ttXx/UO/6Fb+15XwJUA8EBL2OX++42V9mEJGS0DVJN8JqMYBCELggYGA0ODxQFBgciA0FPQF58bXhZWmtIUVJjdGVWZ1BBQn3k34z23yUpzbHVOd2B+X/qIsrT20KLk5tiqrK7ggsTG6IqMjvCSlNb4mpy/QUNFBwkLDQ8RExUXGRsdHyEjJSYhcXN3a8XNWFT6DWbjV7Hl6xXKrV7HAdKprRb7DfqrI+7u94RGZOKis13yrV0kiYU/gzMsoXlJwUSNfy8aO/k/q3qnaEMK3jx4fU7KeUpXTxOt83rfrnpvAMLgWXT3+MZX6Hkbdj2d3P38ldORCK5fBp/sOF656tzHK6A5mSvQwEkJTxgBzv+xz+FRwWIAJQAagAAAO6bBQAHcgSAA4UEwIHyA+BA/QBwoPEAONBRAJxHlm48n9wgI1LoCCftAaQEegIdDSpNzUm5EucfhQxBSl54gflqkScECUsLHYeUfBZd+t8GCTHNq8Ms11Mn9w0oAdY/D2xf0PdgE1/eZYA1/oRQr3edkoRZy6rXkp31nM42lfDu86cZJooCkFHcZx49Me3L1/ba45FtdwYYt2ooLvnH91SY1Ip4G3h7eM71EYlTWeEyqwwhnzIGvQwM2R1iiZ2WA8J5fTTUfNTGLqLGOBYP44R3y2dpSefi/k0m3cDJCjaOVCgpjDIVcMz9X5rCwtHUdzzo2Qa8TOBZ2IdGN/IyIpoqknCXvNiViomGMWxZKHw72R0lsGgUbK2vUKRn1gf/2g/I9zcA1COr2OdHAO6Sq8HnWc4p1pTMztaSuqu6q6v7o68qgbPbDB/JVP4cbgYWHgnRD1jNgD7McJBgvEFDnmFMY6FoulpDFNNfmPvgIY040X2YwQAuN5loiO14cBSEEUPJ+mbFme7vivb2Y1B2mrTqsxXVxx3/Z+/vzwILK7tXvjNW3ySrPJmkKO5fisKwFJTC45+YpnmNxUMjZWYwXanTGiKSpcgubUFJTRuVMUECLuE5+drcPkELRNAIpYLP9HSqdp3ZaODLdNCoonQMysonPBaBfquYBVpU42XBM2FAqIyg3Dn5UwYFu3HxLVW8hh9xayKn0lEweWhgWucAN7ha40rUAFgwHUyHyHkF6JyqmOwCF4eS0AaUpGmPoHUXMf6z3bLQQuRYbM6MXYm+ub+4eDCbhTojOZLmkUJ6xP4JsR9r4HcUqLszoASpslOZx25MnkeQ4tt18Lk897Ek0/0TRyRHC3gt7vww39z215y+b6xWrZ9ugoiME3Q7v78y/DKlFDoqWDBuWQfbI7QYe4nlVVj5fx8/bUv+RRYsGNsE6aG5r8W8fbi1p920J+qJuNFGdRCXJH3BsVWrQV8tbvjxSvozSo/UFPZVRylzBi3Sc+xIFK59ODGOFPbP/JGSiVkjT1cwrCPK2BMxiM3ltRxi66laCwcGIikEP5sK5lbT6ccnPFPzAElzab/nHAGDOSS6NsKFfXh92nH/nTc2zyd8ocQniow7Cq5/KD3Z5jfFg7EMaBp8ywc+n6x/sBXYj1WZT8lkBC7eS1xTlVPgCMAfb4APE9gXkKiCFSIOJvMEhowNjZwBUIIkzirSAlbjEolLn4GbcsPO/K7IOvjL+WFFrO5aYT4wnt3rVJEKiY0WqOVlUreenkCeCwtChhwI/UWaPZNs8YJHEM58Yok3WxcSmYC/2yBdKTR+yVB3KvpLQjbt4Sl8ZvKuj7NdDhMxzM2dnP1YU5vwsJH5yaGjx3wWbaqj7TWJwzSttjnqK+Gd5wrc/I6zEOouBz/Jwd0Lnp/fwtw41FlgG/XhheTqQTCNgVRU2YS4t0UcrzBbhwitTkrayMRefAeN67d1gin8Qxy3mADcgWW+XNC2ZaCBSDK8RNocrUW0ptd7ta7/r+psvRyhqQdNKflq20GfIGss3hmhP3MkOLNCz/fzNw6SZxcnJ0n43Ddnf0hqsq5X3ycm6N8P9aA8xSw8I71EfX8jvEjnKAaZh9TeGEqiCh+43eXxJGfO+B+O/lFHHJ05EXnOhz0xB2It/9VhNajmUDiaJybUR42SUTSKjI6di2j3xTvG4l/OsuS34KzRxcX6JU8bmjgNi8iTsEvm9u72wSvgCuL1Z1jGvDwjOd61S3Huk3wWA/aEywL8pEX25V0c/zT4W7Hy4SCxmMJUqySlRVleORec5lQjFAN5JqXhTqTOdFK9qogeTdbaBYEIeBA0wKeuKYU86LXZrVNgXwMrwCL0+RMrHrbWCmwT6QOKtx8XlJa4pidWx9Tzgr92jsLgImV9dcrjwszTBj5at7Jh0lc1M0u1iqmAne5adOOk1JD2EQvVDzL01CuoYBdLQgi03lX9qyvT7+2zBoz+9vB5FrFPznj9sMKdba8D+7wy+Q5zj1br1xDwJ0nzwqoto+siUgPZbDYp3csjq8TC9XTHTf/9k57Hrp58EEfma8hI4sGUcBWiz7n2SKzvuTbiw/vbNAE+v8KT1wTU7+8DbqGwqptbSl+6aGaFIbEwqJWqR2xwHJHaORB2LU5vOLOQHhKgB0vzVYLkqHETmyS737ltbuRz27g4tTtCQe5xJuZ+WynUWBrIiweMRa8SX0VnoR9eA+nGPgpqMBnsF5zYxQIjWaSqcAGKdJkVh1QZ7NrLzQnZ/u5Vzk0eBdq2ot5HGmdj6cDSlU+lEyHLW/kni5EjZ1/x3muwc0T2Md5ZQRMJJgPGzZE9gCEio3zvcNii9fxGykhQaVj6iYjuxluJKVrVrg1EnOaVDRCPvganxgdBd/BjwyrN23JPoRObF8wGceiXW0GhcxQd3xoR/NCkrpJHQAwUBk/BQEIpx4iZMHu3MS8+9THxMMm2SRtQ8nXKlAXDvQQGhJ2C9OKPXZ1zlGqFsziMjwvjISsnF0sEigzjXA+YHweY3/2SZsZt0aRrg81AADsXzGzFmy0kvfXM+NsWXNbsQB1E7FTk9aADrMQvpA2OX4heq4CM3ON76AOQYrbG5qXib+x2C7/6O8TRRuuvrREdEnry6c7N71lhCBfzQ2XGBBSPG3AgRoHY31UsJuZh7gsPOOC5Z2/vNe8uSGL/bzA75j0sZKo+l5q5jX7hVkWydqFL/hkRHprQC20pn8iYDYVXAVmZhJhodJ/AqwiLMlrR6Fwwj7LeZ/s6LV4J7NfcKi9xM9rUTOp6yLAZEeT1EpBnguCPxsCAsnXUgdHh5aEvVLdcwom05k5kGt6UbMysdOijPux1PZO+Pqg/9qLzFX4o3cxlS8hPGdwdXSQ6C6PPVaIMO9kPhNdJnA07mTioysq6XzdAH26Oo2nt4J/q+Ggo0sf56lgtpc9AD8Zme3+nSiC8rA573XMJl6IJSAUn4T0JMBik0ZCkQ6FBqV7UgvDFmIcwmVWUf2bjATv7RreKhD3+7wdga4npZ5PFsdum40LTJ8n8yZ+Z7zU7LrcIwFYOSDtWLzHcUhFzLAFBDU4NItibSffEriSOTwxKd0uxCVY0jdtPQsIY0D8hKCq7ldi/DSBCygoleMtwp+e4cf0OKm7DaDEfzzzS6dJMN8J4T+sFSD/RKFyOULJjJb4IbHyikWFyXHowL+W5ZOVy+9CfNDtDb02MCka3rmPvj5SWMY63vFZm4W1q4q+s5euQp1+TGAj2tZumkalW8Zw5UQ7zWLCOtBqr+JkmIKQkU2mWYO9QIdt9/Jt4qfaUCyOJDURyatGc/94ttkrl0JJCK/uEyqlFESP0Cj2ihNXsafMjFc3hk4MKsf3YO0gdouJtmKGBD1XaaPeglZsPgUUbPJjXs2Y8ponjH/Hg9cWQCdmjGaISMdLYs59jmEJSBksIi+a8G3b4CVYZkEnJNdyKbwSHs0qFM8inIc1al9jrWvsxmKEVxA5W/xpPdyI+4u+1HyNQNZkXFRsjY9a0NvbH2Kn6oDRHkKEgN1140XrR0OPOehHvnBcnp4wXR4xoRgz+lXm8VKEbpEsjvQ9RfESx8muXddD0Ol9+4RvPpkcitKLzh5EauKlamkOAoAYyOOSLiTIqtaW6Ny2dci7I9oLFXaQfeeJRRxoqh27a5iWSyHbl1qU7N0Tf6YMAXcr9mMg83tVNm3Hg4iamvkRpPZ4QnXQRleWrT0xYN7KLJbrQm7IKRqy7BD5Ff3pglu1vFh6u+72ylCxDGBPgBamCReTduJ51sgm0652RuQPMCmUVtNCBRq7loBdK4/quNNaYEAW8zlNpw35ujjQkj/jHn1jI9bUJaeGrC4lNtOUFOtE5nnOJCATDl6C+QOtnV7IS+jNZufdL57BmHzd5ZLbQruaQqCXvqm1Ue6+rXt9c6tLjmvX5Hv9eApi6vRMFDzPCcc6BGZ2rMXmTdkS7CHTEzfra18RE5FI2EjL3bc7cWC8fdxZ0+g141nlAvsabWjwIioLkbi7+vbx+Kt0SMQGEQT8gMYuODSijNRZzwMIFHt/gTSrkJpxFGKysPUacJ+1rNl3G5mC2M/GtlePterokuuiU56EoPiI6BAY2M9uS0yHSHMYDaAEAzQIBlBKXOmja2CtjlvJ7H/pjaqGLkki8gfUJ9knIIwyrCNBiuAq7LFn7kKZRClF8SEcOcJq1oXBD8bJ8a7cmbiVR9te42TAP19RfBzVH7PIyWd9jbcW697lF9mxhXkbpVZC2EwcGXqyXgv6LmrRFfnqezbyzC7f+B5sffOvwnlTyrpWRt9b3BkwByeWbBpXDCpXYt1qolguqManExk5XaVHy+PTu1wbK1U6sbHo39W11Kl4h0hHv48XCKEys7Y/roSq/J7xfgLPdOfNfuwoS8T/XDj5cNdQZq5wFz2UFRz9+J22HgGSazCwI1RZaqanejjCpqEiYrTvuZIsPJOZuOl2cmN7YR3c/rnJQ1ORIw/ntsuRyOV19I+GA7xeoG26iWxkNKGoz5on+4W8yyw+21oK2dy3/O2OraGwEbe+R4NjnCNX+9y5q5xPBlHpltgWhh/EuE1kK791c5AmKHbeDH0uLy+0+ss6aJAOcefvaXwAsuItbxzP9750A+Afi8lHb7++m8LKc785+f50IS4UCHnYy6fvfzH/CkhaKRkWNNoY+g3nWkhoYMrOtnuuGbD3zbT73pDfzv7phKfbrDSS2O6XLYH5P+XwrHNryi5kEz0ZM/8S3/YLm5f5x8NephItMWSY2osmcMSzAjJMiRabzRz3pC6rbZN7khc2hKKHGIZ4Jl/uPcfWcxJHi2IFW7Lx1sX0rRsHgEbKZEPMpSkhwRf4b2dyw9xgk9MroLatnmhK5mPylD/B4khK9TUt7jLs2TW0bSl/XY9mCWY7ffipjIiPrbcIwtuOMYudQqxR0kBYCo1nUEFJCfNhXtmRHqINyt4PoWZwS3FF5c2sGkdgmWl5f2VvyupFUT5TrH+/jI3Ob8OZ/mX2xbyzLpjzh+Zv2grJaxjcBfOm/usfpBLvFxf++/fKHzDks4q/bbUhK6oJ0MAposLNjg+f+gWpH6uX8U0KPj6VvKeZgEkbejfq+KUB+6IN1F1WqFJm1AFCC9dJx3d7I7nnZXcVdxe5ve9aepbbACqLSejyMM6cXnyV8GzdiX/FBltEDL0ft/3ea5NfH6DCvEmdtjrKmeH+Tw3zRpPATB8w0Eo9xuVrd1td0uFrhRvsnB4lWlR0N0BN4dLGLmwEi0+Op/GRQfZfaC2KDMw21YRDYKtLUFVnOVp1oOsrFpgOa0if9spJ3JOgUQlwNIzrrcQpMkTCZ6FM6BWqQMrvVohL84cW3JK3G1V6IWrHdekt/NbH6YknUrtGWsUeIuWB4vMCBg4Sqk8X8QcXfD+myKJzTVjdUabkoqZOpDhq0jFptrcL3u2v73CjV8Cft34UXR0NxTSQvDFj1Sd5uXzO3K6E3NWsjaSKOHFe1bfFC3wGSoXECSqEW3QfTEDsuq8pIeQAiQdlAaXdCLoilgQTjmdJ9Y0BdZDqsjfLPDXIjEWtUEdJllMQMNE9kJpQhhkXkP7hzPZj1F9MVq/1Ca/pknDsNfCOT6Bed+DHJMwTwoWIzDDSNfM9x8JlHUK6hoTTiFQcEbT7dq7hx7FYVfulgGRyKsd+pL5UlLk638rG9j5Heg6GgAVRGFUj4lr2u6X8D4w9fhlAWFlAwSDbmaMxzZqYfX0ohv3DpUrzfnY67w2ySW0606W4NLY1Sy++wl+soV9f9IfQk2AjyK8sXa2WMBb1Mp0bliiN8VmM4H/pqCXWM9FWppPKpb9HkxedVCf6WlBA5ptaKn+Gw1RmaRUiG4XbvaRScB8Tf2MmhQ9HqjLZvJMKv+mQvtOXTGOkjkLfPy/VZ2kbY3IVEBRrYjydDo2roSXF6lBZTbZ5FoYLt5Q0e/fSww3/xIPDIq1gCWPCitdvZRRoT4eYtYYsF63weOqrcrC+c+PPxSdtj4fM/h+P6TwZvU0T4Xm4rZCbBqMAbDAEqZER0lzzUIZrPGnuxJ2c4iS9xq3flIcY3GtZemUPmk2dcZIttmx9UMNq7nbaWYaE03IBCOm+koTjwwHmxRz+NhxZILjLYvDL2fvZEk1tiMWoiRiC24W38mu1cBl+JsLP5NBhcrBveyQXvbOiTIUVM058Cf8T9u0U0Al+dUMhzcTnwXgOA8kA0xfA6gIPpYBJMXf2w57ct3zY2ly3IyoANgVUzu7U2Lh3PiHszt1bT1JGssDIHweqOjcU8e3rG9AbiQnuFw698B8q46ZrN5PdWH0j2r6j8KaKXHieu3RYQ/m2yD7VPpH6PgibHRaiXhXVQaj3pX4PGOeA4FgpnFegxg6P3lCSSxsiLH9Ieu7u6UbbRvypamqYZfK5vdb03NsWqtpfazUGm8nKMZaJNjSfrxveTr/1WiDy7EvY7RORSU3f30Xo3FBJjEEe82TIgNYvVCc1Rct5297LmrOczPn6vvRJMq83axkH8Du7b7TVOjqJJM9MA+Y4ZPLGK0fnxuvxiV/DTqSqMmKZ6iZpvuZ+435UTXFEOlE4tR2yn+oNrXfd0ej52d7KN48DD3PIV1KQgF7TJiLINZlbiDIRGXV7mpuWha4LhG/9T5Qw3O2jnYcJ77gKqxl+DlPSkQ0EaQ/OLsO4UYlF+sY6xKDC6mKxwtw4TJXY39ENd4PFmIOxE6uzz/UZjazT4K4Mf5sGhagmDDS2QlicU3OB1hn2Haz11zuxd7LQw2Zqgffd3GBCrasI/elvDT0YTp2ARGcfGB79L8Uc0alSRnQviVnDJiXkM95mmSd7Buh3F6qv4XEfRdqFVZf95SUxos1mTA5IFoVAUl6b2MKfkGhAULJAnWWrux7jZYEXjC1yDcjyYC/SPCHO1CnHyX+UjqJL5xWkr1tw9HzFm5Acxtmae393VQ+W/8OhspJOabSfAQ+pnWWjWarIzWJgce2Ia3FZJMC4rPzoTY6JjxcB43ZUTt2j6dUoNq/3zckEI0Aqq9eMaHpEdbO+ksrvZX7WcA3FBcNBi2BJa1auFGU0SxIxdk6FqnCEWI8JQm9fVVC6Eie0wy1IemURdFhZYBbAkY8yyvqZvy/xZSuce5Z1Nw9Rh1h+6o3usQ/yOoGBpTZh4NqTfISEIMrtnB1pZCiMc/MeXGN6LSSffiYC361qitgkEahWUfgZVxFhn2dLFYVnI3pGHGwPRujbHoQje8Tvo8jNP0NdPF6fPsDLbew7mYQVzfdGZTegaPT32iH/6UIHPsMAoNPC9N3gmlU6xGMXxs1g33ecl8B5WSmRfMFNyH6YBG1wTPa8mr8UitThsq0MZ02ri5RXVBEp5ATIARwGZHA9L2UKtcZ0uxhT881tHVzcCg569Z4u1vH/veQaXa3XXAAqX02M//7Z+nw0W6f3Qh0fKiU7AevOuz7muHs1HP6Ujx4fR4J1GHEsv2uvfGpszD64S1TTUQTQtaJfEvpwxCyfn4eLyLIs0AvT2TVqO9KnJZDCL5s1dqdCsdfeO5wdYnWebadvt9gkzao0uoZ15T2NtWyNxhRQqBp+k09zABPsdB0iY4MlzkTpmFgvXn7JObcdwX79xHOaxwEfrwxf1ey3QD4sNIck2c/qCoUrf38PYWZBRfIXP5wcFTTnaWxYZmZq7NMXPoiNMEbrgH9h+XbRL6Db+jdQdsIcG+102rPWxDt+DiMztXvoXrY6QEBVkkBgQ8VOCQ5A0QAKwVqBzj8lGV2NkzEU0oc0EDgMPIl5NyNCHakXD5AZ5RKZODuH0Z8rqWqjpkCGqXfD348VdnV8+ic1Miflj59whJvytWenOHtLaheAs9cn3XUDEbdF70FF8+RTuKP3U1Ku3uxtp88EawUZQZR0P+jl7fZ8lk6UTFK1faknfz0Ofy+hmURPfMfYqV0L8K1lOF3hT5WmKFOPYoxL0luRbdO8PPsmWsVyxRDH7Sd2TM+pWYz2S0++AuNTwbnoFHKwpNjPX4hELlfHWtP0Yq/4SRQyOihLkd9DEznqDnyWlbP2trEzMePJh3MELBq8IN+Vd0skLlMyLKDBqVyCB2AG/vjO+aIDZIEYxyacq8B1vWc3rPUbl18OAoZ7hvnuEl3MonM6hCU6N5T4PRBWdQXv67896iWGmAHQM9deEdc5IKdY9R0JnYlkJ1XNqqDcPx6J1G9W4vkmXZzPyWTMrt0YIXMVomIH7k0fn71bT/PL8IstR8pMZ1T9/7w5cgZK6W1JUyr4AeX6nrci/FdqaDltSi2shp7YZGO7b76ux2m52hbnlHsiPJofJHAJfmlKnyF5xb969I7Uldk3bN02CI+BvCxEmiWjj8vLnmU3cutKJW0kwCzNZHVtWRdJatt6BSkcy3zi31u5+abjLAGulKLY62U8t6Koj7KlxrBR1wc9JY4O30fraswuc5CR1OUzf8RAHm2g2v1DWc6sRyxvCTY3OLhtoWdJhdvkRor7s2FIgWBc/bRHzbZkK2m3dON94YIEKX8irdvm9wNuHtVerHB6c/Mpfmfq/SS7ZStSJmvlJAT2MigtSQY8wxSq+2/YgK2hsdWz4hd3pi+XcUIbJ+okxJo6qqhRYtLbSpM3JQUv7uHrdj4dBy2d/Qoi+vvj4trzfHG7mYkiQHcbnThSW4f/8EDUS+fEJctMIlmNFW/BShZ0jNa4ERvkYPOy6UosN1INFJuYJyFh6gy6s1mpTUYQpa0A39Q9CQU2/aGJ0FI1eLetAvA/YwZaOKTlkdOyVvydjE3tIBvvzfnLd5N2PROaWNI2D2lm9rRV/cnyAKSPUQ1hPqrBiO0ogqpahFqYTcw5uygmZSMKQVTYxp8H2xexT9CJNbKs+BL9fo5djd5Y7V28897jHkCKbk8nZvUVXHdlj0qVJfNv3IL6SuaTWp9IAYW5KxlSp8LO4utgi3Jl0nHlYraKPAwiOpimBzh5j1bSmdIilEwe61Yp/M7ZoZcvOm1Fl9/L41pCQFENoqGwR3lFvU2Re0b1MXq1uZWyV3ExkR5gjp+1rWEGD4chUl5CWdRM+DZoand5VMJUc154+KEjbQqpzsbeXNWTMws7G4rM5EuTjKDO9F+4lIxeVb5mYLf/e1caIYmT6fhDgCT+HEUyOaCtx7OUcYivXkHFtPG8FtGAz+kFShHZnKvJGL1vPShlo63d98lKSf15zXGi7rVbIBhHIUzNCtDvFgaiyLWvSa3imhTtnN0tnBefgBdKlZ8+yUusV077i0RO7z4plrcszNWi8h4/nuqWk5MC25BTxsSoDZ0OGDPedxsoVpFfTybpIMjxrNMSGL3etm3C6EumdDFhpYutk05zDjtFRHdDWhLXO3ljqb7TQvqDraOarxRDz52Gkhi4EMQm9gXyBhNxFxnS3hb8GG1bFfAWJdTOFj4NCS5AFE9ZBqN5VRH1GtvaIXZsj75LbMLHBz8S2Z2ezzHyQJUn09zFoXZuFm5UN9lDrQe/dRHz5o8x/jgGcDMUO6TNwkCjrlLeoDIsLM6i2tvYgMHjkL/q9IjSO7/EJS3WuL1pXLVNifGblMdLTvrqyZzHQebL+tVQYRWJL39U8Nbfe03U3bGg9CPNN8NXi37OI37JmNFkcqUNbGq5Tu3jzFw/Z2qmGjXOFjMfWITgaXzhaFMvyrLxqBUk90bKeXfz+dwrF8VML5y+zysyjUnzb/b3LX4mlSx4kW7PJ0Hn66l8cHHvH4lyLbldbglS7VhYniY6PfSOpICtNj93YVgRV2xSUj/wEBV8/YhiUy8qdrHF1uZBqfPwE8SdqFt+ZkZyXzwxKa20Hi+E5Wah9hSjKGXFjTO7g2YtvS7tD0fZu6E1Ihuern/4AGX4yk7Vzs2HLJg5dh7BWlrc10OzGtuLw4R5buogjFK9TbKabzFs6bDxydU0VpqJHolyWW5Bz0ZgWmdk609NNkbPESyp1pOCU85zsAOi0JBgw6Y6JDCjdXN3mezx3iFbQFHEmJZWOk4hY6gbSQ7Nui+5hZyxRcD3T803gJaa4DiHyO+vRQzbubaXgxfWqJtxFNuHtYoPjwmmuYhrejgSXx7QzDXK80AFh0G+nuHmIib0npeudCRo68auo46eXdsEs6ZOtomMbKQmeyILQfHHdMON0oNuyMFt1x1DKjs3rorNOYr8ZPXRFYx3PLrjdckHcBY5lRYXYvUeTKnnE8U7LopzrsgTwiMRhruWxi+WCCXTt/ZWUbf0TEH8Q7ohaV31/hdQLjAV6z+H2xxaTuSSve4gp8030CMkv6IOuwQlVu8NgMI6BblLhthiiiL/9NC1/pPGGYO+ru4zuhIwwD5NIuA4GWzMQ1qmvr4LsrLcAzRaOGdpWGnleU2QKa3DXN5Rmxbg27V8OiZrQnKOxhf0Eg7Wdou85AkLA912t+di186y+3AY37uSwbJyOM63bkjfODEBdDcUpR1Xs/fg8C0g+wS0eRmC91QtM6sSgzhvcZs69eUqzKvozDW7ro7ddCYKcoUJjoyIM1zQI0h7sp9GmQm3FBgU5mSnDuU0f3Taf0tgza2jR/c6uNeApLlafw4fn1cJfeujVemKwjravFN3CMOLqeOnVQiRwsTDuGRLFHa5XgCL46FMloOg4knlzViYRqZxG1kqaQjS6J8dXCWf0h0i1iLgwUdHYxaQwtkw7W6bVSxY9qpyDP42+IXvSoHPGpCnjbS9YhmIjE/ui2cExjGRYk1Zncjcg7eSJv0QZqT3ta9Bh1ghf7oFFN2h27IHsN23K7Kz1tkyv0Q+TznFFTQphhoWVIF1upexQpaClmljhh3Nw6VbTQNWPEmWuAfZurVZX6r1jleYKriR2o7C6jJaM6VFXz+LLMjs0KHfNP110rafQ35b0Gay1YlURbDLT2+4TXc/bPJ+X9v7v0s/zK9sziND9AH//w/fy7oe5wtNu1P7PLraOEmVa7WCrNUwJvL52w3e1MXmV+ZPoyZXBu+c55QewvHISEwiImpiQiFh46hQEEwRY3FOn4ZKJeqMVraqMZqdjLVlmRYZzWPe4Fw36TRbmK8qbOYMiVqXIIiGvQpJlMlR0H01zc86s8IfoJ5F5ouAx4uaweZQLlDek67h7/Aami31j3CgEbd1fqTzAx2GvhFP6DkkXzwYQ7XB5Tl7DZZS7+rkck84VM7gOnfKAqRlgU2rmqaML1GeeonhXWWWXRdnkXc9CXJBuH9ctksaLskrp+lxGL5+ZSDRK0U7H+tYs1E6W+ZLJvrCTkVmT2rLmiozdbmR39wSGc9vQxPtbtcZo8Z4xFDc3QvRQxUjca1GgYOMEkQZJ9Z6gfqD0RleOEvFi7mNe4G1FM0XiRg12bqsXf3JGj1fB940IK7dja8/1jQcps18rA3uW6y3CnBXO+Vmug1YBwjadbcp7anC4mKLX8qbEJ91rTt7bXPIJsnG4V+jFl3cot1T5e90sUZqn60yiml7TPhHdweeJh/+h9YmC6aukx+96AZicCNu8FCPBJPti/8pFHC+JZ1/60IpWj+Dci+ADtk9on/ucoT7a5iDNPK8QSmX0pHJBAypOsx9wO+l9TQYKWfTpYATz5YWKMJFI94FiaA1O16fyQ786CXkoI4VfOEnfIg1//8Dw1fllglmHhH+coKbMTCVCDMbEsv/LlJ0DOy7uaPWMaVSkA1QII40AaUtQPemXrE3vhXUWFv5X2o4Bfq9LV7aeiH6ePVhkd171s+isI+nsSCA9f4G5mivDBXmnskTJdQYX2t8li/dcHpSgJWrSrCUSgpi7m7DYzFfUc9nXKTP/8YkSDxYDAhnyYKGE6HMoownvcziV97/dqWiZTur2LYkY1loVP/Lab+Wg8vPqX6+QXTqZg+eLe14z593fpeqjQ+d+LFasGS0r1gyMUkDLCCLmMQd+aoV2CT7nWY9lnDjDxDhc1uN9whmpQfrsZcDVZlxo7loLN1G2kw+hYGUyxtAY4XFOFK7lU2ayssnUSDwMhow17dOkUUwg5rMUMcy/gGT/aSIn6vycJrVApXclQmk3c3PrrvUmRD3RHh061Z5xpDyflHd3sU28SLZknuEvypfLm+nbvPUDBwv09cdCAnE6L8slxCFbsMU/uWsuAV/5bJAiYTg5J51byU24hphdmfnp65t8cl6hoQ+y3fGB/4mwNvM+M/FdcHG8JVntAear7MnffeReUY1FvtIZi69cjQzMeB5j61F7hvBX85wGi8QtTe2QnDLp8oWR1cip5cxUu1QWdOtq3obeq9WnWRYPKmvrC+egLmLxoUistlMBTq4XWzmEc9YrEx3CtHhvMRF1TctB8ELNh9WMZQirhBt8McBMqQ/sbAK+lmFai/p39EDky27c1XoRK252kMpLiEBLWeGL4hbFdJWLVzLMihA1kSyUMcDXk4TEG9b/bhXZTVoqWxlqiK+P9oPE6vwVNTH5iQ0P3UIOzQ2i2tE+p974KoQ4pfp1EPoJawGypep5H8MheDGMSNC9tzi6Hzfhu83Ys+kE97w18NFv4vcdeTTWKbnDla+vkt9irvuSidO7o/XvflA5v56h2/ipBHLb9fzsQXR2NKD8lX8zfIMA6VxvWlBf3jUKxu5NX3gwEYoOd41hT3ncVgrcTFPNYgSapoP/PjvRmIys5WY+rO2uyzTFNkv++L6RE6PY7tPY+giwtCt/et4nrvhxKin/vdLdLHt6u9Oe/PCYK5tdOHLI77+IsUAc+3c+Qg6pLQm1DZfcYf5d6ihb+YWxm/5jCh5oXxUgTf1xiXYTAuMPNfBrqWGx6cRxHfILQjyrdS+WZJq5X7v38HNnreMqcufxkdPH77P6/Zo/o/X9anCuA+V5k2TEaO1w5WTgIdihJUZKmaNyvUJ9PwADrLNeSMHhJ4XqpZUou5wbxu6j16vMdkup+rrhQcUtteAjt14oxGJD0Z31J8IMKswYCESwVWC1nEqhdto+lrk99HNFRrSfJS8/tefHSZzP1+hqX85mgYIcgvNLp0ufBNcrFUZUZuCCsk5zQEfgM0IBwyA9zpBuPy8HyipWT1BjdUrLj1UCYI5Yf65caO5nfa4GhL8z2Is2DfAH2lcsXKr33Ogjmai/n7Dqu4lt0cqO5rmW5j6KdRXXXY4yX4SJhdKAyluHFd8Y58ffJ9IZnbpmqlcjaydnIo4/rpu3TtejEiAY0GPc5HSz/rCe34GqjxXr3GbIYJHn9FR71JmJ80hEu+GY0OPuuGrj5k7lNBXgD6HU/U5mDeylitpgMv0PIymDOWVTJB6rrajml3PVBi6zIx2k7NhkSBzXESKwuguNZqdK5FnPv+9uwyf6uHQDhdJoQrAwFixbirnYDxydfvTlxlK0KZgZ1c9LeYh5DZyYB3LUh/mhN1XB+tuBvZyi3exTf7yi3UCpvkTRABnKb1gwEVChOD/RX3z4lmA1C97Y09iK5aAgOc8AmnCjy0wSitago387AtZ3sduUy/hUp6B8bgg9KaoZyKyd4zXUNrvnhacdpPgkpn0c2IlrDMZ+if2M8OwSU9YGqLU7sTZBi6jsITVBVP/S0/g0Igfm7mWy50/b/ly43NGmhMNZtV0bVBjPL/9A4hqAyJ29enLaX8uxlH/6ULHcoHNnZyKy90gqLq52s2y4kmAlhKT1jENx7yEK41OSKY3SedaZNkZI3kMYXjR8RAeLOLDoWed0N7CxMlNpvpYMhycQugZFFPuB6BcUDfPB90C7e4suSp6xEirQAvLxAPMSTP0hChHrjvmB6PSpDLAvyunSGoaqhHw0w/vgwur+8Obs1IcSqOrdATss/kd8UeoTjsb4w5/mb6bQsuvWfmr9e4RLli5VOHL0lE3xb1bYzKqbfX1/P4GcHC2B/rL7sXkNd3UOYlu79MsZh7SqHbobmwjzIORZlByr9iY35p/kIS9+ArmwGhdGTw7PrTXG1uTca3jASOPb2VppfTjfMUfdah5UzCM7x8aGtinHrlK6K9fBjo/8nLRghCfcjnrv7/DRx0mMonT62PtUt1B28bd+nWlsd/vY0cakd/da/mnKN0wFzzttoQnN0JbfrrsztgCBLlA/nVCBHtCvPJhUGiLsUfhnITtKkGSscEOPUSkiH3D/CFvPwoxc7vO7Ba3c6BK7xDrouYNrzFhjzFz7BB9mOHdESYTcW27MmlRHDxqGUfFrbTJmt5/18TsFzh6h+Lw17V/YfaBI5SxEjzUoBUBSIRnuKhE95HFhGxERWdUoKZxV7pyObcd9rYMBo75UDFsE99qtVMQ1LFSH+tYwQ2t3co14eeFCG/ZabRdzSBIwKqaLaThRyITRoSkMBxrhkszL/SOY1o6+0wvDH1Vj3BS7UwihqcP/zewrD8ZfMTCRjdLpM+ED90xX6jKXkK/Z6DIGylqtNOnMWXY3ia3l8Q367xayrFUYSbB3s7rrNPPrEh/yQjgOYimHuaR0yOxMYTId8ox191Mwlnrt+s9dxykfW1H5EO4RqCWubokjiTeosvfZ8/MLYx0WYVeC/QdWuS/dEDGkNqtOpI20X+vH7BdBNWjiB4C+/MpQQ88Fyo5ah6KdIrfG8U/SKvMDxgK7AdpyuPUOwtlnjqPYT6z2TBN1HVOzi7vT1aeKapnSmk6YGN97zDXpzLQOr7v1mQVZcdRPD/mBxO5sO6uA9iQtrN3Fo41YkMsm0AgdHfayIQsCjTG3eQ7Pn8zKBGTIH8JAZTIStyHV7zXpaSEH9jyP+WQ02ewCdhyPBbGmuSbF55FlweXzKcjKpX2bPG/q7e6DO1r0nwOWpSV34E5ikfz5KB8nJs3GYwuOqj7WLYI7+lapzSfbukt4qENvqmdXJ9qHwt48/JfXGw0YHXLx6don+C405RTZKeeSAO+0pwLY01QJUuVQvHyo2ZXTfdXz8EOrVxoXxCVxAe2ea8E/kWoy5i7s2XMS0TcRlzzjDw36CdeeuFevg+PeqJo2VVeqs9CiEfTXq3LPlM86UM8+CFdzS9wYyd3Ry0t4clVO+pCAmfFL8BhSlj4LoJnrNzDnCuRSkCOvwZnC8+nwAfAo+sZ54r/j9bIo7UbzVI3+kRKJlrl2AGoAK1FX0k4crp9KzgB9FsJgNfAL9tit0Ssj8WXx2K2xj4ov/oRJKzrhg2rmHtLrT3tGd0xlzXgmisnP9ZCK732Bkw1uB4Lj0lPHM/KEZ6YmbiW+4ZjxqECt5BRUFrHKaRBJ1+ONS7uWraPbD6qPNWAW/vPqjeUl4vn3XVdj6rpsj8hwo8JdtdRHCYJOx7BmheVQZq1V04oEPUafv1rcCIx/o3qkWMOahQqMl65czcrp3fh9tz8XJHL5b+Y/pfvuJLulLQYdIPIYZKZ14NfNvb8iya5doGMdthdQpwPYDzM5c7MbWiyJ4aOXrKwT9smLzRC5r0vbGbNvgpSknmZod3PrlrsKRUST3qZ/QebsJkQVO9XSPIuNbfBpiPwtMWpOo4gq4qCA8aYXpO9TbWSeJqrrSFuyrFpjZ2Kf2xd1oU9hsNsraG4um+PbfFeZvZj+fZwhjJ8aDCxfpJb03ZA1hI1wrNoxPF/Ps9MvvCfpNlrcDUf3XTJmTY8WU6YSYalz4tSJZVoqXExleHhCv/NN+oht1s8X/2N8ybPeCy4+S5csTywe5oT3mYujK97jQF+hsxKInyc3RxHgbzv+VYz+yk2oTHfWd8CLBmOJXdWZensXivuJZu4N15ZFz451rRdvGkRdeK0gP1uaZN5E+Z44+vf9L8ZcsvAv2eCYe+bKnjRh/2ObOJLUgUPZoY7jrvpLagZ9Ib5fusbLigyKi20U0HAOomGnKMNIj857NP6xOzsRNaI7v1LnSwFfPwN7VEIZ9IPoi6uZIu9YDvRvGkbbd1IVUd5ob5BWnNgVnfzdHNFGrkz6+RvWMMxupFGmukdTZXU2L+WA9yEbeMcHz5ekZX5nGCfI88nBrvmKfmo5qKE/OaCqW4lvpE7przlivUy40YBvK2fVqer0t+N1shT8DqE3rzOw1/4D3uqn9K9vh6KjrF+C/sZ47xVV6zZ4CbP6eXEt6lcWxXHZ2vGWmKIUs6jlDT1EK2u3GYyDWvZz+nl3TXywkblLNc0TpCx91iGSBd6Sh1OmPw7pSl4weWl62ljL3JK1ksj05WybU6MQfq217yV/KNDvxEQ6KM0vLsO7JsTYN67zSugtjFyhdw3NoKOgbP052qYPaffKLDxMlzGaT64zbfuyrUqWgshP/a62LUClbW8eYvG5nq5Oa17b3DSxieRwuby6p3hJitrhyJ07zWTXzFhw9gYL6jwKMzKZsufbuklU+FL4cewOszUVs1gTvg0r2PHDJdNqBEfZulXxe/xXG/qUjYGFPJzdBjsnIx8fklSpsBH3ltdu4GcY4rWAQ36jq5OTkZGTE02fKz7/jhhH0MrdSpxGRfqEseJSE80d6K/dQC5+u6nHAp3GOaMyHdGDbKGugA7YPcLN2vqSSBX4S/5+a9BA1bZBh3lk9CxF5XAsaPeY1aHO6x7VbVixNxgqb8ppHnD/xpaDscONNuJ0qBJdrOE4JTIpvwVcLH3PFVpJT/Y/QVaXaA1NshKtIvOVF11VKeVCQt1SU28YIMtSqYlHlXHST24Son8awmQLKij2ZF6qiBgY1oQTKLpCuWYuYV8+hUgvHl+21ARoTrYKlCVOM5kLeI5Yhj2AnqjxuaBvHADifdRYGjV2ubIahIrLlFJVqKU81tOTJD7xqvHIwl04JkRQvx5ky0BKKfbTSrXMy9eR6emwa5zLTuOXAtv3BAyd3tx3upNv8xMChQTyiicHy/Cu3tu5R2lY4YwLMoepe4epxzO2RqMZWqSU9DD0lBMCoPUXdHUbVnXfu/ghfHPaJidwNnvIt/ZmsgDXZavT3eoaAK7rsEMWHLwK6Rv0hfyIFgPuDoM6LSc77t2jNcbyd6Ks6gB+uP54IBrZ6lMNSc9Vm6tFuqbFOhH+VnCL65OkcOnEqdgLCHQjl3aafMB1TSTPPzhfwmP9JjCcdz4wUO9el0wT2StSXrSb2v1WykKdPh5AjxsJ2i9SLpfgzEYQVwJpSbz1wCz17uIcE3m7ri1r+VDfL/qGhSZeafv3Do6JgYHBwWbxQj0HVc7OEU0w7z1sHvRA+uVEXP/J95/vtTS09IZAHQRI8nhKQFobAtr/vNN9e80X73MrcOfP2kAMpgAO0QkKqIiFcgN4ZNkm9lgrK6KO9Bxv5hMviePZLMbrM/wuWidfZl23sCySCKOmQ2xslOqTxC1KNIByrTshDAICKc3iFdpfoMoKod1O84KFKU6LIKU+f6xcd1xjCVkCB9qeN72v+J7ffZkyHQTaMEU74+Jp6SVhWeZ/y/89YvXp+g3nx6QuJghzB+pafZhGM0GVhDkzBvrE7J4lk8jLJfM2SqQUsSJVhHnl3sRbKAwLwRKTmBE4B5u2wlJ25iqGeH+XfAt5HMd5RYQjcQ6+j3EkkAB1gLWC48g744EEliGolMpUAckINVsDKs+vXSHOOOpOW3v8GW257a/WPFtBxaueVz3yMu2OaUQ74Q4niU/1GvHrCd0AB+XFUGCMI5YOlOW3oG+C0zsYXe0RzHmmhFQAuHWJSbZ5DRFRjSfBiI91xvPR7PDSy5eP+Dv78KhEpX7PxsLRFhXvomxWPBdusuoTgZXY5SHZTnK/crrT+pEJsugeS8uwHmiVyJfDV8qrZttYS2WB2VO+YSSpZK52GeeWe99i7YjnrqjeRxh/wKRE3Kfw15muCQ//RYAvPAsCD0O7g55MfUkfv/CWe9/DrXYhxnQY/YkwQ7nxxhe5dsaQxEgYXkh7K1PXyfDrT6+uq2mYl0/VqaIKTxm9P+8BvkLb8d4H6Jrk0kWSalkE+SU4lOnRC15J0WEKK4rMV2WzGHHdnmW79dmFGGD5PVf+qFO+x/IX+q3T99eriE/Nuo3SzZbqXyCD0AOsAIBHFtmmto2uut/OLQqrbIYKSzDy4+xBPgu8jiZYR3LMfKPJFrNvvGUkCg47VcRPUQgIyFNl+FMiBnqDPH51XNST4F4cjw0svRlX5ipPBPlyWMnzAzVSWyEpIhyqU1bZbBPmFnJLZlfHt51c/kn3lOe187v3zxU978uubxXyxNc6maMte3O/C+eeTyny+2d/O4F7lPTSLgEYfAh/5vuh7R74p92xbXtwhgd+WL1rX4o0V9IwT1oDZOk+Yy3OktD/+/Sic+SS9do2uZHuzqw/+WFiT+UB1zP7fghc2za5ieE8jkp37nK78bn2vDGy5+Jcfc8EanOG2Jv7sXMW+KCNePnjdq55o+Nef2o6CFnftwZEtYZEpFScLdfGwtvskTfXxmiv9tluzq3ju+F5zldA5D6VBIeNiyvy42N/m9/oXMsHHQ907e8aanWveiN1otLhxt2Wjtg/+mHK7HkQ1Lx5LI1U8Os93v6NU/ftPNtX/f92SW4Q9/0TeLrNEZzqnQsJ5f1N94KOsOtwXRPcE/+aDtzg3HtRxZ34ULkRe3AlPjJf10K41+glTnHQZKBpjbRujcXR6Pv7ZK0xAsl+myl2V/FC9/HsiJYN7T+vr706THcBZN/mWId6QSIw5PD7VBL5dg/CMJ72oXr48mCMuEvF+67f13f7mIjtQar+8ryh9GA2m/OHwR9gePAjexHKcmZ2publTffEencEzPymoDCO/ST0oDfbH5sMtXg1eUhIYRC9kDPrPsuimSZw/hC7Fu/LHN9nSpQf7pSacCeJpWAhCPGmYDE9lt9DlduHm97DnT73BcFw/IFiu72yAuYTHucwUuP9osEj77UFXNAIlRvGlZ4QV/Rj8nlxu3G5fu9jcfyKyn9bALZZHIuqn0SptWGU+zGplB7WWdyLYuvDxVi+ostx3v0aPBcl8ZmT0GNNUE7f330qd3jvgdIfXlA8V/HISYG6kXnwox6+2QLiWGVH4ctjjVGRcKGRvG4AyVzDCmuUswTQpkvF7ajjaOQpe+LcH/w47l+BUExQcqKo8/gUjjCljpDmjgjHTbX/surP5n83zvWt/258eYEzAMIfta//4zsXSp4yN1pL/4e8n4AHu4svWWOp+LQ4bffP9R6MzpMXsk68Ln8y1E8RxvNJ3KO6AFFaw59NepEdGjyrYRRe30we9c/6waeqNwkVwGLMAQao92Som+j2Y6OvniSuOAxiJYYhiW68Tlxqvcil07C+FgfosOz76vbr3fD9wnH15Boq/REm363wtRelQSY+5mofGlxvqoL0FPvzW9+e7RjX3eJws3W5lMj/+bPmeyJn6AJncANn2ARqFZC6epfbzgCiwqvGEA9nddB+iO+iWONTD9rPZjIztuU/6umUP/03b6jnG5QqPvb1aq2ZvdEJpsIvTg/m6qTUqYLUKl2GjiA9hJEvPcaKrAFe9NrwYuBpGkUu5PuNyzXjvVRl7s4P/AEG4G34EE1d5JctY3ZXzSDtWwNwYjUOLK2armbQo5vAR/hV55oh+Hip/eQlz227n8hno4ZkXN2R1qyfhkl4Ht0Oe6C1vcWh/8+Gn6/+Fo/Yy69r92BNcae0EVD0uhDTwuxBqqxhrKwhrqyVCVD6vZ/kYkps69jLdYGym4PozY6wPK0xz01PAII9ks18R0QCHMmV1/ckfGCihoB+xBL/IRH+bsPsi9WgI92//pPat9+1A9eN9H6gvj994x5U2n+sThJL9Y8aROJqxHCj+jiMZL3Z9l4Igo69e/A7yz6WWhe6sOc+Jv7xJ3Wjj5rhjBtNh8E/FZzoWnpNXj3YTJ/BQA8dzRox1oW793H36Bu7iz69GIuSSWzz/+i61mbyf2tz30gLJKY3FFJc4vxX4I4o/5V06RUI/6B4q/A+Pj97QvX98ylfL0WXPUTu3j8JOJTs4BX9M9hWrxHmr9ev3aFO/y/D9aGD+00X77M61k9dvKeicAfvoEkD60v3xuPiQD/qkYgu98QXl3ft/RJ4ut0DkxNxr9+Q6jGVvdd0wsHnRCX2pbXJgBAUHNsTKsyJ2/DclIZj+Uqtp07ji+5BJ5b59MW/Pfe+NSkA54fEU7di9GF5zTVpDBKl0S9iPeGl36eqM+C62QujFpP/29XGAIWjLtR2sx/BzhjjOeYJsKDqJbgIKWL7/37Si8P/5vbodEflLffhXg5ZqZqsOX92dY9cKRx5WIkaT01i2CfsDhXmjCbxQLMvd8XZibPoxv8j5S3zrFsjXjTvzP9grcb1nzaVxums6Qmh7gi/4ghGozH0/88d3U2OIzAfsaF7zLH0m5uOvoVxc8bHy2i80/TZl/0+F/acOhZeHPVxkxuPyjDzfSnJCQqjJb7zsSubyrpcSP3hhS5/lAt1/F4Wxxk+nLoXOcaYobXwIXtIhmetvj4Pen6cOgmuEHUjG3MS4tOa6+HPK5uLVqXYF1eV1793bIfnXSN9TE12nE4Nz2F9Lpd8OI4y3rkOB246W+YkGd9kHmEpLjuu7En8MH4S0ujO/6OIdvL/WhiKV6ql5v8e/Onff7UeV76hYmeI01IbvOGxk454Q8Tfj8d/sUsRy8s+Xa31zsvVa67jhe+1E37iL7AaRrF3+hgT/u4eXLqfxVEZb986v+Mw1EU0bi/iIwCLwOOk+6o2WczRv9to9x8yM87112v9t039yIlC5ofDv/4UrZV/FYm+1Im+D+t7iABmUQQ5Mx2p1ep7AmZCqp3roCQmUhsduJ4gj43Mvgj/bYa8vrkTH0LX35AL5oHOORDTQ5My/niq3X99ZF+9NkFmhrI+3G7Mrv2hRm5TnMmT7Ee0vOiPTNbv8Lp2YpoedSbHemD8TiT+1/3WXv6PrTv+045Sy/X+DyF7/iVkT/rrKbDG6VT71Nmel+2jATjCu+YiB7vRGoq3OfyO6x2AeIt85WCDKDC3bkxy/bdppgLMZ3jmWJGfwHnf88ZjMvGeiWP+LuL/R8tbAMW1pO/DQJAggeDBBmdgcHcJGoKT4O4BgtvgkBAsSPDgwd0huLsHCA6D6+DufEWSe/fe3b37/eruf6ueevt5n367Z05199t95kydKczkcI3DjMV+MN/6wOVnXHNXmOFF6gZi9lUP8XtvBBup8I3d3mGphlGu/ex9fArlLd4/bJZM/N6/zcOLqcvrK74WMzdvQLNuoOCBWavRZwgBs9/oPaasSzO+6Mr5O+aVLcg98hk9hn7dkV7pkR7LPXONsO7BjX3rcT/CMDO+oZz8NaFSU7BR2JztndyaXdG8vXFbxGxYQ7hLCMjTov6pw/X5e1ILoGXSr4VaPiL/T9t2nN3v64Iz/5+T6vGPpLrUCxDimik+Pud18FEgdg0OAPsNgy8E+vCNhErgw7uDPVnXHTSFRupJb5QLqfDZ9brwk+KLvfCN0DM9CBTO19/d9j36/NNfc0TXDuG9B7b2zxDvCD55WL1z11QX1uIHhoVedFLEpD/Hv+7oc6xNh5PLCFHRR1YFoSeQH1dVpqoX4npE1MrvOD94k6F/VenOut67j1AxNGAAQFXvwIq1w8qxJTSzxduwTRlF0O6g1s52+/3asop/TAHh9ZZs+99G3AP8Y8SfbQC80qu6+3SqzmodfRTYhXEF2swEPABhOsEERwJgNtVwGVakJmwPAoXu+XvlAd9KAR8zQ6dGqqu4BuZG426vXs+byORjasvFrM8Y3gAP1ICDYDz/ZN7lHhVih1XJfYX33OMk3cgeC1N7+nVI9Thld1Sfx21+bQyF3dJJbeZJrRpJ7a6Uei0S2m0dU7Ghxv/42rl/SqUrnkICLQEXj10qmwPCQ2uhN55DxPpXjjfHTTW3DczWeqHPblVZPC0deSduHrY4/tD+FGXm7pIFjGybXiYp+DFKZCGnG9oL00JybxIBgIKuTRdhZOwYHiQEz4sRzLSXaOMmdWtaeVDf+rDHmQ74kiLa8AmFVRuSuLMbJsTaSDlCBuZG1ufVvSEhXmN1/Ra/HzNcKisCrmd4W4BenhZoD8dwQAjBXfKihmzqBeIWiSM+EYca0uD4cwenb7qO3xhIOGSQ2LkzblsHeCDB7mP6pqKXYVxj8bybRTtkGEjzUoj/isH45w6m35IIOMz+HR3YedYLcCaZ5s5/v/hpY48ck7h8BiOeXAxigxGPLGba9ok7SqyJ9okE8ZjeGA+6nVGPEx+O0M38Ig9kdMbvaBeMRYw1lnawVKGfnmxSpWqLO2lLwl36jn9mut1zl3jxVSMpQkmHt7fQRVSZWkMkr3+qwkJ5J8Pt7uLhm42XA6OVHqKXHwaGEYdN78xZnA9bj/vwl4vtqCeaK2/GL+7p472Lk/vTroJz7uhTrkrA282G43XL8zu4w3dqAtd6h5X21BPFpPA2pkL4DWs9Vhv3qFYQpSai+/pl/pEY2fQFkzVc7U5O7e4nhfoqepyFRo8Sa4IY367gOdsS7RMtPZzfSqGTnpQCLcD4VFShTjEBFPSA4VSMbAdznvUr+lZ8N++nAkRKgK93GAEBW7xbV8I7/T2lzJTtTSFo3cEn4e8p+9RYdUj9izDaVf0Nmp4E2Jjzu7B6VrO8JQF8RcfQCggoam+fWDKAHpQ6gaf4BHZQO0uZcdqbFNGuRVkfnH3M9iYptGsA6457xy9Hy8C/iKW9idMj9Kyk9l3Tev5lAnC6xDXqnnO/ZSHIwYt2bOm4daXxVj/Vvs60CbvqjgHMmjIJKTtqwGby5H7YHUpXbFYCKdXsZPu5WZKN9sHAM/k50nsqsJSeC8llSwRGDLYno6XV78tkXO0JdKqFxtrwt6UtefGQuXaunBZ4MA7y7ABL7LIuRftHlzpCzf0bLpc3TKkLa8fuQ67DvPu8G4IbDRtJ50nsy0OchvNEdhuEmB8wXYr/BHpIYDyvoWlYjUPo51TKPW1K1VpM6/Aah1D1NclC+wevYC5QxboDxG0YQ8RaIjlQ8EtItvQDPsQnEWQZRgcrgSTznv23ZNmiAzqm73bfuH3nzil5vauP1YBH6M0IFhiqxSMcOwoK3K+airiTOHDceV0yraX7serKobX/cNV+EOT5C1UOjMXJpAhS0SXLTg0Ek8tVNQ5ZjLK0C84CnvVejMVfKs/rt9iltZ8vYJiepvw49VNrc0X+5TR0CXHFqA/bITqGzYaecQ6/nyycnRTA0D3eu63TjoCyfvTiuxAtRJ7U4XxAo3kz4Jj8llMJoibUkjMkjBKmMd1lzBmiMd21rPvpSObCxKO1ZMthWb3yRuPAXvvaaJmTRvenoeCkUTZik+6ioNi6dKl04K4MqbUJ65wanYi9rXtXUOMPrfHFLbSo+KeMueL6kOWdb+ts/JAuGNwaDFIWWIZtPm9wDxwTrV8OXpzavNPbEUQFhG92hLwWwtxYCJm+U2s9SeyRY5yLRrIfSzxLLFe0nkmdQwOOHWnx+G3dLpvyxMeWLO8+6x08YCly5z5WeKexu8ESjsBgiIy9wQw1+ct5ybQHPSfjGco6tN0yL8VQ4In0WiDF8G4ikTXb5/HuMjq9NmBpLAirLwg7NksSXrkh2Bjm8e66ZAfceTCDuQfVI4lYmLj1ta1bauZ87GphV+wfdc1Ld/9b8LIPxdsNhTiYJrGvJBFsDPCYdf0mravWHL7Xr6toXNs9YbTJsXFl9ux339Iqi1IYFiBSgFa3RTU/0bW9Sa+Li0ES2y86oFp7GraokvTt7nppm/F6XCupdq2uHtc9KNpkV/a3wwTNlY6q7l/dAKyEse4vGVk5kyy4rMKkKp0LVMrWIAK1PbFTcGi0XbCL65GPLdp0nNrGuZfqEllKaAYKHoSGhJ90ct6P3rptnGPJIsGWU64mgUZbDrs4G1ltzK/AvC0pnsWMZiAe2cH039J154HYfaSx5Esr+oPdZ/epiGO4F19/wLmwRRgljFavkwj8qgi5IaOqPj4VObv+cHZiaTaN3srhH0No+k+p5UdmmWONFNzhK7epRGrVO0m6/gjx8xA0vJJqPba7gS717iMshJm1lATELvTfKK+T+mE0R19OL17bky2EHzfjC4TjU4TtF5x67WQ0YctazkdMu0uZ7pPYKO317DRSVpyHMU+68Lh2cy/zs7kLBzrtjC0SCMy648hqXYjMY6Rbc4PzXHBkr6/IVHNO/vGrg1All9cmyXzAyNE+4vDpncBnoUnvN8k3LDP3oYtZNVqez3a9CWWXe6RY8+cX86bivXHoZfu8iFvY7bI8cKys4RceewsFx9Y/bF1uCu/w3S1b+4dkF3I9VkP7pCC5HtG16bfVK3Q3lPsqQke6axmKcYDhX4euUIPfT10QMJ4tZhwL61cTI61/zIWiP88FoxXphS5jkyXTn9iNKJ84LjwVsBQc1hyuMNVXxbOeYKKgR+WG0vHTo3L/BHSaxXI/+nRdwHI4tSrf7GfLoiUNS/AEYUqVv9lvNOZD4u84pUqpYu6bwTfZ/Vh+JqEBtZ3gS6pCN/v9g02W1sGRK9JhQN6U1f8tSQG6pqy6Rm78jwkOWHlR2heI8L8mEUCJFBxX5dX/MYkGKy9LBwGp/9dk2sFtWo80+vb0+R+KXbG+u6svDDK1b8+1vqbSzT6K2/Xth7Q8VMcTmbh3snE/1A+Elu9fmAzvm9jY9d9dfdkpwej7twVbaP/vcP5uUt9aPq8/bV36llt9XTae3cTbU/HMT/cBJjcp3AHnO5f7vcgNZhvew0P3Kefk5x5jDUDUwxQXyvOdtT3XFTcFvGXBWo/z1aP5qH4XBaaFX84xk4lyeJRb0veVTADwlCevW1pQftvur6VoQM4JT1Y3naD8lt3L8FduSaP/QTIXlIfaaYaPuSVNrFQCck55iv6DxO6WNLISAjA74cnoJhAc2rST/E/SKU9Bt4bg0LadaniFW9L4SuF/krbs5MMd3JLGVpIAGyc8Od28/0mKa+Zm9rbRvYM03DS6XrofH52vn/LvP2lvGLd/EKtdj5sCB6/ZeP45aG1/UAv6YPsXEv+pD1TJGn2//9K88fv43xoZyRrj/9J87tF+acxEzRaHFS8TNJAlvq68DFWgcKCLqI3+c00H0Zb43st/VyO+rvxPskv7Qz/Wv/reC/q3QX/R9o/yWGSPufRqJZW95v8l+v9H3psMunb6y7gtNcMPc09SAgWHS6aKPCnPfM/fdx98CLzm9sC8ltxZFkIN47XHHmPosDG27kS5fFIruRCoM1exGHYEENq63Vgcg5QgYcSEpzcwxJlOx9WY7KfHmSgyzHIy9jNqxCKVM8SZajANBmvSWxTlVqVP/IEUWBTlV+VP5P+J5FVlTyRoWv6JFE/UaUItigr+SPw1iSyscqvS/khwXaqDmRNxJ+07r+uCG6KDnUEzeWBvlYg45Yg45UPPCkPG/Jor0JHyAyayHiIaooNtknFd6R6wwEff5KTqAS28mNA4LKpY1jLrrB76R9ACH/2PlvlHH/P3+lUUsFXyCyCNao1PCtyIVE+5Ci1WVfZb8yHvVdbw/w14NU6dG7d33cZnT/OroSo2kwWQYrVGnRK3OJVTpoJtHdXx+sJ8Fw2V/fJ8iIlKY3/+7xUqXkoqXkrjREX5N+n5N+kJPBMdZTRhnHaFQUZyxJbL6tjdv0nW3/+q5j9JOVnyMsmxfIxOmtuV383zxpT/pvA+FpHxiSZhJccb82+jX7Ke/11BTjOhksPcfHQ0M0taJiqW5u8KHrL93a893q6Szlp6MrisGdXSJ5YLcW3NR5+WmTs9WDqo0VjFZXZhuNx5jdxLbk+7qRNnw1cT3dn7PA+RebM/Y6Lq5La58mYzrX+RkyqTscnYH/RnwEOTwnA8XEaQ5uvK3DdKI+npEhIfg/+WYAWaeV2Z9+bVSHa6nERiMNffE77mTqUbfgxm/O/JpuJIWrqYREAwOi4JiO+1498TyuQ6uXBnX7999d8Tz1qm813XvdnjuTiovV5x/6NmoQPob+YEKva7oWwWOvrN7F04N6/M3oa5UPJDgnd4vLtn+0n2dsE2tQ+izTl/0/z+r4g/Odyhf1Xzf3OKa8ssrXcSiOrXu35S5eo/+3+buhtWAiX/YF7vBI2kPNv9f2I8Db28RPuB6xs/YDZ520j/Tsflsrjx5aRuFjRsoUpta6bAcVel7gykY7fzO3WP+RlhWTKKO/aT0mfrsO4Gm6vJ1hvO4I4Vf3b+Gfmg/QgsZ2430PNqa8qp9dRAuWYjPms0n+OGnpnw7PTb8ihCRYoeVaF/5/sDkR6IdyvFGPO23Atq+AiM/3j4/b6jOfWAyrSHFHGhjVz2/kMocEldE9BacHEKsUEQ3vZ8OmDB+Ov516bJH/9aoGvxpyePzu8VxE6b0c+WenVrFE5f3Td9e+WWOnodDcm5azqia1TY8ny5PzZwCtmHr9Ds+Xnbx2T5j8dp4/Z/vkN+vZLWAwjeSesBjIJpywMo3F9skWcFRAOCd34Uym4/xP+DeXFKPh7wmlTZ818JVbkUhTtVudRr0qAdzEapE8DIg/dQiO9H9ACCdv6zwQyV4gCMgMVX/pWw6yml9sa40ahtE4QOr74UjGo+kS3+7lArPbxGIxiXeKJtPepgufgn/5iDe+seFuSwy/Evr+RmguGDqYGlheWD3YODh6OBewFnCxcN1wQ3CnfyCP2R6qNPj+bg5eFT4G8QDBE+I1IhBiPGIqYjdiB+QzxCEkEafUz22P/xBDIWsiKyP/IqChmKCIo9Sh3KIqo4ajTqJhoLWjra2RPjJ5+eTKBjoQeir2JQYKhjOGKEYeRjDGAsYmw/1X86h8mAmYmFiPUaGx5bGdsfewgHD+cFTg8uANcedw/PGK8Q/xm+IX4N/skzi2cdBIQEigS5BHWEeISKhIqEHoTfiUSJsolxiJmIU4hzSahIGEh4SORJVEmmSJYBAIAqQB9gAXAEFAIggE3AEeCGFJ70KSkFKQepJGksaTppMWkdaRfpKCmETJRMnkyTzJTMnsyLLJgsliydrJgckRyLHEAOIuciFyWXJ7cmv6JApMCiAFCAKLgo3CguKOEpn1ISU9JSclCKUJ5RwVGhUxFS0VCxUQlRvaRSpTKkWqU6oLqhfkyNQ01GzUBtQT1BvUy9R31Fg0iDRQOm8aPJpLkCRgKTgNnAUmAfcJUWi1ad1oI2k/Y77R2dMt0nuky6GjoICB6EDiIE0YA4QJoge1AwKBtUSs9GL0kvTx9IX0i/w/CCIZghiaGYYYARnpGH8Q1j5K+3hB/AaMLAw4jAIPx6FzsKDAwcDEwizCMYWHDSvJZWFJG0qvxsBT+/rgCReAvizqOIZ4ZdNN/tZ9khGrOY4tFa49adudShmV8nbV7HKSsXQb86RRSqAVz9mltIFxF0LzEFnj5vIfJ+dDHUO1D3Yts5nDv2Sen8fF7+QNSGxwZ/XKEFKrmXM5S5kzHtfg5XmHNFaxnYxroyu/xqKf6Q5PDDBZD9Dt7hrj/EmyxJy9uySxjHG6z1+TBnucT6voDg/sXNjrxhV2rQQv3xuemN0WG3y91YyP7tXbOn+Zo3093NdLNRt1aqh7+Cd0v92EXsBfZhjqkHUUUT+wq/IRncj67bQ7zJ7q4mNkxvxA7xq+86Q4Rx7z2uc0xvKA9Pk81Mb+IvMvi9jbqE8RT2bt4TLFxXeDccL5je1PK4n7kYglAiYBNFGDpRjOhEybMl1hH6VcjNGbCUDIOZcazlWTnxeCWNtGVs+enY9Xrz8Dfsk5ba4I9j1TENTf3DLTT8AYjhEmrEH0s6mDtQa7KAuij4GdmO9FoyQjMRmWqh4GTwnODdNj5zEkTm/IOqz1n3mZQp/xxho2Nz81EJK3N7CIyL0YAlSLDLzbUT7eoa7oJa5NsGP3RbV82j3YVy5w69wHwITk2spwZHgcWtT29YPRhcVC7wwjBPjljBZKXt8PCjivKFdEO2wrxNmJ44hD8G56P1M22hLaFdIU9nD4YmpNxlMrf6GoSQ+ADmwRjeYsmGO5Ji6lLJNl6HEKYnQ1qFtGC8oh2xR6LU2HTe5xeQJOpuBqdOKNMBXe4eJvupbKN7SEE4uNEYTW0HuTdSZo56Hi5lvkiAaHU0iuxYW0L3TJ4GdWsgSgbskYgP6jMRPotikGMrUXQg6ChXp3PFRDox11ykiO0+lpKmwZ7rzUyNtotAAHWjkxqV1ri5wGK47uAjJ7gc3g6oU3fpEvTpqqUYOLQ+1xTZc/A5fPH2wiYuOb1q62gIcw/3G6gTtwwUfHKZIZU4mA2NgNMRDgLgzuJUy7NwdzYURsCk+PiHH47ndSkIzbRiNAU0Ybh2P3ml/2HEL1laXpfIPIdYM3PlKRbFxFo/nkaz8SZxy7NyeEsVLxHdOMbLZrkTd1rwCWc7Q5Fn6Eg0J5MPrYbirFRQwE4owZPRL6uCp0yq3XSFBIsbgbw41VQYmlh8apmd3UinlvvWTkfxAb4jAd+e2Jm0l3NSVLmI3siCK9fbJPJ2sBZUPgoCLHe+VL6UPOf1+lr72UhNlM3Ey1Hj+qkw8iX1Xnipl6KG7Qn/pQz/ZMqzjLMn08WYXkNT2J4dLDrJy9cqXp8+iZfdOOgW+KgipmLTf6XpClfK9KpWHZ18xrrdZraRe/QohMGhp0JGPdPThnKE7bQdLUsgs/Hy0/SXjDR9PKUbxEA9HSzUdoplkccTmKnrHqFNJU+YXw0JugGLUA+5OWQrI0gPPIKuVQuiPx6U2I6bDTkRakbXFFRIjpQW6UIrJjSLz4ra5j9oKGUsG2QPavs9JxEmNyMH3fmWzXAnSL1tHpLmq+BYkbfMddJTA4MLSbYyCR2i+vBRJX1tR980FZQv+jv4DitZ7FLe0o1Zy51gU9Cv5LnSI4nH0AwOJBzNwRkN3uN2O7GTfo935LaxQE+2D3HdkegTcG1HvSaoyKvukrLUSSAgaS/EWvlSh2VA46n7On5oJmv3u1dvDStDwZW+EjoBybDxiJ+nubClXPUT5+63ZavaBAmFdUWmkoVmPbTr2shm/CFjxI9r0j90odcbDUQ6dj7mQZ18Ww2ZgHqmWI3UcsTQFD4ll2O6HGuocybB291Wn50YuNas9yi5qiIQ4FYHh0TqxOyeEpcZ2HnBzJYvt6+FPuUNcRh8JwblDKNfzxwS0XikURDUxW2UClrv8DKp5uJyR5mlnNkZMXV7pRd5azlEQuA+uN784bDTS9w4230Us27WiHgPMbd6VF2rX2DoY9qS9eiy3rsqE7E4PilN6HFwVT2BitlA3Qoy2GPqkVPTZhooOX0d/ZCzfTKQnnm+1ltuw2nhSxnLZbemoRPFLFSzfFFIPKN0f8QUp//97XV8EMoMFHZCsIH1KjagaZqdvWYWMNWlz+TYmU5szYtVaQ9mt6HFDnVK1dGaYtUgZ7Gb2+tNiBg5c9JBslyYax4JWgLpqatyrghuTB24sDmPuV016Ddxupvd+6gd5fdXc71Z4DgljceFtXwO/OwsfE4OmGYSP8f/qvCtsG5P+4u7T7RZrqRqH8Fle/yFEXiq8tSjBA8yMAEtUDB2Iy1I7Y7JGoJFZre2Ts9nceFTYSJr1k3Yy9W33J2h3EubnffXUTnnDOIN2Jx26rI6KwgYgeyal5iJ5pR/2yDES6ztB1FKhcgkr528nTXYM+2EThnYxRXuS76YcBskiFLVEOJuhLI400N2aVIGpmdq8q3zy1+oTDre6iY0seUX4w+c456XND23bL+YrpkTlsmRjMtc4jUHzO86NJKYqBmNWyUZaxtnOLwOpEtdkPSwvn5H+aGrsfGK1TSUIuzr1U12Rf6EJgGpBbxhho4Ux7WtO+FI47FybhdB+/L81+QUE5zsl5MkusntzvGuVaen8ian2i1nLVpjFW/i6/BVnzyBCpXOA21Kdh8n5uDMD/sv1zHVG3/dgxSlCu50L51ObL81GHaoF9D4/DbJ1iU/YhwtMTFKtS3m3LUzoVu7IGAulMO9EqP7zVseyBCPby+M7/Sr86asoAgx156zmwC2T5AlMVd+bsDb1LUZs6dF+JeWI4HxwLe33fvuDgu729XeTJe7Sc+3GZwvcNIvTposJFIHpflEE3OUbwsNPE260/g3W060T83vbM/Vzq9qI6YEaquua3mhM6u5u8EoFcOY1/LsMmab5+uV33ZuVOdGRoIpx2qHiYaPVNeDiwgHNbWMPhGKv/o6L9QahBZZ9444EmB3OzmJcsxBqS8/5MoNlatPrWpRfTWocAtfNF2m5ymkgFa+6znDA5UXZvE8TzCzRnfWztpyhF5c9hmHryKOHLGWKRPe7iYkOs4OHgdI0prRLxzHMy1C6p46NTL5sZFMjI+X6tw7240ML5zyChWVF511BtR9P9OQ7P92uLCXs7KtIWuxo4hUZzfYk9uK9TFM0EjdOXPcIWAm/ysDdHdd48RSciJleIWkY/aucYu3zJUitohpr3QOCs5IXdxKmPOSVxLmNhfSCgGH5V818+4nJjZJmsXXusIpl4a/1Z2F+ByStPP3gc0zNF+lgLuvrGTv9L6qNy1gW7g2EwwNvvXgHNUoyOdIfeWhDT8+5zqzPHSqM+TVncnJ/11u/LY9YOiFpn0vpqmrnUrnuB3m5xxyLbuKDYLBvVJI3mBHgfPNN4eK+/vhXmv7vniVnCTyZ2+H2Seb9ynnnDbMT5nlwkqbNFesyU4qbj/UbOM0zt7uOI9fK1g6ddoI9WeMTQc9EzkXKiTTqtwzu2Eq8urnWjt/xH6IyA70KBluRhPdL5DsYff0Yppqx+W7lB+8vR5o4fYY5W9vQI3NMpE9SDZuxr2af786fLKlJVnRbalzKHMs/7ZPWeqZzITTgM2dNnu46icS/Ldjig1tVXGmzeSq09wfNgo/Tjj6Q0+LrYquGjLGZSkd4IhOdJDKrxnCT/ZZlDkAqm4phHIkXYFUwxeCawhGgksF+Be8XQK3kFGDxKqvYl+XcM6rGJksvwimcfItuaTZuHbRb3IaMXYNPBesaMeTv3DxfyO4e8WoN54QGhJnri2zRchQBfC7h1ps0M0KL1aLx5Jfu704O/FxSS7F137faAQx5xoZmZpEo7p1u7kbM6jPQTqtGp0R4umArW+n/9pmIoAo4N6nQCuvPvpFU4Ee6OE58SlvukhQ+I0+2DBFt2aZf2ebcJ0nRHEWhQQp09vqQ9c064JC/s7IyeG3i4PMo83A2srwPqIwnjgz3p1w8w9tdFJbtSM36a2U0G7U9jalLA0iPSJGZ4gcm1+sybm1e2m3TS2SZLv5h66Ate0davlIx8RDm01BXi+PubR9heLr8WwkJG+ETQMp63eG1ECQ2jThmHxUzScxZuUjmVvU13rOWdj+9qJaa+MNxTdW927jjfXne0OqT4cJ5aIkyg7ZIMxoHrgbTxW7HBjIg8buRMKUcaG9jwTTxi4aa7urBCqSqC6lCUWGslCi5AJwDBzaBCIgM6ii+2yvCQEKKXwL0iVgpifBtakI5hEux2Q7NNNHI5uOIkTEnjep7i7TgWX80TkZS7LoV8A9220uq09SR73Da3l1Ay+ZZ9tWuhdu/LUg9fwpNUOX/b4yi7S1svqCvBEi849eC/nEY1DXpr0w1oVXImj7YB9lvOSBPRCTVMBZKkv5QeLTMpBchGoDyZIanwo0GKlH19zDz5Au+zz0nctKvVc2C/5EficctzruMNAeQegaiLZsQqBvSdqWkQsfJYK3y//Y74Rza6lXIhtHT64nWAJRUmzQRbx1EHSg1qDHRGmDet7vgZCxngCLiTxiLNdYGOh7D3rBifE5MzZRZNMRps5HgDdREfNVv7/QU51lUST9mGMfRCU2DDzfaVT3tliGGgY6wIs1W3pBRY6guBNs0Wo0lyQcNgVRRScZ/AztD5YdgUkEiz4iT6/Jz758OMQL63lX5Z880OW58FE6WQZe7kVw7qby4AaHhaVBW4TL2FxAzZx/Kuwz/ZKGdzYmXA4dPjwlldQV0rM6EoR87PCnnps1L7nIFLv6Ip9uJuYpujqVjoXxvfrGzIwliLapaZ3tpYMYb3jnK1JFaTv4Xsyq602mrytckTk7s5yv0DqbV5BeOY4plVUqdqVElntbELAnAZGF2W3P3Hsq9VSqfaux/lyrFyr0Xd1HuBJZug9XqpficZqoSa5zWeHxNYF2WjnSZbvYNra/kKrpt2Q2FlcWKf1LelGqb2iTcy3+fGbH/iSK5D7hcjRjQyyqDmjlnOfnqGJjKO9eXgsqxNgEFlXHQdJZpqdLZHvp1sbQSyX7SZckn7qnDTrUP1NCed70JQkmd6YKhoBMzrDzdZ9JVIeaUa6LYLUSWH8y+SDok+eLFO6NRVAk+p5/fksAehkZM3NEWHAkU08GZSLXJt5GAjwnfFx8DaqRctbXwUp+Msc3Yt5rnTJYKBRSKdnETK/aKl+/bX9e169ulRk8+Ty2w6+Ca5Luo0rkLlE8Uv/nNrkRyWDJwH1qexPxFdHLAp6DtAka1J44lRh5cmqDFX1Z7TRr7YnRYNxn9VHHd47Bnz/p9USNlJqtGBWW0/bUNvnmPy3SJ8cJRH0pm9SeF0LGz6zSvW3lrMCE6scBFDD9jFBRJcgMysP/gtaweyBrbNAJx4uUkNRgBRTVUf5k1u7cx7SyTemCcYk7+w7v9QYDzUe/Png61JZ12ENeb/lvAl60AaBvBFbDGXaRGsvwZCI+MT1N1ALKDoFs3nJkDFNrXKZTIDPQY+M829YU16ODqyqkooqjfM0jQdALsxw/Woy8d/NSsu/EnOgkJkutadCp3lAJ6KwTbYVXCJV/obOmGR7Meh6trOTLKQUAgeDEG5nfHmnFROCTktcO1/ZrGYikpWXEcr5Ie5OA8H1RXBELVlkh/LWvki8XpeKSgBm7OYmViAGKwMiXrQ5I/oZ08Q5hBnukl89cI/r3bHlt/bddd5coJy/O5uyr1Eo/7e/kWOkIf1FkGr/GuUBA6HbyPaO1+pAWJFyAeuXy/SL6DSq986v8PLbPsPeJAiSv8N9bvBwxuMAgo/T3+54W/Nw28zt/R+jAc1Mo6OpUrHijTGluyj2XZluUwSnYcsDK6D0iRsRbN5Q9OcfqZ9Q426AUIMGnDNvEDFg6uOLCWcFF1tPGEmV0y2FvfCDRgXcemjwNtiEEyXGCw5oueUtxRLCTE93QMcXC0VlZPuYzWaH8q2QxXOSo/qSyN4hMHr7ryEOz++yOfKsSiB3rbfOQHe9G4CwzLzuIVyxge2Codl+Z3DXyBiuErGBC2sfae4RhhOt26rVOVdVjj/m10xzgoxzvPXmy24Fxqa9bm45nXfE15cIxK72bTs0bZ6ueZsHD5/xHct7OXHWfpQc+87RPayUBMtk96Jt3MdxJtu6wgGVbr8Noyr2FACc62lAMBUT8xruDGI8gp3ZMEpTha/bHyaHgPMxxlxwqtL6kpKWM43LhzWGI86q5NxdVfM7T7Z4y9vhTBSbEIfEwZpoVzKHYoVKksva7A8d3OnMO+JIi33rf3DiPejtxQfVH0PD57bns3PZq2S+/ihGG6R+JH0UzVfqhbTVld46xm7F8gn9BLZYSiig44LUl6o4ElCtCrzSD4S/fSOIvmTNnjMsmQLWAf9NK77Ipn/RcSr57TzoV0adY2E7BVADbtv0C1jKQxmjby8UX9bNZtQ/GkDYEjo//wkX05BsehgF5b3woTcXLGO7bvNmIoUxhalx5IGWuDFMHSvkh3NPIZn9JPXtUnVkgvOmIhZH+sBPiUx5qzrP1d7qkx8INgEOiGOpmK+jRVeAd0UbM/T5UKTdWd0oHV4n2DknbhmgenGT1SU02SumZf2pJtPwZpL/R4hofvtqEBxO3sxGE8zxLGA8UzG8dKmm0nNzllBGk9f1Z9wfC8+BZ8ZJ5tzQIyOq+Q/eCGjgc5TDkAmFGImm9GvNtu9+W2sByTI96TPENOwiWkmZAvRRLgoO1A0tKAJkqUpyNLjNSko/AOFKCi3c1UkoIwTGNfn5THsNt9CPkJI35tnSElD9syAP4AYfVx/Dlsx4yRHa/NDQjHkpbpZcEa69ZIpVCTNGMCKSoV/IM4bDjxTmj4kWfvIrvcjRlidIYMEV3iO/2mEvfeEw03aFydug7uGOIdM3bpRRkzG8z3aLLGhiRbGhIzw1mNkW3TdK7WeUO4G3VsXaIRPhensi3t7KEfg4eeAQ4Cgz/fhwCFFjDQeW/t8Jgdh1CJbwYUPtaFiiJN9aB1UAsbkI3ePGmVYG+/80Kdr+5GQK9xiCIyS6wqtCABHrZxs10HDyWtMS5D6bEK3sZLS4T0vEyqUPmHZV9rIP2IgU3rwJS9VQbZ7GDRCRV/H4kRV4kzVYkeXwk9XQkZW0k8FL5S0l+XuH3GBWiW4hk/bzJpLq1hdUrK8a7dTwZy/fDK8gWm4WVPZlu7VxJzvWSAZISGa+M0rqwQoufxhi+578IIY82Lla7bfqyRX8jxGkrrUQsse4crlA17jqerW+tCNeOIE7E9CVtz0ejKu9t1Z7pIIlBsV8t+4BM29zpcMyyzz2CIm8tOgxMKQw9TDksLBw9bDkcHBw9bDWcOBeIHAfmMX31I5FfDKYaVkmMgwr/gZbDP0aiL4Uhk/A0fyYuU6qvrF4KS1QKo1EKGwbz/wUAAP//mrPrAQhzAAA="),
-	"html/playground-login.html":  decompressBase64("H4sIAAAAAAAC/6SQwWrjMBRF19FX3PHe9kw2s1EMaRpCoZRC2g9wpGdLqSIFvZcU/31xXBpKoVC61dU5V7r6T1mqVToO2fdOMP/77z+eHGGTsDyJS5krLEPAJWZkYspnspV6ZkLqIM4zOJ2yIZhkCZ7RpzPlSBa7AS1utrclyxBIBW8oMkFcKzBtxI7QpVO08BHiCPd3q/XDdl0dLDofqFJl2SilnRxCo2baUWsbNZtp8RKo2SQ8hnbo86jQ9XQ4xsHHF2QKi+LSy45ICrhM3aKoWVrxpjbM9fGDrgxz8SOWX4mkDZRl/iu4HB9xNbDJ/ijgbK7E/nPbnotG19PFcZX6fRa9S3b4TnL9bhlS7+NX02TQ9TT4WwAAAP//FZ6RmhsCAAA="),
-	"html/playground.html":        decompressBase64("H4sIAAAAAAAC/6RWwW4bNxA9S18x5Sk5SFvnUqDgLmAnqVPAEAKr6Z0iZ0UmFEmQQ9n6+4K7K3klx03WOYk7M2/ecOaRFP9tsZi/9+EQzVYTvPv96g/4RyPcerjOpH1MS7i2Fjp3gogJ4x7Vcv4lIfgWSJsEyecoEaRXCCbB1u8xOlSwOYCAm/WHRaKDxbk1El1CIC0IpHCwQWh9dgqMA9IId3+//7haf1zuFLTG4nK+WDTzOde0s818xjUK1cxnM06GLDa3Hj5bcdjGkoJXvbG4rXHfIKKtWcebNCIx0BHbmlWJBBlZyZSqcEIvZUpsEjY9IJKwGOndL4EXpYjpGUqrdyZGH38Fe0GeZDSBIEX5BPh6vtWviTW86gP/DzOqbzpksfWvQQlJZo/WOLxE82qQDt94dejSKbMHo2pmsaXPwmHXgRnXV5210xJrxvLSV32EcSFTF5ScCQFpJXbIIAW0VmqU32rWCpuQAR0C1ozwkRiITF76XbBIWDPftgy8a73MyWeqmRZOWbxHJ3b45i2DYIVE7a3CWLMvLok9Khj4hkqPGxisN5nIu9voc+gDZnzTmbqgfrnCBwbSipRqthPG9ZhjoZvhyztpTdlGX9QKH968Zc0KH3jVh7yUfi32OD1/QRWC8gvX6UckH7C0cDpNjytE/eqMh1fK7C/aiiJKfdZPKzZoR8678s2g9fE0hXXnYM2dSWTc9k9edaAhwTPpDOFQxv7MOF1RZ6rp0xxFk9jlRrMdVzIUXE5N7so9RZ46oqLY3oj4b4m59HWvw+gYHe3kw5P1yYyPKDPhc81+Z+D32U2f9n12ZdT32Z3r6Tv5//JxJ2g6RY8rLP3qh0Rr8uEVx4N8KDeCMklsLKqmGC4OyWmoTx3WaMNPtfcT2ldUVVBl6+X3pWJGq6JYEVF0vOXGvvGPRUZH+7loBqF9GgltxkPEzukzhdxLDUhsjFP4WLPFVQkOEcfS5dVw27/0doze//MHg1f9X47/AgAA///moDTrHQkAAA=="),
-	"img/favicon.ico":             decompressBase64("H4sIAAAAAAAC/+xYDVRUZf5+/Ki/FZmYqWXoaAL+wbSxlDt+u324FtyZkRlN0UOrqVgaM0EolUpt9uGqzagpuZmm1WZrNSNSW5oL6DE0awTRlC9BQAUVBmUGCLnPnnuHmUXAtM7a2T3H95znzPu+9/c8z/u+9/2aC7RDB3TpIv92QsxNwDAAKpVcVuGTLsD7N6HpuQoxHYHHugMDAHSR4+Dh/VLq0rU7unW/G9173oue9/RGQJ/7cP+gQVAPGYJJ4lA8qQvD1IkCpkVqMN04HE9NHoGnnhyJp6NGwxwjInXzVNg3zcLBL2ciZ9csOHY+j7z02SjYOxsn9sWgcN88/LQ3DsWZMSg9MBenDj6L8h/noeLQfJzPno+qnFg4c2JR9uNCVB1NRPURE1zHzajPj0NDYTwu5C5CY3ECpOJESCcX4bml38D85m6ssryBd1Ytw7q1K/DRhyvwweZ1SNu5FdV5b6GhOAlS6WuQypZBOrUC0mkrpNNr0Hg6GVL5BpzJ/QwXSj5F3elPIVWlQqr6BvXnM8DqdDjP5cJ5rhB0ZaG0rAznzp5BTXUZWH8Sbrcb9fX1kCQJJJXxaz8Y6KgDbjYBnSzArTbgNgfQ2Qmo2BWjqEYU9UikGcm0IpV25DDLx+82GOinAx4wAaMsQLgNmOIA5jiBhfTHMqrxHvX4nGak0Yps2lHajB80GBirA6aagHgLsNIGbHUAGU4gi/44RjUKqUcJzSinFVW0w92MPzgI0I0DTFGAJR6wrQQcWwHnHoDZ/uAxNVigB0vNYIUVdNpBdzN+N0DXDzA9AFhGA7YIwBEFOOcCTPQHl6nBDXrwCzOYbgWz7WBZM34HQNcRMN0MWG4BbLcCDj/A2Rmgqis4Wg1O04MvmsF1VvBLO5jTjI/O0KEHTOgLC0JhwzA4MBZOTAD9p4JqE6h/HTS/B1q3g/ZMMOtEM74KOgyDCeGwYAZsWAgHVsKJD0H/FFC9G9R/B5qzQGs+aC8Ds5w+/kAMxhPQYS5MeAMWfAQb9sKBYjjh9CcuqIkaPeE2E7VWot5ONGTRx++FwbgfOoyBCRNhwUzYkAAH3oQT7/oTH6uJHXoiw0w4rES+nTjbjN+paQTuggm9YEFf2BAEBwbCidH+xJNqwqwnlpuJj63EP+1ErpfvFxQYqOrTO+DeXj17dO8kl70V9/bscQvgN0CpUPUOCOjVUykHBwUF9vNE3ArcHjIgODgosF/fPn0C5LJfSEhIcHBAQGBfVe+A2wC/0JCQkEER4ffLFUo5NEzz4KBBQ/4/UKXyA/wG/kGrffTm/3ss/PGhfeWyoNV2aD/m4Yh27R4KvB3wG6HVdrxp9HixXTu1Uh72hDZigmaUKP5xbPfO8LvnvrBxjwwPDR0+bmRwtzs7+93VtUv/gQMHhoaG3n2Hv39n+PnBrynJ+ZYpqd2vgzfJ81QevgFNe+xYePbfqyWDKFCGVJxwXeH1ueF/w/+G/w3/G/7/m/656bO4zzZNwYl9Mb+7/6niH9hwiQpO5u763fzr8uN46uCzrHZW+PzLy35i6YFnlGfX07+h8AVuXf8U16x+mWs2fsX1H6fzr3/L4Or3bFy7+kXaNkZfV/+6/EQmrEhj/PI9beLlt7/6z/qffIlSpZ2sPU7Wl5AuBzdtTVW8Imcs5YOaCRw6MpxTnrEodbYd28kLe8iL+ylVfkGpZMlv9y99jfz5DFsmieTBA9+yj+o+H4IHhLCg8KdWsWysoXQm+Tf5s66Qv5Q2rUtg+PiHqH9iKFM+W3/lwMZaSqVLr8X/olxflx9P6cw7rWTcbjej/zSTA0IG8aVFSyg1VFEqXkDptLVVbE7OEY4d9wjVQ4YxZUcqWZ3eNH/ivf4X2/DPlZ+dPRRLqTKllea65PX0v7OHD+kZeyiVve6ZHy2STm/wxfXpG8hLrgLFv+JQrOIfKQrHW/obtWFfyM8OpM6kVLWjlebatcmX+aelpVMqe6NNf60u0hfXW9WfDTX5iv/+lBne/tsv77vGOHmipsbyisiT++dROrOulabL5WLUtGgGBoUwYUEipYbqpvFf3So2KzubI0aN5cBBatps8prIUPyPpcVw4fxHZf86g1YzR/EO1zw8WaeRsnfO5vlsEy+dSGBjkTz/in5x/snvyDdX5fV5xflX53lPTbG1eXHcbDUo42DUCpONorDz1YQJvHgsjhtWRHLDyol058axsURefxVta9Z87+m7d12VvNJ2exvdlMrXUypK4NHdc5j8lp72jVPoOh7n8ReFQwatUPTsjLH8uTCe9QUv+N7Tqj+LdOUleuZCbQFZX0a6silVbLrC3raA0tkt5MXvyJqDnvEpSVK8PrAaWO54Tum7PMZ5e+Z654HLKIaly/nFz4/n9k1RXL44wrdGY6LHMG1bNOvlNfkrz3l5rcncOdFjFK3pxhHcssrIT9+dzFnTR3vWQYRwVH7/BlG45PX0QSuUePPRk0dw1Wta7vggijnfzuHpH+az+qhZGTMZcl6uk5/JMXKszPHyjaImv5W+KEjy+1fmoHbYKINW87VBFAqNonDAKApzAbSPjNBMiRSFE21wrwmRopAfGSFMkv8CG0QhyiAKe5r00gwRwgRcQzIajR0mimHjDFphlUEr7JLbaBCFyiZ4vaqbyiWemLC35T4lJaE9/stTGtDheiEJaNeWp1xPQFmil38PVl31G4XruJnVR0wKzmfPZ8Wh+Sz/cZ5yJys9MJfFmTHKfbRg72zmpc++7Kzz8q+mIcOr0ZLfUBjfpoYMr4YMbzva4tfnx12m4cyJvWI7WvIblXugR8Or421HVU6soiHDq9GSL+8NV9No3pe2+C01ig5vYdHhjW1qXInv1diX+hz3HTzGvfuzmfnl/FYabfMXUCrfwMaKT7hk9W5GzXuH02OTuXTtTkrl77M2/0WfRpt8V7bviLhQXcnHx4/kRN14ut2upsPtEqWKzYpGS35t0TIfd0nSq1Q/OIyVRX+nVHNIqcvM3M/+QSFM+fx9Zb/38g2i5i45X3HkdR9/0eIkDn7gIVYWbfPxv8vMZL/+wdz+2QZlT/XsxZp75N/Du+awRL4fuA7/+4y7dMFzv5PvYY21zdq/hbkZc+n4+mnffpiZMoNbkyfT8qqW5/PepXTuE+WMan6OShUbef7oK1yzVMt/fDiNqZujPPyIsGivzujhIW9P0gm1yxeH8/vUmcz6ZpYCOS/XTdIJ7jHDQ5Y32x9jWq7lSP1jfQ1aQY7ZbhCFnQZR2VtTDGLYX56Z06tP6++h28ZdK4DL4bxj27h/BQAA//83wsM1rhoAAA=="),
-	"img/favicon.svg":             decompressBase64("H4sIAAAAAAAC/+xbS3PjOJK+16/gsi7dsSSUiTdUliditmM25jo7G3umSUjmFkUqSMqP+vUbCT70sNxld3VH7LSli4lE4pVIJL4PgG/+8rStogffdmVTr2JkEEe+zpuirDer+L//+bfUxlHXZ3WRVU3tV3HdxH+5/XTzb2ka/Ufrs94X0WPZ30d/r792ebbz0U/3fb9bLhaPj4+sHIWsaTeLn6M0vf306aZ72HyKouhpW9XdsshX8Vhgt2+roFjkC1/5ra/7boEMF/FBPT+o59R6+eDzZrtt6i6UrLvPR8ptsZ61qTePIiihc24BfMF52hbrtHuu++wpPS3aPWwuFeUAsOgeNgfNt2ktu6Yod01RzuqTgHXNvs39umk3ntW+X/zyz1/mzBRY0RdH1Uz2PGn1xMh1tvXdLst9t5jkofxjWfT3q1jwkLr35ea+n5MPpX/8a/O0iiGCSPBIcAb0w5BbFqu4e9hIiXrQPjjLqDA2tJxzgDmMWhSGq6AxDWhZNDn1cBWvs4cyb2pGZrr9FEU3hV93pDq0Rykp0cbRIuTOFVDpgvp70L3LumGMUbTLNj5vqqZdxZ/X4Tdm3DVt4dspS4ffSVazy/Kyfx5WwFj3NCyqdc6Hy/ndfVY0j6uYn2d+a5rtKuacyfOc/GkVc8NQcanci0zqCjCpuMTzvKLJ97Q60n1d9t0q3j29KL1vW1KosmffruLwZ6qmu28eNy0Zrm/3k+Feramrs116d0fOcaT+Mjv1xcan27LYNWVNVa2zqnuhHjTrpvDdSXWhS/syiC+WeyzronlMRydGx+EVjcmxEdC+ovJEFSB/Jfd5FaevldxmT+W2/OaLVYzBaaPoZtYhkw7Foqh/piX69EyyeBKSxUmgUM5uvXjp10G+9X1WZH12cPJJIiWf226L9fIfv/ztdmzhJs+X/9O0X6cGo4gUsrtm36/i+HYW3xT5ct2026y/LbfZxlOo+venbXWzOGScKNNwDpUO1bZ+CFwXo3eRb0sqtPivvqyqv1Mj44iPKi37yt+GNofPaRSLcRjjIBdHo7xZTGYIqc3ZNFXZna9WMcWV8xnctM1+t20KPy6G+GDZk8XRt1ndkQ3IP7O6q7Le/wRJisCBCc35z5PxfVWVu262y9zSXEOa+7r3bfAoZA60MFbE39d/WsUpMCW1VdKpucBRz7ZZ35ZPPwGTDrUDp5IUmDVgtDM2OfmcNSCBn+e62udVLBhYdEYeWmgpHjFrDVcGZykFIgWGOYX60H2KXal1yJxw3Jz4+GgYKY/Uu/658qs4xN/l57C3wJe8Kndpu6/8sm7qb75tvhRlt6uy52VZV2XtvzQPvl1XzePyoezKu8p/CX/LquyfZ9EYl5f4peyaKuvLpl5m+775si2f0rvK10VK076sya+rL6EHaUmG3k3q3T/+86+XMtJ1WfW+7ZbUmawlra6pyiI9HcYgO/RjXVbV8rOw0isZEmd5p0Pu+rb5GpJ+/B6i3BJhSlPzebZbts2+Lo6F/9uU9XJb9r6dpCFRlduyX8pJVmTdfda22fNJIyRt1uvO98u5oUM/B2u0vi58W9abwaIhWJwLu/ts90LY+6f+XObr7K7y6V2Wf92EkSyzPN9v97TC5uBws8v6+xdLJG/q2ud906b5vn3I+n3rVzFcnevqXG9yrslPaBeNUDGjEgTOGThuojxKkYHVTlO4BIxSztBw40JS2igVjCtntKa0tVGqmBVcaScSYBxJA5FxcFZLkUhmhDFRisCUUKiUSMbPCBh3wLlOOJNOWEorJ1AlyJmQXETAUEvQPEHHtNQqqiJumABuhBQJUFepS4q7JDVUJxUBECJJkdJWUxVcJelUATAhtOAJ9ZlrF6WSGeTWIG1oDLlRh57qZPo8sgg1aPRgE2kUBoEzwSgaDbogsDb6drIF0CqWUqvDuj7bLa9L9rpk37JkT/GEOYUfDgxTqN0pVpGWgJpw9ju45jIGeomy0ldgVvoGnPWrIG/EeFq7+D0g0gr5ne3ylcJCg9TKvBmBOiu5FHAE4mau0hSe4H23irvhF793zz6KEu40QEumwVirDQVpxUBqHuURMWGntExC1FQRMouWU6xkHFBEyJwFZ0WCTEuKrWiFBEyAKRAUCFE5YVWCDLQyFEgRpUaZIFMU8YAJqTU3CTCNwoYQCEpIqsFJSxGdaaclDwKhVSginLU6hMAQNA0HbgWltTCcoiZIrnSImhYdpzLOGCVJoBQ6KiQUGHItZEaiJRUrhKZqOdPKUExXaBBCqHWobEQNWSMdleEIJuLMgOYuaGhlzVE0/niB1miPa38NtFfgfXWu/5fAWzBhBixLOwzFaAqDTmtnKLiCkBQ5nUI0PAGGggfQzZUVwAl0K06QlAAsonVIoJtbJaLUMSURUInEMSVcgNyc2wC5QUGAx1ZzFSA3oA37BFIDBLkFB0GQmyA8lxyPILe1AXILpUMMB0AZMLcMMB4JtOuAukMdQNgYhCPYzY0VBLu5of0mSR1DtBi6Kmg3T9KxqwdzBMztBptYx8NmgZxIBVlFBIA/muUS6jZ4WNStz/uPu1jHzJPFCkxwq4BLfV21b8De5EBSmgMGnK6RiCBqrQ7Ybb5RYkqg0Ednf0+rGCWjNYIHYbjdEMRu1SkkB6a55Fpwd3DjvGzz6gNzx+Em67rrvN9/B8+R0pxSR44MjTJCnjBHhHDvecwcV7FkWgmjJL8Mla4Q6OqMb4ZA3DAwEow4obbpwG3NRG3TgdsqM3HbdCC3xo3kNj1nt+lIb+VEb8/Y7Tm5HbitUBO3Hagtqonajsx2JLYDr3UzrR1Z7UxqzzntSGlnRjsQWjfz2XQgtDjz2XQgtHrms2kgtOICnT3AHIvvPnN41/nF5RObcCYCTsbvPXR5ZS8LAcYK1OLoDOpyLKK4hWFmpNUXg5z9yPdt1z3yN9H+Y4YwwC/FneMW3BlU08weC0dQp0Bb9QIDcuacMfzorHGEjS8zDiDzqJ4P6b35+u7qvT9waHXltxO/dRLRXPnt206lHEOnUcsEQViGVsoojyAh0BKQlnZWOQJSXFseoAo6E6AYauXCaRBaZxIIn8LKcDqjASQJOAgzACllRTg7kiDscJcsQ9oaa+QAtfhwHYDWzulwsSStGqp32ggglIaW61FZgQv31uFOA6wVw5Xs0HmUfLjXVuNoODgTCiiLAYk5Z/jYvAhIU2ttrUnC7YY9kdCICc4NMA0JRkqlaDApZ+gkRoJxhcbOaWTGKUc9nrMIQnExJ0fFiwDP/J4Ar+teiRqvFfmBGy3rfsXHDBNKueBjZNDh4ojsaBQJpJHDiwOyzpQe7IiSvOBgyEANZpOPujDMU4DtR7MDoE9mh9KXZ0eCOp4dUvzIt0m5sLm5Xtu/d2M+e+4xMhrFFCh9ymgUc1IKyy9f+R/d7X883+OCA14pzTu39eG1B1hAI8TJ0TIyIYWR5nqwPHkYGhSIVw/77QfL7vRJNHImUXLA7x8sI3NagD5+zfPibOig8/2zIS4YIiDHy/38yOz66uY/zq4v4OQ8/wGQ7MwpSFYMQClhwrMvw7iZ3uaiASnC+yiwQoVHVVw7E06HjeE4PLOSaHiQXPivgzNPDj4RzH/wAP/g66aYJnR+rXniBMCEMwKskX+oM8wT/6vHHP+qYzp76ies4lKFOdfMoeLjnCurwoUIiIHnSm1AJsC0gMBJhbAWKa24uORc7nfhjnn+558DrsmoSohh3aHhMsqjcdnNq25cdPOaG5fcixU3T4EC/GOn4HeOR+ronet0Vyc4muGuTjNtxGAWZZUeHXPwy8EreTQ45eCT/M8Vgm4Wm9tPN4vuYXP76f8CAAD//72e9qowPgAA"),
-	"js/codemirror-activeline.js": decompressBase64("H4sIAAAAAAAC/5xVUW/bNhB+16+46KGRUEXaXut6gOsWa7ZkK+ZsezCMgSLPFluK1EjKmTHkvw+kKJtOlWTbkxLy7rvvvvuOripYKoa3XGulC6CqO2i+ayxkNIf6ALdE888SPpI96hq/IBDJQNkGtUmqCt5zYzWve4sMeslQA5Fwe30HglOUBt9AY233pqqoYtj6IqVEW91cLz/8tPqQOIx3SElvEIxq0fIWDRxUDxKRgVVg7EEg2AaB9toofWlAcIllUlUuecGYcTVVZ7mScOnDF9TyPd5wiZdw33DaFHDfoASUpBbICtjxPRoH6iCIj/aolwbuNek6LnfwlvH9d77wcrUCKogxkJ60uhrSXFZaeBjJAi63BmpCv+y06iWLgJ4DuTplpGWSZNteUtdS1iqWw98JAN9CZg8dqi3gX53S1sB8DqmqPyO1Kbx6BeG2VawXGF/m4Ofctkr+sEoAwMVkGv/sucYsLcuqLCvB62hMaZ7PEgAUBuPKDLdcDtgjQ196OC9Jy3ytxe17X2Y4ztbTJTaF43GqU1XwSRAuodbq3qAGlPsj25Nq+Sx5yE8CRRdep9SbyWpObeqg90TD778sPv2xvFmsVjB/aopj7LvF8scXYuNhjWnf/3p391LarrcWdTpLEojWrhxU+tlbOEsfWTgtYEuEwQKOHdO2gD0RBSgRvDFQ6DTuYe5O3Ujc52Ie17mW3M58tJvonggXduGyRhQA2pbGEoslOTIwMIf1ZhYC+o4RG/Ezng5tS8GNXaFAz9Fkg38CpOurxq3SeIxYNkTuXHfm/CSkPZysdxGYfkVUbbf/FRaACiT6nP7xjqFAi5MaBFYJwEPu5zdOYxIwsNwq7XTWwGEO38yAw9tJ8FKg3NlmBvz167MONbZqiFm6tyObSl7zTQGpe7fSIjJ6LP+/h4mMXUSb8P/Agt2LaDfySMczFQ1pcaE1OWSkgHoUwY2fBHWcmevwdw4aba/lsBqzJ8UmZ8qGHjzomm884JpvpsDCidW9PzjnOr0C2tnMxPsYfllO2zNFcUibdoAL9fcwD3FrflxEvxr+tMS2s4csz4EqabnscRYBCP9gu8nt0Dq6H4lkAn/jpidiZYm22YDSIGGli87jEkMT6+EzjuIKvvXy+ejQZ9n1psmi/IfjCE/DnTJLEQDycRBDulvwDjXxT97x7YvW45lFnvRC3MEjoX01wtjJ1aHtF3br+byXlun57Ke3Z5T2ydd6+C9MIf/awI/eRm9fg2LUY9rfBkUZPD4gOuR/AgAA//8Nikt2QAoAAA=="),
-	"js/codemirror-go.js":         decompressBase64("H4sIAAAAAAAC/4xY73LbuBH/rqdYc9qEdGTSuXauV6lqJs25c+mce5nxddoZS2khcmniTAE8ELSts/USfYF+7tv1ETr4R4AU4+QbgV389v9iwSyDd7zASyoEF3PIebMX9KaSEOcJbPdwSQT9icF35A7FFm8RCCuAywpFO8sy+Ja2UtBtJ7GAjhUogDC4fP8j1DRH1uICKimbRZblvMCdFpIylNn3799d/PXqYjaLy47lknIW73iRwOMMgJYQy32DvAR8aLiQLaxWEPHtT5jLCF68AEvd8aKrMSQmoO3Z7Tj7y9UMABRPLPDnjgqMozTN0jSr6TZQJ0qS5QwA6xZDyQWWlBlsp6EWbfZTsiu0rLeX32oxZju+nhaxmSs9vJwsgw81oQy2gt+3KADZXa+tD0eynB0S76CAAI+zqGsRlPNzGS1nM09MjS6XvMA4uuHRHHqEnLOS3hgv3xEBlBXI5N8YlbACQ0z93nJm2W5xf89F0cJKnwSl/5UkrCCi6ImppkRbgeQ2WkjR4RyinLToFxVhfsFZK8OVpKxzvAaqwJJ0tWcqsETRr5Qj+0VJ6lpWgnc3ld/jYgCnvNATb3jwKf2Clv5zp3JvAEGZRFGS3Avekab/bkh+S248TRAWrlB2gg3gWqxV0jqOVoouXN5TmXtzVF72izvS29YHBHOB0scq/g4rTNMU/veff/83SZ1L24ZKJHVtCoZy1nqDWctLIojfYVzeU1m1KtaU3QyUlxVK0cmKl7LCHZEyiM19hQJ7nBnAoc+lbUdrSVmYSh8EFpjXRGChK7tPJc7rHnK7l0Em8V1T48Prr74Zb339W58guvQGGVBzIn/zlc8QtQ5OiI5hGA1vMkQdZbKRYpwOgfPk66/DVSCHslAKZfKbAUwX4nRDoG6I1A2huhBryp26ygiTrW7bv6DgcEfqDq2H1cGwhoKKolwSnwe0fk6K6y8ucKRpkBVBE/A1kte8PYpjsG72oxZQYxB3uiM+IjWyoAxvPRfD+wFIQxj1ld+I0N16VbOgSkkdLHJ+h2IqjWn7Q4OCSC7eVUTACrLrV+uz0xcff71YztPVH/548rTONlnPn3fiQ8dyvXbuAslvkf2JtBi3UiDZzaGVRGJiS0Ofq2AFhpoyfJCxvkTMHaloK3gZvYSnJzCL6GUULP4VOSgwyKmWSH9BWBnhVzrJ47yysACmTY3YR/oZ3kOvSHa9LjZZKrGVCkppYJWLUn1phgzWmAbxNk6SxGvoTYrSQHFw5u+IzKs4+3h9fvb7zav4Gi821+uzV5s3ZiN5k/VGHPx1bhHPn0V8+MdGgZCz8u3ZnzevMm3CiOn8+vzsd4o2EvK8nqfr1Oh3+rzCQ+9HrNttUUQTjr5ebx4P63idBA73ptk0U7d5NYoo6+p6jGedk0XDKFhDkMg4Oo2Soeem0khNXMjksmezMkPiZA55y8dys7FcTWpvafMjv2BFnBwJU91ECYqG0N7aYc1OeM/L/3tFaxwdGJdIxC1xEKYxRna9vl//6p/rB/L6bN2VZVluXNhtX/AFnndCKE8FNd5PV41Q4uT+fXvBuh0Ksq0xzjsxLiCFt7KDl24FdsMNU0mQI4ZrbJYVeZR89ub+Ek0clD0ygHK0OyKoOqqJh+O+aFvTzx33HdGe7efZybZpHIttThosYAX6XpuD6p5zQNZvObvvVZwgjhXDuNkmcLLSlRPmonKGYV6BVk+1uBMrMIFHI0PdGUvQ4/Dy0J/1arkD6rBBOdEtW60derReR1OFoiQ8PcFJ7DCeniyGbfvJZwpWXTrjuNuBx8bqE1GZLubgwtqR/RYvWOB414msn49vtMkrINOOcGifb0GhRWDdPvJcoJqTcholU7kZdpKhE95xJpXS5pWEhXox192OzfXkOgdS0xs2h0bgndNZVrRNHT+swH0uPdVg6DeY+ggoClWZuG8w2NVSYGWkBftKLKy0dKN6oHnTtZXTXrtQq270HtWX8XBumGEFDO9hcDQdmO9sV3UyHx5OjtXgzQDKiVahPxmcTY0TjU6+Y5oaDfm8c/T7XQc20c3PfG+C70OUDAaiIC5D0GGUPuGYY337jMkyeO9ei2ptER7tJUGEvFKHF76ZbUmLJv5HjdQnv0v7hfF2v291WAxiFUAqD5wncBY8+edwrp6VvInmplQTD+esX8D5PCw8IX8ov6cMF7q9ufqyJWQfB1rFxRc16Vw+jL24PJ4HWl7HybgB5/LBlYHr0MHWsMNPBltDmx2i9UzG7IG9rp8fqWDq012lRgdbs9Ehen7IuWpIjsqyowktHOTCXeWzVu5rBR+P+qBKcdcIk08MW0b+3v4zc10u8em9rzHk/YyTjUuG04fRegXRY5RM9Rw36uisjJO5Lkkn00/tAdD1lwJtPgMUfylQ8hmgiVgPJqnJQwd9oQ1y5mBAVMced8XnoBxG8uljUwk8KInjiLvyNSUxqF/tJiXlbSlRjN8Kgyw8Ca5jZe8x2aSRlX++fL4bzKGkopX2jd2roP8Bu0WaV0S8lfF5Mk7dYXHqh+jH+M1CrZ7sPJyst/Yd5e07HjbCm2ZY2b0nlbzhneHLXhtX85ayGxUHb5GP5WTVJSG47eOvIHZQb+AcFvB6mCsT6hyf8ZdAMgy+/iEpaK7UaxcQPR6SRWRugJLXxQKirSA52q1tzfNbOxFeqVxbQJSdThAvmDp6mllSTRlaijqRRebXyiGZ/I/9/vIijpT7s4cz/Ts7uuGqQDX//wMAAP//yuFhxb8YAAA="),
-	"js/codemirror.js":            decompressBase64("H4sIAAAAAAAC/+S9bXvbOJIo+j2/AtHedYtjSbZ7t3dnrbjz5HU6u0mcG7u7Z27isw9EQhLaFMEhKTvu2P/9PqjCSwEEZad3zofznP7QsUiwUAAKhapCvRwcsBeqEO9k06hmwnJV3zRyte7YOM/Y4oa94438rWI/8SvRLMSlYLwqmOrWomkfHRywl7LtGrnYdqJg26oQDeMVe/fmnJUyF1Urjtm66+rjg4NcFWIDncwq0R28ffPi1fuzV480jPO1bJlsCR5snPwqmzDO9CMmCtmpRn8sN3UpNqLSCMiK/Se/4md5I+uOqYp1qmZqybq1YItGXbei+a5lL0/fzR4dHOiP/6a2LOcVW8qqYK3aCNaJfF3JnJdswfPLVaO2VcGWqsG3BhagsBClutZAeGfHuIG5WtupmlXlwaJUq4N/yjdTWXWiqXjZstmjR+Pltso7qarxRhUZ+/qIMblk4+6mFmrJxJdaNV3LTk7YSC1+E3k3Ynt7zLzdqGJbCvoyY7CIm42q/vPsEWPMtJk5QPrBOJs/YkyUraB9FWIpK4RmcYLO8PmMbwqA/uzdSwDciG7bVObt+NPFREP2gA8O2IeSy8rONhPVFXw37vQa396ya1kV6jqbkbV22N1lfl5wUkbbVjBNYHk3mj96xHQHzz+e/nr26iM7e//m9es37/9iHv9XuS1WooW1WmxXLdDpQqz5lVQNK+RyKRpR5aJl3Zp3etW/69hCsKXg3bYRCKQQncg1JfFGMFHxRSkKtuCtKDQ1bVvRPFuJqmOiy1lbyeVSVqvZI8aueEPenrCKX8kV71Qzc0/nplld8m6pmk3Qyj6EMepWK5FfKnbCDuCPzwefiwM560TbjR3AzEKU4r+3daeODnX7d2dvXrHPxcGOxkdH21o3PW9kIaru88H46fGnf5/+x8Xt5+Lr95O77PNs9qfm6nj8udjPDmbii8iTgNgJ6fr21oImPV2JppWqgoaaqMa+/VNWqHyr9+3M/vFO76rbW/Zv7NjC+nR04Tq8FotLqSf34Fex+C/ZfT4YHOTfO9fY/LG3xw7+307P4/7n2edif/DTfN3ojX7CDl7AXzt6qRvRdrBIp7Vo+I6WLV/yRuqWz+q6FHqv1ttONOYDTwdXoipU477b8Py/V+Kd2lYdl9VbnMqDdzxnp2fsr+zoc/H55fjTn3HpPhfZ55fDyK551SlNdQcf8M//PEs0tmunWoctznavLUzpO7WQpfh88Pk6PaOEuV8rsdyW5Q2TVa40z+7EjJ1tVyvR6g2PG5eXwCQ7eSXYRnRrVbTsWpS52gi7zTbQpSYpBQzl4FlVNEoWt9dicXp2+7zk+eVz0TQ3t7Aq7J2spP1TLeTtm1eI9PB+2vCcgH/HczM0u0kjWjk901P1efGiOT37vBhcAGR8MK3XsrK9E5gBVZGtY8hsb89zmNmGd/l6fPALNvp8MP5c/EnT9Z+yA+hQM/gQUNYH/H67WYgmamd3XB+CxiB68uMJO/ohY1/9VljyshVzv1e7Zivm7A5J4UzvLHMytHo0cJheN6paMXGlOWfdqFo0ndRcWrFWripesnxTHORdU2oWrOneTNSylPWLrilfbAp9hPAcOIzb+re3ZObioZycsGpblr6Re/OEHX0/OzrK/CLzWp8PH7VM9KKU+SU7Mdz59lYzNA2esLofT9h/ZPaoOq07uZG/CxQeQGq4XotKD7t1J08Lh02lOj0jxcyxjOuPghenVXlzVvOqtXM70W9eqLLkdSuK4JXt9dXLN+enH9mL0/dn5x9/fnF++tG8eEYlLFm1Ha9ywRqhp0BUnT4yjWA1c9tWrxCKGQgDDk9NiDgcqddxy/W+LgQvZbVi17Jbz3SP9iwnvWp6z8WEqRr2PB7ySGyPUUSwaKkl+SzLrOBRiesd4GAGGNOAZuYZO2H9v56CmHu6+G3sEDlmX+/m8LUWakUnmo2Wi8RyKXJgR/ZbJw2s5JWo2BUvtwKFjUIs+bbs2hmAsT3Ypw7LCa5Whr21ovvLtutE075WzVtZCdyV7Tgak6aJQuV+EDPoee7mz8pzus0JG2mhqVqNMvORnreXKh8XKneIzDaqEBPYCP5ZKStxJmre6PPI4AjziXAKlROEZFVvOwPdr8oMHp91N6VoP1m4/tkFLLQBDcOSbV3yG80toCP3E5DGX3alAX+AZQCY1rPrhte1aEK5UsPDZtu64J0wM00R6NZiI16sebUSBX2up5TOya8avqxWGbxlAaqu87zkbfuebwTbP2EjMiVT3WLUh8y3nVqqfNtqLvIYj7bMjQnGOYP3Y4tWJbuzvFFlueB+IH6V2o53+nD8atC8FDfveN0eMy2qa9JuO6VZzobXLeNFocXbG/3Hf0FD85W6Ek3Jb8xnBwdsLVfrUnNAvcHt2wnjIYxTfGGAaOr6i6iO2eEEd9Viu6lFgRxQvzwwgFgO099OgAFqxi+rK15KvWJhz7JaKoLidSM7cWz4onleiJLfyGr1vNw2r/SZEr2H2RRF9LTd1poJtq8K2bX2ncbZYlTIVisDwB41JsVWby89vWzNq6LUBxoMTFasMXwbBmk6qHnbiTda8pHVynWQb7veMz3boqxZI3K1qvThAd8e5NsOetcsEnfArFZlaQcgSs2nqtW5+BIPuWj4apV+5Wb3GDebnjxRjLMJIRXXhl2r5lI0rJMbobadp7Az8fdjw0b0dz9XS1nJdi0KmJ9W/H2rFS+LaS1yycsXa960+BW8uCNsJd+4vWs5siYtra6wDV/JnHXiS8cbwVmuqk4LDnCINwKPpzev4MDVeLasUKK1UFTF1LZha1kU+hS2QJRes1Lxwu3P3rH+hB0dZZpZn+PgA0WV5ZtZuGf1gdqNtfCTzdndhH1/aHdpI1ay7QTS5k+GdCjjEVW7bcRfSrXgpXtvv2473nQgzELfAR+T7SzfNqf1bKmaXPwMDM9KYNCEdx3P1/oU0I2Bl1q4esz3MKXbWz3MNW9fIz/KHOW5OVnIqhirChpMAKPMDB0GVraWBlSlt2fAvbT0P9aLr+pOUzgiYycgI1zTPtO4nF5XH1BkvNFvHVJhS30IXZhBm0HCowl7U0l7kGz4zcLMmj+Gf5VFtx46FpDKAQTrP6NfucFpHfBwziR7Apz8J6Uu21kpqlW3nrP9fZn5x5/kRUgWRXLdtVhtmBcgocXNUqzkQpay08oW+xXk4QlrpZb2ZMcWjeCXLZC/BbERvEWOpiqmjzt2bc47tlDbquCNFEau0TPglerUEcn29swyMLYSnVF2Czj8x3af6A9eyqtsptH4KKpCQPdg1eoNY2TXNfp61mqYMQg20hQMp+2dkXxfvjn78PbZ3xIy8flaOAEEOTnKvC9P3zFZdWKFUz5hC9WtYSFR6NFMXlYrBKKlP8uJioZfy2o1Y286tlZl0bJGeNOTAsCVKlBmNFYn7H/aiJJ3omBwiIci9LAYZI56EKQCkQc4gpXQ4F/PTl/o47PVY+rUZor23vbvW62IwCkGg22dnAEaitESkAqKmXv7Wpal0NKWKLvxqJBXIytTjogA5JpPl9B+ZOW3GNCsFd2zztiUx6N8M61UNzWzO5qwkWZoI0/9wVCYWrIVCHk4jly/RKnvvfjSnSsnO2nNRVUWiF5AMmD9MhouQn3YWLFtb6AUxLeO8ldZlkBiXIL6yHjebbVuDCJ8rVqpyQRlFRg0NLqS4rpWjRuE2Tb34a+Bkr5foX0dqQDlvU4ZHbZTXn+0QoiqcENsm1Y1raMX+3IAAYOGHcoxg90gr8Sc/T6VVSG+HLMjP5kI/SGDQTTIeJ6xK9kaznJshQGBg3QCH9wI6DlstQgGdn9ZrdxokGGK+zo3zehCarLU69Ayte1aLdKYSwW7WDDN5sNCH6PiBh5pxgLyJWxssqLv/jAujdYCxJVobmB0KD1VQhTAqcQX2eqzGJAEDEXTyVwr+9Ma6SBXqilkpSWN9qbtxIagdVbzPELqk5u4SYj7JCKQCV3hiafci4k7WcL/dlCP2nb662NWqYqO/p26AkM/3PBoubrmjaYAt4OASkpcGLfu8CYcVDhAN/SLcPphzUfZxQ5KJ8idic5sMrYWwJ4NlVhj+YTxslT6pDFsy9xEwFaTv/eQNKhHSEFLwor1T5B5GBrIHD7P7UWKWmqwLVh5QAlblur6mOkTF3a9JgxQ1ZzQLatcVa0WequO8bxRbeuMgN7OZPcdir96qPZ7HB5oXyCty5Yt5IqJSm1XaztknKTXWvJtHsRd+KJV5bYTczO/x2zE9k1fovkLr9k+G9Vf5uxaT8cxO6q/zHfxY2Tu7UTLR7y6CY+N9mEnRutXQlMLnlvxSjxDxk9mxbCu8GBOEACs7iSarIlH8iJ1YsfndO/o6vjijebO+tCaHpEp0pKV5aqyYtdrma9hqtDEyEp5JRwfMxaUPs6hcDCJjtEJwStEf5R5aedX1Vzajf7m1b/b84Qttis2rlTHatEsRd5N2FqLadBGP24EGDYXAnbZttbcWRTZLi3xz1oddDNq5NPfYYLYCZsezYOJhLdmx4CBGdWDO28U9ZL24zFanff2zBVIllFYoOQDOViVzwEBmTFzJiH3aKanvCperGVZZKz3aOxWxaypueOFhr2Xd1623DYNquRaIhcFa3i1Emy84Xoq9d5dgXjCK3fsuVthQw362esGbqvwx7lC2+NsKZu2sxTZCFyRX2hz+jD12cEBe1MtVbPhKKks1LYDRBy+wKkpKuyEfbrwnWKzX/BFsDd/AnlfVktl4HKtda3KCLixFHXsmrfujYWhVQxzSCKfv17LEu8HZBWcReILehSYM7SIsXm1qbsb1sIxPJYVq+UXUbaZRu1KuLkn4zxdLluBNGjZEG87LSX8JAx1+kf2mHBtt5Hu3Dp8TAO813vO3QlTzBa8IaAX/tVhTztoX5SyrmGU/rYD7U3m6FBVecMaAQKbHh1MdQW4BEpBJXLRtry5YWP924IBqXqjthWctSiiwWmlVTWrBt+gjLThl6IFoQGOB2PBzAjzfr/d+GGaB2+qSjS9p2AJi9fOnv/N1lAL1+LLdK0a+bs+dcqpO/UNScliJTpy7IKANmPP4FbHqNRGlaUf8FKuKjywWXuJMxzOCp7PMOeW8uCjXwFAG61HMct5vhaFHpUfKj47F186stz48AOyv59CatHnR8PzS1TGN/yL3Gw3iDiaS1ircClARHLT4nU4CybnlWY7l6LuQLGWOU5oT2ra8C9vwRWGrIR7+hY7JYRpXpirgwGq9JsZbsbWQpS+Y7ZqeLUteSO7G3sM6hYv/wrTg3//zf991vGm+2v0+2+JebM0067lUpMEW4uyYIW6rpyMAG8Gd1Knp17D6Nbg0GXUgzWcDqIwpiT0hKo68aVjG1FtLRTN1RQ0JLrfa9W8wLbvRLWNWQOHO7Zztc3X4Ss0p8pKdmN0M7IWnbPzZ+ev2M8fXj47f3Vmnln0NV0TQUNWWjZlRArFWxK+4iCXiMpd7uE+Dk0vpeLFO1WIcb6xh2i+melTZaMKTS7k5mslOtN05m77SONTeGZOTLAR69ZnGhsN3Y3P9d1vE2IgO9F4U7TeHuE5r5/gndCzZSeajEUPAmIPPrkpReua6x9B0zszBoPGslFVJwGcfeIPXLBZ/wpXB+N8M2FHh4f+azR1maui/X1vZM03aM3OWCNWuMnSU2QNlfYOz8+RAZO8xXOzxIviRcnbdkxs+EayCcVhuMFzkhBpDWK1keU2srJMbzQaattXsO5QsrI4NZs/gtJSVsU7ZEp2ppgRJUXbyY05nJEBt75Jb34Zy0vBmxeaPZN2ycuPr3jqkytJ3f7Or7Ldrx/h/l6fpG7lgH1b1NpI1+VAexO9nbetYLxFMEBXjNd1o75II8Jtq06W/sRfiFxtROvU97FWS8GTYWuAoJdJeWNYs5aaQfR0mFmkcK4ISV1xLblqEdsdZWSVsok3m8NOSJGev/euRWMOnGtvOWfveLfWh8v4h0lAOVbQz0spqg5p6EAzLHNJQdBgU/YvbnHBbeIeFvGm/QmsYGPcvSgheKeLQ8OMrSMTnPvuID/scQ/TIkvee9AW7upD7u97rPqQPskLo7RmUff7Jyzd0CJ194hgdx1d4rv5iYCyMaxCLmSJeOjlNsiyA7twcCd2lLE/sW5NNaT7gNvWd31mNrBRqY0f3DDsOolWr0CfXI2Qcs8JoeGJ1otkou2wBV1R3+CxmWu7El7gNx3jjnUfZPSsCMcZeF2Ep9qgS8XJztda7wPF9OBz+6d8M22nn8/2D1YTNhplbN9zYrslAQP/0fh/3X5us8/tn+ATBgBGw9wwHI3R9vvjCb1OdnHcNHMFOfsnJ9yWN5a7fh8z18VWlgUxRFnLSzshFjW24c1KVtawWIqlNSsiGPDoAtdCZMeyQfUm4I29MRHi9NYuslTOMtbWIm9Dxmje2WnZqCsBtodGVGPzbscVKgAMr08paSMAOErZCTb+JC/mtMWrUtO9tdZQ48cDzHVgMSSdZMG+CXo/6ZmCUS9tR5TtkUkL7H8azbm/Un1VdkbcuDaSxDj60imfyKDAkjnyHNH/P7RTec8ryZ6y0YgdsxEYzfs+VGDhTu+GZDNCJBbpPonMFBggAPX5o11iFtDxWwE6DMLzY7Sbwtw7o5qy5g3P9WwaJk4lDH6JvkVaS8hzta06ux2MlyVasFo2bgXsoUutyGbGEgCscS0LwWre6P2mxY3flKwQCETK2Asf3YXsgu1UOuUyYM7uBDQS0ckJO6TnsZ3MUlTMsGVyRk3YRjQrUUxYvm3Me/wGjUljfK0XgXqSPutApRzn2yYLt9ISbKcnBuxMi5rjwwlDBxfLXKEraKkVgs3M98oAz/3gbb5mU/O7U7N8TeXVb8T6VVX8QZw1WtMT3V9wxE9DRFMj7FRqfEOAeiM0K1mKipLsa3vpWCpNcB0KtOaGwcVLUPKJhP7IGcBvn0kgOMx7Jo+V6AAGSBVOgcuGrCCEat3LbNAy4l2QHiKReJKm+yIQMkXFfoyxoow0gTBOdPQ6IDLHGYOj9R2/FMwdoPaAc/YCvAFC5cUcufjdEL93DuCsJJZShAdaSrMVwQrf6yBM1tySPFxvnC7H0TE7GT6FvOJtYJyw6VHs4GN69jMdwSfOyZaj56rKeTf+NNTxRRZowB6BH03/j/+HCLSlzMX40NFP730NDaDTCTsiarOzNL34ePr27fNnH62Z6UMjat6gi1AjeNHClTmanvDwQ8cBpxPP2EuF+1hVRtRq1woMvBtZYYQAfnhg/QrgsqprZN0GxGBev1ZNqHHv2Pc40F/BbDh4zhrN4id2gvondG+0QXsE7du73V9EE2i9oapplwb11J/M5WoRK7D4wl7nX0lx7Zt64b7fEsH8ipey9C7NP58woiMnusZGpGfXMOw4aLfgjZY3jqn0upRfRGGktKduoo/ZofO8Vbkblcp/CsdA37ir57/wGtZzn16O2O/CC5Tj3pWKbWcwwUbmx5DG+R4gEGoyHmaIzoR54kIP0w2Ql6eaK9F01nPf/D3gGxFcxG9kNfU36+AWQVnElds8IxIoAGZ+25n98ZDe7BX/0eHhP8/1npu6J4nO173OUUnUw8vm5gd0b++YVQUvJ2xkLs0nLApktdxVNwvoOjMzjW/w73NVT9jIetuMstDmqirs/AG9QTtKza43fIM/NF3rSXJ6Ju2RLP1a5Jei+P9Eo6wF01wfGLv/e4E8sBUd48jZthsjnsPjiC8yVcFV+3ix7dhm23Zwyam/lh2++3M2u+ey3ZOCt8A6e4YjyoR19ujPVF2Id8GsblSnupsazA4mkofq9Md+yg1PDoUYcKL6CaRPeEv5E/vRPaXGvH12NI8h/NKDYAYXg3DGpQBGa4drG4f8Yk5tY9gflaLi6fPq4WhRqvxyNB9sarwxT+w8PLWooJqmFctD8jmYVVXHS7d0FmF/MLCpwZFAO2b+YDeufYutVuU07cDNX84xzFC2GKmlCXEhWCVWMBMT1iq22vKmMHpZPBwQg8EiYEZm9THih+YstoeT9GJNhxaLjLmnpYdXAjtXI7kQg5jTqb/rUcBPPQqgu+s+EqBtG9OdIeR7SKD3eYnavZ05c/5Gs0RIJyZ1d7CT6bdALCn9MkRKBJvePBr7BSGAcWqTTwc2uUf2YUs+MP2jb0J2cM0fp1n73l6aZH9kh7Gd3vIYMFAAtN8tmJ94fjmOZzVxjHj9kGBnxcnGhif11mtinL6PWYIvmGDOu4k1r565s47w7lq14YlJp9x9wB6fMGiZfgsv5zEQEzEG1lvzJSaUcCN/zhvS34T1P0uP4VzV9w4hlCbCAUTvdqKvZf17sEfJp/dRgHtAEscpcQUsgz6O+3Ei9cFTNjr63rAOd3wn98l6SAKw2+F68NNaQaIWCM9qExDi99RAyvpLaKNYMbAu1ewXFJp7rczM9WedTN+CNxOMePQTueDNEKouLAYM6k6n5DcL8RKRGdPdfXDAzo0jvNp2zh0klOFky9pOlqW9bp2wa0EhwHYPvnWe9pAtSD8BxzRr5sI9TUHASZCrphKN9YL2/S/UFxumHjykAGTXinKJ3l7YRwLxjKkGPLowbIPlmotSKL3PMXIgm7E3Sy2xpmaCXQpRh6jYtDLgKSy77ywcaG7DTM3SYVaEdhacdnrEJ7DMK9E914q6rFYvgEt/FHlHGS5c8MEVhzMemtl/3ajNB93JeKG+4Fm7z440R/1ihbeps4a4q8ASWOGCN9kuMgt2hHEaBSKdtaIbHx0eajmJEF0W2t/YQxqb3QFXc4P8xDj0U4Vxhs/eq8KdOPhkRm6fCFfO7mt1RXid1iYmO3QJVKe86r0tS6J4Z+wr6iLB42/RRDL21R2b9mg8nDBzgh7eze87DjUKOw8b3yAx83b8A/jj6ImzlNuv71QhSnbCvo5QPxkd9yZxwkZal9dvAvB34ZRG4fE9X6DQm0K38RSTfD2DcY4D83O6oXUkIr4FO9x47oER+FQn2/azLoTT+YnYqdwbk3/BLVqlCpGcAGsIk1Urmu65WKpGQOs05uiM7yYJbaSQ0CUvZX7ZWt5O0F82asMuJTpCQrSxiw02HY02atuKQl1XaQOHWwz0ITOB/d8QpG0TK2ii9cL/nftLo/FNcXp3k0AsmzD+RUbCmX4C18HU2hLuR3CS09Ja4HVPN2TU4g6sdPNH30afOx3fHkCeqUvfYOs5ZTjI8uKe+Qi6IaO2N/yhC2H/3tg6jE+whbMehE1+Ig5BMZ7gkh0gO+xu8K9aMCWoPE7iwm5vA2weD6AT+jyBJnUv6L29Ia8yz3VwhMZ56E31i4kq9BP6gEnoLYX79L6VmD94Je4C/5Vpe1Pl60ZV1nl/Cfdunl9AvBkJfPVhmc55BfbkbBdV9ld78OKEEJ/8HbxfaSyCCXYYe6KhEXTpwJ5xEOAAUNFGEtoBkjCeW3vaOIyZQCh41sdgaJSXM8s1hWgcMPo1fsxaVcqCdQ2vWpR4Rj6OiKAMOyHo2xJyIp57t+Vo6IN1aoyRxWTo2+v+JAdfGkvLA3AlOXsCPMKduCPMPGxIbozolIWR4ffNV6L1wyYr8eF1ZDUjV0fpGduN6qADDjrAgMcMb4TzzZUV4zaRlg1/dhmzHrmwHHDhMWFluGQu5D2b+S83/MbHZKp6ggBwbtAvx/jCgSOPqp0R5INqM5J7LuAhBtW3egBj6k0xcR0H3sFgUXEo7e35IPwODTGQeO5pYDWmTTJ2zHpOv85aY1I7QCcAYVkq1Yz1g6m9n9USQnQ3i3qj2fZJ5Mxbj1/85hh63Wd9D0xvHyQ5fJYYj6fX/Zn1lIZJ00MEt+7US8NNfAgbrpdsGWdfNchj9hW9tvL1nYZCf9+ZRHUTk1nDhtNhzlfVWioET68g4n4sl5qg0A7gr5ySE4UkFDnRwjMTghi19L5Q1hUTohLjVpE/EajbHuoTmE8q+5r59W2ImTU9t7gPnqGfT+Dv44Fk2T0rnEXGao0nUrKsxnZ46EFU8hb7yDL24wnrVAL9b8fyXGUZmz4MRzMV9sOUA6RVl5G29P+Rqtzu7NQEkd1nR9kdZW9v37x/xd7//O75K+cZ8lFMwWWXhh5ibkDjMbrhzWWLGQY2taha3glNoYZRxVFsJjjNsaKkOzCVYBwjpg4gJoLVzpj+6Q+1x/ZxENK3t0feWKcRf7egRdzHA6eadVL0fEcPFWwXdsSvVfMTHpBjErEwwPPMpY9xRfHP5pHT8a9kiAk3lwkz90mADT3ZUpI+RNkGsQkwV/oxRBig4S8Q3gfcQgzn0F8hVl5QD5+Ht16lGyGOEanqxH0Dv/miDPgFPCRBF7/hgH5jT/B7N6Lf9vc9GvDq028XQwjcPdo9xDg1U+hSbMCNzbSbtcoS7ro/xwkgTGxnIorXWVohbFtveeNUpXUCPXwqL1jLJ7yduTgkEllr9QQEIq07V+h4NZwdLDQyPY4UNOeyZiNxvMtEP5xDs0zDFfG716oJYgmdZ6Zm03o/6BFP2VE2SW5+v881ZOuU+viERc7iEIIcHmkdhpbYhlY+HHCRp543uqtsMFHLjv/SPoKsn1hJlN3Iq6SYiLRCFqDRpregAQuwuUlMM/KOTQ2IeSLR2F/oBr3uhfdFcxkEebtjBKFPUnBDNAyOGXXlSLr2n+zo2LnqDUCwIefDEJ4ySjHHbHr04JlJYhuqIoORBORI9pfCwUnt9k9ofwq3jNsvLiLEfH0GWXHH/Q36GhJCdKIZS7ZPsvk1KMNgoyxLqDftPSfXrkPpkcmL2rFtCwHhqQsdG9HMWbtdTOGubMrzfNs4ltWIdluGlo/dp20wJz30B66VkJeTgxrMFLsapzLwvfz47FdTtCFObofsFcwzVjqeoPx+n5BD3dSc0O5lbJ9VAXKEQjJuk7cZjplr8V1ZAtdnJdcHDHikFar6rmPXHFNCAR6MQ66mG7Xt9IOM9ooK7cmAvmg5vFMZSY4+DFO3MZjshD2Opduevy58Z976jAo7NbP4s5iLJHxfyUeqLMwqRd+5M5A0xrk6wTmjKaTlpsVYg5dyI6pW77HoU2FvETHhClBPQB7+Pmlm0sOfeKO72Ei9ySZQKoWeymvemmSgUZsgnbO5ba237XrMmxWIDcbYPd+BB+b8pHjYrlOyJe2JipjWwAqD0udseTNG91XyxSd5YfHpSScvSlkPe4XvlA4e9439NtvK3l6fQRByJFYs06qf5WXH5ym5v0eAHnbCpOktX5H/dGD6Gowhc7bQ6QD+9wNCoypYeZ1x9yG4JBLbhGeeY53KRMcb0zecnpCrdeklZAuWPeeyhNyC+PEYuTzcskHaeReAgnaVSpnUf+AXWagKCzzB1i0wnczBgc/+EpnWzZZ4s3wPIjPwbnxzv14aBiQ5WsTPI6boyQwyYvwixXVCZCCytU9HpecD/FekTbOInNrmkuNlI3hx4zM06eHbWcF8IIU3CT026CGLI4luzXMDHYw+7MdQ6wZLzt5e3LRT7EnY8FxRyC5lbiLBki9jMdzoxxh4RqHbV2Faq/ATMGKrbdW9lE13YycfXf6SK+Cma7fadM+qmnnafWqQvGPG3KzlJNmBG00lromRD9Zkj3Uqe+QNdwXNEEa0qnlsyXSifGqlp9TIb3t8BxymH06HZmIHEgxnxSRBFvvDUMl1b4/E0FioF83glmjx/WHWG5jDc9L7YqC7c8V+ZFhhJXo81Y+xm8RQI3okdyxxbRNPIktrXW23vARiUi5dxdKjaGx+vtmrqiAtOxXSDbInrA0GCZEaNIL3puz2VgN+3Nuot7exXhSmTHt8Yhc2FNfIlg0zqvU+ICchL37btnafTJy50l0/EtRcLrekQdVOR2+dven9g7lgwcQBkPa0kFd6EtAY5S5kBy5mYtcVAGETdsP9RQJde1SSfeIS2fc5UCDA2DXc2yMfnbBD8CaNuPZDud7/Rh68g3EeHLDXqoEkp64yxrXAGHNwoKzyUoG+aF0pTTUKkjQVUZi4TF28Y20NiX23Ndb0scnkW6YFSNV2PikriScFnxp2wjBB16vSuReCo7Cd5x/Zv2YDudnJ5SDxC6x5l69tTnNNyoNTN6HHwB/vexRWi4mSOPZt45i/tBE2vp9fCbYQkIfPJM4D+uduiuxSwFSvlMusb9JLq8aMA/0+W8UaMcUiCzbwBGNfEdreHp1xzRPIG/OnkaNt+Ih96srFPIpcsZLZuZHhgQPnEtPnu32N+9kldQPmBHnSocNZKmWHnUeXujmb72pGMz5H5XxCK/I6VnGjnFs0FxE5qAxL6OsovbSWKRY9H/jICvmDXLqf8uxfXcqzu5BT35MzMzKFhSavWrXdz1QKH5C+iUHEiRjWLhJqqSgDGTVk7n4avYHedjzGd9E9UFAE4vbWdtezHfSNB5HbudaIrAri0If0HHHuYy3FzwKHwPuu3jNimCZT87VT9bEXUx4Y+uyFK597KbrOvwsC1HDBTBpYhN4aHoNWPJftAbK9g5JSqY6CwEkobzBLueH7vk/2X0LUrFQKVgFzo8kOyuDMBrSV2GpFtbQBw1WkrcVqDzn379V8orbniq4Q1CqJ/cOJIrZDAc3Cjx/gFwdeErx590DPROdIZ9nYLh87wMwD9w51ontpbqgcCSUaGr5hJhCNRNByhI9Gwz6gbgFCp8Je8mQs8BOJt8PfnJP7/ARall4wCwigN0mRxSSx/onEiYlUzwlg82FkWYLQIlNL2rZxBgWmA6s05a5bK56SYnUJQzZZmgcQ7teH0+zOU+D/AMJ2xINW1HE2tChpeJEf53Bazl5kscvNENjnCICEsRH1lgdDGBBixikAKUfZOCtE6tr8o+AFNQ7aM8XYBsOk5ugE53OUSON5ArXTCvdtpzALXMLgl6TFB9mZTW3bK2dxjWV2FGedj1vKdk63bzp3JDicQJom2vaTvLCeRUEgx7Zxvhy5qjpZbQOHioH8/lE0FniVbJsZhAu4QeglDR+GUiVjjh40iCmZG98kmK+F6gYjhX1cmOv03uAw0j+J+dI/OrsG/si1dhLTA01NNk3MrHn1hH2f+X5IzlQrP9FvAPyPbHZ4eITK9HLJnrCp/k0nvZd10uJjV5gMEduiV1PUOpJj9ONGtF3afca+TXvQDPajP/n020XWdzwLdq/ek7Z6IU2BS9K0t4y3rcolyI5WQ0MQ6D0LC9LfrgFKyfxy356rdX9f2rGnU6+yfk5WoEgfetfbEf6qg3G22JaX00bPjGFiL0/fObMSutOhT75Pu6SlZT8lestPXIL4a2GuckHLLYVW5U31NoB13WBJT+MU+vL0XTCRPbvzjlxLxtvp693EZTm0VYW9p5qJGffZmPDCyzu1uZWogmbeq2Vi16iaQ5tqVokv3ZlclLJaTcJEmBqlT/2Mm5/kxQV8iSthfBUqgovz1npLfNFgVLvA9S7vIjdL+UUUH1R7POCgkIVuQwj93GVMOE7nsEp9hJGNevSptwbadf9rqs4PpIQKvEDPbqqcnr/2ANQ023bNNu+0xAVblp5IE7SJuAqCWBFwqZrYU75SmAywsdU/8AzPG4F3gGDLq0RLqnPbcuJ4x3cjOnLs0098a7XVDMcUI4Tag9TOENvqkLUYgwXK8GCcu/fGrwzsHGlHOeo3Cp78ounLCjavpWtCNoc1argU+psozBGT63yBoHvNi8jeIQGMv6rmsrVFgzjr1o26NjU3bI6Z0zP2V1Ndk+aYdhV7TCoDItkZU/mvayHKc96sBCT2BPQcEWLU4Q7baZRkOmatNEIZIEeXpHq4vSuQhI+wWS7WvwtxFtK3StXMFRu0GYetBcNw4Zsqh5vUbcPGlpkDtfv6YPHyZt4ICYjxVjPxlZp9g8NukFFSVsJYeo00GKcs1297br3Exf2xa6RR1+JJ8IDMP2jMliwz9hUSY6nO2HNuRBfIbBVWkIDszXBZhXMI28z2YBbCbDIvtnjiT0XpbpvYVR9QeWZuuwEdB8rkV9VrZJJ8u6FlZqs1mzGFSZVf3LmB32rrrT2ET7joEnKzwhLNnhhQGohDxgOf9/LEQwNzV0JlRSPGm2yYccsJG+FpMMog3WQWj4f4E8Sy52vVoGWjfdBa3SWMZsanMEA3MpQnBp9RfBLvwwpeNusDHBfiXHwBGh0POxjDmyxLou6z8nrCTzDPOyIcvrcJ+uELf69+9ygkuwSV2QP2V+Ojzdta5KQ0hL8b4/qgNSWMbHlS/DZecVNuEOe/NQpyKa541UH2ZupR8505UoODPCFiP5gaYp8wol/08KR6RuiWjYkg4g8+/RawNGx1wkZa5RrRTP2aBAIcwyjz4GO3Ofzn6LL7AJLvQ4NM/QEwiCUX7fgBqKAqEXxtYlUiXAgSSGPxTJG6Ky6QByQuOHJ6yfOt+qUarZjw/HKFEgGMBl1GLbEtTNFsUaC8BXeGcDB+6Rruj0daQdeKm6yQV0EdCAiCeGvvU0ThJylS4PyhdELIooME5V8fRWzCHDcPr707ypInJaS49meeF16GWthCC8iWAnwmEdbzFNIBV0u3HzbYhJCikozfp7Jy0w+Gch3oxXnuCKK3PiDBli3dq4sVVgN42n+0z0ZQTSDk+O717S2UsThmydfE6l+2GfS6f8JGLAqn8NRLgpo9Ju5tFNdUtmQGfauwMEfZBnv3a/KLASE1hcI8BcBuXWtH8XwCkAwyiTW8dpF/yW3UI7OgH/19KFb19ozuE+v9EOUjkT76V7PDjSrhRL0Xpl481kW9lmXJGoHJKoU/cB7FInKv6CO4c9rg1dhyQToKuT6hUlSFiJYSd+GpRTfd22OQ4B7Sp5+EFJnMJbOzTCVZAFJS+0tnf0XKi36jZ7BL7Np4ZvvHnLe6WXuROenBNDljb6pONDzvSLYJQhWap+t2Jt0EHl5sIWyKUcE2WD/lRuvT9bZjnboUlVGzZbUst8KUzWtFAGRAquif1cO8BQbgtiQJ+taT5fP7D05NgsEHq4uqQHyQAPRZbZfpYdzffZTm/BEOcR8aTXxkmePjBIu9vTXfaSD9Zu5p4oy0IGzPAZNlCRiupXsS3mztEHWGWNjAslJOe7fjWOr1FpQjGjq4EmSAZtyG10HmoB1cNcB1AAz24wYe0lS8SllSGqC9WNcuDFr0a5JYqKephwNHr28wcPhGqz28ZBGkXfLEveJ1SvhbRelEwqlKnrTmkx5Nr/oVx++GOnyeEBge3DX5eACJ571jfwgdWA1ahul/KgkkEHhANagpOSo0PQ3i99DI2FEJJmygzcGiA5oqZtaqzo7ZFB7EdnNfAe3eTknJ+xFELybAmWtoN3N9aWlwLtM8/87t4A1vLtE8nJq/d/h2PlTe0xqfbm8toD9KDCSxAH7T3yMPoQmj3o0mZDW/LTD6H7D04WL10uC1ovu5EgWGTIz9oHcsr280pL3t3qGPotsY3YFnmaC87NxC83Tihcj+OH5sqen2ltm/d1SiSaixHqIrEwfIUnWUrCeNgr/HxBZzgW8Kfu997FkFIYK3YtkNDxfpwm/2COQ+69eUCyLMgaiC9XZbzpm4LtHEdcmeJIr+kQvly9irQhbJOoGfLi8mvq4Xdjdb8/b0uvqA2ZtuxrLI8PIFl1sWF6G9GD6nl/cDy0qzG8A3F8kdDsvxzbs7XiVZ9NYjXEuYdd+MzPzdveLgDktZSp5wuUWIvO+eBYexv6hGdSA8/ul9NVw6QbO5bVwFJqoHXMpBiYJI+uvXVbSWwv5ernbcjhmxAnjcA4yLVpF8DlU3udUisSBU3YhWVB3WJgaH2DZveJev6ep8w40PUfoers3FWtQ9mltPq8oeqBD5D4lO9TAd6dGDVKRvtj0/eBHvMfdBNRF3c0hTy222ZSfrUrBSrWTOS3Nlj/nBTY3CxU1cQ7KFcLYZO197px69gXhZmmuHjbUnQyRuw7FcP8lt/ICB2Q0dt8VDaBKebPHXQXHEgCdErlGRi5Brkgpovx8VcJOSF31sMPCgrzDtBNkHw8tSXT9bqKswE23o/hRnrXqoqBjOyYRdt5H7E07T9Y46tSag6IRdtzANfSv9J2wCBHoxGeR5lFM+Np+sIe3BO7VtBWZozwbyGstVpRoxxTwDcWZj5vyv/KyPsQfEd2CH3StuBh4KmMTKLpY+xc0gOCzeI+qjk7r0jmV0ek0fSKeBA8V1fPIHOKFP+1uuWY8d8KgBA+Jo0Ff5WyeLkKVbaeMT5UklcULrEY6Th/SniyzDRBbBaGj9XZAuUtFL5JrEOLSFqkaYz4ASG8mDSiU67HF6ktYm5ynXFxNv85b0P6CFxtfOBIir9tFPZxrPdxLz1H3RD4lZssvNTjRpmJuz1PyQVSWfB9WMp/ePld4ufDg9e3P+5vQ9O33+n69enLuDS6+TrNqOV7nwMknLuKNNsHEbVx2wWT1C8tBfntC88vggKNg6Yfk64KVQC8F1qJYaiksFCKERH1TrPyUJX0pShtUWCNKy/9qnOTGx/bwRrLtW3hN04uohm9wON+DMBvcnfCMmjLs6X8bJDnUqzPrGZMtK0RqfeDsvVy7XHBRuvpaY9gIs7pDGkExMvqmjueETtiBFGDiObsoW+MftLeNY93gxy9fzOItLruobPU1fMvbVQICf5qz+MtMzdxfmffmiW0Td5pvaPHrCDtlTtmDHjOOtmf9SVg/4krNjtpg7Ynvz/sPP5+ynZ+9fvo1TOJkUohB72k+IF2Xo35WLX1UOyDygdPDcgGuyBWTDh3hAzP76q2zFMVsoVU6AlI/ZJ/QIufApYVvlPSQn5tKMtx04zF4Ldlmpa3a95h27hHo3Sx8ui34iuaqlKAwMLVNf85ZteGGdJy2VlLztXkBbGsrpU3jWdXlzLr50b/SwQWjBg0wUUMlHwB+tKCdMNXIlq8CrslfCmca7rOWyC52WYPpbUWYaoMlz0YqShNfrKRAIE9cIHrypcrUxMZyIBWha8C6ytqPvhAFdlxIfjO2YsglKyx9MP3pCrN/fr2YNdEfvaWre92Tu7fQwVZlEN7Vo/Hs3TBzI3p5+NWuoEw37kR2FV9pkifb2yIKh6f43Javx6HM1ggQnbiBx1bNeN//cg2ReYGU06uUVTMmnC+rRlRSxk3ADITuGi0d/tCgRnE/yIuXnRe6k3AKTofQGTocWDGzDaw+BVMYoCcP5VF7MScWNuyily3vVbLje8Gt+JRUk/uyUpQi9K/XJArsRCEdcieYmIo5gQvurNmVHepJ/xMmeTkPJHNoG3xHfUZIbBt5B8OvY5rbGR50KS8fgU7Gpu5txFtOU2f6Qn8L8CfX2Dg4YJi/Dx3ZkQVoUzcxJomlfYd9AyqJ6TEHpFC37XDcSN9Bj2Eq0V/eadAtj1J2avNUTHzodJG02rzNKufA01zJN2XaeRDJLUClcB/es5f/fsJX7s+dGQ6bQpTaiIVBojQDejVwz3zan9Yw8ptSBLmegdrGTfo7pTtnjiuybp+THJ8n+mW5qnJ8LduxZ747LJOTcx5aDa1WhNl0YVs6OPePPt51j+0/ZKN92UGdvH47nkY9i3/BLU9nfJZEhw0yqTRAPDHA+Cl7YUODeR14mtytFyNHm9mnkaiWaV3qHNzIPzk+b8gJFkReQguIXjDT38aupJXOJEcgCunbdTW1SxrrcbAOH5Ul6Mk+GUpaiZg4rOxa00LY7mOHmcZaXsl4o3hQvecfB/SZ8NFuJTv87Bm/Pg7rksqIF/RGUZzZiVmNGkJdYZyHkUFpck61eqNOqvBmDLZ3meDAV6mCqSKmrbfWmOsXCREHRpYS8g/hM2KH3OwRqzOhJkEwCG87fTlowoyX+w98J0/A7TXsNz00maS9+yc1GFJJ3oryxwCHhp6wKUXXJDM8WJibUjdJhtBvedG/g4759CSUym9bcCWX/+44rf/ysBS80//2RHR0eAl+QoeT0SeqOLrChdfQi3wJH74fMwv0t2q7yjabKd6oQzzrSa5SzWc8MEHngaA9XSVo7DiY3KB6QcKHufzEUrEk5zMzGBiQ+11TyrBv/ltnogK8BryXo458msdYknqkJGwEljALX/Si1xYAEFqCFey4SGvotZp1ou16mr3j58IRsS5mL8WHwOl9nWTBff3ygNGGHBZP1z4YAfXM+xPgOcQGtNGuG9NG63wfFWPDy49OFgdcSeTslafvtGO67gZiiYAP7HeexnkcffDQb9Cuv8rVqjolV5DCbMP2df4YFOQ/90WtQAtHeQfNp78DnTr/DzQdvfbsZdjnxeOCOzFJhmiiV6P/biTs2/971zzFzIjzH7GHv+Erm46UUpWPC8CMyOPNtp3LVNCKH61O1XFqqGWzNa9nxUv4uHvBBW4uyhNKzujGwl1Fwc3f+6q/nzz6+emZsGmfnf3v7KhiVPrN4I7g9t+xg0ESF+Ud8XmkhGNoxalWWYFDCn5DX0n+nD14rcoxGJN1byVfWTbSQOQSn2JK514KJLxD6AiDB7FHXQrN7wUvWKqMOQBDpNRvzpT7YIMc15v0q5aVg312Km7oRbfsdUw37DkB9l6Hpq3FZ42osOgKR0lUrr0R5MyPY49vXvI3sDDAD5XIKo8UQUyzA6Iox1JDatwcqrHs89skHzxueX7ZopiEzyda8ZfUWuFGn2G/btmP1FrvkrF2rxuVdsyFBttZPZ9YTjJQktny5LUuvMpLRysomWXdpUHqjtjUrrlVzaR2837xism23JubXJ11bCKx02axU14kKx4YZ4DbqSrSMX/MbVEgsrh6ZNW8HsEB6VJsaMwKS6JbQ8ITBjZaqxyGXtLdNtuPhwBC+aFW57cTc1V6fHonN3ObHOtac1HgwHNVf5iabwTGDRmrbae5zzCCalWRDLeRVdOHViQvXu9Y/l6W6PjaDmLN+oIrr9V9or4f1l/kooxn9CB207FLUnYMlClbpXWVSWrYKkrQaUZmmVlzy3KT5k913xhyJuasLJDeIWVWGcJ0HAFK8BaK2DZY4NF2hERHjWNlpxTCK2Bgqrxten6jl0sjOmJUZMx2AwaNU1zN2pkA3w1h0PcBrWQhL7T7DIALOWBdfkoyODg8P3R0DSCBdfE2oEYm478EBe7NkmAMbVn86ZfL0jC0hDXWnmKpFxS7FDegrbIx745+Ovv/zv2de4YPa9CJIqA0ouYqEi5K7GnipA8clO3JFDa4cuw9Y+Y56yrKStBhyVC3BCq3IvfW204KKLS6tDwMSPN7wGhNVrGUBZk3g3HRbU8KnafnZSW+rkqh0TcGt2EiTVdIRM5Jj67JEulLlmEbbUoypiAZt8N6jEbmQVxbFWVAAxmLmejnR+Aah9mGGxGTV4EJeTXotgogZj9p7l9ADkp0u5Iotyq1gi1JWlyaKHHZLxd6phSwFO+NL3kg2LpRoq+861gqxcRxZVkCKf2a8utmoxlkR+iTnNsGhTz8LpYg7YW0Xu4oQ9+LOfjxh/wGPYFYp984Sz6KIGEZkiXFQlzjCC/VmgpfomalB1H55+g4MLSBti0yrfH3jg1VRH/l43yE7h9fKPbJL3nYfhhD2F+ONqHkjXqj65sW2eyC+DrO47rPaCEjTjhOpBYhQSQvuYshNERyf1vaG0rJbi3ZMMzZa1UWPMCEQ9HVC5DChlNdvca9s4cysYgaFStjJDuNm+AlKHCi2diJLKZhhaoM4gads7frEsenegpFMQkWMEK1hrqFmNn/A2mjasktjFB79o7cmYuYji7fdKF4JTR7BqhpYjYkZR9GiFeV/F6oylbXDueqP7iHrSxaMYP8/XKxHOwc+YHWkm/QuYhw5sLNwM7rOXSNV36Ra+WZx6Y4HsSSQp96YUoO+QA6ypZ37f/4HOZNNiNxjSyQ5coU3TV5cl8H5Ob4WhmWC5Kauq6w/DZqIoZrVhI0QEOQB3jkbjwenI2PivyNDrdh1GBgdAKg97jZ2r4YGzkyHpvLxSEuqoygvGk6h0y/s6eUe4Iaa5aXgzTiLZp6qJXQjQffH+A+9wABYwJE3vLmE8EdsEyHaqVE2YV+dH/Bx4BHneh3deYTu+uX9ExMnqmLnMR9PRpBpJHFmJ6YinLAdDXtCwV00grsJkoDZoI7XHacGEEa9BqnAD1Rj5Cp6dUUk28mDCriQEwATGZ/0MMMzwGP0TpkMCbE4u0MRc5oU400ntS7WpoNBtFINjPVX2a2RcuJtsBa8QA8e7OeFUk2BbrXWqFc3csObm3EG1rIJA/00yoKjhdpTSI0Yi7kDORjRCBd+YrNg3pu20RR768S5LwJtyki7K9hdlcDYlB0dflt1RjNPMywAbXCfYc1pM3hIdZ3C0ri1fQOatjDUt2Lp0Cwxe53F05Sts4jqnzR6IrxwQrzDDdau1XVqd4GLdpR69N5t4y+4aKafZ1XxrCj6+fMnxg/cZOvPHvQ1TatvAbhnYcpNeIekZNOjkz1C9dKwcgf5LvTCTH5kvUjNVya7YN950044MCtw8VoLb/UEc3Fbq8olT/FsbIxMYiEY+FZMXNUCrQdDLjy8xqW1nWRrAFiXADAxkvXFb0LfIWNuqzSreieq7QdRYbnOUESBqzBZyQ1HKRN6mcTkkeCfD1NtksbknhDuPb48G/PNDHrshK15q6U79FMIUnONvReLdWWkri74zF8j2oE6ycJz/iwj7liHrhwCzfWHXhIWradsNB2xYzd5Jj15CDSiOnt+OBHcgJ33NMfQHzGQwimgWBhKqflZ0kIb9Uz1rZisEsuZHg1d5G9Eh0oSd7TkYVoTNYsQssGV6F5LURahfOFu6inKc887t3Wtmq49V9t8nf4S1V73BaxKUobx+8+f8o1xVNDsa1Qp5JMjE/2JJqLb27iWSrjGwUo0N+xrNP3ONZVkQuNdvmYgzd+BBfTVn9E1FdJE2kpz1NRseDOavplqgCnJir08fZdkgYty20TTFWKlGwBSk0eeeX1wdvHE9A1y82GOfRhSgDEWFq/7S4RA2lJdGyMQZeb6EVwFIS93iduw6C348aH6pemQqaW9h8J0bRZKs61axltIh6r/DcybrvwNUp3BIzkPsRk3SCDlhHhz0RUz9qDBrBVdjyL1O0gkc6V5f1qXSKoKRMPocSgTOuOnd0AXIP4uKC6veYvXZLnaCFRq0VJcykvwc1GMF4UmSFwVd31mDyCrCIcmbPCZhrvOpdbAm0lUud2CARkeAJtLS6g2ZaoLCVGxv29lfmlvGa0NcXDZNrJtvcPIJL2M8RJGZgFvi+ypxabaIzg6DK3QY9tob489Rnwy9tUhBj0lqOTfDiesRu99micrhazhh70lv4udKnqdfI+d9GUpXtDLKMqb4ooAvlw+LQLgCYvQRUsEGwk0ZaJMGIkqcX04wrLn+XhblaJtzafr7QrW06TDynkrGGeQNWitykI05NILtsQM8UkIcxOqObayaxleiesDVqptW954XIzSycY5r5z0mE0MFhooVkaQYLo25/P4WrDNtu38tXuH7Dfk+ngHA4f9hKSJ4h16/rTszbtXGc5uvYvoQ6FRpkSECetJD+4BuT06M8XgZKuHlnO4suTsT6Xq/jSBs0/L0LZeKm9Zvha8Lm8YJ9dHNtWYuZyH1QNrBo7NLsgaIupEMWNUFPFgbCQHrJVe7bAsLWelwnSc6N0dEpQHE1KMTeuuFx2lpmu1LQtY2S81ujTM7pfmrSNfwIR9pUeQjOmokG2jq6RfCP0rvJ3PQhixpyVKuClHS/MK8bkUN2fi78T3sl9BMPB+Qj4B00Fo4c0yLnI7gaUPpsdkAQvHNKSe9IvwukTa1jeiUlWrVyEPTK3ebURW7M2r/zg4OvQsw6yy+FKXMseSX3CQQGCbFhUaeaV5F2y5bSVzVQjv4KmPGw8GnGIuxY0+DBcQIMfe8ZyN/+n7f/vzf2SzRw+40EsI1yeY4ilcW5P7++DT5+3y3w8Pp/qf5fLiAJ3zIBiVnj69eCiYk9Ds059dZ7ywFZ9Q29NLRgODRPlaNS88lcfHniv7Bpmr1rx5gX6bh9HRZ9qdsMMv3x8eLkJyzwIWNPq81U1GwwCOCu7ERjvYQJfINzPxReQv1GbDq2I82laFGhEp/M6v62tZYQ0cmsPXCCxG3HFF0ypxTbZIiym6DiespEVq3VB8xIIPX/B5cTB9McB4wko9H/5DMo+6AUQgxBMMLzK2v99Czj6KlyiXkVwz5GLtZjjhak18PKEvck54j2KIlRyytLl7siWxTD9loz/hL4ghALPR/BGtUfcSCm5grQ2Q15Ns3FQnlR14rrRsuW3A5c16h2nhJyAgGlaFdgSoy6ufWkdeDPdAh13C+dArejloNAFxrNeig1z5ceDXcugKIHw3bOlPtUObib/3CJsMX4N8m4n0IXcmwzcOKbf8CQ26+ABeULuVdyrsWoaKgnZ2j5uh7UtV/yVuPjSiHTYT/EHTCLyPPRl8t4SHHifv9AY8hKxWN3SRAq420vhuGwsKgVjDFUWtIDk+ZHIwF6MTcxuCdxjxbaz549wXcwL9RUO7vQVZvVNOvdWb9rQWjTFWLJcy35bd7BG9PrISri34DHvaH+OqKm+s6SMvZX4JoMDcse1aWQjLmdu+NKiVkO/gGHBLc0qn+zuGYhFEADZbMQuvm0QXpovaASkIh4AvTYkOYyk1SV7aca1aYNrTIy9quaLJsAC0j8w52rdQL9DLhhpOwtOADECVxYuzM2A3xvySt+05OF+rsjD5g7EFEkloqzGNQ/032UTzvL4r5yhKkFeL5jmUEgshDVxMOe/zh3TlvTQPqZsm/oICrJDtUJiboL+ZaxvEyFw4/UCTGULGLJIn0X751/BLcwX0Q2aTbP0+hfPiGM6QOcnyG+bw0nzkKRs1qwUff//DDxPm/zc7/CGDCLmu4VWLuW5HAWqRv+vcOElOzRQc9t73/VxVzXPZ3Rzr3uZsKctONMeMl/Waj827kx+yeZBrwjp4GspCgvsbZMGoCnVtuMLf5rFs/mLdgIkI/DPH//T9vx99n0X+fUl/Ctpn0MW5GqP04LHI5kmIkbB7cMCeFUXLRriJGC/LEV4AwS5mG1FttSzx+nUUt5ZUS4hHTsJ9h43CfZNQBkPz0aBUHUVzGbleH/7n6Azv7ucK0WneYNF8RvkButKLvJvysnRK8qoRN6IArRwZbCO+a53+1inDUk2WA2d7w3oYWpn+XTQKCY+1Nc8FLY6W84qVvBMNg4AJG3hAtC8oaO7sK7MBpz43mp94ftnznQBfYsMTz8D9JHHh6IRfco2UWNR59IH40l3BTZZVOzQrcFCeehI4ZqPQA4sQh/72qOCjOXXsR0O/IYopEJ7WQ9IQEI2+SwclOILVCNhHT1FCXhpO1Qk7mgePX2FWWujQxigRCHBcT/G8lpBDAe0joABDYhPjzdSwTm3ztSicg7tXlmNXqI3gVSc3YvaI5jH8lt3Aesqbr9Il1rIQCSt5ckNGnpK7j7zgDH1EJ7nf8MXZ2T13bE+0HBmKWgvetOBtaOWt8bAopknAymyRznSO9jfkDjDj2xY2pUKtq3V84Y9sLOCREjNGJQeVDezjeLOZPGdg/D9Ja6K+oucgdVDyADWgT/Mn7DC8je7vDr0NfoRm/a3muUEWwQiluBwNDK2Bqrlx7KtK8hDI/X32hB0dehroc3PY5p3l+nqiJuyH4MrbgbzH6EP81uhue1iX3wdd3qVsRgNq0m5CAGMTr7ttIz5q+e2FlvXp8ov/bjtVj0V0x7/Rmsu2HiYatVyOUYKYsJFpPZrY7yIN2g0WOYceLh2t/1NVDwHac/dN9PDDYZb2V7GX0JgXgeq/V7wMFdPH8CSweoW+RjQ73rE94s3LSoiiNUk/XVSOcWd/pGEMhbpkLo/W6fvzV+/PX718c/7s+dtXg9GPppNXBpH7gyAxlxdvu2cQZvoew8Cjp6dQspM+h5vkuDE8dG29fn5/5CC0WDU8Fx9EI1XRS76QGtc/PiLI6vvzXqQPvsG/I88/ImD2opsKeRU5Xl89yNf68YA/dT/6w18o97rZ5VgsYltywTsOWSv0H/Nd/sCtKI/JITCBT4/h/xN0DX7pHtxFd7D6Wd8rHCuMy00Ylp1wfQrjt22kvG7YCzh3NnKTdBoye1pbI+JKXRs9DEy+wzueyGrj0lCDnVKzYPz15CQAkO3wIsb0EbGt4YNqo2GYdNnfaidkjA3CYvvpcQWe1T3SwSvmYWqNxpeko909RM7bPdJUW6grEPUUUZZu1KcsjLsAnB6fAKCZo1C4BjlAUcNc72DTIIkCfDOwOUzgG+Os3Witr9BMjfo8o8QON1t41YjOHZNHSXHdcMgML/sLpfdbwTd8Ze5Yl7IRBWvAFxhuximYcD5nqeNw4PR202dSzhZZInQG7ihe+D7GMN/peCvCLXDOUwB3uMuHB3aCf4LqszNWwho8mlwQpF9VxXgn4PvjBeNAghTBJa6HH3ufD5v7O6Aye0fkDc4UhUasXOambH63M1BPVf+HxeilwqJM2SnPH0e+Vgc0mv9fFRDn9Z5Bre3+wLnDnVFzBsI3THzI14cC3w4OIJJXfKlVawq3uhxY7NmHNxO22GLwL8ScF7LN9RvrVuwSikF8vuwixt7LsPUYooPp1AwnzcK3YUYuMPtBTq6dzdpE4q7JjkDPbCetHRyw07KYLnm7xnQGi0aKZXkzBYPtlE+dZ9Ka55cRkV6W2wIuIePgc3M7he8Tsd+R94KLgQuDwPHzSbplr3ziH4x9hZAejt6p6PuOxY+t9y8UWojuYYeiL+8/7waGTQtM4KgjYrcYxnb0/pnVN5cRsQdiMx2XTghGcALFDb4pZmx3LJfzSPNZ+kngz9IsgnXlCFyp5n885kZWSzVhHb/EXMORcq3fwjlpu6Wl612+0sijF87jpTIo395S6OjVvFbXH1CLSMQeuCbvTFkIwjs13Kw/sBjY4OyjlG9uVcLQh4lVddxQh/Qd8CHcNqiCw77YnCtI3WlXUH/Gnd5Of6MWHoPyOywFaWl1evIzggPCgUMJnMrsr9mCg2+t68a8xT2DLwPWU49NLmsHYuI+NrNkwlWyhE0Rvscs2ju+75T9Ona7C712jLG+Vu25enn6zs8LxYJOpgClcqA99Brc3WMHekZEVWQpFCD3DCEKQv/z8MqZ5gl8obYQ+gNPbO6wwAPMd0+5oB3w10oV4hg6/3R4YWu3zja8/vT9xYQpWP1jdniXioeBoXwFP643f333ihW68wYcdeGkugmtiK5KLPRGNjbmLIzqxtqqcjU7YQStlj0Nfn6iPyg8dkzbEX8hWDgzbj3ODa/pd37QvZffX7Bp/+m/XNz13PowGgXYMCg4sFYYEm1KZ+Pf2NVEIxX8jaUf3OkBl1k+aOV0i+kabfkZKF+0AbVwAT4bWmEvYqdsqJkPDkvXwroMt6JjxoFabqx/MPWzCB2lYNVXIr9UzgEokSM+PKdntpDO4PB7WuTjplq5zzDKa8aLAvPiaZR2SlOwN+Asf1aWRmKPnbgGwQVD1XvNbC1OjKP2kiaAo8pkpmaYLHsa6TH/xds4x1kysKsRG7FZiKZ3YrmDKILzTbEywb12bHUNj8bQHHufXIWa64AJN1agnc3DGN7HWcJkNqT3+PeY6DcG5oI5iKJMbhnoed4/+SOJxY8wGbgbc2sS/guSyYOif2MgYRQwwImDgP39RUQrf0QgCRa9dxMQUv988GYgFj16UOmNQSBtzIcuECIhJL5vMQN4U72CWLL/ydBtvQVyqKbd4knNvN6JO8vVZqOqZ1Uu2g5vTbmsRBMJzc5PDUYNMj+tZ/cPDKY0fAdSf1GV5W6yM1JRtydBig+MIC3k1TcFj5oten+A4gOC/5C9xgRBmYq7gUosflBTyhrivJL0RzlPz9kGPFPT9sSdh6g3HSYDqFPxZISDpmIb69D6c5c6hh4eKUmg9TZn/2L1m/emoZfoFH58kuRXt7c9RpRoal6QuI+AIQVfeLZlYBOO1G+Y4lTByg1u6SjiyAch0SPVSl2J8zNOFLRLAQ2zHwZ7ZFD6sIB5Txv9JkXU529JgXiABmqKzTkFFHIh3x9jwQKn30GfX5trGaDuNJh6LEC3vb2Fb/TfGbuXQTzqG1DvejRjLgoeGF6YdlCPk9WnjQymmAZMOS2Foh/EhVBcoQ32hFEl9bWGcXtr64awH4O350orV9lwyJsGC5Xa0n2dnCQ7G7vPtLQpq+IXKa7hN1KC/d7aAOL4qUZt3voyZu/VmHaiFeLUhXKjNkaMiVvbMqRJzeQBHbrRfFPHfg5Ajx6qwkuroXRqeNJs4ZfggLXtw66J3hxPbafMOPtUMI8aRmOy+ZTAdEwN1skZdf2k5tOiva/nBQb1gP77X8KM2iDk3qwGdZCvjUei3XG+hpPmdeJL91x010JUjjyR2SESE0cfEzOu0OakysKAXwkCCDmZLYLz1iWlxyI/+DsuKmDA0+I+WRweZ0ZDwrY0vzVIJGt0mSpdbWe/hW2nf5uv9DFlZ2lWqxrqxhmA5idiNp3SrB1OnTaffjq8gKtl/PLT4QUFC+XUKGD3wE7P/n4PeFDSIVjTfNu9bhRkuDmc6F/owXs4j9YdPEQ9fuA8jw89mhO24V9eeIAubBEBuJhF/HQgatEh9CSAtrdn0KBxirZtZqdr4LUXlPf37cNogM8VVKMmawt4+sd2iYNMSoAgzhgd7HNFwhhjQjs5YUfsqZ/4Y03N93nBICoEaESnKaCJmdWoPiFox26sBncyh/FoDBBgiTjp0QcxpuSDaBleVYU7Iy1lRXMFls4Ti5YrCpJGyo2XbiPyw3zvqCI4oPP167BgGTIW0pi2PfdVxrBdtBxPA5LpTYZenaB6fJ8V3d7SYUCYfT1GJCeAQCASm3vtj0H5K/P9hNHPJr50Vi+Gu5+Z9cFRlBigOOyPYhTtOJPZNwNItHiwyqGlt+CJcwiKbt6gVc8jKEqZEDTugYwk4djwED9O2S9iBMg4E2G+1GxCvXNSMYJOqHaON5l9lj2Ka/GFnm97eyx68viEReHAL3nHd3YbBoRn/kUIGnw6As/C3T7JbnrA3hQWS85D/1p2Youv3B/AKx5coeyb539wIs6gSAhoKy8Max0Lx2WtjZ49ZZB1A54dM98gm7DDnin1Qa7gjiL7E4Y46eaRGW5XMLLzE0+kAnuQD7neRehCvttFOpvHhc7x5hIuLb3TDLmKtKrBa9W4wlG1aql2gI7xuvntLZbAQC8UX0DaefYR191YGI2AoqVvqbAS6Wtz1GhMxldQeR1dWslX7jNbf2IlulP9J1RR0nq8BBF/VIplN/KoQ/NQqa5502FUtpaxZSE/8KbTp7Zuip3ma1IsEeDaj/6ZfQ9Rp3K1xiKMpL+7Rz3PDL3xnlUFGjjeQM35d7xGn4YNr21viL6rkgFeGsrayM1ve1sGXmSm/6f2pYAT1fwADhRU3PBeHGF5qAUkZR3Xqp0wMGt8hUmDPzVmC+4NngZUrdp5XGSK2njw8s9c+xGKQ+WtINWPK7ONI1edl/LKr5f9iqWazXKtO+rX7SfsMCio99h+7EjVDDffgK+X/tP8TNgxsgmMO6Ml5a0fK3NrY5PwhXorlC8jqIPRYE4HY/+cYXDy+4BbR+jrjec/Tc9Xby86Ta3fEVRjTTweAB3pTf7/Q0Xa+taDgRJtvyATir6JyiLahjNLLm5VY9GtVDnv4BVuNGAn9vOIMIcq1n0LDELcvj6MQxfUbu89B/srUQG8Mgv82N0ZGVjmyiimXVuA7r3yM2M44GFIsnSLGZh+CcjGDunbpXse3Fox7ZOBxBai0tttANFGtJ3RC4JnGTtmwWh6Ynl69CZZd2DiQEaWLFpt826RXQlUdW58c/+FPcVpODb+sJ2q6Q72q+agaJUcZCs3XYE2al97Ogg7JEJHhFffmRNOM0N5bg3sZwD2F15uRRBxjMM3uq8ZDd3yjwlp+MH2G/pT27v4uAUzjyYMfHdCVx5bOdXuLy1wjC3SboLjLRXxlukRMpexdQ4ifkCgRobshboV6c8OQ38iBm4/tPrqQNFS5wGkf+8Hq0X87cycbXj96Te2z76/iG8QXBu/WqDLksc4C7ELa7SD3quxHU2wV+je0Zvpk7yIvFpRX7dIXrB9M93zXlCwoSvdC0Hxscc8I4DYvvvgKTuClbgIQZrN6wtpErkq7U1+FwlSNrTrHrrxWxMjqiK2AQ8tY3B1HcGhzSZc5GUJNRvLG9au+eXNzHhbmcIiUI1QXIObFaTFBFEdimFBA/Rx93lbMaFk3ahFKTZteFxixku/zYghH+546L6GBAkDW5xNLR+AhCUAdu6gw78UNt1cZFKh3cQ0p+dVaJ7Bed3BlqEBDUUzUXay7SKeHFzGw3j3Lbp6rEbHSfAxN4MLcFynnCq03LtpNHRuPpj7D/GP+LuBKcLGE/sRnaTp0T9glvb/gbPUl8wTNxITKG/fu4noF+cdjSYsL20Mlwn7AX96UfsrEPOANxxcMuaPouwRuVpV8nfxjjeXohnLguYXtxr4Bl6SN/hgJgvNIWUxZ3fWou8gX/PyMjJ0WKEnOGZ7N1f5xlyyQNNVUEYx30AsRlxrx3wymL7BgtRzlkUd0PUyVsqxDUpcTfppT77AWiOM+a6YpujA0xP25uXgsLDBKDNlg8Px2a/7Z5zdCvlmpneDXkVDywNXUbY28qS39Puul949u9kYyNj29kx1A010+sWnwwvofBxWv/aTlbgt88UQ7N+dyjA6xRBs9rDJdTQVzSiuqLW9jeAuwJYz7oFLKUtpyRGlGYKZI3MqlAeHvMbw4H+N60bcFvLqts7+nwOJ0a5uL7yHdJbUud5t68DkTS7ieuJxvI0wr08VnE1RyC4Y1pIBlGUiR6NdTbM+8yS6kcfp3aP4a5Lnp6euzue+R5hXTR895wcUyiLV13hQgMNB7+49KNHdmX1rshrYRI6YSOSsuykh4vCrr+h7HOaGgBiikLiOk6a/O5s64uzV21cvzt+cvmcH7MXPH89OP9oXPgPg4jeRdy3jjWBys9kClBl7BqkaVAWJnfNG8E4UTEDp2k5uxCOXKd7HMJsSAfpbkoeoZaZ4wkafsJWqpupKNCWva6yse3DAxljhpZpCcC/G1prIxVY1nS17yiuocb0STVR2+5EpGyPztcUYEsuivwutOKMf6xb6++/asAQwQkEzI8B3WTvN+rUTJrocUg67o8a7D1mMda/gQBDk3XDxpPgHzY1h27MT/62jEgc/SHjx1cSDwfh2OGaa6vZhPxfOtVP8fctLas6HmPXw0MQw9hP0NspSt2GuFRnK45N4cLe3GBEf1uh3DYOnaf/bFKfsf5vU+SBBedDTJ3kxMYnLTwLEAvUPD/B6rNu5Wvwd+QWVSQ7tFSS8wJJjnfsbm2QDuaCHM7SCeCdE/ULVN8lrPDcfChxjP11MHjIz/splqzVCk5LFXI6q+sZFhrnpsEOdsIH3MMrYZ1ND9ZtDaeYVUkR4odcLOd895G8ZqL9+8ihDyQCS/5zSc3+hDI7WEkdQAwM5xkJRmxc8cWFi/wACtkJPOIw+oQqtztJqTxn7EcPngEABXV8eKmNPgug4N3Y5QKDTI6JQhJdKSEGBDyXlf851FP8wfv/GF1T/4zgeAEpwOz2eNKszoYSkn4kH7x3ZOzXwOUYS3vc5kEySKinHdXlXLMs0IPGZy0Vvks1EbfJ1PL0HB+ycXwp99G0rexbWSh/4EmwS5CyFiC61dEcnJuM/OGCLrSwhUYk/87Fwvexm7LsXqmq3G9F+Z3Me8KbhN2y8UYVc3rgzWnbhwYfFf+Tv4gEnIMnxY2iXHEaPXBHRdqZH6ON9+IQtyDppAubOSXXhIjOdIy09SW22H4qTVUDpNjQWxF070NjzPPL66KgbcUWegDNOWA6uhoT0sM8gNjXYkClvVCzhhRl8xJUbKPnUuOYago3AB4Gn9ib1CoPAryzDAzcoCw8udrYN7sJjRnq19SNmdmeSqAWb8Mis8HQaCC2kJmwLLpC5GE+ncsK+n5CDRiP2VA/m2Jgd8AHMwrGWsLOUoJ48WfpE17/N3OnpbVYiCfyTR5l+A9XJ8FC8sPawO7Nd36nWVTMQXzrRVLxkzz68gdQXLVvJKwF3pZiaCwv2XApmijCJG4TiKh+IL7Lt2LXUZ2NQ6ifYjBo2XKujbxOkjYHhuDRXhcrRPkUqeVZYMRULPOxj2UH5u8BbTqhcFHSgac7e2dtZ0xRhL+PZEw8uoyZe0vchuecvMVf8EArzfgc/wjcBbP3Au7gCetBm1q/9YNlIKetz9VaYwzv41PkV9D7vzQYBob8oRUW5HRjD8QrfjwMZPjinaIFxrcdjPyVDskh4wAjCux2t2RMiUYbfHNLW5Go+HoNsyYx5gik1e/JLsrfHSrquZI2SBPJMnx0IFI4ROye7ZVVoGjJfJ50GlAcNvYnD4Ky2Hd2BXuf9+cPLZ+evzuxBuhbsO9T4vmM1b/hGdKIxOxL2YXVjdq6eaKNcFiS1stF4NVMwlarsRmbt2tYsCnRK9KexCbsODpg7VUPdeWZwfLNk4LIM1bVECTXeLCepCrYsuama1U3Ms5ZxIx1aDVZWebktBOMRs0E1G+O4gLdoiSBXVeFawAl/cMBO9XivZStMkMyNVYBJIPkCrWrwJGJqBgqkY166OtVso2zxatZusUSZIx8cC3Jab6RDbjthgVoKOWpVPss3mjrxLx8/C5N3ewvPEWh4kDs5FKVgI43Gmmx8PNeqfW5vCFDR42CTQBXwib/xdgzLNH98gqIAQA2+iDJqObzCE5fZcCkAkMx81e8smLR+VwMQ0xJ/+gDMkt4tvU/QdmDCo8IvyX59hbSdttgYOrC5oYD+RNsliMef2kBAZBImhujdqRWEg1l3YHrs98ixF0IVkuYFmrVtN/P+6HhJime1sGpwu1gZcVst6Wt93jPkhwgFLDXIfLabhWjC9tmO2Wj9dLS9idjNlu2gh5Vrx6UHJ4yIzIAC/GVqD5EIgzOBFuOeTgGGAzr71HaQXMczUaZX4mdIZqn5ZSurVWkZmZGrCCsO7qjgVua0ElFH0rGoaEqD5HHRDBof/YzqPDh/5OIlMahhVas/M+mx+zowfmt1KpqKYOQajUhsxiOYsIEHbKx7gixTuP5FXglzpXoWxlDa/Pd6DU2B0k4xWS3LrahywfijKKsm5i8NxoX1OCLABldKOWQ51eI3Z4Wwy3bs8/C0NkAFeyMmAnzdKxrtyOPTxe57oF1WLRbZgkJDHhWa+oa8h6WVHQASWvvuLDDVyJWsju38QbiYCRnHV49Iim/MRomQR8mlHgFtT/TcEycOPO0z8j14hO8CAQ1CKGrxm12DxySfkrdup/iQ+wagRVZsrTH1xW5TRCHSRckW+Yjc5SfZdqq52U2EUJMJOcoa28/0o4lVo8q2G+sHZJylqdal/53FtKjbftL/i8KJSOUHiup79XNVqASKSUkgwQcS31CZXXSMAwGn+fA9ABPs5x6EeeGPyHMVrIDPbY1S5lMrZGJctyzYMXvP3yeYVxLdQUSIPLvm7U/I2nbuicxKtij7hl/t2gnughdjwh/KA4l3/UJyzbESuxveuHQKIIAGkpK5EOlJUOZyBB3Xpkfs2O0hOntvqspOSnsp62ed2sj8TZUiBo2IcXS1mIMxfpxAGpU0KAOKmRC9LuGifCCsCy8Af5GQHcvyn90LTlEWJV1mPX68cxub6ciifHCuGCjuwx7j87uXUCSeO7ZYTfDqniQEyEdxjM/yTl7J7oYO0qoD2O4t7xyB5sE3yGqDU/wX0cjlDV6khnJHoQSUQYLQCbRit6ZmVHXDOKywUZZ5cykKewyGctmLtcgvAzJIcYGHkY/b8ejdixSRzADh5Sng8NSmDiN9+NgSg3oYgW/4zYtS8CaQUGzF6JQUMSDChxlDzf1SILLvyD1IvZmTaoLdT4MAK3Htklv6gRNhw93FmFRsqizco2gmIrg/oYKbhmrkVg8THwxABHEBSzl7hB+H1gPzEnp1r6hpmSRa7zKjavW1ggmTxHyfuJ11CExsd7us5Lqbp4MaVagzsOMBWYUQpNtHYPJUZfFB/1vIJk2RUbhXKtbLBnHMcJuf1VwfiikKjls5Ot7fl1FOodqWeyDNQeeEJCc1Pg4MPuO2nlnfHmubHW9mYEVr5ZV4K5Z6Jm2rJ9auqyfNPrOxYVkYNK5Bd2oIMNThQcidYj+GcPUTBzUmpHjCKTMfeykA+fqrqhPNqOdXt5lhdW7ZlQgsTj1Fgo3CFQqcn0hE7NfpVM7hklxWWzQP78oODtA3M+SJmf/uUZyH8UOc1hu3OdecA10Px4VsjCCh5YjpUTaBcqjxmH2z3jIcs2jJQ3dC2526Ek4l0s8mbApbAN5DGgbeuOtfd2+Bjvm+6HKI1e5v9/Y03sulsTxip2ZWMvPWjgra6T+P8c8f2WHkFmk1k9TGRtB1f1tTI2F4a5leg0CY2zn3sLeOe6tBcV72J36p8QQUl98y286vo9GbLjUDy4EJMLB2cFt3w2KMfiA79i3wEoUDWUHwKK+MRJAyv0SHV8B1hwUBPcsnzIjk9g6NOut+M0snidLGj+1jSI36MEgojwf51u79cPqPw2FKkCBxWiYaJBCnOTpRRiLy7nvMgAgAaP8UDWjXU1dJPOvpBtnbswdBmLYqugntX7UG9hp6KWgudGn2gl5YKLlQ0Ij8GCMCJ7WmqfSJHl6YpnF+MnzbnLrKtN7iD8I7cQURXouakezruU/ZH9yd4cuPz3598/4vwQqigkUzyNkhkqsnk92WpsAf9173EvJnCWWy18gmF+fNTWgSyl2sxcSHln+989s+3zavG77aiIoGjGNmWlptAR18X5qf9pMxMdK3ouxDaklJ9gfAGlRRdtw0hLRk7oec3g6VEKkSQoRaL1H09ZxB1QQyXxP/ObPdesHI51CywLvSWRKPmr1u1CaNictx7e4ijYtO4EXkGoGHqUvCqakMpbtf18LqitXKn5tFwz0ZYkugIqoDEdoIU3X49NtpeDaTjrsCIbSR4otRkoGDA/ay4ddgxMeb4aW55cbTEoAGwUoDgzGq3Lart0HQNZbeR9gvlGqKljQfFfLK1bXB/9PaQXgfgp38BJXOPwhIgUGNYQbtE9P3jNe1qAosHyLKbmz6GH3eHh7yQ/2Xd/af2swgZqbwpylrCyXPjSij/4TC56NEww6yi+l2+q/BZlir3SXgMuXuVDtbqK5TGza1IDL2J0pfORm/gx7440TX5GDCzVVV6N2JX08gy3aF5ctUxTj77rftpv6OyYotpD6XcUF5CXER1NagYb/4n88xCbGYtha7aPoZ7c3Mmk+nORoNtyJrhf7jvRVLfeUXDj+Kly/1jVtFP/PhAjpQehlnf/4hgHjX23fRVmNcb8W1XK1L3Y8ovFFrcBf2WEB/F6aTktIzi0in/mT5hiOp5oUpLW3++okcuNmE6QU5s1laoAGskQcAyVJMC+8pZ11J5O+i+VUW3dolWIVfcPpPWdDM5GQBvVGviekN4MeR7rwoxhoNCFaesGvsAdczSlmlanT0QqJxTib4ExButMipGzpyNoQRvDfAfcASzuXQnjI1v4Ldg075epv5lFt80apy24k5zPQxG7F9hpvgvgvGUf1lroeB37g9MMfZwKdjrHzv0zn51ZpiN8fYPHtgf7iNDHC3f2DX4H7J3OEVrpimfJsIyUToNmrzrFnpJXzWrPoJTE7hxjj0SqR5VW27t6Iy9qrTxW9UhJ7HBWogymAe5xXPzem2Rp0wzHqHSgFkvXJnYBBx7w5Dg4AB0kuFKTvR8E48l4U8s04lQZal08VvmZsULaocmpkhq2eHe4xvSKpmH3isZfM4U4hYmpxMZqzYGrMrZRMkCtD0cE+ZLRdEkJLAvaASoPlUrwD2ElT2Q/beGNZrWhAGMlCChEA1GHeQN2hiczNlKasUpG7qylFmSsd0m5qgRWbBgp/TjrpNTbOaOuTTSDM3KAtiFs3aXW/+6Fru7TE7pYcZ7UrvzXDqXQd6i08dPvrX/0/b+7e3bSP74v/nVSA63+uIa0m20+3Zc+woedwkbf00iXNjt01PmtsHEmGLDUVoScq2NvH3td8HMwNgAIKys7u3fzQWCYD4MRjMDGY+8xTCRuFQKi4uVG24v6ERox7X8hqYwkrWLfeRc5yTNWTZlX0U8bpoNsIukg06qCmeCN7rLPFVLGg/HJTum8NwN+zsCDQL06bIwhXpziPlkPryJZjCJ5RKx/wdvZpO2budnYASXL0yMnPaxFCd3WBjmkDlscOl6XoKSYvoR+L9dMoL7OxEREf169jsh8FTjtofxAsmnvjjfVccbKFDv358pegXEeUo7nYiPzIx1M8wS4eMKx+a/90exYBCDWGmcm1yJBpAR/V6o7dJQXlnzWzOCYrb26jYKeTLjvA7CBV3rj1AXcSaIgTy4GjyrWUjD6odFjmPkMFhhKAr/ULlr4pmLUuoYj8EcSTsOcFJBKeguiCM4HuMj33vmRsNPzYNKVjD74QdmF7YIxIPPkZjiz+w7+CeaGI5fp7NqusqdJCwcWC0Uf3HiRU+jtx/iUhf2r0wEqwBzuNeus0U8DjbgKH9UfS54Dcjd3zQaSxxqN3Vvah92lWJ0t2PRW7HfOpChuyGkz1IDzqsFrBnXzfYpgnV8oLbMazahNrYeFYW1ScMiGOOCEAU35lXzC5oUxJHqV044EGfjkRqfKlkbfOsOJ0EeqBqpgOB6c3bqzvJqEiFvCqaYlaURcu1Wsr2HCr9MJJ3slVwe/TAwczw72NKMNe7ZNKNe/VkCN1/qKtMPBMDgM1EENEBy97V38VOYEz/YJ50B7N9jlg/LBn8ePLDj69OfvjxXPx6+u6nl+/Cu3JDBr9qgDOBVArFMjDvEyTOUucK+ciZIQ0MzIU3F7Wu2kLVXSPiubZddxTltHXM0VMslRHeq3zoXmBXzOylTMxRMUa5sWE5dL5knWQXEMEbFj7UUzdlTe3uDZQAdit1LV6YmdrlhHCt60/nxZLhz8G8UL5umNqhnW6I8YJHlNcYUqawDmVsP6FbcQ55G8hD1zlmFeT+5CqyWLrU1cYoYTTeFd/u72dM+wkP+XvMFZqUQXImt7RAYdJl7pBE4O4eiLsxB7t+pcFaEkMjiqd8apfy5kdLHa8CZZSwFJihaOp/OZjgkpCj8GvPkgsCiwVuJ+aPCFBV8H7zT6icHh7FI35ehsN9XsqmMYOu1DW+443M8W0oVGLJrNsI+uA8L5tEikf8dE+lAHIVLjwaclo3LM8t05cvfs0YKgabA/uUXYMKO+qHtnfgCvCQnn75Ih7SY2z+edlMZpfQOV/FPfFlAJEqKuWeZf3e6g/d2OBC5klnSOSrw6bAlfhQfIwG3IE2sNWyYH9OVutmEWyXLg3JVh07WD1LksgrDreQZm/eGee0xPfPk+n2DcQVm1Wt56ppgu0CjcWfTg0hYA3/S3yLuZHv2GNd1wUhdnd5U1yk9Qz3aQhsITpHXMSPX6hSbu6VOCHjMbFsPR36y/aMXkl84W47UcBErS7NW3J2hv6zOuAjNuAAb7eBCPh9UeVoOQcNrdWkLUOkFlxxwO+iuhRSrGTdqIk4rwvVuLDMi6LKhcTqUEsKv7Yj0WgCNcKsvKx1imq5kmWRY5WJOLnA0heyKJuRqcQjNP032gUhNTVLWZaqAdAkVbWAtT8iyCQMI201YFdiA0o2LVxbqpvWjQCGJea6rtW8LTdRWE2Vg5TtCLsCkIR50QT4gcuiwh6MzJ94VqTvBErAi6AmrFdRJcahKFVUlapfIyDmwf6+0dkO9vdjpIdGyRpCsqsj+/dT84EjMR7j7/AIpjJPpgk3i0i66Xd3pEbGIoSCjHc18G07TMgzGHzbySg+qsS8PwpSY+YACQbSz7pqn+tyvayGjLOgKsT2aytnZ8U/wkRftB7cXdGtlnjqvsI5gqvCBnvE31Ltqau9xYeKGusKqwnxLU1coUNEv3IF2llCGk/AAfn75K0UPnLypyn8NIygj11mxgcZIwDWJWLZtinfMcfWucazTeLlR4kTXcH3qOqVPe99MsGOkld2tIZezMIbgllBJgQ6l+j300T+PvQtitIInuujB30nH3zvKw663V0HvMSPG0c0kcriCttNZtrk/P/t6dkJ+Ae9fnl89vO7l69fvjkPnXbwzu9cr6y27kEVOHT+2UrOFd0XnutViKJAjfyi6ra/laW+cjeOdEU/7v0EFkh+5Uf2CeaFRsqxnC9U/pZKeuaXfM2UNvANSiS6dp23mOBw37iq1WAkBv7qDTW5DeRU8QlMn+vlat0qlBfFs743Q6ACZRT7WlUIqMhsLbKVYio+410lnGUnVTtE3Z+mBC5wyWbUVwY9VG/Zti2aN/LN0LSPJnXI38oeonE765k8I9bJVgbkZx9E7vgQh/KDXKG67mgVASfqH+RKjLm2WMm2uFLfSbzFPgrxPzpX2gEMDodUoOYn87JQVQsVxDjqTPDdmf1ijDlCBZAmv+ajjsy3f5WIveuIa4UiwEIHYHtIRwXFLeBV0YiVXq1LaVREcY7w34TGw6L0ySiJu6txrktwdYUyGeD5rFdCgpW5wiaAo4lfjaBo/vyV+oDwmBaJEJHFZwqhMtuFrISuSIDDzoa4BzA4w69x4I1j25iUwohpnWQU6DDBRAHencBxEFd66qvt7HSohu2CGPbfTe+XL0ETnXJ4z/9w6r4Z3qfbZrqJBSb+1YfAgc9+josqPZ/1Xw119RowUfvTdgDzAdp8Z0oOszsiuU0hFmnbAfkLYMZMYQRKQLBoegAZSePbS7BAyVkznK+ZV5CpaI3t4ql4HEYB0Lyh/swr7mJFuPfcE4/FGD7dc7Np/w1ag/KuG1Ht20ihsurQUq4ghwOQyHwhazlvAeMC91irEcK30rlqMoSCpQBBXEtwHQKuGrkSQuuImDERw2P8DZA8sNWwEYJ1FMt12Rar0u5lUOq8A6YDx1NG6GtU1U4C2I04XZXfhh7L6Q0/acNcDXQZnEWXjUu5OuySLiSIgvEmXsLz2/5wwCAnREpVDjpHiSPi/t2jh0jBW3tpivw7espz3thMFwSu9ebf32OL94/5127DsNAqV7UlawCAMsSIdwmYQsYeVxYb7jU2n08AL8kiGasqoO2iAd2c8JSoecIwxoAS+I6h7JWuw1MCnddfhp8DVzp7WliqLDsXuVFmNphQn3UkenvVydoUj5HCGd0esZoa3yHUnt0Y7nP+O7N1AX7uUMb+AIxLsO8gHDYMzpTgDVLyAqgzWdXKylwJeTVKePXaiq2ubghBZpoPMEwAz+AzXI7a+0h283orZvpGDAvkUONSz2WJ7kJFJVsFgD57e7TYyMocWwwWl4jk+ULWzPge+4NZBdsXfoshBjmFFsAPGiNP+Uc+Aa69ox4GDksPwg9Yh5qVJotSmhXHhqM402CSV77puQtxWcu2XZx1JUxMn97JgI6f/sg0GpfTu4eovQhkirrOPAVQJKJh1kX/cBf+bop/xFyfo8Tv7YnXwSFXAcbcqixw119r0bRq1YwoyL4dr1fiWtefYDlIfF2tSrREQqHrhaZDDmHVzTPObAi8EiEo6Yh0xCe+p4VrIKkN+bgZkiLQdLQhapFrQxzaNNc5qxvTdSlqfT2CEbWLdUPSLAcM8GOxgriuyg0ioehqHgbM3UHEccLBu7hYP1n69YaiRtdzzIVJrthMMsDK1oNqBJrvayK/Nh/9XtdorW4cN6NOwJXmi2Kpqga8Me0lL90RI/YDpANG0KvAR+A2TN+ZhT2+x3HBIiHukauTz5o9gpmZ55CKmSqHNEYjNpLvDZWEM9snx6QzGh7A3/5MhmeUmMcKqLIhBekQo4Y8FBHHnDIkSVSUhxsCMiSM7DNKLmBjOvWFkBVHbWUS7FDX4kK1RnAQRYtuk6Yy9Dnr4+Qp5px7LjwyM48DCmBYqSANnhJyjVkM6CcFxtAF+D9TiOhgkI184GLUEnRzspDN6XX1ttYrVbeb4Se1ybr5kMI6Hz6pzcekHxqQnSsMGuoDfzXGHvM23f4yWtd35otFdem1ryjTsKvm151rWmmtOfjWKOwK030SbceJUqKJYeuKMarpRQ2HgElvZvpy3WSpqbXpbxLBVmTfsmlznMhhH6FDVpgrR68OPU2JZ7Zkq1fCVjOSTFAJpZpkPVL/bFX86bcbhO+vy/IdrjJ1eN/1E5zHV/Cv/ch+BDbfkz0XeUMk/MDnrKGYXDZHTrE7clnfMLCX7jHcLZpRTa1O6ZRQJuUAkqse2VZ0DQlpVNOCQzvUkvWlakP5La3v8MSCRZRYEO7OrPfiUq4Q0wI9J+FnYBxwKMFYpXOJi99EDxt2YeMzCQfpi4PDC5t9mbgZRt4ypm8ybwkMPIciu/5zrE2I4PSjF2PxDSEkm+dmkDs7bpTffBRPBb+ws1+Agt3P274peHsQ5UxYGEENh8NHj676RwnnRGwuke6r8vkeiyjfI9yu0eL5ASGk1pQSKhVVo+qWwEZw9sWhixkIEjT5fpomws/YRqmFnR07/ijRA6X+LPzMjsXjj7QO8Oubj/zdwUfWwxgohA19WIgxEG6c8XILdXXd5d0oKJ01H0awzMkhPYlpKaSeqV+kv8IYH9qf395/kLv3GGRIROEoe5MnWzZuPnfIGVfH5dwzsUP3l3l2peozrIBzRM9emmpm8m6TN6w/N+piDTwZbHhNl4nSqWxZ94M0yWV32j8Tcd1DattaPjN0I36IT+gCBeeMCxXBlxImVki1cCSK8fhf/FwYzQxjjzAe7nnK8yjlUs5VfyZ4d+qHh9pRcKYZIck0M2F04p55cnGPAqpxT/1ZGKx1mC26k/GN4MoLsE5JfziOxLpRDJTczFtdqCu8hWFGjskWd56/WiIhsLV6I6RYyptiuV4aifuv4F9LRtpKV42qAB1i3srqsiQ7LS4YGNUCDoHTtLMjigbRmY00uZC1n3Pv4WWO7eN2aCfK7i2xi5OdZZkYj5vwxKHPJOqYtXgi2As6Cv6ljqgqN93Y3WUBD+Q2h+546o8rVRtFUTwR/x0eCeYXEMg07tVYxJ8K8wTNXRZLnw36LvE8Sl7qmonYD6KdRSJb1rlxoR0RBpyZ/Qub+csXtpnBr8afgzGEFZNQunID/jvuk5P6JYXE7B8A1MkcZbjNjLRzM57vdf0/Wi+L6pKbHt1tOVNDrEIV7b/rIr9UrQPsvyS455m+cfIrGn6w4KQj0jw1ExPJFt0BuruxMNlN8jYR03XSRZpNlBksYeY9kA+4oZ5x51gUeNZh9OJQ7H9MZsflVLqVNL1JpG/LPHRMY/gQmv3yRTz01GZKsLMjAlc8W8kqvVuC2fiwH8KQmGqdWSF1CV6G+p1/JHZB3bC3tCwkHhQrLMhs0/YR09R655JO/064W41R6fbGz/APrkNTnNBM25VlCAZxOZZUvjAbc1hjhLipDfeSvoC/AQ6NBdENcf8dk728jK9mA7+43BeEqyXGPiBYV2NG+We+EKIpWx0W0jrY6gx+wCH24JoO/e6L6Z3Y2KFwJJd1Zg6IIREJT+QRto7qwTPfnm2bhJ/7Ng4EFZDSTLfcOaZ3j1gMIbByBEYUGzx1NyYLOMFAK5b8DPm4Zw4Lwfx1ZKm1F5XmV11/EhIgE2yGdvSinRHjEOiNQs4BNjtFI2bKpUmzMocoKucoi1LKP7ReqtzUOnl5sA+W95kq9XVoBew9FcKjgIeZkTdUM6+VquCkg78mpb4s5rJ8/+LtCfPp9OdbshQ9zdVVMVfw0DC6hWy+k/n/wAiQWVF3sujqwsuN4Mk1l6V6L6apb+11PxVV/K1T8bdExd9cxdAA5unuL9QPzyj9hnLvugYxx8mozG+MWzL+Zd8mtCqI4Xvlbw+Xqmqfy/lCfa9rd2GddAmwsxtYyru30QzsS2zzjuGOkZ3b/eyfu3sXW27IWb9u7z8raVi16LotGE14c9tzZ5v1M//oOjA1zK1rGF8nUmKqxHihSsNHuG0WjuJJQI/B5/ZMDy8k8eW5urFucom3zNnQT1/MZ7nslvE2lvLGh06EGOLRnL9ZL00vOdFF2HbyUiGe9nueg5N4mHn7HjVhQMt1YED2j5clXskgDj6+m+l8k5HH4Ct10UaOjv6Lv/V88bd/5YvnenXkA4V1daXqtrn77h+ugvzlv/VEcsoxOo/IClMo+Rei2TStWk4w7fdNa04MMcOk1vamdWDaHRC6i3fpNU9fFFfZSAzgm4M9OBKGKi9aSO87wDkZjB5YW/bATM8gOKFMvwBQ5gw64m4FAT+mhnsyihuJOdvp7M8JKh9bYJ5ZoShyrNsKpDSZ6SsVYUAX/0D3YVOIfE27NRmuA/H53SlUPQrYu30YqQk2NAZEKTPbWfcEJCw9mgtXw86+P+02pwDvi/z6uHWeN6dB1pLgk9BChjV3p9z5nIn7LFYACo47/hNI+OlvwNKDGZ49JAqJEI9OCZ540vVCv8MiYAdgmiAstGHie4TGEGzlIBzhBrsAzZAGdK923g+zkBCw8hQaPOKSgn0WGAQ95Wx8eU85G1chIg7PLyy7cDwBNy26VYitLMCxHM4H3B6mBXQYT2Y3s20f+uDUehnvaexBcjPziTWNewRaqMP8xRCTBR9PHOiZf+SUPXsBB2C5yPuIADvj7idWJi4BCMU0XGgOnxa8+22YdXw0ursNBOFoNmiY5v13+ibcAwgMdwf9W3KzTQRQTURa7p2bLaZ2u53W+f4992AoNVv0DtaqX7dDQTBO/G2rVynRN4Qdg8gjmrsYboyrMpbrJUDUrK9glETAJizfdiqlnOTgBYICUz88qXcTfbgdyh3ywgygNpjS7MOFvFLOKeQR7N9HRqMEBwrrb2xzgHJHEvR7CnE0reeT6UKRF6iMyjoMv+yAnfbMuFXqnT9jx5XEz7z9i4E+9yxC4HZhxe74W95G0+Oj5Y87fi8F4HY17yGZhkLfiqTPjBula0M8c4aVQzKC8DkIzG/4zaUF+lwSShryCPoFz/mevRc9hqRmjxM+6u+KvICRr2TdBgkQEPgSLNO6zlX9gUp8HDkQsRXi8FypUvwvayzjGYgNGRlBebgC34CdHfsV+ydVfhJ+AW+B4RW/Gw06Mx7b7jBr+ULgNyFyij46FsOwm3Q087QBdjyBe03giTDtNsxGQ/3npuLtA9y9e4C7u1sGyCZ13LMKflTgiZa6N8ACOzvk/7BC6CJ68BR/XwAICBEb7pIg0Dh4Y/cPJzYAzjAjQhYbYzkm81c/hArRZwM81poACYmC38q6PW6HUA0QJ5mbpSx9uYDSj9jFcl5AKmLvbnElS0S7jSq7opFbtiyDDKAN+qbOF0quyo1QTVssjWQR3UwSn7eceeJSGdsEy62qlyovQCjBTGLoNhnmc7LNh2w5cEVF1oKgzCzZNeOz2X10dStopYz+4i/BQqYhSnsYu7duJ5UTsbtV8+iKFs4EF8oU3rKGKoDV1vCjgaPmW5c531laZxvmheyieRq9hLTZtQSX0HopbVLBvT1x806VoqB01qqEOElx0zmKAbM/IIy5XsLJQsnA0aEaXf18mu1G4weein1zRBEoA29G1j5dN/QBtjx91buPetnCEKS6kctVqTIKI9Trtilyig40Y6nXinyw0x8tC2UrOf+2R+RYbdSQYi7LRCaSt7r5tWgXJ9WFHvrwAmpoBCPtgq8HOLNIDYa0YFqmUIel48SmMoSypg5yrp/OsUKhvz1TxtzwWBBC5H2wtycwa95y3UDcpZNsKSRjyLQxroOErrue+mCP34zEZjua1saI9Xeo4ps4x79bBJ6GZETLPj7oCdM5thYQ2N6Aio35QvtycRzFQRdQIetkQ+l2J2poFOEfm1Y4ao7t+gHjcDc46BtEu35wD0jlN9y8e3QUyknWB9cvkHfOcdIYOdTDqnGTwlLVl4SpQTGAZytZHbcvqzyyzvDyeP5R3Z0d+gszJO1HQFdwuehKDtFbFs541xYc9ObZly/CvZ9OEwV2dqiAZT1ZaKmPIh5wOn0zbYTvGd39hvltkmb9O+dYd7cGoLeg6WYjxtssYBi/rKtLQhsF6WkkZP7nGsj5MSYwsDsKIoDDmHUmp/xTiklHRn8/nC8S2ec6qpjlhWYCEHWb7LN9WplbBD5gLgSDo4KduqeiWblQXwsJsLI4nDhBQQ6doPYTTDa36lTdTVXtrEAkb1LljpUCFNekoJkXmL24DwLd4tYKC5Z80bLarabn77iVNwurvscvvwdYWsIpP3XHjBuSac0VbTW0nijG8mDciKemTpJJ2wUHL3Dbjmd2e3vihQaNvjKaPnmdz1R7rRTEcjYKFf1mkmZvVj4WzwhKGibpyxf6tdRX6heI4yw3Q0dniJ1+kAEIuxgLm9DwIEZdB/HfMGOcvy9fxA0Vf2/Km4kaixsC4YXWIkg+N2/IrqDYs2DmD/20BFVvXmCyuxtAeoprv4d675mSuLdnsRqEFJ/KdY5Oh5gpnoJtDOUCwEIkEvFGjEgmAWwGYxrHyEJyi9ygRLPQ6zIXNSbtFgWD53KtAHDDpapULUvsQ1FdGsEushY11s/AtM4bANeSZiKGJ02zVuI//rr/t//KIihxO4U7O+Ih0ID5Y+/3Zm/SqsbfdXC3QcpNSbOL2b1Cv+UFu4Dh2HZGYw7ZU9LDhjtydWENFrI+w1uZrzLZ9CHme+41dW0zhHHPGtlrAkJ3cAmGvtxLsvU8wfmJPb89NfvoMT9vu2H2CU6/Yft35MO07uuRK2jfamZid3cegVY42bvDhkKR/QXmiRwfWFg1SxYHlEFzvwvjt/Io7LdBcgrlkpHMVQFQxa3YE4+zkVgWeQ5IPrCBd6HsUcS/QjQxVn47ngY0hReDrlaa5+HrEbON8O7jW8f28WeEioYlnoqbTHyGAwcfHdF5QQWOos3JjowMSu5OAaDuyB55MIRbfrp+poPOtm8PL/eFnpOLmhwHbd7ycCvadecUWRxpT7m6kOuyRRfuBM5M61wL7kaMYm4IzmiTxI7yBb34z7ppPZY8fQQvPXyUWywfKW3O1XU1Xxi+SyklDA3Nan3dqLqxgeowAb7yhdEgMR+V5WnbHNT/O8o+HHQwgBSPMhqZ9290roYM9mpbdRjpjGesun3wL3/wth/5oON7zD6TxZ6XnqNDLwJQsj3x7b5fWyoPaT+2eK4sGElE7j1Rx0IjEzVvk56mjASWzL2xACCAJvG9FVrQ7qR0742zndBdOT930iY+h7VtVrICFDT33yALlBVO7iPxAWt/zI6+Yg09YAWL5MGG+q4HKTUV+N/6a/Ax90DdEwdshbH4U/E4E/1+S9d+JpwjTg7YxtSWXbnTty/fHZ+fnL45sw9WqpZWdlJiTbZcI6ZBHn4AWzWSFobMW8MPercQCCogJhaVaNbzhZDiWm6QGSg5X1BFca2rRy1e4bWa7LoeR5XkOFnlDl9yiBd/1yAdzpSQ15+uZZ2PRFPqa4BYwKoKUmmtal2pbCJOqqaFhIS2GbIgw+BmsjUTZ+EZKiFL5yK0nBUVvVI3ar5uzY+WABGI32s7Vz/Uer1yzlg2+FbdtKerk9zmFNvbExhLIgGexVWedPDt3RqEvnqANyCmjhfOl4divhwxYAFyHTu0ertlut+X8pJwb6u8mMMEwE/EYEJANAspMVOiVhZTzYVo/EhpvQgVFhnBiJv7c9UagrfN0JKi4X4ma8/lLVxCp5u2JSgjJHUjAGwAQ6JNGOIq/rpQcFsBYVANxWSgWdmcqrJWVn1oN6uiugy/3GnFKDrYDikjM6Pe1OpiXZpPlMpQrbopGgAihvN8aE4vc9iB5uFzhCK1n87+bMJOm+8dz+frJSLh2e2ER+hFURNEl9kp6kpVrVWicGMcz9viqmg3P4L6Vbu2IT8kWLgX9Aams6hVVFHoKtngc1mWhn72oa3zWs4/NXTjHjXgPgBbeGb06TlUFrKslcw3lnhcRtGINoMJV74cEFCTJEQkgdfoJsnXkMYNDuAQglPkqmkJDIc1Z0i0XtLGVpayvE+jy0RjfQ7ZxJ4krqP8zUGlW7FaNwsk4Benr8VGtUH75/qtbjqUa0meGmy1YbIrNS8uirlr3m2d+dpia9hdX+SHYnfXsZqAnn+uir+vlTh54YE46Cog4FteuAqfT/SK0OYs70nnPHJIKPq6aiwn7LBGL8DpVXMoPthaH71PeK5KuVG5ocGZIbxD8eGjlcX67KGGtl2F73V9umqGl3xURjqRZdnY1oFKoTTw9j6qXldtUYpK2zaAYxteaSRAYmagc7vWpgK+O4lHMSJ5lkCCQ3h1cpC2ZdMe4O71h+LjxPwYBin3ndz8J8rNf4on1BWzgLbFP0MgRDy+XKf1qvnwZxRKr1eTNLdJRIN3yiIjEU9EbysWhj60AfSV/tDzhd1dNiUjqL+MJPhbHrIeT3WMtVU0CyErT74jZDrWi8hKEYZ0muKykiVYnixtOVbNwEPz1Gnupt9ugxEuBOwcv5HYpTQRtU3fAi/aeiM+b9kCNlvhRWE6uunZ51HkQkoN65JTQKCehgyBekGFN8snwvWvA1d5vlDAPK2UBiBUwNb5opgBFc0ikuIad9NL0FbLooJAZoRDM6dibdZJr1sbuPz3dVGrfNK3YBErwUVr+KbpD3qIJwu93GRuhpeYkj/eHQxxArOva/PXumhxzoYQ1ZSlGv/1n2x8a4cf/7/s8ON/f4eRaHi74VHSXQ6+8PMl7k3IatQLeQ8jel4WqzMr9jYhfq9eTQIpJgMINPqBRR8QI1yum9bhiunVhAn4kEomhB7DJ05wsdqyD0Vjb8kHbRg+wjtOSrDfgf8LsuJE/0Xt0CVnjEh/rjPfSk/MC+bp6sdMdtOHU8KmyBnaIbEKto6vwPAdF4bAkcNgykaEWXUYjed2FM31XbTzK6cd1+P8haOaTmewBBU4uXgDIKRDojbXwp00+/ifotmALG0vM+oToW+dVL8Q6GlIozNZ++vdpbvXjanfSlEn6HqzlDcom1NqGNEUFV31yAY9J6it3NnFRNE6+CdQyj3kuDXEPWrIyrGUFgtuYqsEM/zHr4/FdVGWAKGBt4DkNkz4jblm2IxGFSz+gaGnoYWqS7z9vmP+7J3gjS+YaiBNaew4HTXfecAvjjJ7jfwNAybs5lZHOgg+fOQ75FeR6J7quB3fzdqeQKsf9SZrF7vdYe9GIPO7KWj7jHVyKW/OnKLGM8nvf92Hxx109TBbZXI7WPYaKbOZ7569WXO5+4XnfmCJsCVcgSFwlfm6uZOf9O/ro6DP4UA74FmdqAlMuLAsqj4aoYzt/KKmsxJPrEnIq9FeMmxU64sCcbtUfqk0BL6JUWfJs8itZ1vgJHOaYO4RrfykvjcTjiOFuTeb1v09nZotX1ypl2U7zBCFwln5F7LByjxE0T4ckhsQzVCHGLJup5EomoW+DiiiU3Xk+50d3U2h3lYHWP/cWpcFZpSAR49CNrDtQ5kz7LQvaBpcpoluM7ydzu7ppHVl5eOsrrRQzArYO6dgujMfRGMfG4yfSTo/cPG81rhlFzrx8WtO2Djr1rYDd6WtSECPhvHpbw+z45muW7HUa/S4UKVRQFvJ0VRHlC0NFtmoqOpmVRbzoi03nWMMmgDT9HsnNkbiIZcnvXj0iuC8Oi9AbsriTLD8O9PE09+4BR3dkGu9kpfWdTwyutmrBzqljUZhGUlAdZ0hmLH1sB4qFlRjsjbuGYY74/neuV5Fh5JPWpr+ViepUDIbyyjojL+dDCuZbTxxzBayI/Ge9dQKhj0NBxOH4qYXfctU2nJhxftNZuKIv2s2bcKc7RJK0Jn7TSacXNH5dsd0UvfDSraOLIvL6kddF//QVQtOFE5BvGVy8rVyNylE9wnDMIZwIpZurkWjJynK7wQ2UdjrlFp+q5uTqtWUK2DkwiPA+zehI2ajflUwcNrZ0g54/kXPlrK+LKoskjZ4iaJBeBrSE6O036B8nxE1VLm+ZhG2oYyHcbD2agWsC0tZf3JeC7K22ST21hVmlZht4I4T/Mxqp1VUubaKASbthbKo4Zne/AgPXmPbI7GuOiV+pkdUht3kw+N0CD++CyL3Oa4xvv5QfAQdxCf8RIvl0L0eQf5t59xh6toepr9r3/Z92b7v/TQrMBID+AWf755J5FnMXR2yHqbbL0kCHwtWPLhTQ2D+wHjrbgD4RvKXeE74weEY+hrQ1d1gJOi8ZsUTchTTGfDBhCSLMCB2XbGACgYLERhCgxT5PJtrlBIdb2+cg7kNSu5eNh8xuzIrHduRO2btI7Li4j1qLVeNkL29nrhwX7irIwdQWzqwxmr2ETYs27Vu0tr0gCdytSpRnpL1JcisHgq8bxbieUg34pHI75gcB4Uf3PvJPBdL1S403lGiGwWSpZ6LompaWc1VM/K5vdqFWoqCop7d/DQRyr1p8XQ1vOeMtYui6Zkz8+oes2aKbZ+3TkN3zRw2Gc+dz3Kn56+/YphOWsexLgMg+Dn4dPfSzT3n4C7K+eoZ6NIOrvovJy9/Fefvjp//dPLmB39z0ijKoRC67tSKMmlR8iJIZi+GlL6x3GD+vMwlH3YRck7LFccumRAE3ntcdtO+TehFGfgQQ8x6BBamKd0o6NFcV5WatzaYMMz5FRCwS3HRSVzkr3XPF8png3YO3rC8lGLJ5rl1CB5FtbaFsW+y2vhaRhsNEjNRFZXz7CV7e+KNu1AKRov5oYqGWoj6RHg5/kvPXKBQ0w7d4ywj6Ic3YhechClUDAoQGDH8TTA3/o4Nnjr5wjRx0qD84WcxOGOeg9+iwxDWF36VLR1187yF6wT5oEyN47omhRWjH1rN1WRM6zgVHyjZ3lsd35xR8t9aL48oZW2rj+gp1Qj3ss38kkp0lQi3zXiUr7BNUmrdXcwR4xGIBPYYvR9YeqsAoRqKBHHPdGnJLorh4gNzojZtvZ6361rF+aYIv9n5vvltBxcvEneZ2T8F5N8ZUx0eZSleqSovLi5cCh9QjJd6jYmGkDxlnqtc6Fo061kLvpmwESmys7pUUT7ONYVje18/Ixs3Kxkn8QPYGzsSc6TLjU/HicwoL66KHPAaQWFx+6OZhBnjxRDQGjML83hZNK0RyxGccsxuDUJCrNUlSzlvaXAkSpwXLg+h+7c1jpIzOBnKWMZx9DP3BcEnnRcjkzXFYvIUnfab9EcUZrn9JsbW2dkxX4wzN/N4Eicr+1RGyJganlu8v9BTGHvHSpNojSIFAj/E4DrSxs2Fc5y4/wM4ZVppCAPi+Mjy+jmPAW0weZNlxm+0288IyxDPDcfwbVRLHKGLOWSmtZsrO+wbJve5f+deVjnrX6vFrqe8p6lvbetrx8NKdK9jd6f2A0fJQuc6UeQ2ngobmZZK9tbq3gX83tCWvlJ1KVcP7jPlfd+h9owaFzaHWVpt+sHn69Yc8m91QfmxMNzPT/IoSv4/Xwd5JQJmHPZi0pTFXJkKk6LK1U121D/nU9OlCUuTeL9Jj9cyveIJKu2b/u8oN/LXzBhyRPz/+F+crP2RuGu+4F6zM1v/xFTAgH+QK5t7E4N0wjGjFeBrhk0Vv9P/CoWdY6gbtvPPzOC5XtEkMnvaZK6ruWyHCcmKqlAkPX4Xf2WpFtJkburgR7P/V0T8IMrqeK+UjpFCqnkORz86ltax08eI22xNASnEtuSpPptgAFGGsoiQTGiTJJhQ+Cok7rAoF4gVKgZGULdgn4PLdduqejASg3kpm2YAcKD5pWoHkTTjRSKWcbTdrFQcEJA8iLfJGqN/MtlmMtfmHfN85ywHMBhpvyPR50xkfSE5fsUv3T3Xk37UZh8NkJpRwQoDjY64GsNzlVux+MsX1oJ9aJQdZv6DLXd6MZR1bZdxOjUsyTSKegY8DfSzUsnaZRyOxF22/bpozvzIinO1JoXdqBRL9Z7ET7EOzUGSWttRoQjLN07f5pQe3CxoBISpZvmYXUq4oqketdZckcxl69ez4vJ9lchg68mF7e4qAdX6vYuJxaY4Ooyvmc7DbLMTp90VO9DXjuFBPwjZOlBCu10o+vzgk4eYLvM3RuG+fjMSeVGHkCC5umEJWP1cmkqAGGEOvW2DBF2nKxd/+QJfNOTdry3FCdihO4fYK7L0HEIzybzlI1GJ9MrBTEMrgYtyZY6L7gzj/D6c4piDAygvakzV8jlM9YPTNuWrCYhwXRpB9gfa37AiE8MHqI9dyMQYvsuc7s3L3d0tSgC0VkUVrRHRPDPjhDMR18D+YsYL8oVP6lWmTgZ3u/DH5weJgcPMPBH7BCQYz0NyInxfavEXNhFizJvDiPhoB0AxrJrKwrmdbhx7gngyx59ajanaHD8C+9dIIMaaUMtVuwk42QOfdLJAI7y+uPABYBgXYnPHB6wKvanczWrKNpY+ohOyY5gR2U46pJf68kWkNW6CKkmrXg+S4uo2k97Rg17VyIMJJFLRdsqT7aFPZO7tQ2cUKWE360iEne8/2f79hOjcZZRoPbnXlHRn4VyDifN+PehXCcImYY22j/5cA5pO9nXKSnfw/kO4ITsyBmLV+ADxNd0/+GAMZjOnjXkNNwUvTl/7OwuM93CJyddgzgS36aGuRaUr2IWqamOsuHXVvijqdhMJSf0nNxyR7cbHan3dIR4JoHTYBLdMTkYkIz34F4aiJ0m7XJIECBLoWoIBuud2ol/+8vLNufjx+M2LVy/f2Zju47aVlG23UnPVNLLeRNfdKHwVVdEWsgRv5zCsm6smoAa9NNVtdFY0wXnCtqmrYe6u50diAN5rub6uBqPolldXr827F/q6yvydy2mZq1qcvAQn77KEgEsIbJUE5Sxci2Rgz/V6VirDs+efHmzPFmdP0KiP+ayE2p0uuvtFFQsI6Bjw4vQ1zA8UVlmoSYQwMivdGHYBQ6biYRjeQ1Puyxccx0n1AyiQVNI8h3U8qX4FLXIYqHmJD6s/VjXUeIEwDUP+OUB/0zUtn9n0v+o6P26H/OoElHBVeQdVJz6YqhNEO6AfCyVzbx3IWKqEu2c8mGORnFcYfmJA4tbTzZleKo9JgqHQiAq9VNVa/AWs0H8x6+ugws2LkZCtbQLjn1dGrBbXSsylUUyWqmkQmapohaw2S10rli7EtF00tgXcZblhdoy67e1a4zs4YSi0ctWua4Rce27mJOvMFhtIPGG6oq68VtXa0tUt8yF1gcfrlWG1DbmTMq5gpgND70Sr1/OFWAD6ScMga9fzBcZMqhzAL67OoeBUfIbsufu3EdA4erpAocgDIZ+g5zW845sq+IiYika158VS6XU75Lf8QX1rXbgdARZQmFje9TGokigywXyVuxBQJNsIRDoeWtHAur4qPmGDSKcqHKWawHCcX5SRtb3uEED9uQkWU+GqsdSFVAdeTGqZF+sGQOMO0Hbvn/4GT5MI6BeyPr6WmyEUHwlAfA47DI8Imj2CX+FIicD0jTrJyo+pF4hBnG/cW8xugC9drgV/ot2Iv5j/7ZoqfzH/eyoe74u/iMf7fAzxVoDmwBtgkGbPqK0mucjOjnjYt3ycFCGZlCW+gC4jHlrp6xThCBFT6WdKOV3p6xEAa+VReH/iP0OfUAOTBzJafTIV3+wbTcpTOeIN3IYnSocKp9MYTi7oqIXm52SIOaSOeqsgnHWnxm9HHUg22k7ZUf+6mpnhy7qVdYT9gEkNzKJbv6SqvId++H5Mcg40W68RI/ZheCrnls78lmAu1GwSH7as1zs7wpKQ2zCYH/WJWeg4nJ+wzZcTBpQc9NQmq8lGqPFGcgZ+wNAO6JMhb/AvMwi0PUOzd+vun2ALg1kcnTLegeka8mNE0oNVS9gH7/4q+/QLlOvSn94qt5gvA6wJuC7c1fe3uhlaoPaR2M9GHdx4XkLsigNTil+rzJfgRu4EJUT9thIS/gpEJKhygQFF95LZmAOZP1jvQedzWc1VORjxikw4ONtUcxY7YgFTL+QnBuiDABq1kiU9kzO6mUOcT2kDakEZWukaUihT1EsUnjJJdhX/3LbzkyETfGMEMRGgMSd8hNlcdwPW8nR4WhCLFjoEu253r8bYHL8CvRWwtSCEh3zRjYgICSNaDV6EZo49OpafSl1BbvZJv3IFzYbc7LOuyD/evONiYaqVF6ev4VA86yzDtpZ87A7KkuTJjQYqdaVqv+r+q1Sob82FKxEGrERPKe5i/8hPdD7Ja3n5PbXVMCAaVbWqPgx5fb+QkAn1R9Pqldl7t/Z01ldf1cJnoasXtbw8vXIK3BFrVty6hkksCOfbVIU4KTfbtnheYyx7qEW/qPXKhWoAZtVX9RWkHfNNDLkgZ1Qn/LKbBFwKjL27VO33hSrzoaepolqNxOCT2qxXHbVOVz+pzc8rhHCxU3KbqNtjKfhJbbidgNcArSZd5a151akDfHcwErOiyoe6gtBA2MNxwVm5rn2578p17YuFtzKG9swy0PUs9OBKlmsF1yzcZHItm1MICykx0rHMjYDwXOfqdVHXup6cVAVPeAitmCIPoWYEyL+u5k1o4Qp2QahjXF4Criu2+MwwlkOh/bU6FkiF6xp9vZaXXOpuUEDJ7l0ZdqGrDL/uX9nsP1fX/Lh/VdgPri78um9lvXL1zI8Eig2BHzE4sirX14gjoAJ4IF29g4f3MKDhgVfKpv21liuL5MmZID8DDRH50hTf3S2MUfaBBuYsJ6+hnZky+r+6aSEBM1x3eGwk4BGYCRSzEk+I6XYRKfMUDGm+LW9uzsLxnpcFBIkEod0kXpnpC+7NX5/+fPYSLaFnQX45OLT9suDlT2j3WK0UphUXklKbBgHBoVTvDW20ds5kbLay+qOVtSlneBpcM0bBTUdQrJqsZK0qwI6N1FVIMz6swDQMriVT0u8rw2eP27YuZutWDQfz5bi4rHStxihFDMC7YGCGO8hC6JYh/x6QBIcEgMZ5V5ewrbIYppqpU2zif4AJlXxK8e6AEOG5O4DP+nRyIcpipmqbnrdoLLJf0Yr5QiEEISIFSoEONODFbKkDUEPAbKhy8rNByRSDjEDLKi4IXmRiFumdmqMw2qALdK3mrawu16W0EKZWZqcYsbYmZBCXy0qmsuEoCNayOKg8K9BMbTTNQ5CFCKA8gjzKHXvwyE7PyHb9rutD7ouMVc26Mnrskk+l2zGYE6s2JJ7gOtd99WYkNiPRrORcsUuje+bC3NsT38uibMS6WtUqL+atnJUbTNn/4T//9hF3KBJR0cAheqlyC+X/93Ux/1RuJixS7QZMDcjR3osx9gtTZIgNe/ebe9diOmlE12vnC4HiCB8sy+Dm4mVTKYhGLMwEvM+IvHZ2bPpVTMdEW3dIsSlxjILN3lpUCvP3ZMw6Qy/jRChzXRIMPtx3PdflelkNfbSMSwHEUGlaOTsj7wNWxIFq2JGCmuu7NOrGYsNqDAHgn7KT/chzkzns4mS2NPN56n2Wiq3wKW4tbzkmgoALnrms8GAi7zvY/KPgxsdoaKDm4y+HTIzhTR5t1FDXyL+Akw6fVeo6yA06nLd1OYbmspFlGaWC0mIoy3Zs/sogGOLm2kboocMqVhuvZNOqiTitDTNa+vMV3poN0OilahdgebeZMLCZSreYEO9C1QruHUYEr9yYaXCsUNd0bIVhlP7Swdu0goi4uwCieu9f7N7nhk0je4QoJ+uVUVobMlCE11LuAFoUZGaEv35SG+Ye2HvyRkfmtZp9KgILwN6e+K4u1EW5EUBa+uICWYqcFWXRbsDPV5alvqaZIwQo3kCl66UssRreEhXVpQOuT+AA0AfKTgYfkb7A+Ly1BTht8TKjY/nhM8kSpycuC7v+jEj1W+4gKXu/s0hh1PB1gRzzj9m6bUGTcy6IslHi4NCj/f/HN4//8+BQLMFwBBDpEEN0rR7VCmjaBfC5a1y3NcOIXoz1p5fVJcDkB4a27vuh6vpCoHriU3api/Y7GAXsDTpvQx3G1WUHaCA40ZJl9zHYQeKZIz9Zjw/ZMC3xuuEY+f01MBC4L0zcKfgx9V7LhqPpuz8L9qtd8NuReOxJ7iuG9Q0fVvImM3E9yecbgvKNam35TepTQfINM1fPkfObP9FKDA+OOCvctuLMibRQGZ8q0PUZZBAo/COXwAW67ByyCUyKY0kx0Sm6HEJvYOaKHPYd/KzDR5O2WCrxlK6A/rq/j2gYq7jqBIzvNLLplDsztqBMGBnPHJGDTryOm0v7+bs/fN9P4hntIMXi4U7FZ/MVuhJb6eYQm7tN+pbZRlEMCBq9X3N+WRpImWldVFU5EkudFxcF5MBdyrl4JtRkqVr5k9qIQyNStnX5k9qMbCpU7jXP5C1rAoJDsZaXx1Vufz6cLydF807J/LQqNwA45q+9Sd2zQwPR0X0J7sHLCf1uiANk4qkYHwQhgmZ1OtUw3vqDe/wxA1iXYeaW7gn6FMIvn+mw07JrYdJqVvtpp/YTlifRb0AwZr6o5WWwC/3Ed91FWF1gc1FFM2lRdSs+2swLTr6j9K5FK1SVG6JVigLC/TGPyFIlBbOiccDqg0q2KHuh7Ib6ZV7k1aNQ9PqK4d7pFAoVDEvqngRQqZaXL8Fnoc9b6XEoLdnz5v7iizuZ7BxRnqCgkL64cMlx7GUE2H6pg1lQsNfEFpc2PXZ5xbi6px7bH5nYFZ0iv/EivxniPgjuTrvH2uPYBcuxgp0dP/FiLB7v70OWLFoWHoV1v9MY8VJ0/clqt+tK3axKWVQw+3iSrGo9KwH0Q5y8/G8x/I/HB4//lgEdPl/UeqnE8D++2f+vgzCBHS6u2Ygdn7fpVPw372ufTGAD0Wc63ziZQNxHVIgym4qeSkkXgL098YpSeIBqRS6KPtdgwdyk7kXC3lrVR79Ea6SQ4I/JXK82jPnLso14v4PrevkIEgPUGtwstXAf7+AaBf2DGxrR+4rdodxjO+nqnnvJssQ3qNQ0HmhSNkKvVpo8wxyrvKQL2hRP62fDMs/fqOu7mVo3b3NSzvQI7Ov6Hfrr63V9go7/8OEzOMHd8V3bOCz7ks49f0pjD9Fdw+mcDKmXmveNxgdugFRmS5sz2G8u21sxpQ59sAU/JnMAs/LeE4HmlX80EoRYNdvXVV0sZb3xuywxHlMGnnUQcOfAWE7PgPLt3IAVMRCCcE90pbxazdsANvahJYf7DlBs0U5tTuvgDp4Nb3zQDfq38hTJn6EhLen02l1jfiMExgqEgp0gr08uOr7CUSIOniPfO9xl+8jClOwfHkn0Xb/0jmsLimdd5xbn2eILdH1b/v2zAcZIOxvw416z4W2v/Ttiy1eDLeWp/2HIvALi2meatD/ebfyU8I8+2M98xAluVPkHMO+QwgO21VlY/2GMFk10iOCTQkNuonPA7ot/qMgfqbHhJb6/meetaazHz3jCuBRMui4ui+pQDP4CAxzcdiEggl5CDtSdnQ5LnEDsFUIvpzSgJJ/+mqG6iBY3Pjv6oICb3V1xkIGe/y9Nw50r/dkZ8yDp8WmlohH5o47RbkRPTJk1CjDCG8GHI1dl3A/nGtzjIuQ8VObfWrc9UuLDKALfOmSr5f6Pds2A/8cOirWPjB4JuodArhtdToTetDCE57qMrjnsBYqXqtnliX0CToR049GJvrijTevZZ1s0v/vbKzlkbVENba9H9KVsRBd/DNa2UyaRjZd4d9gusW3Xw5FAX3UOrm5WCQaTjaIvxrWDxM9mfSEUf2raPIIfYeoo8htBwTmYMT5bZj6QSMyRSvPrX6Wm0QIgobN5zSE/mY8m5SQLDzO688JvZnh+hc/CUfqDk+XgfuoK/8tf7Yy5RixnO+igO7ehshlwyqzn84HEFPkv3skMI4n4Tq5453kgxOeY99kkesQcbyO3WHjnUIe5n24n7BmE/jK3p7llgOH2c7lebUknSxgxwrKqIMG74VUP/fkSknhSZgyG7jjaHV7HHVU4rHoff+N7ORt7Dh66GeO/WRzNbv6jqfGOyCEjcHO6LCrzdTe11lrIPxT0pLOGna9hpb7vyZvge2hdTPpLp5O8B6dND7Xz3OuRGBIsS7AINFakqq/ddjWl+PR7KSETdjF0IASTzsoYHvkuPwsb5WWz5aCVR9RqjB4tOWThhag4yrIGMTokIvjwNe8WhQFysoK7gCEPiSHEjlqVBdhdxmMWQ1cq2YDTMlqsRuj8ghUb0bRFWdJn0IkGegOto08Pegahzwx6nVE0GsLImedFLZr5QuXrUuU2har5QjZhjhzrqgWLvgXki4SiOOJjvq4xdHkqdnepdggiVW/XUEOBKASdXdexZGV38Hxdj6yYBYAMwdbdftEUGiDPtWksklUsBuzU/mWO8GbITTKRBXS+rl1OLwvJ0gK6gHvzxL0IQ+sD82KPYToDX2S3OlM375lfl6PbbCQOvt2/46Bwefu9+fcJ20MQmPRMjB/voyGPijzlRWaIr/ZMQKH9KCsmfiC7z6g6J4od4UM+wjhEd2uWht2pHeJRx9gcxPKa2fp2P81WGIZypTjJp+/RO5cAbhedVBdFVdjI9K03xFsNwKkbAwr6Mv9kd94srFmKCz2fLIqm1fUGZOAzVZ6CbBIhezmmYL6w5c4kcjAJvB5o/oKb62gtbllC+DAzLkaXaAcs3Dv5Ng9owg7cnaQ+YzHNkDX+vrCZkJlvZZXwggU/WfAiwYsvc1Q0HqvWwQdYoODQyRPaC0zH2BZzIrLmYiIcO9vo2AfL8557943E8je+uQMvvsCJD0mW+cQs3xvuBarQRakhosEZsLBbTd95iln5s1SkrmnZ9X275brfw8oqet/pm8id8kVx1X/I+6EZDkYNWPb15Yt4uJANQTQgaMdm5d2AhPojx15SpI7yRLv8TYynrkEM2O2igNkjNIGTwXR6O7U8ZURwxl6yIdvC80VR5m90rpoIRONS7OyIy+3rZJZ5+T62PjBM8GOfsQov5Za/RSckeRlPEwNhHQrCvpCMPVaf3TWcKd89855Tx7EkSfyHAPU+sbcIb/A5YSo4g7llAz+V6xyRDK/ZJWTTonZy8lLM1EJeFbo2XAKSMD4qS/RSLJaqsSjNY4fEAVKlRg+7ca0wuT2FtuHiOHkNwpbE8D8Ovv0WLkys4QrcI6yExlwY4Uos7b1IDDQRsXS3A+NW/IyEk13vGWfdhtgYEpf0fagftDgjcVGUoLaoSS5beV7LqrlQ9QQeM8duiwoSupBkW4I4TAsw5yPD2Cmpv2wUuJoWy1W5MWdXLeet80znCTeLqlF163JqgqszdHVnB/tszSg7O9Zv8PuiVKZveHHOHoYMoAIjkW9hZI1LiHNUgbct6I77XPYutcxNY6a2Pa1NMyNRxKgsbBeDp6dZHlP1fLOCAQRqqIVq3FoJ12nCkRx5MDHP0u0UUpwJ1C391HDuYH5PdGVG1i+RdG1x5LhvlGtsolbNumxj28Deh99v9vfHv9/s/9fvN/tq/PvNwcXHz49v9yatatohtZJlrL3BgDdiluVD8RGMplAi/sLuLi7UVFSxrYGi06MI6thUQuNZkHHks1ElDgV4lbUa/9iSe8r079D5cK3KokXFBox8f+rCemFgoIJayVq2uh5m2daMVs6yBd7bg9uwv0v5yeO0kis//IzGxS0E79DM/yNKqd41xGxCZi+AYWNjL6t8SM1mfdaPrENJ5p/jBlxhDbX6I2ZbrveKjmi7vXCXQ8KmIrp8Zl4Ehq94t98XYFbItZD2SsPq7sDzmYAJyhrFwqDcq3IXFNNx/w28NjAHWOdqHq4tngaXZT1+H4G+tLcnXvqUwpSHp2jgcMMEYw/ucJfp5KHsc40JFb5bnjLmQcLEHh0El6p9IVs5HJghDCI9vQ2QlO+cv4fJN+D40jV2uuUBqagsGk/QAVxBSOtv9M9VrrfTuFmyjiXTfi6dicy+TWUiC+7S3gVbczAYuaofio/OSMqfLZTMKUp0EPRqvpxQo77/aN0foOA0GFkmEdfbqv3GWChem/ncJwx2o8ED32WElksv+5cvgQubk1aeQHiBUaBYSDqRKtOi/jk5KjwRI4puAoqGaKVLbk91uGFhNXVxoebtsTmagSgHhmxfG4WYg1yJfL1cbkSxlJcK8mmZA8oIqCi9eWgwKOHyhb9Tc3MCnskLWRdi+P//52R/8jgTC3mF8bBVrqr5BgC01CW2RHGlRWNNkyPRaCNc5ZYbFiBZ1cpLUImZqOXlCXTW7M8Gvh+KS8XSaEyqbIeDYnk5GIFVw/5/YGMRD8VFcaPyI7ieOjTbBhLe7x8xK+TyctLUc/DKlq08hBnYuywujmayUf/519G7/fKH0xfl4vh/H393bP57/uO33x2//On4+OXxK3hgnr88Pj4+eX5+/PL49Ho6Dbx+VrVqPNqp/eY1hQSbvxc2FPgguB+K7dwwoflzoxUOi+VlyLcR3tUcNaXc6HULEViQRUtcw9SvGyX0uiYiMOzEaDBCz5q5Yfi1ko0LN8Eu/oH4ReZPzEB47oGq7CbdsnamjyOx7wMEwukwzfog3EkNZpx4cH2bnqFHMFXoDjBBqzJ0Y4AuanmJXgno6zmHpFA2s/P3tbw0/1pmldfSp6t2atYIJTTTFP9eBD+AxZPpyP1rS9l5ceUoe+BhEMYYkNcwZASIuqPHg1R2cB+ViirMd5DbZZj8/IhXxEZfFFdRhKJbrVpVx1V+nOd9jbkZiVX5jqIa5mu857T5kXESStc/umPW00kPzp6/O331KoylB3Agn48Z7npkrSRoiT5k36H/cNgaGxh9halWfTo600BgKOwA91zJks+Sc/MOss+fg6kKij4Rj0Nq7xQEzAtGr5dq/klnhLZDObjPQF6BDnwGBnoly9vsKLVY6VTS0JftpVg/OgW7iZ1Ng/77W/vM03z+qutPxDhsGOHtgxjsSdXhIgZrSDYxwsSGtF4UbF8Wl1UEfN3FUbqS5UgUzZmNluMCi3sqnplyDDueAfscivSav0K0wfSiQ2veLwY60b8aNol0qgQHzEgQFGEPuZXckuZ5O93YvNl3EE78vTsoB5bBko7b3UVFuOiYPx4gYIzKY7aqyoWugiTzZMZz676G7KS5rPPiHyp3KGFOojKkBJe59ERQ/AFiKmAbl6pSNeS+XOi6LmblJkAFgIviohFznVOyx0bMNpToHhGkKNkdJclGoRCjTM1jzD1woa5TIzHSnAeMQERvqDoSuWohASKlswMoClmJua6uVN3ifEGg8Kq4UQRcgZIC3UhfyzqHOIW9PZegkyQNIxNey6o11T9V+pon65O8h4h0jCNz2BgwtKIF9JJGrBvKRzMHKy5DCrO3DZjKzF/ZULp8j+7mpNVa5eu5eXIBWBo1uP2TYRaz9EvDWBpMwQBP3prRN29V/XNV8GyUkBOkLBFHhZZ/jHOqch/0i5hDmtEMmpmvqa8wOxoy6wBMLkrSIIS3Gqya8/m6BkVmjNgaYPgig8ISG5kpiKevtfm/Xpe5+JMy9EjRlCB+0oABVM0RDQyQcAOQuIYY5VU0opT1pRKq0uvLBfgXWONvclbGk2+/MbPivM6IaycLH3wbFEVH/N6G/xYUtupCuvDB3jdHwXq+RDO22frTxI2nQ3VVEygOJd8Tlit/9htDKLpxyQchWCBXrSzob3lTQGpCNfnx9N3J/5y+OT9+9cfx+5OzzH4GS7PWNvdp7ZeX785Pnvu2Np22PBT+xuPYdoZxFKSWuDkU+c1IbA5FvsFsEuZ/DJHLTyHOdP8c0gxHc+7MT/B+ciP+Mk0sHC+y2VbE4ufakdzG9yddvL579XGEi0OdpNWn/txxuWl9AEU3WN5dUPzvtdlRF6gYP2pEpRH/wmjWWNm88Fc+ksbxXkypQHB2P7UP2XF91K39W1ybIKmi6viUiYiGvnd2eC++fDEzwp/9Fps79vbErxiM59icrsTpmXgv5EzXrVhqI1Gtl/TxxkNUIeCAbcXaSemogyMCTJSIF0vHVyCYk7Q28UmTwT4x/ySGjVLC3tDB+VpUYiXb+YLEyAxs242wAqBtwqZ3KhrxSa1aujSc8E27swNRczs7Isbj0GtEXbQmPXSgUhMcaDrvCRR6aBfsiOrM13USOetrEzfwpCofio8uBRi4Z4V3GKFuaD4Nu+kc+o5dCo3+gFWAY97ushi45+ztiVOEgnEEMzLiEYmS7OgG+WAujWBTK6ObG5Ek9Npj57+V4BeyETMjqdWKRPcRNGJaLFrWwnVdADggXQsXiO3VtEbyU2pZboxoRIHU14qMY5WjE4LKylFGavaYkDky5eEYpqhOPzxb3Y9SVW1RKyMiqlpNxAncQc8UHd7lBiBdgPgr2RZXaiRma7dnZqrF+2ZZicuyaOfAXfSaXWPinkb1D/5COw2Qb/cgfWjPjwCJNmYB/QC0HSAl8OrnvAj8vBD+vPv9UZJvjVN8i10VddWxu7sBGsYuArLf2Q+rOXW5L+sFEHa5sb4+zgbrl7q4MEpCCznV2dPGN2Bj8sVKN+huKE7N2XFdWMQ4sx2auBnfwJ8FAsgZDvxetLWcf1rJnHgukOh7w9qapSxLUGHg2W++ASf+DYumWSvxH998+zcXTg2nRL4B4L7uuXAfhBhn+gSJ20jK761gDThYeGJgHkX08jJSaSVWtb6sVdMkcYHsbnj0ttZ/qnn7KDC+OFBhlxkLNJJaSdI2GhSiw63ps3mraxCNrxUK7CgWB2h5RZvFB8R9thZYNq1w1bMhQjDTlZh2DD0jMYNEry3uq8i03D3pyViL34Xse9gu3zLYFpUZc09LkDXxgzwcB9wSFUaBmLeu8i6vvN321NJYWr08NP/ediMXAz3tiXi8nyUTcQXEFc96Hw12uMNRomBHsmKW86jhF6bR/CZq5cVvsNZHd123Rsf31pF1XWyt42eeHBhzd2PtJeunxpus/ltcvYFVElMxpIYYbJqdCSNI4cu9+F2E6ymomffdZt67Zt53mnmfpRyPw3VPL3KY6dClgzRjSk14UiUdJp7+JbQ17Arb5l5E3bssJbP5b3eXv2aXqyPxeH//7tzqdrJ200S5y6nytmMp/+nlbxHk7NqISxa8AmHPjOAFohQkQ/2kNoHNNNforPldAY6NsPGh+AjcJs4WxUWAUdVuVkpfUIsQKdvWRXXJIpnolZjr5VJWefMBHoRZyeBRyrP19kHoF2FOrEKvGwGX2IEUmcNVpzO62UE3SjVC2mbmumowVxseGaTN2JunB8mrcrQ/v4XjZshcqU1nzggwMA5ER6/q0Gude1eQ/TX0m/Pe15QQ6WVetE0AHkKMxi9FJwI+cpSnbsAMwyXPw6l4K5vGetFcFBWIMomrHdueG2cHgSfuZ2flrEFAY4R8eAVVav1pvfpJbb7X9UtwdsFEunKpRrR+HVDj0L8Xe/FJbV7LVdOfE48sclP/xSF+JG4CPIzwy0HmBAgeh0YckXL3tmCs3GcPfBl/UhtwUEx8vFMu+LoV1b582VYXu97ptsOka1q9OlN/J5+/F6qUG4QJ83u+aEDnNs37BeisAfrf/B2J3U3bmfo7c3BVf49StjavCbbI9t37fg8oI9nAB6hDTyeNaoff7vd4Gwa+RPh9w3eCLwc0ast0DovOPoeE1kmXGPfMjAAQzP4udsVADMQuPOJkkCa4LSTOfOhtvakYLNdlW7hwz8Ro3GejinZWbVV0lnklrcf24JPa/Ehl0Fec1runI7Y9Q4bd/vlUFv3ahNFkZd1+VxbVJ38DxQRGM51G56Xmd3bE3u+P/j9yCSWiudd3Erjg7vHDh37L2gMT5wGPQW+6olQPicgRnIuf1IYfj8EGIQL5pDZv5FINQ4AYBFQ344mOOuaLw3FuHkbL7mcB7jbqpoUzpdVminV5pcTFuiyxD8OimpdriIB5BMx7/CibAOa1VaLo2kq2DniuRf96uM6BO/nxUlMqUzi7CeYTrT7twrfy6FI/yrxYQZMI/TAF9bp1nZhEqdYi3jPAYnZjARtyfGCWic/uREnIKXayb7kXHuEDJxlc0HLsfsvFGy7aiGdi7/9c6g/H4//5SDQ6y8ShmE1wtrKuH++2TsfIB7dZDyxHcr7SQ7njg/4b99kLmJoRNkN3LzxfyDrYDCPhEcL71viRWd75wrDQRwNTp8/z+f6DsktPQwsQWc9avVqp3GwqfwqwSwlK4LI9ruPOeNd7Zl4FqDaRa9W4EBdEcsZEnqqZyxVzzkvmisXLp09q85zsxI//lgk1wc/8AveZgSyG/vkUkUrVujf2VpKlNg/+EzwqGRC2bcpmEp328cMgKi1wvussh23rGX73MDil9/bIhc7I+ZUW83VLTHkyEdfKMj/ZUmg3wugT2RqmNdNcy7DfAlR8HOR//Rfw2YVsnuvVBlYN/Fa3gK1mAeRzxxl34F3G5mvnG+2tX+eGhCmvQNVqMa910yxkUaPx77gEU9dCleD68FrOPS24lcFO7/0+405ptp3fZzaWInTSelFcTealbJo3cKBa6WChr5+bqj/KwkUrhWJ6pwjbJdRwqADRQ/JByfPn5qtDehq50tleD7I4GH696mYuZSR/8F8QXdiFfxOiXt73iy68IozptXmi1qv+MrCElPyHlbuN8oNuaTQRJRu2F3teQpqqYeBmHU7Jf2aITGnjERIKYcShEHZf9X0OklRt5YtdXPzQ6xpAeHvdte2eIlwtQrf88sXe3LkN2PUZdeP2LA0CVWr31P6IuZFpmM1ayJIy8Tl9ZKSTPFOvvGs6+8bwoZpghBsMFf8E4FfAFuvhnd2RQrrPM5A9ANbgOY1r6EdL/gnP3LgO3VQwZrztxO66KQaqUUANXIL+/vT5z2d73736+R2zPHnNsoOgbkH6XViAKVFUl65QYO3YbnYNw0rihtKhN4nPRbkRMJvasJs3kTsthjsF4Ni7HrS9HbtPd1I43jXZikAarTTK6INw6azXM36AwoYYUB3LEWmTzbGhxhUj25Nj5l33+JDLUvUBv3aDa39zMtm7twb96kDWJKwUyvVNScV9XcplLuYlIMMOG+c1iIZAUN3Js+pTUZbOV4BlUhrLsiSfOpRf55+CGzMr4tmgLisXqfJ7XbNUAYCc4YK+IjK7w6LA4YJ7iLuvFSvfYiAXyJF49Xfwt2/249idbhNzVVyp/Hse9WPV4652fhvSt90Q9yTvwPEkKLyVHCml4XZqDDarPei/khpvfaT2SdWq+gp64JqYmZlwyO89q9SzzXqtsbceN8Zl6Dt9c/7y/bl4/fLNz+LH4zcvXp28+YHenWvMV4JwFiyf/7WuP4HjQqUQVWlGeWXW1aLImYulqQK3p8Ol/EReFbIR60rP2nrdFFfK/LQX2JlpqkTEawqoLy4XLaYMEq3pCnm16orCrxl9dPNocBHlDulg7itvTRbTp18ZQRubMBM0uPsk471NST79HWKjimEtLMiBrztIQnXcDZPAWhhhzYBqfn774vjcE8pzvVytydvWpYCjWw1VQZSZtLHMw6JtxKNWPxKrWq9U3W4sdsIFhEKg4WZVqzFVUFUOV+Y+Hhqh/ZkDIn/s9gdFCfPpwkeYVMwl2KJnOpgaCM1eOIw3izXHGrBB/mNx0LEclEbxYd+yZXfFMNECJER7JvjnIGH9PiVSPbKJv3LwE5J+isHmdkEpknHuWztt0TqQ+65cIp4BRIax5YEtjhWL4BdFqES7TUJXzIm08NnNuxM+X674K8TDQkcCmmcHRpgqbnT2Jwx2tRsCztwuCdWEAQMmF8stgMZiYxEvcwZLigIvIp0y2d22Pp2KsKEMTEnTbhfNWo5ZYdscIzSESZkvkmwAdtaZKo8vzL5ceOzmcLoJ8gswZY96b6usThYiJSfuqiIIdcIF9IAvet3GQJwxTYTgi9TbLfAC6foYgxxF/Acm9QS+IIQ+dqDdU1IzBCoQoCSkIh6J6pqTMF9xXea41qE11FSvrhmWLKy4KQzWxep6Ml90M7h0Kxv24D439l+zjYYc2CXsDE0/jU/ittRXmKJNsSx/yCmCJIC1dWvEuAFoLwcZeSJ+LKpWLCF4Qgwwv7GpYMO8JwlaJUyH/EyVnFCbkVgUVdtPr4Ru+rZWV5R20awgRCAgCGilruklFeunc/pkP4E7YA0qyUgbY0H10nzHkQdjEiP7edejLPS8SlRk/GBLdT/66FASbOz+GWjTZn2MIkYLkkSaTm3kkSgqtJitgp0WIJkaVs100jVhnvg9b9p4ZoZ8KHBq8AFM36Fodb+vSaIxbAHOiJQhy273AFr+rp1ujk3YDAP0/kXeMnD4bgTa1mpRVBflWlVz5UQVTtsXRZlkvyNyVAvIevYny64/l9VclSq32OgWcATwXDhZuZzbh3753UPEc/EHmku8T5As9Ap/joJPHwZX2Gibs52ymrVw2ewtYdlh6dmfE4ql4uhCsFStHjlhwnw3NIrgYQ+fo+0UAMgG6wy3XJpKww4KyjJC8tgeWBaRQcw/QQ4SxDl8OJ2KdZWri6IyehHU0BYCEf844gMnRRABMgKCASIbmdlgpixDd0Yh9dUmqEN2asJzrOxqm5m16xBlF+bRN0goprCddPxlJ59+sWXAJ/j3bbATVqty4yVxcL3yxl9Z5ULmOTq+Bw5JjwjejMAksaiR3uWlbJ2vvDl1BGituavZBAcEQwgKthBm7LY+SPz0tTMcAE/iQzSTuInzEFF2uv3Xsq2fC1yasOmEX1GW8iNGe6ZTwRJ0k9lcIPMlmlt54QS1MB8Dd0T1854g7QvTcLIep+e3qGhvBEBCQepwGiOsN+3zmWww5JUUscZwRRZ5UyuZj3UFzciqEYVRxhs6ObyTDnxiKhp5baf6DIrv7IiH4RJAAgwI3bFP4EBo+GCJ+r1mwPRx8yU/b4E8AO+YqmbEg6cgJozHDCbH08pJVdmVpK0HTXwoPrL95x75TViIZ+LDYPBRBGy65xo9+bkALKuLebGtDle5evTLnR3erw/7H0FqGNjElT2TnMh6QQBIoI6gQ+UdCoq/gYPf59qifQWkbJu07FI8E3yfT4pcHIo38o3loH46zgC1/oWe9zXYtLVq5wugvtOrZB+ZP2WtkP5RMH1AaSA+qfyFntujyx6DCOS0kLXKzaAiPuVfwO05gelR+yMOz5vFSW0EdcNUHrKCCUg1ag+1Md5mjNJy54zhhfH9Z+s2ELPeKYgHd6eL6YSiUCl/kNgzpOdc+L7Wy4A8KIOaEeGc0Jc+JMDbu5d9A9dJNROYicGvwKzXVASTDtLiiJN9kLsGtoRe13NIsWeBztdVrgfiGTQ4AQfYQ/zbvDCqYq7gS+kKWMhWQddVy4N/UXVxsXFextZbat0ojHQE2XZo/ZAhWfs/EADItlAplZeqacoNYch7BREvRbJe1QoHmtasFN0eYZkIsLY7++IZOVJQhgK4f4UH6u9rWaICCLcbh/YFpeF4EMQVxjm/C/DF5P0MWRhMaSmb1sFhuyddjGw/EUdHfSOdrHyOQmZvtr1l29rsUjcHnhUSgRmKiO5pErPWO02h+1g3EwN95TMs+TuV60RKEtGJWLhl8SeO/qGpDg4UBPzwFbH09t26KHOxXgE21ZWqG2df1LM/AaZCg+xJgifkXixaZbmFC7dqIV63VrnGSzqzS4rqEiXSq2KuAFlDskQHsmoL5C0NszX0LAM7hPxKmD+Ru34mY8Ehb3VEyB0INQaE5B/YmY0e2wnkj758Ebu7UG4pb35wzxlnQjkQ3JX+rSJnCr0auxeaUbryU9qkEtRlTCDQUYnx8T1D4zPk3S/y4l0Ep/Rgo3No3C6+pL1A9quHC0tk5s+g9KHHBitpHxTgM3anjfYQrgSwp26z3XkgS6TFpaov1WmZw5GcOohJ3ij8EZi5ozBML/S5a3ZgBgdmpboNbFoJmQj1CdIBld24sfr3wOXR+koB6t8hQn2FEHUPMYozwnuKUvdYuJQP7Nl6Nr4q1HVD16uQ0AWMwtV6OVN1I+BWV+WEjqBuwFtP5rkiLCE501fKqHczVSKqztKmMECgAI9yF/rYFRetG0teNK2s5oF2YZ8l9AJnrIX4MCrnX2FW+dB4t5SrYWiYZKRRc80msP75JFHcWGlN6PbToSlzMl/03z34phZK5n0Nwbv5wku/fYbHtOmiVpeeM0ww44Uzb/vJG7PvuhXooBTnJILyi2wAFwGcCMPBjkQpntDvc30kyt3djPHFy1dFpaL+lO76d5Cgyte6VqLU16oel+pKlZbf2wWjSBLAFKjAzITpxHhY296eGFa6tWxCV6rJejSBu7U6s6nSakBoIQoJaJudyH0z2/5R7lQS3iY+8SvJ3EuCbZW+Qr7fzWQUZBLLvZ0L66fQHZ//sAvH8rwsVvy6l/h4U/yDwKiLxrTR/4nkiEEhsp7cvePlRN8Z6lFy8uBXdhRbyOhYS9wZmcON3d/Yud0VFB/J72UTDAJtOx/i+/yPo7Th/TYOwTJTT1vVr8JRH/U8hfIJC2DfsQ2Xx9KMNcw+aVrhM94/stAmdOe4HjA5zoLtQOrL7xDy7g6LnffEsFsq+2dsSZy9JnbvSUVBbnaHu31Mu9fpKgQy0GEyrFwCwRsp0fMEVf6R64pgRbJEZBd6CLaqlvbWuccIj9EXHnb9gYuOcjtxbRGHVnB3e3EX+3ST0Z0IDnrFUs+PehCs7CVOSIThZSWzotFivpY3r6xkTsmB5ws1/wSIKGeU2Nxt+9B71Hqcmje/1nK1KqpLvjviZky5N3p4VTRrWcJ2CLYF46NBjqwCfKvC1szQWIJJJ5Wgq0copVr3AOvitZQ3r6JiIj0h3Ks1GTuYiv9kdw8dyP8+My5PxY+3VQh3ezxvi6uiJUjOBwx4I7knRg7HxKUNyoJtfeei9c/3djerLUuAOYcrWn+c22Fwhth0upU5EMNFekXmoc8J7AcqEuRWT5Zw61mqaksx3Jl5ct37dsu2MGAMkY1rZj5KDJfytRsGj0m1AgA5lV3UumoLYGeUORKcPFXtZXsqwdFc+HO+v9Jou38Fx/UHbM3y4uKiT0QYejkodM068sj0S2X0IWcIbUABVQ01mI9AYIZZsI4wtCQdeWZdlllHUHdOwA410RPpdOo4RHi1Et+6PCyaXxcaM0v+DAsSp0dhH2ZS+XzJZnQkBi1LchG4EV2mKwT8i+aaTz/ZZ8g2FFqVwEhEBQaZ7ehdRQcdycbW+PIl+lwoKoYOE9Y3wos53g9iiyeExSPPDyMBxRdIyjZxJphO77Nu8PrcXu37a/24sh/p0O1HfHM6+xMSViWfT8WHj1mGVgjf8G0nTLITDuBjSsNrwyD5B1IdBCl5D47QdwPYOLhiaDqYQ/9MU4O5cWJmwna5Qs8kVsvKC+1yxbNmUCyzjSL0SC0UGholLJr74KHYe+BzgkwsecAQnTcEyrAh4DPAuZ+8+UGc/3jy5oczcfLm/FT8cvLyVypwcgFpIDH7TVO0jb0Qb/UKLCuYW5BcZzGj1wiEskKW5eZBCA+m1wDFa5RxwnFGOJfGQ8VgHI2R7Gz22FCy28wU4ZbCt1Ce07rOAyU44Zs+wF7ggW8tgYMscfWVlvtmQSpGoxn2ZlseiVyfWaBT78qCYaOmqxNEC5tRLkUgIVbFH1Ae9Rfr0XT7qk/FkNKoFVWlakL/Q8s3WrbsHy8J+DMEBOSfjZJYujcPPeLuw9VCVq1eRjoulHtDsX4+W8Pg9/Xj/f3ZIJGLRM4aXa5bRVlIBmJ3S8KtYNpSiSfFWKxkbpYBQRXtqmWZ2BWD1c2RQKS1e3+I5nksgvXCcf4gVxB/45HjZpIm3n0Oc6zc8TFqu0RYRagHmVAOxePVzdEdqSt4AhQ//95e4B7Ftm+7rNub5/kjOs0HVlqkEQnw45V3t+dIgMulygvZqnKTjcQV3NqiICLJGla0HsZxpuZy6aEIh7JBoy+uIKDNO5Rvy2AI/TAfdUIvIDMlIHwu5UY8yuvion0kcsCKh+QlRiwPrb8woLe6cTPm8pmoKh+JpayjcwKfeHA5+9tewThzZVkswRdo/4j+fCK+pT/Tjrk50xaBWBBTtjFnnvlpuxbcUhiysIUfqiqHiFcEBFvpBi5q4O1htylV5UFTbi7Md2U5X5eyJfaLSfsYVCij5pHvA/zemk0v+i9uDyAOfXOtXhnpF6f4q5uVN//ebvr2kGHwFvGJ4QhEMcHEGp3gvAecEl5SOodOSgkuYrnlCTOMxOCNHcTZRL1ISdyWTMX23ajTBxmjVK7O3W7ppk1o0dtPh0mbqnmvnhJmo5vJe/eVPAfzrOtSYYNvYHmP+lmgOY9AtNN1XlSyVU0fM0zwnYDp3ByMxOZgJG4ej8TmcQD9dffGjCozP8FtVPMVtHL30n7Fgro4TDsYIeGSi6DNHUuvlMox1pLeAIy1mXcbPjdvJdy3uDmfiHewco2RZMnPAgyMfvgu+wgbBYXlFaohQGvUrM3x43ypRz6IxMIL7zFcbtdrQJipdOsDRRXF+URZcu63ltximUbZr+TqtT2FjC7A0luTZOTXcHOAAujmwB9ZRGNKVQ5Bl4djo77WIR9ztnRfHor+VEfx17yE7S1rI4/g9pl55m8ei7Hp81OqmonNYzE1T3bpyRG3636HEp3jqJRnbtcKjr+ouh2GkwMODsSlYZL8tI6EbF2Lm8d4w/SdFRp9uWiW3ZTy+1AztiD5E37zmdgXh2JzEHjO0sCfssXZdeMPUiir63OGUVxUQ0M/Q9frZ6zDh0BTY9sOP2GoGcTat11PdBnLBUY1v6ilO8r6aIizjS4RUbKnLXmPYjJiGQPAtgry+jiAb4BkiBhQbCYjSjePgUuIYX7okvZhXJP+tQBF58YQ4I0nwGu/2FQoM2Wmpsxut8zNAeKPpOiADv/9SAm88TQE8sqWqhya+uYA7YjYcaSrg/0sNurdeLq6dh0u8SD9Ztu3bh6L3UT7jqKug0iOLvDfWatrm66xhVh4YI1ww2MvYqNjgLw4KOkCZVuyV9qmL8BhV6uycO4h4E9nS1wUVdEs4mt3mefnOuS8KCeyHYsmdEauX76ALeShh5YG9D97imJDjN3yygxRskPwU2YZ44/5LukkREvUMBJo6fYIUufqjg7gph6mnqY/j3y+W958vEXG4Bwo5CdlU4jIVpDZh8Vf+1ViS+ztQnRQL/R1FSweWpLQvPMLKo8MJmPbqvi0I5holpIwZv6ub13bOz6b0ON+1zy+/mS+EM/wCn5dk2maHo+NZHrIc4XAp5JlPcT17YPkuqFImLQJohpyyOFqcVJxUuzBVjQ4/kOQkYPAKRCDJHMeASy4om0E/7zFMzDiH4VTVbpdqDpInUV3wEXjtinLS5KiBjQYYhuPmmIJYlLziGWFAHpKGCDA936+UHIlrkE60sGFskfTuMDraRLQbU8tVrbpq5HhMGgnlNvS5MXkNBuAmlowhhwbOnv1rC5H5WNRuvZOkunztYcbIALuLcRiC+EcvZ/SjxctxCO/RokOWwAlv9VWu8cefY2O7447aK7GrAqtxr+yf6YNq9C3mmnyvGMBMA6tz7AJVZuRaFKaE7efHb89ET+fn7w6OT95aZHiT6oczj6r2oABDFLeiYW+FitZy6UygstcVhiRvpR1O6DMhQOZ54M9srg261lby3k7AKSLwapWVwPSZ+QlpAcxB27RoJmJfNPazcqoMiVsYTiC6zWmSb7Q9Vzl5NI2BrtcAZ1tspFQy5VFMcH7R6MrwY6BIkZdgojJUs6VTexnxrjUuSLpoAE4dPLerBUcXEKWugqvAbBBcGkA3NWRmZcRG1G/UwcExTAYNn3tDXfwA+evU4LmOET9lWUpZnL+ycwRzq0XNmBURvFzGQql7TjwM46n1S50PoljO00DE6zgugbfCBKLwHjQ+ejM/OmSGY9ElXUE8lbOzop/ECOypwA9PIoxRAKvkSqDQwisw+CjtK7a57pcL9ETgSJvkeqoQS70mCLQVXJ3ih4EXI3OY/gUQu2R7wFeLC8hF/7e//m9+cte9mEfQvjdrLLTmdE3InSeERana4r5z7MmuO88TXul20FHD+sjjLCpaCWHMOYRG05TFnM1DMfr/NV8uUAtCz6BKQSMHMofPwWIq9iRnO+QOMAlRWK3kWulHTMUCuP8KnKzJB/IcBY4uYRkNT7IttCOVU9Sq5NYCrNz+xbCEe8up30s49MGpdbXstA7Wx7fs2W6/LUfQHf2+3R8oa8DZ9egHFf52Bvu7ICP3Z4aDEaUJ37/KAVxiMV/LdrFuZy5aLMgQAY+elFqXQdEuefXURQ2SMZ8andq3xyFvdmdisHv7YChd5riT4KRdGo0tGWCb4/pRuRBuFuo1sNpxFu4KSb2EeA1R4hRg/609Gffrh3sAr6Zv8Lbxu5SWPlhnLRPuYII8RaaClWia9lYffh6UbQKJsXqVWAB982QUI06BWauRe9V0rOd4C1bnNzJg/4UivdBUbonjhITf1nwgTmYISieBx6IJz3THjivQUoYIO2tSxV6n8Hyn1YqihcsRizeAi4CDYEFddllQSqLI8MraouyaDcwn0bxgYvQ0AsWhj7b2ESIuqZ4l5G1cBtisY5c+ArkKty1ILbV6rJoWkjY7NZbSJKr6IYjtDvDM8+WXf4SjFWH6GNvAwEDo3aA4SMrMODPo9jJhQYSsLqUiDEvi1XchcBKBd8k71aXr8OdPzpO7xW5XugVIYxVOuMhYukokErz0WeBCcv6QTr/ZlWuFAjHIlelQi87ddOKSsk6BJ4aGkEZ4DNbLSCdm48JMZIkbLoRNqNQXm6KZVEaNY8WSxoCmkTIvqpVb5SsPeWiXwz4RHb00EZw0FQXcwRYrT44NIxXpVstqt9qLE0hBktlNKJLoa9UXaIRxDZBzKE3jvouCLZW/4TdosEMHe9wG/B6UZRKDE2HrNchwTlgZfIDLpsWymQMUC9iUxb0awqjC8OZve+XLeacv91HrDcY4wzsrZiKoOq9GYig7qybBQ0pizJyvQEJCp02RLvQjbJp3fn81+vqpDrFe70EhHOwPHw2g4DXYjzmEVTsyLQqlhErTHUPFmJ/tdqcjUiu/nDssdyl0BUA7s30k92yObMxZvyYiBcF+I+NDwzvPCCLq40iIIuAWFeUwd/oz/OFrAeAXWok1IHRVz5BLFANqWOtGkf8s9YN+aRAkhVZF8rs6sG1rvOBGEosUBkGYB5lqHpf1nq9Gohh66xPyiLPXWBhKGF+mVrA93U1Nn+P4Q93tjs2XjcZWgXQ1b/coGkAQFNrjWmj24WqjSBDGLKtHpvp8LC0I5EXNWEeHIilkgidh4Skr2WdIygO9BAiT0nYsNh5KBvpOihtOifnrYfkhK87hE1utrcGOxwJ3g4YXuIMeZDTGBVosSjasyJXUyMqUXVrbcRU/LWS8wVGi3IrYjLO86Ko8re6+RHPGzjY86JG0hi5OY0zLDBwzQAdEx0tDe1NTTOhNn0Krr1hdJL3EA96ZLYyBkgFfLCkow/czmquDJY8+Azyk5mdyuJQUewywniAgctEU9N2JIz29TrgPN4vU1+p02quhrAjznUY+IEXkoBQNnSk+gwq/WJ/HsLPV/oSLU/ZkDpg5pjWpQPzhG12HUl8GkPsiTkOwqlNZfu2yw1rOsyL2jBz8Qxm4h2Q7yGFVly0rnuBFAhCClQPa58SMpnlp4c8OJ9k/cTK3LIGK4bslkit5fHqII/mlFiaH6ZboCxWRn0NZH6JOjDxvfWQ7X35ItwTZHWRj6i8PgeEF9LzkdlN40rcBr1AsWoqOsg2l6pFmQvFcejCc8MPB90YYdwE5PFz5H4igEJENHbZdnbEQzf6h2jRyOIz2l8bBWtsJv64Hc4XAEAx+L0ahFUQ50YUza/U6SFcL+FgM/FMDK4HjKYOaaaMRGM+NoUmTbEqLPYQy335IvZ+b2zWmTW0aGY8KDtYDUKxxn0DBwt/mY5mtruDJqph13Nnxy3t/2Xuz9/jtrF8Yfx3/xVwfXuc4qhUkj2d770jRfajeEn8dLy8lpPMvIp6LkRCKlossppkaWlb//v74CzAAQiW5KT7zuSHWAViXw4OzvI5DxHLIhWKtyXGqADy+Hhf7Mf9201s0IM4AFgmdpJEzvAtPb3fAt6OhQnsLsrVYd8sS3L9CqCJ6Z4gUj8MKOewmylCpbOWoJsrMD0b1Yi/alrP1rjA5XZAcIkBh0NotMTlzNXPnqEhPcBKnxuEqbXdn/y3XbC/eAtad7+OC+iv+YIF/cnN/pC0wbiimOD63JBgO4ixvSG090z9EcN53kU31FsOJd6qf1VT15tteY88nn+r9tT820z964hNVrY/SmRhBf2Yb+iOeQo12w44z4B/U3uuR9vq34LrOoZvQpVAe24w1ljTFh1SpOVMXc9UCAr5EHPOm3XfgXFLcJwk+XymbuB9pfbUDfMiCzKQ/0wNxgeCanMvnhvEz7Dz+W3CChMrkWfm5YvXH999UG9efvzx3QvWpNkdv1qfVmVe3TiT8sP3r+fqbdOTBQKqX96tpmcZ8r+kY7abRkEkfanynqmVac+adonYkGXfqf/TL8ru/3i13Ddz13rptMYYPMI++HvDj2lyq8EOdHP1pun6AKeevigyN2TfmxdNjnQZbOGrrlFl/cnkPQXg52AELi7Aqm36BiHk7BY41fkFsuq2Q7ovT1Em5awg86a+NHVp6tzAUFJVOU+1vKm7vl3nfdPuiZwz2nH5upPIu5/p0J1R7BOKkRVEheBvt7MH7Hv+boVuI64iFHbN1KWu1hGnSdJriozFwmzAm1YH/PUY/w2Q2aJP9txB9fY2wTR7xU2WTWEmsfpkUBaLDqsnf7huvtDdu6v6PVFX+iri2XmEDowLFhbnhjL6Cq3BGJmI0OSdj06ej98p58lNzO2My79o8hA8WRYrmtwtlC6Kv0AAZJF9qVczxRp0XiYoGQZ7PmW7xMlq3S0mlklZ14BOMTmZnpseK7bVZX6E3t0x1W64LZZ65fZE0PT+BhH3Uoay3toSUKno+AFRqu1GWeoVhkqDlDlGnoXkkB2CCrsV6PzKWRSwfoPfu5jidyBku9kThIsH3a1Mzjuli4aP3lk2x7xvLkytnsEPJQ+tZazfNIWZhifH5guuAlBogvACdM6Z6hdtcwVS8pe2numEOtkBm2Lp3ympqs/W1dxz6WI1GipBGHO2iT3o9Qz+f7Qy+Z7iAeq/rc2eO+nufNo+20+3qQZsLT+Yemtrf+AzbHN5PCOxre6Y64jw8JgPUuPatM3c2OVWi4ST+NTgnHaT8bREEkp8JcDafvmiSAYPP2XcXHxPuI0ajgboD/dpbLdumtrR6d2MrXjrlkDoN+2LPbUANbGTQ6uPQPtgeYiH4cCjLwP9q+dmnHABHy7yWMxB70+mOs/YDAAeV1KDTlKBguVCNqsuCsjoNMspT5SyA1EFE9mZqrNMGr4g3U9MQriPSYXJeoHURC6aq0TYE7eNYzUB+JKp7cebNvT9VYIjqsCHqPMDk6JYXCNs3ryB25Qt3NicbRrsOOd0JRXl4M83xFpCmXQh3xIwER66yMMKIEADGHeC2TN4nD6WNbnp+WTJr+3CvvqkvrMt2HP+KYxQPVjgT2DbNBhLba4+bFil/egFztGUDg7wcRysD+xyrk9oUFwaC/KnEMXnqdoN+5xSnPptG2hPUR0QVGxXKkvCBwVSsJR++Ck4LIbihnj+ojJkKRaIEv16R5njWQRA3WC2PeJdUosREb4RMmcf/IaDJa9024HY3F7RoCUmG0AnUp+rnzt7jaIeROcX3QMRP15daTSbL2tL03tvi4aWYtMOQIyall8jlhOcM6v30TZ72As+CvXdrcnLbghD2OsLA0VosoPMAz7Urgrkli8CChd0zwZYFpO5IsFaisag1MeblYlH49tA+wCOsOF3bOxQ2/U3FRw0GsMR/KYesXQ8KB7B1NlKyLr5QO3OHGrqFGsWarZM7agniaBbXnQYQmwQACU9yLC64ycngfHU4NVPDGFpd/yU+rWFncrU06eSfEEUXZvzGVduf/yremI7ewKuMfaBny8yN6hlWUR3H4gLw9JbtvR3UM5NjP0W0E60gYkG52t4cjIQGtxKD+q1QxV/Bv/MGTd1ki+3ibtRk0ztibuMfTzXPQkyoPweVnYAKeD+sIc1ou3e7gy+b3vbfL8NLTc9vgMFY+7IytJFSaabkKwHa9PaypwOR+ajJMHIu/xTZKGhen/AoRvIvGWiRdFxlK/HhCCU78p3oCvSiZzHuycjFXeba4YrHoJxeXsEjvWI5eP3M5T3Ci5bNtYlqAOSsnfHNvfJLJh7t1hTef4FI2kzY8EQsSXkGm0Lxz7rSYZ9IYDl+GMUF4rfVvhZVp18nLqcY4wWjv1SVzR00fZxeXIS32+Xugq6a38nbq+wr3OcU1YGuGZEejSS4XQEeRNz4jPa98rd1WGumKtOzaEtM/+v86o51dUmdhVfXzL3gGW1z6lVawpx4jIJ1ww9RU8emGgEag44RTcUzhQPYbi9xck6cvaFd9+vXu7upDihPjg0xnLmeOj7lVIuW/q3pwJGmuOrhqbpeG96UK40vyCxKcRwOkTJWwIlDDQADTN7KsGj6fYmDExAbwF+3iEr4Nm/0PrY0wBXbIKO5JNslIuArKEZM2bFOp4Fbxe1l3i3uMtIoHQIPguI15cvrA+Jpg8izkeT54oNSLjPTi2kuaK7GnUaBNEoJsbtOjgTCKJvfxzddL1ZcvOi0KC5CIoBNBayGKmOPIBI1E279Q5J/SI6uiC1UNhR8hMf6+hngC/iogj7s3s76Pi8Z+/owMCCeyGm2fmlB4JxD3UUCj8x9yHJSKJDPzwk+MohRBnSWiduOj7tKcmIgPN1p4xJgT92RA/2hwCh30XFnClomJxgIUNsYGe3ort+nwYmY/jxQoc2LH6eA0I1CMvoS8p3YESFy7pvhjvC2a3g1ti9Y1cIiKipHcUzPxG0FbaDVXaGJ8IvnTd2Yc70uuo/OuViFPOQ+TWvfJSbDOLZy3rswfoVAalS1eT8OVEL623Qwf6Nbi/spTQUPeFGRa/71y8G6pwg3nFiAT1S+x3AqEvoQcd+ROeiWwhCmEg+UJ9lRBiq4pg7O9DxEH/qVEZl9xKkV1Qwy1SyEekIkFQB3AqJng+WjzObmlPuYAQotaQzNov07aUSxmIDPOA7EX+j8Tx6lBiln7OQOd2UczAzKWDQcgDVL+WnOP3DRu63Ep6MbG2Vo2thK3pdnzV7Y/vvnmQVI5d0KSKVMGd3UsABKG9kVz9C76g97Gmi9gE9lI29bVKwwvUG6/sB5fxcwX3FwRLMHp1mhK0U/oTBEu0lli127bXFnle660Q18HumTs/lB/o1U1etXskP7ndc9VVZnJveZcNftwMu/JfSXK2adoT2kmf+4HJ/5V31408fm1upYf0VGo65uhreHOhoDBh7/QxtpCOW38EFyWb2Q4HYkN9Mc4NRvOcVyazYc5pgX9iWx4VwRt3hTWXmzgLpQE0YEHIS5jP9Yd+35em6NyC3Qef7bYzONpmpiaWUkT4xtCboTP9zbYqy16eVmdYSJDEE85SIikE2eBob5vsvo5PrBy54PPF2dSUhGg0YSrqk2ughd3XZkY+t01NsNmOK+AXh7b4YrQlHjPXAHe7Q7gUGpPgsDvvOjnqB/IHqG2dEBm5MEG5nWqK2sTwrTYEO3xhu1WT7CnAgrsrOMI8R1wGRekLxI8/WN1D/N3YCA4Mn2CgI2ENWXE9pDuH5zbZQT4cZs8BPQyyj2h5mTnCkd3TjuwPuR7IdLBmDswMWqKgLMYie0lrKmuh80SJvDwrFtFccPOwE2qxNVteTxNmk2kVKSw+hySSIvG6JDGxm+B7sZqpjdNNt6LRKtrwr+jq4o8Le2LYnGXdhN7F6Pu+yLIrK+NzTr+kxiOyT3abaPL7rZBwd0S9shANIjwqGIZqpkS0yo+UcbvLwhurb8vzctO/qv5ibF82VVAg3nEYkRGZ93xp7Q4Z5ITGR+efVnmrwD2rVXJv8OeKoSNHAsggZJYZaiYW7NmMW88ic+ThfFifzXFfVlFzIhTVHOOqXlcn7tsxTbDNGU/8cZ6UFQBd8z/ex78heHAxeL5t13Y85kfAtjFbQMiooFGMwccyw/XifqrM/8K/9EWnmjBGMBjc1BpgHYSdXEVmXYEnnDeOLgmF6yiVmKPgka86kjbWUZIXLsmwuzY+pxRjYCUePGIG9Yq57UxdO89x9fzMdC57mHNzd+YbgTF++sDck1mUTQvODoY2Wmy1+RnnZYTBlwpu+7as3wuMlC0nSsAlvTXunpPAW9ed2OuMHCjrbfc0kY4g6KUKdjYiKgXyZau5AlnEVTCGnzNZBFgLePGDiMcGH7oByNlKurXgixxeZrLEsqyH39aZlQm+Q/U2LQJx7gw51llP3dd2qPf4uG7CZIP+teFbGC8RG8ndQkvNGVwigkaQlaDfvM/1PoS1wapPQ1fSysCQG4OIjonLt35PXzjg9eEiwv5VAUT9Q1/tpsvZLJFj2e/8fSct++T20LD5duNZdoPnEaawqveosP/9wQMM43B9RMHKVGjmcUsrz9eSTupH9IYrFWlldvG+SqObyHKX2B373G94DMFKlvCPijL4amGZWHfoyUR/xURy4slDu8V0Uuqo4gyuIjhVrpeQ8JgAokUoK/YzzpcFZoecKD6APoLxpXVZNN35TkAMYzAUjaXwl2kdA6wN8D7lASJ68qV9g/1TW6FIErs9aYp85IcFUdzQgU6jTG6H9Ifslu0a/Nm2x2d4iPmwpJApnwgPyowFwu/D5RcVDaKrDotGYDjp/xtBgYtyHkR7AtvbrD6aCs+XB/EEMxSv26BEpHNX2NhkZAnXc2jJ1EW5o+GxbYmk4eSyGCksOQ2Dyi9Bb0RV3PotiAz8TzP1CiL2kt+PCFZS6GqX2hOOiayQbVEk1CifHRehDuJcu8DAowSGpfK+yfXFLE6QErjY5FMJcTAczBnY/mZ/3uA67Wt/JxUrXZerC1hKs1+2moL/eCR0XDo3jfCpUGL2CmvPzCmzar9qylwrDSO9CxiBrI2O/YMLBwLgd6or9YUDsnchnL69k+QeCBoL4NdAqEVLpi/JypibezGnblR9hH9vl76wrCBJIvMPE5fgIEzmZ0f2dHFCop9Xdq9gVSijkhlLLc9O/Kk1VTMFOROd9eWleVv3UK+nK7oPRxbu6uklX+vBh4MUxbyk3OAUwc5/run9ZlL3U2hFYZYqVAZ/AYJdcSxzkm40oyIExbFA2ww4lIJCvZf6bDfkJpT20fT/n+AORhiYVvcgT5hhnfD/WXYA6N4FCPsNYRkOU+1iTwMGIopw/sr7XxxlCI6LtsGMu3FBCQ9EvBtWy4OruWlGQFFUqhc17ETo/VDRTQkC2F+KuQ4ZIRxIKuFLbrMVYm2GkHcH2pTAf2jBGcNoCSLzLbPnb/cidaySOT3COhpjJCaE/afxcb1Mqv3YYtZkGzhGbgz4O7ODRBeLOmaA6h/U9GHhb9E3mWPXAucIdAYF/qw54gr58UbuOYob9Qzv9RKyXu+iDSh3x995Ka6Omchy8GOm1c+uQHYUD3LpA1F8TDyhVH8hqXXW/G9hYwBKLygng2FX/NTDH6RpZaeeqvAP0mN2/vg74eOD60Jn+qPx70r3rCqXazm98kxhQAAP3pl21pkdb1s8Duwo8l2AR608lgGb89bdi60/EICJIINRhGVCbHQX4as/+SB0h6K7f6QnwAVQKYL4D0dOrQMGG5q5wEdxdmTNO87UtQnyCCNEzhKkHQxL76nmD8dOWpu6f63wROa4wiNHbJggz4/TWMpAc241g/lmc/WNzjyDSrFtPv0NljvQrdFgTuOQ2P5JvEfBIsSkJd3iCRSbZwLlAqa0tzLU/iEHskNoBIBvj20ZRpoiVhFCUrTlrTbeYhLoKPhTO81uKBDN+xzCIG0lBvZs8VZo6S5GfalX8yBsnZIwB2sObj210lA0o9PiwYY/BpuoSpR398Pe1ZKfCRElHMKYzGkGBtnrIXophehbVhQvzX7dHDeIy9VTNv/Ubi8Hz7dbB/NGYwufC5lXurvQKPOsTEsMmH6xYQvzeVMUcSKG0tNF9r/PFi4YVV7au+61F+ABpTWf66chqxQuVXqN4fyTim6ogqDDNG82Me155UANHx5uqiKbz3PSvbcfh1RQgTtznkSXQDn5FCkuoL3fWRARZVHBE7Pd9a3DvDF8F7uv7VkBBi0AIAuwdWMe8Ka/Leurft/io3dlR795/fP3urXrx8tXhzz99lEgpbJORN/VZeb6mGB98dzwg+RlmsgyW8DcSqRika2dHvaJuA6Bgu64RG5+d9nVrJBStR+5giIuwgcE324wEG8IM01ovAT/1rOo9jG3d9O/q10IEn+j5MTiNqAMsK/D/EWg2xt+g3M5wghoIxFVLMGjFDaw+E02y1zp2BWsOs+3fqj2Bo8vgNu91R5ixBESy4IngeAOtQaQZoBUgquFZhW4Fc0kpn/sGGZ3UDptE+Sf7t7f7fq90RvVXjV3EGcDgoLJTQ5RneJgg3o2DhoGwrusOAj5QjAhAnMJITbZ4qavy71EYGMTHyXVNclfdK11VdmC02hMY6QQxR+OJ58XOl/PO9L/YnM6l6Vb4jXJdAOzCEvcNlbGXHuKqoBkw7peq0QU43RF8qW9FNOOB4Scz9WTmCiV6FAKwT6SekrMIFIJJogYCW5/M1J+DMYm4SOj3Bh46HnZVXhUuUQbZH51FsB8zK93qvmnvO51USM4lG1OHkkXyff/esmaoIpuFvGloySxZ0hG+0/GYjIQ/8JRlR0Qfs4FduuDYSndB8rbHAuDfFUPakToJcmzZ0TIP6y2IeHiommKXZ8ucQrFs4BWGnxl9g7nSgHn2ld6B6ZtE9IVxuiqOyxNCwBcpJHUOkiBglR8iqLpuw927MnmpK9R7zNTO8W/r3d3d3W37z+Mz+///Bf/XBYYS34Z/zuz/n/xv+P+//7Y+M2dnJzvns1HK66gAiGhlmzgz6oM5f3m9sss575p1C7EW4Be8Bie/9RMAZgTQji/210xNzicC94/oekQz4eVGXCCyUqOjf28nfdFUBfgy0J10lPwen+OgjdSJNGTKxLOcIDOrdQ9e7ZOZWjanZWXscPOm7iFuD9rOwujt9tet0ZMEgvQABMjXCzS8bno1vTF9Zkk+h+KF4FztugbrTyT49u1l2YfX//Hm5QiRiWxpJjP1EIHFuiDb1aKpgFvHpwk6IE5SRLlfmCXcIzT1kyS1hFxIAgtPFon/itJT3UbEqdFmhptWIOd6DCzvGApkqzWX6kCld6B69EgU9Iw0WK/ago8eQQXzwthnQyZ/oPrZh55hxN05PjEyJX6gVtpW+OULCi2Gu91c963+i7np6FIIF0BKJviiQ+N8m0Jzm5hSmvzJDK6CxJo5L6TuVQPCjrcg9OmEWOSr11EED6XdtPmGi6KLBuahlxqiKTbLlak73ZtXTUuCCgkN6eVPk92Ju9tC2pLoaN5cGnIVemuuezYuOAW4c5rjcM7wYe2y4e2fqrrjLEw4JjXglaZPjuXwokof+Hd86os0cXGf51F86qR04I6SFIw6kjZs5Gdoy4zN2T9xn7UIOYR1TWbq8SwqvrHHr5p2qWP/uLLuzblpvWacEuwq31l3t2iuEOPm14WpyWxInlhaULYnStFa4Dldjnf1c3vPXPdvTL2ejAzn17Izz5vVzfN1P0lXicrN5AoFx5FU2yj+bVCZI9QyTf19tW79Lgx2E0oNTm2G1Oei7Ow9GYaXjxQU6ezQ5yiuZyyOwQHyprEjGlBYqhHkIBtnwvPWiYZQ7pPajkWrz1+0zcrRPE6gXgWZdVU1V/bjq7KCGCppuo8r8H1V1hcfdG+px7f/thvSsIHCbTJTqSwoksNDMrYNxU6GGIbPRcn3Bs6tG93ddVw17cXHEhiHx7u7g08vTKVvEt/OKnso66OVrjvXWvgSSzSmC3gmgkGDX907i61Q2WraS10l+rKui+aFWfUL+xjd3U1sl88cMb3s+qa9mbsS9FoL9yDlenlp6t6N/8m3YaOX5CPnlvNx2HB2N1O71Nc/lueLyi7cT/C8wMHZIdw5J8vmEk+JfVrjFrjzHr/rzLwnOzXP6IfPcHgz3us9PCKjnPf6FLDGiGn48oVcccIF0Ou+AQTd4Ljt7Kg37168VC9evnr99jUIAA/fvlD/z88vP/zn67c/UJa/1M0VIgt1M3V6owAdUtdgbvfmNXDjDFkUiec46fPtTC3LpXmTyCKSPztZ0kvLFSrdngPWNooF7Q4yhdKdAy/7plOFWZm6MHVe2s5dLcp84aJBQ0Cl0xs1rcy5zm8ytTT2fVF2y05BKJeq0QUgw3wCYaSdo6XuMdQERSxtdKE0NDZX0/etOTOt7YSrSZXYndb8bV22ZgcxmUHm1c2zB7FMsaxhsJa4O8BKEE1KVAbYUwlZJEwnQPDZBUCMyqqaZGo07wFk9Yy6m1B+7z9VTzIX16Ewczmd6kAdtq2+8ejOiGyFTjWuqpl6QtcerLYTmDJ+FK7pcBpev3kpp8Hug1kAOOp2xrH964Rgan2VOzvqB7AF1bALFUJZa/UZZ3Q+ZwbrlkTXCtFZICyNhpkJo9UQ44PLHZaZh0MgO4V4KWXvJTxMAmXVDS52aoI6POeBZcVc2ISTASI8ZPMYpoDnyyCu924WSmRpuK2wAwillMAIcTIuD4TFNcCy7OFPZ25Cw8tbo3vz7vQTwyEF2MJiNISG5Hf10LomNds7fz3+7eq37ZOt33b4j63rZcWK/WjOh7hpYr2nE4hMnoMWZOd6WU2C2DcbOuJc4Gkm7PdbEceOvjJEL+XCM36b3PYI4GjzT3V9A+b8iPYo96fOc7OCSMxnJURdJzh3L11Xuua4YFBjcssTEJrc7iEStJBN0KqOTKBYW7g2znRueQMiGcMNhnB3lCtLrA7DU7v+gDxqZ1Xpsp7IlpqC4Fu4tmkKzJohzF5e96buQMl1z8NirkHVFRZOHBgvXG6blSprKDjAfbCJccu2QJZBKK+yjkFGaHwjZejr8eS/JmoLWmYqbVPht4A1kcnqADoY5pGBUrhhOqNuwH4+IcnDubneSJg4KuhTouK2iB2UtADhCYwyPEiPIszF45EBJKhEcNrelHW51JXTg8JFOXazT/G8JuSgfPgBwzXALmuNXmbqM/4x7y7K1cfmZQ3651vPySVv0Knc6jNmCPZlpAmKZsfRJVEyx/FbSkNx3dyx70BRh+UppgcwOJJAQPsYnk3wfn7LD5nA4BuqgkUO9DaKyQsC5vmOSgqTPGnxxkee6ll8Hu3PE7UXH3JKF/g+ebO6sXeS78IMT2qwPV7+x8eXb49ev3t7lORzXANDns/+TKieHbvlWCmbcZ/V+IMWXjT5PRt50eRfXbtTZyKphFFj0Oiy/7FpLrxH2qDoa8oiO3WWqc+uKKqyzsDH4oH0hol2kE8cbB2Oafsje9F4z21gB3ESAkeGe+CFBoCgQV9c0mcCfNxTxyd0iwel3PQ6NKixrv8A9WwewKo1heU54rGMzkRi+IlOOoxLjK5gW9mL2trD0rfhpodX49HHw48v1Y+Hb1/85B+LLpSxM/OwxPqqaS8sd3JV9guEnJ6rl9f2sQ+6fTQCqE3XG+JO8OVYGyRaRQO2LVBVvzCl3X61oSfnnPeOPa/wtg93j0yOiAtC3Yp9gWjYBwcoq3PMBiSH3IGvNsDe9clYl2A/aqqcNrFgA2q4wmRfJEQrfDgOg9LYL6WtsM4tqwnvtIzyX+pqnjd1rvvpsYc7rbkaqU0PgifVbpT+OPpoHuGcBunRpOrHM6WfRNdeFBuEqLJImXKxPScmTfO72jKxDFuOG0Ljpcwsrr2u/P7wHC+WITdC50AIxSnsam+58DNLavomuuIddPIdu4j8u2J4ZrmsZX3W0OUlEJnldmHkL5vxyxcoQA/6A5IUBPYDvLEgn9iriqGMXQWJZedGgtgqUMke/hO+PY4+Hr59cfjhhXr+7s2bw7cuphbheKCoxgW92q5M14GjEhh82akmfiSIl6VrrAQ1vTO1bLq+ukGexS7xhbk5LeuirM+dwRnjfMRn3aWy/qUyeX9YVXtD/bjUN0zRs3budCvTzMf0z5ciykMiKgGZ6KHsWATVSOiD2O5IhuS2DxgUN04nus4XTTvJwEZapC+MLiZjARGo/YuyqiLIT9nwWDzwzY7dd0TcqEwt3GSHsBfCYXZg0jII74/I9bbKMLw/QYSGyxAFm5A4ajHagnOiEdUByPBudhtHav1j1c5s72WlA0+UdKU+Vol0i79NwGyHS45r+g9d9LB7fowU6gNHuZtwK7F9ZzAI3gi+uB1PMPN+dMMx/UQBdf+vD0osAH7a0Ek0CS7+4X31uHn5ci5c/IfYBxRf8tvQO7syZz25Oi3nAgyZXBR3ySGxb1a3QxCFcKKoqt87LR8itNn/3nkBd6gNEyOULHY/vCgvA7C1LfV492vmLnGwuQNjs7euiyZxT9lkb4zemmQmm+wz2SKjFxFV6ec9qHtTseC7sI9vXjT5kWXlEmUiKJGRizas62UECLaxJnEniFowJEuiS1+HESVxuaG6CIaEYkTxCo7997lpy/Oy3lOTrWUD1jCnpe721ONoB4h+Hy3/cOfjnVkFdUcjkbBRX9fdxGr9/kl+WRf/2CneTnd6E2362un9I5SJgYP+CRQpgf8lZ2DDpfU/ZQL+4Oj+kUfoj94+q997IzOkaL5AFph5bY9G0xnd5ovpzm9HOx4W+n5n/X5gQMHE/rxKkGaAuZpuP55xTOqQECOk5EipVKH3+vzOhij4Q1DmzobiQnYdUgeBCv2ITdn1jkslCYgrliwFiEf3aA3yDUveo8VByV+btri7xaumLWSpH9pmvbq7uXObbVDu7uYG5Wwv724u7GVhwAQerbcT5QjiMLWAVDQOihOXTBa0Xb1fm4PuAgrVPdocFIRpvV+j0dRy4fs0GxVFL6PDdZ/iNqP4oeRwFBd+k+7woLAuirjoTwAse492OViqKN+Ztv+oT5P8a4w4+dug5FFzliotoWFWOjfsZuSiolpOtOx83zpg+9HRCj+zyyQl/rFIqSI2kYjEGeF0NRWAJK7rHinINHV/zBxsGXVM1IJDRfUA/H3Ut1Me1DY08C+u2MADKTHjHVbTxc9GDFEyOu0EWJCCFFXjO0pGWYL73sENTydum0zCrvStrrtV0xnwixnpDnvbBw/WZCzb0Z1Rm6sjgFQ9Fmr3r98KMg6Z3wzIjkQYdiyTyddtAsZuDKJOQmCSfE4izGHLtblCQSlVjY3kCxH9MFHXU4AejYGFNle4NahQbjP0T5MAaqIfakslvzy5EyJlZGhPMkYq3XK7ZhL1TtgncQ3qaeCSOJwC4b8ztnYwoMH6SR+ebDCmTdO0a2cn9LpEV81pJkIRjf0H7kFUE/wtQpfeDT8jZ9cWQZehoI5oBWzKpjl/kP4bjxwSM4+aByuYr9ssS0V5iaT14N14ZEQIvpB61OYKInjVBTrf/hH6gaL1BPEYCNKTgCimHiEWjASVqDmIXjjcMekNwg8LVF1EGK1bYK8crpAj2LC1B4JYFBATaHsYkdmvSyqusnDPiJalWZk6JSRPswRgSQOayUnGt8IoRiLXE+WYZgy8EOvN/vLyP98cvj9iDSs6/4VaLJdGBtH4e36quzJ3mq2J5bMne2riHzG238BG++QP5Pww+XmFifiEsyn2reTT4BcOdvKyLvwH+2OmJj82S+MT/cvSfsPXGn6lvynVt+F+URsvEN97T00kN27Lfa/zC2ASxEfnozk5WpRn/famPFi/vdn3nEel/eUK0yfP4Ppx2y7sqUl8jG3Z18Aw2K/RUrvSXW6/RlrACVt+7Oyot01vhL5ZX6I2+6ysi+3WVLo3hddr6tbU3/RoawV27FgHDQjCfVsOrzBgzlwUTY22XmSB3i/Mcq5+ri/AeJ9rxTrAmr5crqobhZFzAGuDdtkq50Aubqc979tq+xAGx+pUOyOQ/IJWgNRQLv3/tenrumj8vPv01mA6pPynTxHN+f3GsmVXwG1PlBS79OEWxx6O7nP47I+Re726ouIw+Sex/XpY9aKgOxH8SZRzR0g0Ge9e8b7zvZbnw7/h3PcjWA596Uu8sil2LwVt/cCpb811H62F+/a+NZfRt1e4KkAco08fxCfYCqK5Y3+y7BPOde7Ep78RpxTXjbeKPzRhgz/zDgky2IlOfUHbGl1V/aJt1ueLPTUByilP4i+mvVFIT1ujC3vYd8xS5902eOEqtjZglxL0P9F1oVswWHijc3FkoORNdF5eJYgwrv6QaEP6++TufZveunbw1IITnvCkfO/TgwYOE7sVD9T4Vv0lpt7BwvwS0/yAJARUHQ/1KLm2/eZyTk7iBhSdFy+DcTX/xX5iAwiX+hHIdfCiC0b3zn5n5sDtD1rVpU5QwmWRIoTLIkkHl0WSDHKyo4LLIkEEl8UYDVwWjtTF6SnSaDvnSKBPD0mYoH1+QUbJn60zJH9uoy2LmPqFe3MT6ZO3zVjeYGNsIpLLIqKRyyJBIpfFKIWUn5hAwvrz0RtSx8RXSSCXRZI+LoshecTkwcYPTQ7c8sppiPXvsvWNlJa/DwhtcLkObuPh3hoS4GOiwDM1QVo5OQkP27GDvgCnBJ2rZ4ljuDfgUdgqDZlq9eL10fvDj89/DCDA6qZdgp/LX8zNW700YBgtjclXugVrcps+71ZV2U93tqfPHv4p28nQhFYdYKZj+L94mp54805d9TOV9201UxB8Y6byZZHA3KH3WVxT9Fgjo3rXcBmYf+78dZoviy9L0+svy+xPOyX6MS2bAuRhRYSm50QQO3/V06rPnkUlNJC4kRLT/Isd1Ze8qfu2qQat9W01Xrib2qmIy2BwkkShAVbMz3Vr8ua8BgywZVOUZ6VpFXlGqS2bNHC/0lWf8aoBlbAZQ3cU22mfB7ZxItOyEHmWRSILDMRnwoMUZmPjWkq4la4ZZae0uqjWxTlAnl0Ys7I7fKlXHZtAsuG07lSrr9hHA+uYnur84kq3RQdAIbovT8H2OiMjVPfOWBrVl0ujuvVq1bS9OjO6X7eGqgFXWD4kCO5nXyXLddWX213fNhfG9srxRHP1uocGy8p0SmMlds3cQStoFDOoqF+Ymrzm0Ve2qQoaCEAAmjNLa7AaQDAMDW/l8cVXsXt7YyvyKOfN6iZlYX1hbmCRylpxIbt++HfsBECZs4Ex9tquMpY5pkzxsbRpXwTt+zItzBfdZ4CNw/6GvoHYkcuHWDg4UJP5fD6B4ExA3wct+9K4rbinF+YGnGP0ilsiqjZRE0vQInqYbVJE2Lo2qSEQA8ztdhdm2fZflEUBrJRE0ZmBPJ+asobO7QeiqmoYSzthTikrAv/k6e5MlSAo3lQxTG5KsiSkr3Y3HQerTIbZIGAVn6VNfRgL1Fb08AB9+QfU7XWdN3VXdr2pe3e8YBmYhgxUKiNbQZDAgW+c7WbGJdgVDvouHPqITGGu0AegapqL9eov5iYUUslkeSJnCk4+g2zmCOPinLrhBHvwKXsU/R3qvI31CrzM1TP3J1btattTNH7hJMrOx4Sc4kwhJnUDSqNJKisdMs4KVG+QUcQ7IXhOwtxz5TC5mJA7IQYOWM0FJQiVWe/Qx9a71jPqJg52UPbhgZocE9EEb4+TySD0pVsRtwyyjuGSbDr3UeFNJKA1Hb6RNrcP+IBjXSBTcKjJTSr+HDqcei8kZgjs9bRqTdeZThVN/U2PSk97a37TGl19E+QgLyKsY7Vu7dtQNWe0+SXnys4GZcdNDY5B/CkdPAe8fJBQebD5deie/4zSgMu1hLk7RsTWC3NjGzwZMBRQ3HIvgFXvUg6rPkwAviRMetMUk8Cv46emuVDrFVzQkKs5s8yJubGTaElTDuLJ0xula4QSFn7idOu8xRGGkmxKdPMCZe0tdITM02cJPdf1jT1kkIfHbfv7b392ycdok3HitomASbL9ONUd3Qc4h0FdJ46ft9kEgh3NCwOCYyFd9T+0erX4i7lJtWYLuox29R89wtYf0iLcxYZOz6pyZdfv+bJQz6hRy9Tbyvbot+VVoQOydlj0OznYZP1Un6uf2ovqXxaTu5jfh7SEfsGAHY4nAjff1zHJIYZziHEY+pVFoRv8WaJ35aWuTnx4Bua+X3149+bjy//4ePjh5WHktXvWNsuP5ro/bI0OvDEJ4nLGUNXcEUauPnB/PXOewy7rnmNJ2QiE2Umudy44HZhdYS0CIEEA78GZGfSH74BB5oNh3kTdK48fGlQv0uMWZJGDZIl9Dt93ZHrlcIcsq2/fe7YD4MvJRRX4debrzhSAzFKeqbJXC91xNb4K+5qoG4rhahAHXRSfe+BV6qwvOoiIA87GFH9LhbG0ohGLSkQJP3b15YsPf8LzcW76w75vy9N1byT6UhbyEr62oskBT2d+2hQcsIpCwfI56PSlmWbqc7hlUJN7zlDWHPUOgEg441nTLiWaXfhlpibd+nQJyNO2DTcDENR/VTWtPq2MWuj8AsAC9AVCAmAhilSAProGnRwU8Ftz6cvoYo0YfWmOoOQbKHhYNcOgiLZbcnthN+1VjkUBCaZdzrELIvxMezPQdyMWf5EqKA94bIeBsx1aFoRFfXdGc22uIOiZzBg4kOW6zxdTO0W3ESsUEJSzsi67BYG4j3oe6ksA2tCXRkAVnps+RfJkLDpaiH3fNdB5jxST5hNBprJ7q98Cju/7FpkI9Otum6U6NWV9rlpdq/6qzL0jXrwQnubo1tT926Yw89Ysm0vzfFFWBdm4hQEbplmqAkRcJRN7ezdNQn505PzArJ+d3fMQRahAQSG3FQ7UhOdvElrupPMn957bHkQ+6C0yPty6qc3Ec04QNcRfhd4Yvg5cmFPzj9Z0KMeH7DOfrTbX/VF5WpX1ubPKUCH2qfMBiFyNP7x++4P95+XhG765ERCAEeDUSredaTvACbksC9NxPNUzGWbCkixi9zG/WjatJWB5XtaWhSUeFp9gRwDEEjKy0ReJ3gLYYWwD6SChy26OJpocBhICxO7u+89YFBz87R/ii7ca5b++fFH/W2SodEcWne99Gz7xF7oXZGvOH4DTcZrluPxr1Cm8TFOFERJlpBE7vqcHcjD8TGSH6LtKc9hQ1zkuuTLmYrwoNUYWZ1xbZmdpXZPBxL6zyLruk0EeXSxS25HvEqMYvK83tL21FRocGS0bXVoKHgUrW6iDjYNJgKtBNSGiGMRHubAsAHyAHIFEXWZ49EhhV0AKCbIV/gHBb/cwwf4dhky6yNTnrS3u2r47rgviNfygf12Uldk8cj4KrroHQWBcSDa6p6LZ5yjcrd95SkTW9T2AsE/jUT03N75z/FsHgQV2T0hUu2mJIDBvXNN9uukgnsJ+DolG6kxB2TiacQKxT1bBkSnAyDu1w7DQUwhNIfsB6W7BQV8TLPmpzi8CP51alt8+UDX3G11TNp/EkKx9J0invHxHiJ00cxdjn4laZgF5nSVo6SxZexzfMEl+gzjPqU0bd3mbR+0o87M7BuFyhgOxJ3c3pD+oSo7DxQ0gDkebI+vLoJFtNwn/vH7DoZdx2gGnvAaBYbdempnKdWde152pu9K+2KJdhISSSoWkMnxg2GqK6CL3t0zUiHpm7+h53/zUXJn2ue7sM2zPpsno4EBf1qdd30anDxMd3Zhx//ieCZlO6NkUy0CYZ0ygMhEEBxTAqVEPveDbncCtg6ix/SEQhdTCCiYyFUKVbqBwdKBzcVRljncIdzeOpdvjPYQ3D4oqwA2AJUAiZl1U5I5hQr7j3ZPBOBmASF6NAQ3L1227KaDacJySmAAl5XjeZWFelW3Xx74j9QwBiQQTHZ6gLUspH4g3rEfnqU07FTHxz8paV5XNEVUBxDYlJf/48j8+vjn88JeXHxxWD8CtFojGtdTthX2hgXSlM/33TXNh0+hd383VobLf3+j2wrSgs+ZIXXVRmQDUh0EGIU6UalqCHIU2TOExl8raC+Cd4EP9VNYehXDRVIXSbatvOojBfGEKQEZHnaku67I+xwoAb2Gm+maG7bS3rF1eNWXdAyhqY3n9BX3nNmYSDBUE3A5AFXJrzl92oDsAmTcBRoHzhHqzrvpyVRlWpNemU0t9gw3zOwX071QTxGUre9XZoSidt03XYbm5D7iHH6/KqsJoaCAu8tBndrg7fSMhHDncG2GnYVusHe7UqblpCBcLtu6OqQtl36ILwwOBIh205wZz0cH9zuPA4fULc8NHprpRfbPOF+79VLt98rrAdwZ9EBsoeFYF6TLS5Qwku8FzCtv3DlN4leBrpXdwoRwOU4FITaSVtkdbW7KL+4nQiL5HDkbzOexmeHLCB7tUPpvQ4eG2T4hCHIdjrldVmZd9dQOVmmIYx8yFMcZQ/MsZHNN36HluCeFDDivrBbqYI8PVfceBYr1DBEYs7ChMIUfUhO5Osg3soz2+0yGjmKXic2JtFI1sjmcS/+6bwEYHLhKIFI5cxlJfB9FKU+pIvwGcJnJrqwx7Tu5uPqswmiK3TcKqeuPIyasGgxLPBYUJArOyKRBMPIYubapKrzpcunS84Cn5VQI4a+SHiNXFKFu2b/O+8cGdcVJkdfvDAiA280VgTlNFZEQ4OVKQIy2bS+MnJDEbtqlgNnzbB16WHc4NTJet6nX3Y1kUpnahfHGOQNuUL/0TG+2DfAjdKXp6iRi8IuzRwOQL1yfRhdEg1793k11CbDF1QH+AB1Ow47IZOW5VYKRpq5li3mAObZ6nEuBjqa9/ciUiEWqUx7W+vyETVqQATm1TPgrSElnjBRJmYMPKWmouUhOeBZEgZ3Y/4uH2PpuDGY6EVAPyGHTLUVHdN8sydz0AOqnr/mVR9hGHJT+FEXWUO4mteb4w+UWAyOfjI8tNFtI9iNmNNwL1djJTNlGQD0mcTV2MkGZkJEGaykwt/MALZRpCr75iiEvHTdFNzswKcAKCrfoAF0ynNHFKZGnXODbp9MZ7FR2VhXEQjS687LnplQb47PKszFVXFsQlbG+rXTU9bfpFNlPbj+2+Pusz0OM9VlPQBWVz9atlT+yyvzv9BAEzIKSK41fsI5rZPrwNwQ8KuDylka5z6IZWg/KvX2j+RPaHENlKTZkDXZF6oWoasJ8kywbgxaBC0DFko1c5MK7yeVgWZsZjCBBjy8IM6CByJQdqckrM9CRTmFE9FtZOxLf+j732EpeMp0t4ASgORGqX9hl2Zi+6Bl018R1GU7f92FtXtM0yvrWS96MQBzVf0QvHisR98F3omxTEveifXWXCeOMV3LPHKdRdAKHovLcfns5vLF9f2OOUczA1eHat29aySnanVvqmWZM5bLdo1lVhz2Jr8ma5Wveo5U5zn46SD/hPDxjh2brtx+ReO+MuHVBk9ZgDFYYDtpIvX+y9GjKudzg0i33KsBPEK3mOxeFR7AfXrbmyAyrr4pfSXFEMPc9qBdvVZg5uTks9bf43RnfrFhSBELzYbn/IHFyMGJO+42vAX4zuG8Srx6ChyftSVIP8zBt3YcvMMJMBc4QLELFHlLgA9ie180G2XxXIH6mDsIAfWljPQSRjgeDtURXEceGPTG37VsLDQ4lSYTnCxwGxoR5scXsRfyohxpMbHEMX0IQm4zbDzA6ZjIBRiMBcANJLfJVPK2e6MF/qm1ODi8VPZcBlRrF6MgPRUyJwXnbKmX+uF3F9o9/sozfLELlAUOnbmKmCDO4QAUVKziSGct00k6LSbgWSLx6s/0J7debYu3/49E9Hp37ky4aJcqT5uXshLIXUQ63r8m9ro8qim1keqmkLy2dAIHowf+kbTGNHBbP08cYAqJ6wqrGeyvJUvWmXIKhSulbNujctNYl1sNTmRjWXpq30ynKC5gYkSLXp+pk6XfcQH3ml274EsR/lzOabJC5OxOdEWJbGt0aVPTBC0lKHVhIlYc7UiIWCKIrxAjYXL0dKZ+x1twBunSd06iVbF6ZwvGiXgSMt2UqrDjEkDARbQ48WbpfqAxkYGII3a5KnlZ3S59o+LZo6BxhxLsytZAM7MLsD+RnYLaTYRYUNbh6uM2x7CeAT6srAcCyTWquGWXvfOu5oeIfKze3adkWmLOnh3mR3dcQLUlh4VpsrcdaFCG2mivLsDK6x1ZRrlEHK2UYxMlqcua2D0nY3+hdkYF3X9vEAyOBu4dc1AL7DzWu5/pfwteywDj8ztkdP1a49ydi7A7WLegGgVFF5L/J/EMn0bW4RlwFLk+dl8WspX9KwS5sregxo+x4ivqdcrqrSdMqLDujq47D3aKzoogpRH13u8Hanz1jDW8TfN1U/nVgGdDJTx4lenszUxEtFt7HsJNtPGdDh6XnTrDsD4TS7bNjkvAvMD/PlNuI8bMOjCLxObZfDFrgBtOWB6MeJmv3XMKBtvAhCLBCAeDX1WVVCdGBHhxFcxu14Yg9jqX4m7SyVz2k5o77BP1FNtKkBypmoPhM63oGfjh2z3Tl5dHcMKfOKyL25Ljso0tRinjt95XrFIjg5ifEs6qL42PyIwVO5g7ookDd1X3BoiTeJfYgTKC6TlsntTEGMa1PN1Fv9VhKTfN0yT+DXAV4DTKACthY7bT+VIA/B0m6OEccnyV1Iqd3gOG2Q29lvQvCGVR4cJMRasWRxnBNPtc8T8VDMRDbG2+661dVFEUlSAedNiUSiqXeCYkk8NOy46Ip6hn/nC7WHQvTfVR+v0zP7l6vL2/5sbVHm/QcSU4k0Xfx4oRCsHarGDGnKcsNiqY6kyA0wSZbsdiZv6gLESxuohttXYi/ed2cFjyv/qrpzCW+z/cERhLvoXQ3YQJlq3BKqySmYOyIGFUIHhY/f4DYDHW6ieg7hLUJ+6qsPlDgkEZ6z4MDLRePg+OA6jWIye6w+t0fgMNs+MfnI7k/D6cuIMk3kIBlt+o6QrwB7L9/UOcV+wZAzYqjB8c3u/8AeDEEYBEgpdXp3DXU2bi1114FL05cvPNS+7Cv5m4Ia3QSJpi7ipLzrsjSGm+sUiAHdSSX3u6HaqRzomuQdAitxl5xbjcm2D4vCSbaXrBgdCMY8K8Y+Nkc/Hn54+SJhfXCoOvlaIHX3klTpgzcDuJtzSGfLqoEcBwNBa7U0vd7GirZJnE1ABZW9L1yt6PQs9eMukhCy/mMa6sRXYd1IL/1VWy51exM8npfuSUp/CT0H5VcHXHJcBMydlfLf4KQB4CfqC+gpnVJrx8NAShSn/iN12fdQ6IyKvDcMWn63IxcqEjWulvbykA1DvpfMP7DvxMVDeWqYO+HG9jVPzYFvWexRFjqT0YE6iH0h/R48vt+L/sTtZb9vj3cFyokTFXPT/mmAmfD4vmjyjloSRh3hJc3ixWFNXpaZV01tbNI0QILk/QFynnBkHIHIDTOL0vomi0e90ROaEC8vTJHajv4+xjx2S5bde9LlyfOgxMxWXc/EIwuZK7aDM1eDnTokN47cuj1mdyKWI4mYnHaqG5Fna8jRTWleEIsWYyAhf4BTxp9lkJUN3KYnjcJ5aEnkaZ9FvEGXIWBi3GW+apyX5X1I4xBAhym2pxcz0oPQ3RgauECZV6jXkpOwaro5baXlxybxUaiVkL1ZTaEeyJ/FMvtufWqHSr3wW9cX4eEzhYl/z4U8SvBcwbGgViSYN6b4y0LKTwIH+2CBUFgcLtE/bXXo9j9wYhIecdHkJ/v73pCFicwwm6Q5dhfS4YVJKbJ9d9REtz9htz+5bg9uoE9D3AOaTjEEdy99igBDWHaOXZn5osCAsQ5UGvb6HG61Qr3NYNFJTP9pe3sWYGTfppc3MAxVR+8PMX6xW/Xhm9VdGgk2J9pMqGXEgwQqXVKL27OD+lXHJULsEohA37b6RkHcfE1RYzUqr8F0F00zjTqHWKDeEE96iYcKbnp4svRIqOzRjjS1aeHTuK6ddOmQK4LkAvUyT8aBa9UF9de1UEXg2D+A7RWPEqaL52FGBUFayS5Ntp26YdvRlg01zVmvpleGgC/si86w4awdIppNXpX9olmT4WkWTNzABKwTdl/xAW9nm6crmhE7S+rhAdU1bUF10wZqGs4WXoD0lMAHQ1HwJPUNWX0EI0jJXWTvE3Zvg6RngyQXRNdWdZKpPYV/Eavp11soJoXyjeMhcwxTCKJenTdt2S+WaBegi0/rru8cm4Z7HvX4IzbSHxemM8K5MV/3icNj68aTguXzhW513pvWGQ3JDSbLt2aJttUqX6zri05NG9JWRdsQPLwpe7ShxBySR2hTFTM0Tn2+mKmyQ4GqPJNNVcQncqbqK9xmTVXceSabqoALxNEjsUD7sQcY9YtzBbaMdoeylKPOq3VXXgLYonomMn93wMNRezKZU0MzGtnkly9hm1yNl4QOjYfAc+8hTxsYYMjtJwT28R1l6qIDSEweqiXBGwYKiJE80r5RTwfjtImDUSo1ra+gwvoqONxp6aefgZno4TPslmsnjicSBqu+YgKR2nZQIe46Uxf/7XvuH7AKMIxgDSAl2Ge+mXiTYemv22L33GH/sPMUjJBOUzjGr95lsmvh7oL6t2lz3F94rhzlFysY71uu9177NwxwTqSfjR3J5AsxmcyV6kyvwA9YyM3sRUJEvrk0rfPUsPcHmifYFKq4b5oLBWrHOXEfHVZD4bfrvmxNdQPcAtmRYskZGKCBxpdKqFNTNfU5ede4m0o6u+Adt1qBL1CtTpt+AVaQHasGuG5dF/Yis+lnVA004ZVrgw4Js9bo9mOIe2CAwMOpqY1Di7HH3eh84eZoqs9AsC+qlkSl61vT5wugKu8uTUtCVxQ1LGSQQHj4YQVnYKg1cGkjey3wEAMsDOAYRFVzoWx69MgFf0nnkKxKUP9Peqx6EiOPVs7fh1WjBpr7/uiRekgNRcN8EJCF5wtwPPcdzxdEkH06qJ48fSY7BVFI9A5eSrtOC/WD6b2GCXfbN11f5heqWfffhFvOG93S7CeZlFcoBBlyKn6CKx0Xd5eNnY/hhUOTsrOj3prrfqaWprUncdF0RvVXDVwOftr00pBCg0ftI6eDnepMYQhQkiKJXBwXRm2pqavnmbi/d4XVRxR6COzKr9V6peahZ1lzhjO2QUAG39N3ZXBbQr4gwoy07D0Y2jeGDklDA2aYbnFNDSBXHrK3ErfBkxEHfcee0JzJ/OzANCT27ssWLcimFzdpJfXIlMNtVNa4t6ZNq+Bd2C+MTe0b2rMl+iUDneOublgWW9k9VsVmG10UZ3TKCVsHg9EmPYK+YhlJ8HifdYwAmnyjg065jrk1xXaAe2ASEL5Bg1ZvNyHO+oa3Ui3//nZTG2dnR73RF0axxVlRFmB+RfZ99Y36u2mbbTr6fJkHh5zbBVUIWFQB3aLP+8H+tLQd/n1IBzZjiheXht0sKH5troQVJpQV2KwP/YwEB6Cq1Lle4T19tWgqs21voG0/Dqz8HGBjEyRxWz2Z2a9vpI4Nm7RlnqJZGY5kY7ysIRWLlpTJlyRWwX6b+m7AcoufG5hVVy1zregUGVpipDp8rldRP/0KYEu+A66iOAst4pA9dfkkm0oCK3Hf6taQBaDl4VACBda0OrIBxApQ7QKXiq66kNOK9xdJ6cYFzH9QVif9WuweCV/lRO84/Z52iYo6xSbTUhZ7K86C6HmCTxQywy4pSlrXO63ZLhoU02CYSei55YbY+EM9b5an7LKNFRBSb3NGLwuWqTpbNfY87/HVQO+N+KEB2U3BnmbUoJp2DW4KwKgGwRI4tbDsjrHgnTgJ+n/aAug1OpoTMxeJkyzD9K5CZivFeRPfi5dLMuO+4EuBnwcVwz14+5D79eJcrkUSOE7z9r1VQfQxtX03ChiaqngOgTBZxEC1Y6JrKtrXVMpuWpdd3pqwkHtJtYcvIDr1aXiN86ly2QNdh0Tcx7ovcKRhvRfZ4KLGPMcXJ0KGLlkCh2bgj4WD4sPqN16ogstLzgxOczCwDY93WNjoXPaN+iavytU3YKrOVl0cBBZM/ZcaQzmk3pkohGe7LzBY7UJVvdztXq/vqcYftZebByAbSY4yFpBv4C6XqOCMi/iLLkZ5aS+cKRzKopZDPxunALd/sNpM7qWpKDRdJi7fpdSI3gaadzjETq+ZfLxzoJjjlPvfydfZ8ISq0ItIDWp52uUFqqd3N+orZRyZwZmFTrsYMrFW0j60V3MwgUb1tvoOTfLxA45uCe9uwOiJg1U4vu89z8unmXp8MlMFceVhPaRBL+BhJdseOGUWJP6DzjxcXkSCQ3CogNrC4K7QDVxnWiBu3C4RduA2bgpkqcOGUAQLLfXNne0sYSS2FTsm2QYuD/IDc71aVTdTSJq5ikTmT4ADxA2I2EDjpAgqk8ToOfllNK0qyo69NEinCirGof4MNfyeKe0CEsFkP6U224+YmtDk5X5c2wNxOXn6IHzUJJhFQpXHZzRQBqBKLh7STAVc5T+l3yllYLLfA/7uR0Au7RBFCW4Mz6ahKFf6GHiDdS/1BBi2TmmMs1Pp9ty0qolW21z3rbYHaepU46EV50BOv/1Y7dl5uB1UA8fk7npYoeHq4fcEIxOQ+76AYaIBn4GMLDluKSkuOx7tFNnMdaeg9YJjDvUL02Zz9UpXlYM14klbrjRAsZaFh1KiilGobq51DmhHDCHQDi5wrMU47w62lNEzdSpPUmXqF+iPpUMvyW11Grr6PxBYIVDk4YFASqNEEX8MsQg12TTN1CkmnAZGTow78Hy5IipsyxGZPOU/AUrV7xOdqW3x81TKEbEu161tSvCt9U3UliWVp/iHbwc3km8If8uWoB7hpe8aoZTTeVmobaXnZcCcAVyGZcmuFgagIwhQAl0WWH8FTnzRkZqr16yNaGbe7tOpN1Cpr5G4RntBuPgc9kdlYZj8SDBLJKxACgZuQY8eqSFAA6JxCjsWyah1q8g0Y4Tf6FZAe4Zv4nnKEwaV16Crw8fxnv2LRPH0dJYMGIrqgIkYORCQwRJix9p/p3YzKZxBQaHLkBBPuIm43TTttud8k3nEy7GlQQPTjTW+rIv710dek97aynR+E9r/0bu7G2w83FVO+UWiEySBnZoCSeo8mpvdxcZOKXsLAxwLxm8BrSFtz50ddbT2lwi4LTe90lXVXCGshBj3Ric6RGxIuegJOodajEDNhOXka/z+u39s299ny2NTyW3/MLHvh5yuFGD7AhF3PiStARTakKyKI7A9vJVltZKGsuIB34Qh/RzWGFzQAZAb9fQped5iE98dIC/MX78Lvj49SD0DZG2cX5CTIUs9wmWEg8P5gt7tpT/ZvgxcQuWQ/lgn/Lv7u7gT4lNAugaAqtLa8pCxw1Dz3PFFpDtVtPqqZv+5Lm+NqefqVVMVAKF7xhZY5lovVxA9S9cq1+vOCK+Z5rzMqXKAvPd6d2ZasBLRiTlGobTbOCAnqJyXvWUUG6wCTTB5GCsqM13b/MAkaYjK4i0R+s5UZ6FQb+DFKYQbpj1nyRrhcmMSuEGMkfhMYNzZxzOUiJBu0IlKXiScMOBIhVFBNLUAd8rCqGiiiF2wvcJYWWD0156D8R6gYgVWdHgL6NaAWeearBK6kYl6To0WIzOG9LW718S5m8wTycTEJeZNobAIJSwekZPlK+LFcxvPc/DWYd09tErcPzuMfvUu5NeD24wjM/i2EffQvS6rbGaLh3B/YowsO7PM0GXpxUZQdrDR3jbTS4Ikuf26WajNdR9MBRitOCQSMRcjI39ZF8nB8wDeqqcqcvNIjOWuqZIndwhyJP1wh3Xfe8N+1XYNZx/PzRYKVLzABI2sovdB2SnE4EFc4s5HFMRkj97CBkfGkUI93Lb0zADViq4xghXyiQ2iArvFvEGlGptiAbeHxMRNyUzVTU0oEcPXx8ik/75Hxz/ptXE/toveJaFhQQK1PHy/eD+yO2vcRQXfPMkM0GzwVL4GJG43n/YFc/fVP7Isibq8tfpGqxgyJw1tTcPtH0WiGGnX1AUpCQYWGfxpCAPL3OVApRkai8a8lhgN1CsU+BGiS8I3NNpp91NCwLZLKB/u8eJ9mNpJtE+G6mH59oWHsbR7xYSHwio2GxZJ7r2B/a2c0qiSOzfpZr70p9dvX6pfX7/44eVH9tAGyzEcPDiaqNOqyS/YhrJThLVhCqVPm0tjSdipqZorJ1smz2pbz6/sMCp8qoP0EPUbY0dFIRYDmCK3MZoV2ES5DxLDJoro3az6TDoQHzer/sQ7scLPO+DDa/RLrcnRNfav9kPKQq9f9PE4ytumqn5dmPrQztgvZVeeVh7HEDGa5HARK++wFxzyd2o6ZfgDxOBlyEKo/GOzylB5A1BqPlHgxnxssCPvmw7aRlsTaNtdiX4k9/EGH0Es7yQiKZ0jwouTX+x6JzGrISZrcJYeXt1DSH8VkQNwMwYfJIo0ldksKesMuJSuvFGG7HegZoW4kUm4RIE3NwIz8kb3i/lSX093Q0TEbaoxE50BhM3NqJr33FrbixBtcQgfUTczNQlBp269x3x6Q2wGG22q4kdeaGx+ltgm8XYQxy2BVglIlSg6H847gVSKxcQTJXfM/bAp+TDcexnuAQ/6NURgfwCG6WhJEp3z84PQoX4AGErUP4EO6g6vxx8N4V49zCubXWMyYjODgYIPWso4skAqo6gC6MSKACgHasJeaHuqNZXuy0uzP9kfQAPMgff9Yd33PgytCqraOgCUkvOy3q7MWb+nIK6wQIM6h8LdHK00fy0LMI+erK6T7dXNj7hG461d2Sr2VNQMBvJs53lVmjrdjIhQ2Zr6sC4Oi2IqcasQo3aGWHFFeTmZqWMxoSdMrUWPstRTO4Z7FXXIiI04Ix7V9Ta6tQp/7PFm5tDtyRs6gIaozZWKS4elou0X7zsEBhssipxxXZXn9a+OPvvDhoTJv02520zfRu1g/BCcopt/sqxDXAjHJ1liA6Gf1GHv+XYqgdKRK2YQhOU5Z6BLCa+IkiGBpRVAeH9ELWbZTLlU1wJlIjorpTgiqsXoIz20I9GCZqkDlWRQAsZj/8FXgQKniFtkrCG7kI3zM8EJSGE8343jPOSYQ/dkbGI/ZqNfHH48VEcfP/z8/OPPH15Kbprw7dmBF6IbAdQVUkA0INN0D6Dm2gU4WpTni8qOBXTU9VkDOLEKwrl2KKd0eLDkPBIx23Eg85kKnnam68ul7ml1Avd+m5sCMeMEDA0rEGhHWo+l7vGwDfUsSqBbfI9kMwnuOmNeRHAhyDiOxCwmrhLZMgH/S65wCwPSAVP3Ss5J25HqDCXkh+u+Weq+zL0mrqwvdVXazdOpXIMtqV2UdqmRdNaF6tsSpfCt2eZxesmlrfmbjk5QILrxB0XEP7nXYrl3dbBYzrYPttoherpFCQGPJQrYzeUyw05LZkScZMdsiLTAODFtYTS2pXDwwy0F0o+u//G+mwrp0h5bUIGTrCv+8EBSoFFsQFcgcOt/AQNiYaEzvmaGSPWtMbAVSu/fPzB3r39eDTQg0KUE5seGGQyvblD75UCXn1e660wHYRFnqln3q3XAMCLUFDyk9veHaP1QnIJrUVi/nemzvb9++a3bysBNY3qq84tzsPLezp5NfzvaynayAcw91JOp09boCxfkzsftosh69CaCzBgZMFNbMkP0VW35/D7uX6A2XbUNy4Ew2+MT9UxNTs/h10TtIWoe/goMqWGqjm35k6GzR/hVVP/kZAAb+BD8PT6Y85fXq+mEZi+bBJ1/cmKZxemzvT/BtyzD0LOymWykdcuSqri2BFPI8dEi6CVdVd9Xur6AXbgEFg0Ig9wkNnl+yrk8cHaQPMVi0pIWwYJr074BSWwc5gw+hTeVyx10xdcJ3+cj/Ul9pBK+nnD4rdHFx+bC1K691ugltRtFbExJGx7vRqhGrpMZFj7ePbnPCKHXIUYFvpNgND30MOhbhC6Bsbsp1jD8IDMj561wU5kwYkAEcGy7BNsIWqz10tgdqc50WRFnUlzqOjdc/ySEOOnLquxvYI7OTQ9TeojxJElNBEmdnPteX2Aq8G0YFbU1edmZmdLdIfA0PPUC4efd6adp3qxuBpFsP8OQ94IJACG3S7JtRJ7+GNnT5aCohtMBwJk9PXu0Kl++JBF3YV32AMwMwuGubo5sCmCnBQdrD/+4DRCfQbQBQkeU3s0wuD2+jLi4W0REMAtA7Xw85zEdnQuvQj3B79BJ8n+mhSDBHK1G5DoDwe8BnE7EjZ865mOmBHAzR/mdKdjCQpXkFhg/iJiOpAXkHCAH9/v7O+hfvshQTo8fTFNNxWNFbgDy58DSDuaUDtfG0x+csKi75FyHmxE0LqlnOI/gGQ9yj/dvcHY+rGsJa9UUljNc6bYzLRhquTeBpdWW/z/zEAZQcehFsq6BukAUMmQd/d6bqTNxxRqwJ2xzA4HI5Tv+rNJ9b2o2UwaSINOE8WeQk1nAqLzYEcNaCHX8iFZrd4a/cIVC0d/49tuw88SpkZeOyKuLwk4Z8jqPHqlj26pwXEWe+kBNJlmKtRq/QoO5zoLtPbJ1B9RcdHOpr3/kZ+AwRmA05UGYO47b6Zd61Ta56ToXSQnnj/aI74BEDvS9wufFIMpyN1izhOs0Z0rM473u4tSUhnHCoijLZB4DeM0H7k6GCy7yP7JZMtfByRIEiVP8/Yxq2CJeC1P3MDXZjYfBgnz54nf1wwMsLvtIu8Kdg++ia1z64InD4kRF4Z3ncmypb3d3d3cDv/Yz14o/aQl/OSWPoThDPscdZPb2wcaB2d0l/cB/NacXZa86Y5b0bD5bdwb/qiEOjz2FdVOYTlVNfc4RAL/9X3/+8589upn0GMe9Gs8RMBqjM3Q29d/lxIhJD0YYm5Bo2hpoOjZ1RmQwS+DUAOYfeLmfm5rCv7hQipayO+mCpeeQhva56vRGrXTZAiSHqX0w7c5HscbWkavpMhGeiKMiOkkS3Dl0MTV1KJoIbhRXIoi75m+UwQ2CAN9CNKV0daVvutACxplsFabuy7Mb8kTmGuwE7aCd8g2Z3JVgU3gKYfubGgiaMrq7CSaMQv/gdWHZinyJ3D8wUT+Y+iQgIepAfb51cDLPBdqSbUfACsFwONCdu2FDtifi9IScGYyzo1Pf9chGiG+0r8bvaI8kY7kGnp4ZaZfk3puHb5YG3yyN+k65CeHiQmHaRD7ImAOvy7DQcXOCRhmPZ0r3Pojr6PxQQZogjP+5cX4EipCl3J5E+fBLYD35Tac0bmQ4FBRskTh5gXaIXkF2H2FwdsBILPuYBuseUceGjs/lf5HZTYiWQtcOfARMttC1ueudtnlmJ4uGelxuPbYzaIuFaCf2Rf9EpuiA1EP5uNhtcJU9pJkMsadJrkHL0Kz039YmQpx1XaWLpFTbyr+jZmqSL7d5T7hrMOo9IeyoLTmIBIQKypwo83eq5D/t6GOcF+IRce4h29bjk/1omt0HdQAXjn2ErVu6sveAe9tKj2AE9oXvuxTPQa82//S05ME+7GYqx4x7stScBE6WEZDJTvZE4KNMlTAOy+1QYEEPOricOkGMUXL5qm3qvgyhbh9K+S01Tz+PdwGZNaaQkdfE+FNRGm0EUjdCmTgYvTtiG7C7ON3gQT2ktO5Nne3LOOeBhBt+RJ9Jpo3dpd/y2UcfaEWlKNxfH2GegfRvUCRdi2SafcwTXk91cMBygfmZW+MoYWtrxOpaDMypquzUXqEQ/Kxpl/aK85zB9jY+EOw9i4CCZUdGx8xnYP/4njtd94w9jMgv8iLyoCXefN4ZzAKQEZLq6kZdomIvYD/ueKpoUH1KS3gUm4hN8ocekAT1O8brMrk7BIO93fSjcfyFeJ834f2kFPfgxoV/+KVpe79MIub26Q0zp1NTgokyKlQRihtmjF1mwQCEwpspQCxrWSOZoSrz+dERJpCbScnaMgpAbm9kXXUNhOj2UNXbV2XBNbFSE359bODAQABfYNxmw/Rfy37xBrcAMnZuI5V1b9pVS9JJoKD4uksaGz50sr6dv/7W/eufdlAijzfeCDhETh1LChaebejr3vBboGuGio8hz4l3eJoGyfRK46CC053fjrZ2zvHO/tOjSRbKnOhNtTDqxbs3qjUYsqvXMpo8bGGpCLVLdbouq6JjIqC0+gYIw1KvvuHXhueuuH58tgHz5Zpi320OKE+ANogMCqcRdGf0ciFHGjSRQR/wwpBL48eF8RtYRADSZd0FXcB4vLavM0/JAsXtabPuB3sQO35lWoRD4q5QB9z+gplhLx1T9+66+0UEpaburnRRlPX5dssUOCcndQYptS81JKXfnIIulWeXa/ERZ5uaH89hqNpzQ0OpzKWp1ClFF+cKWpP3uj6344NHZ6+mZU0TbJ4vdCteUqwlD8NYepiymbrCHjxTk2Boe2r+eHU94bhy/qjAZMG9/HnVmj2sd9WayUwdU2tRREw7lEk2477s8R9J7N68qfbULgiz4d98uafyZTJr3+qyshfBSudmDwVnyYyWNe4hV7enpiXQBhx2Rra456Z/B0d/OpEhCyfZrUeAsJuBLb2IRvGKvO5Nay9VD+I7dDtb6gsDAXztRpaOfGMqqgNkQaDZ1nQ9MZruNzNggA06jNBBeoQyLnZsnwaPTyjEPiSzL0vhkX1olemi3I2TwXzHvtsOMAl+7HuZEE4mof9imN2uWRq10PlFh7iYToixjWIi3uB4vzDW/syeh8LoCmUPp21z1ZlW/W1dthfdXLBdC919r4vvy6L8YPK+S1jnwVpP2bzBrrj9k3hg//LbOERqYhpnotnL4nlaAvTh8YlkscFnm/lDZ8jEi0FsPffdXPemrXVFUfALdmwJLa6VIpBvQcNwUakjs9HnR6I3WaBASbDBn4N36SADP5nka5onhF9TB+pTU9YsQB6tYjYo+OWLZcz27+iBe52l+uCfbnf1wuWcJQqHPaFnpZRx6F5VRnfArpWWXsNVBp6OeJGCgMjfjHI7iw0kAIR3h9vUZgBh1C7YEHIykdi5Xq0A07isiunfTduAeetLvKpSZyRjQRVCDkA8EoTNWCHTR6wSXdYcG4NlNpLuSck+dl5snJig0kkRo9ofz5s7NnJUWzFNNeCNQYdfpCOs6IXEr0/3Y6RS+iar/Xw7AJV3IdiN3Rfd2qj/35N/333srcLplgoIO0K6xittk2Gdxbzv/HaaL7d7ffrbKXHBACzs4mBm2Heb9re1aW8wvGTTMpBsmDqdzLG2SYpecj98kM0D4GB7fbp91erVtqX9k0AGgxH/MF4lXgKWRFG4yuh2chdSK61KRKoY1YP7nfdk4U0nPUCswVz7iYhbZ3pd9UeWPdaVZcfeW65+0djs03whX7w9XTCSN5v8tn6y++TJBPl/EpDnC46DqEhzjHFLD9Tkt9/WYNC+mNtMlu067Ke72bxv8Jk8ffz/DwqG0cV1W+rtSp+aajKTNYcWsz1f7/wK+d6OHrmZ5DuEAkAh1evxhgTyURQK2F3v/syPkLn6qC/AKrOVyqMO51HoiVRnVtqyW9XNkIvH57a79VjkAG9FH9oVhKL0Fwx3pvIufEQCKPvA8InI5Ue01eSdIvhLANtxv0g44XaUZFgztQfdE469OFJxtFm81/nN1M3Uct31lkMNlcXkmQsZ8azDEDzt8Ae1UltJTXD4YHD+IQhXbccMwRzFHKT4nZCEgk5OMpNbsmH3Jgg4jhI8NkvzX5em7ezKfqf+PZOjlkbfQd2JUUU3w8YhvqCfr1p9vkRjIhUxwCTwAcuRAJwV590Szddg4Ci0jU6+BY6/mNFcmxwXKMzTXZSrFbhmLdUztSRryW3oxp4KwbODBsD+AAsP9R/99T0XlKw10aQLVovrHOC6p1cpxXdI6nbcX/cnYWWwPqlyfTA7X7fJqNszNVYJnQLKl8pBO2qQI9LYLCPjWDTx8gUZskDYK4BpoSXd/SSxVii+DMlAJNuc2VzoqXTgCmwHY/sXTt9P7oW71qmzJOqob6fcUDbj23wSLl9vd01wp7RNZS/xibwRJneUsVWb6x7uv36yacW4QwMVVTi17QSCdbvf9WTsWNw1FUGdz+B+/vNuAYop++eTP6eu6nsP19a+abiPN6ri/CgSm6VLsyCDFr+qe/+9Rz8xKQ9+D3l4HBMG8Zm1MSFka/IOt0RVEE/BgIWU+jEg7v3bEw+5wCLqMNx8EGee77svXyRzQmZ+66oKTHyQRx0ErkNTGJF960A0GcUfS2Xm5BDQbMi1CtGfK49MlWwEWcuIg4V/I9yLTc9YKB1YbW7KLRmMkFEf8mm8tqgoDZwtAoXnYzBh3VFqJ2C0mFkOmTqdGxdgLWxg5rWtk8l4THXB0QylfPmCjfrs5jvsp0EUzxykBhM1ISAP15VHj+hRHu1Tu436aCuX9rzQBhbvPmh58tt6d1fvTtzqwXi2DhSJu1U0Aa5DQ30nlhVvjF+b9oKjENRN3Zm6M6ool6buwHLq1GAQy1UDgF1nTRuoIihqQnm+6Lf7BlyMcWjpNwNL9sDOkMV5YextZ/Pyx54X3pSSLRTx3y01UflyG1QK26g6gEsmThsExBTUH0gd27qgLUeCzx84DXmw1X5BSEggs3a4lT6qhEe2Kju/1UfjJNgep3evxGVXB5gzYZ1jP4u4lXbnQlIQyXPAgoU8mqvjAM2DpNfJ711MwdINq/FeUdw0WeNkyQZQEZOsXNwPcRBp4TDIbHvUWFQNYcnPMYizuOCGtBFGEwBM+QF2wIByxJvyvG5aRk9JOZQ/lDkEeKPH59mPQBCyr+P0oTuB7zTw5XGzgkFioWdZr9b9vDam6Ehq7nig0LD7IVXvLc54dJuunui9xVJXvDKz2M97wIHhPE1c+PiyGCA3hTASanyMnel/rk1R9vq0MrEr+6YxBFnD25afOe6NMcYigZBCEPZ34AQnkMN9mJrGqcnYdaLHuBvSjdqbIKCTKZ4EUCrpHJDe5qH1wB2qEbZ8uBsxH9QlJPwRBpLSilLC0n9ORYB6TKBraCThSePBk3EdlD981D4deM2Nk1VYeZLNxq0lOrJcHD4Vsgh3bODfVAHDx42z8IZkI86alCjSZOKoXN4JX5HaXPfkwA2l7CwRBbR/vnRkFj94+siUkemRwBCStxjgDok2QASTqc/g5oZ6NtZTcBRW0wfxdRxLLbpDP48kIWbWNQeZsue7lQCDJnIdjvp1fVbWZX8TCn0AmPZ7iuaLOgN/63TDC1aG/olg1JJRf1wkrU8UnSSC5xaiiZHowgI+zS66peIBVF9o9BmOh4K3hCH6REyflbvLqeoxLLankME+jULQN5edyoedzeLeUaM+QqKv46GrQ6zaUwZN/xzZFgQrC3n2HwyjDYt9JDdKyKX4+Re6Gb8jnaew+L6fKmy5TNyU0xwsl+z/t9Rk39vRQq5UYcFqDCcxPgOiR8P3pKzVPWnlQvmZy/zLExVp/lcQ+mduBHWYh1Fd3NaFU2mfZvzOpGNKn5IzFuDlP/Q/N4Dgu0wu7N8yBpMeEoNudcf+fzq29RB6MNx88dazeTbbQoevfAnFJ6iJ++woyie06o7IhMt3/Mk+Dk/iNQ32vdsqotTJg1CIGs07z2+wA1MdjiiNpIOx9GiEm92dRZUcfzqJzAvCPRL17ssXtZv5u+aerYpagvi09p7dgkgrMkOGwv47Yp0Pt+VwIoUtV2J8AWhp7IKQzgujlvs8cl+8TTjYgX/kgR2re7eJJ+16BTGmnNdEZeqZ3Fx3aGJYWjO8B/FZPNBBDeLVCkzboS8DCJ+IA0S3Eejvs+jZB4nbODt7AtPlbgbPNTAbiAf8fbAneKcHd8a7l6wUDZ8bEbYlgqQ8Cw/wHnB0yedpeIcRabCLaycgU5+Hb1QxM/vEQNqkfdoJsjr8aupAOzPgxeSlehs/ju9gl7e2TrKhG+xGBnorzUAPRcYObObd85/fvHz7MY0v9f0NGwywJ0gnMKfhkSNco07NeVmj5TYb93oA6x5emQWrGKubGSCsgr0bP6wA0r7rmrx09sK+Egcayy+r1qPGOgEQTOypARNXsBsv635d9uVlaFJbdr8umgoAeZD1TsX+ZBvpIMi8g3aWMeZdYhw2HQ31Pabu9CHi0Vm6jH85dchV2CES8kqrhvemBZcKDvLIARgcMFChe7ClX+f9ujUJ9KcXTS4Hei/0JyGQ1nX3qmmndaY+y2BchPD9TP46rk/INpfOTNSTAIMqeo+OIFV1yV46NwUw1vlJ92QzOVMTHOSETQmDsKtRp8AQ1o7NO6WNvI8pgxONH5/gg9nSgTBkMvnvuMDMMCA7FAy6yrNZZoMxxTDbWNPg1cuREP3+BHNUH8u6cW9esSdFrK6yRUT+GM/DRfnMZopR++M8FP5TIoPormfhA2sdsHwQlc6OOVY/zVTN8R44rOi274U3ZD5Eb1Rnl+P2uod3pMkIgM0hfCmIWRgJCnqxO5MXrZ91mx0hNaeBMQggEKIqW5YLDDlA/7iRuATO6ey9okWAckWOFnP1MSaZTCh9BWDJWQkUQBffX13pG4/5361XqwY9tefS2LcogC0amRNYnP3gWE55Q/itQWImt9KBTg0XNpOTKoLI0tcAEcV2yWEli6UTxSDPcOLFhj5wvQsFMH3IVTyWTBQN0NUy8ydkHvJPdBfY96rb9Vvp3HA/ZKnZGejLkwvyeHxBFOaOKMxXd2ic/PzOKQEatytp3K6sM7WkqPOWy+p4Fb+842v3R7pJazg2TakxpPYyjiDYz9EC/+PnMnkiae03jWm4F7926/mTrZ7ahbhrRoLC99sAgREu3utARv21HgdTd9HwJE9Udt740xRKW1r7/cfWgGFHR7HpLZ9p9KXpZsBJkiPiYl1fMAvaUUT6JRqKnra6zhecHyxNG9WbmqpRHFYLg7FgbnJWw2gGtioAbFV9s3KG9wRnoakE1oEOZuSzFvB7ZOWOMbDUdGk0MN9lrxaaPOl0UZTo66KWpl80BfLPK4xeUJouQ183iiNWVdRLYKAJKrIq64uOOtuCJTi5zjWW6W7UeQM71qN8kOIEFCbybiKoBFfC3Vl9w0XI8+51j/6bYF9oJ+1GMdS7dN7rG7uK4HN6avorY2rK5nUwon3X/Bmrkvum1xVD7pNzolOLiXk5Mgb7s+j71d7OzlK35afazlF7ai7MvK52TqvmfCdvCrP0XmXbdrrmi35ZBdrLn4w+e24315TuRoGJy2yQCLcF6QnUzlChPfORA0Q0k5EQJvDpuDzx1dpG+HwybPKBzyeR3W8TCLyLEOvbjdDj6aoDah2O1VH5d7OXRNb1s+C6fjtz2CTGcTfEs9unJ8KNq290/838gcdDh3glohH7vK5HuHr7zcC/akvVxNEng8/HcQW6wChAzsp2gAErdDAxOqt44g8fMYWpTG8mAygoMU8ErYHjY6QXnjIM/CyOC0qPlFbdUlcV0yZUT7JJfGX0GU4l598Loc27aC8BD0IxyCFhJjo46NNrIP4Cm87Hw0us6YzCY1mSqXTHlSz0JSH7UCUe85j9zVI7gHq3CN644cJtyf08OJly4vnC1n02z5s61z3NDv8aZNaCvUpZpCQO7abD6uf0Z1rgUvp6ahc2rCfiTdPTm/ZtfDRmqlkljoc8FghhY/ulhbUBBscRYz3W/cloaKAw7MT3sP2QGuYUwCAgiJwIj1j8U8AuoCVySPxGTdW4/HggVzAa42ziYEM7YDY2d+Rrmu0LSpkvonOeL5Lr5QdGfff2CWMUdewGAEor5u930lroAG+ke1FO3/3tA1Vv2s3BCqannSe+rAo+XGIBZqr7Oy9JMPUhdj/Av/19KFNvl1JUX0Nt2/a0ziCiC881Vh/TaUXpYk5gJtpQVxyRfF/v9mi9oFf7u33DtEu7HOE0RSGEqJZw+Qdy7WltG7fVoStjZHCmIigt9zjR0Onu7/H1Yqk0Ug2SdJUdXhgMj/fkWyalhiGzHJKaFvIJf6EAZymdSMU+UrX6zlYZRjBLbR+wb/3yRT0Mv9qHXFl3va5z05x5BiTLUhd4F3g9M6Whq24ayiSGdOgY3toRE3cykv94d4TLupUE/O5r9iuPV3yQ0qOj1n/fbclEMbiy7nOd/jdTioMBqcBCYhYSYx9oGqEMbibQBriffqN+uxvr6OzrtFH6sikLtTTLpr1R/aLV3QIQHBemlhVBnND1uVFTMz+fo7RAXZbmitQruj036qysTDZTZf9Np2pzCS6Jln4U87jh13XXG13MiPEDfgRD8PX6wtTwwLv5hn965cypwXjQnfnb2tR9CU9J6LrOcwMwU7aSM931puvnA51ka5aE53OQmqZ/sed+Sz35NrLRoT2CWjZXByrlvktUtD+0gEHTMH3GcYPckZWlY5eyA/Xk29ANxG8RwdlbDnlI2QfHn6n51lYJynxbblA5VJYkEilDHLlDwhl1/Kibrqgp6NpS35yao1VZVdNRVemovXL6vvBs6K92D2sUaCx0p87b5qqeqXxh8gsXBrfsVbdo1lWhTg36OeCu8T3bSwVDc3dGfCV8d6Ae78YmARi8OprPoolRIG1r6NBoBosmk5wQ7NuZ+jb2iixPK9zfdptJlpaqj6jQwyWH4SDzv+9N3iwNW7fbbWAnMGJmAP992IToZLCcNrvfVKFBligDSKbN6mbGozgJM4IH2spbBCa8vJbGcYNUx7yLPPuWRpwdzjQ8PrBmN+yhCsKfd2dTN1uu0zO1DNEhh1ncGlJ1IF/cdaNMQlxyx8Ss0d9eZu9iSacYEw/36zs0PG58637FI+x/EE+9RkOaFFedviNhnDC6NcSXbpJhY2NWdg0WLv8IZta/OtnK90WTvy64Bpv4AuIjiGAamBDHtQJBrJDfW6p7ZFaBYz5QKMmKvmhyAetmrmzCXRUKGHyp0iIMfKG23SXdqHwG5rqqKHBWxKkeOyXRhFC2spPsRIbUQh7jwLchvrnga7ynMAFiCrvVwM2m6/5lUfahvRV+qoyuf3Dw1Mo5S2LzDpYy7oH0JnrfdFKLsisH0JkKHtXLVWUQGMSun/Q3Qda07HrLwSA5/RF/TYX5GeQq7SbZ2nJbRnyyS4coUhTGYD+U4R4Zjg50ZFbim7nu0dCLJiaIl0QAdWA0gpCIk8z50cDo7F0JIeOlc7637cBV/3zWNss9tlToG/+nue7R3IuhXjrT+1nC0um5m6nOVP9VNDVFGhUhRF80eSB6QHeSd6efpknhxIxlE02N6vum3bN1zEbRypxEXh2tV6um7TvVXzUAL9qp7W1UwTR1daOa2sF86/YcClnWGHAyyXDJRbjASPx11MKvqP4h+I/WGChPQrXO96kFAxwUPBJuH54d27PpVVCLZZ3zpi7IJbBu6m0XdDvzAjlxFbAtR3gboJyN3nZIVUGLsi3OLliAoO0EFA5gY0XBoIQ/+Vv+aYdqSH9l8XjeNvX2an1alTlaop3p3CCgCsDzgY4FRCb2B/Kl4pGZel+GMrhYkndvOekdags+08ErL5q71JMvkIwlhGJYQSgkiqqts305g6/soi1MC7CRxuvlWhOIa+NJnqs3Tdf7/U0qKX2pywqgGWEz+EtMTU1R9g0EjMLbqJtjH85N/4uu1rG0QVxloaiEDH84HOPde2eA0Abk8ICoXiYxhLvI1gkX9lNTuj6B06+gq7rVfdNOs3B9OjemosnfwJS+W03d+PLADwRNZVd0l8jx7JJ6nCnuYGhq27v3L/UFh7oOKG/frJDu2tptbQ7fjvLZtEzCVGfjdrJEtCPyD+OZqaYtz8t6T014+BN0cN8DzuoW4fC9jdzd1L633BmHJeWdD+ZIH+w4xY7JkXnxlAq6InhXsk6jUFLYnCApCm3VwDo5zNQ3mdpT0mdAdoEyjTQf7Ilz08e99gXu2vHfo0KZmxsOYzbs9v/lfc+DtFtibxB7GMbD+5hy/QgRi0nt6JoGy1U0DoxqxfzDuv8/9r61u20jWfB7fkWbexMTEUmRekuM7CPbmon3OnKO5Zmcu5ZutgE0RdggwAuAlpVY/31PPfoJkJJnZu9+WZ8TRAS6q6u7q6urq+tBZm4uRVNaX4YYUDu2ZucEPl3gdX8bsJ9f1dRs4/R3DFF6SZnZ1qRdduSqnEXoHtkZ9KIwkZmLqj8zFAw1vK7VSGFw/XJVNL7KonXDwt03cuv64lhEl9dmPA8Ub3EojR6TqFOdHEG0bbNHwWj17oz2y1VVl+4kBUmDULvHXj5a+h4tq2whqzsOTtUK+eH5zekXvbmSaS/Syj404YRXraD3tgZIlmUV1KGXG2qpIu35LTel98IsVRdsU/Z9Qcr9CLygH9KMDY/O05jVlvXWm27jVD5CuLW5k6tLkBCy4oYAqHQDpal81Cret3Na2znt3CW1oXQrcDntHeFe0cEZ1yw38Zz2QobPbkkU4zfSEQZ8ZwW9/bib1hq0adpBbpPpP446QWmxdQAK+JrP3WjSwe4hTBnDZo5BPEJEAxidWAKEiEHgxYP3FV8/Fsd6A5L1w+jVPn6YktBBsn40Gi/u1iAy60CCjggyxfSBkq0O7KoZCKte/1fh61LgujHTrTP360ActUNUzFg3+xrr0KCrXLkOBl5VClbi5u39kF2zNoOkJJ8uqDIciTQZb/LLWlcXac+Tcbi7VjllXlgFoYc4bE4Df7NA/ewmGbUoq4XMsz+c9VuuGjPWa+dNpuk/yTnMBle7Oxx3iG9bLOun18Yau2seHsdjom8cDU18rZHuHBq9uztjs/kYuG4EBr4g23VM7yDa8AIGFXYtedsSHe2v7hvYjiM//rIxmeRw8rU2wKpVjjl8VN6VS/GRIjru+f/k+fRGdQsAnSO+xHR2FGhh3ej/vx52d/ye2PFjBazKv2WM0J0S+kxcrGO2tFwFhQK9DB4Nu4iZjoja8KF9QsWIuSs/Av7aGxdn4Xey4HS1JOQTJ+E2q4Z8DOt+ulq28cIQeVsYD6cXbexivUHH8VB/yVxe0xZNlu7fpjFY0/2AqvRhwJb2rGO5cRon1pW4QjQpTazMPdig/KBgMlr/Qf+/D6mGLnxuL7GbxvQVpCb99xN9KMCXmKTxHQ037B1aYpgzv9PVuk04uZjDf2HsntEYDoeWVlp6IzswvocFoh55uwBjp68seE+ggq2DD125LCK20SLZ/++UC8t89DfNVZGWa+grEn9azP9SlQsfiR5U7UVTqzf6hyFBVQcSAH5oJ38Uclof5qL4zwJGXF3AWmY8R/ETk8AbYJ9lHok//ZugzzJ3lCNdtYJTHtU0hzq+y2qbeboSc2ZVmlx+INKyYOtZGBx9n7ieB0DFERT0WQAKtuYb7BS09COxtQVvHgRJra8DSl99sPQuOHP/qUm3wNCV8DeVu/e4aZIrWfEc+uO14WbQ/TRayC/2BtMerhey+vQyV7LonIU1t598OQ89s+/7jtZWYx0UcVWaZZWoS+CO/mHDeR/4J3A/clk3b5cBWeDbS5V3f3hLW5Uf68+lTV3YZiD2bR7qYIRE/8bNC7kZFBwzoLyRJoLxbGtGO+c5IBsgkRM0cuHS9mRocIAyrTMTUddDValUdB/eVrRRgxqvZCMfXLrfQJzapUcvTzbn8dDV7dLa1scaE/fRvUFwVuRGSLykN8DSkyTT1ORqX6ePQIXvQNzSnVmS161JpOXhKHK9OqiJulk1DWmizJ8noofxqnqDdRpkFscrvCuysDD6NEAySTh67fP0iVMhlsmnG4wVi9U4W9ADlVoYUyVA+7aSS/oVWJYB+h8A32vScNPfeA/jGBUaAQG7/17VTR9GlXOwWBDmOBSEzcH6DngTQ8lrpcPG5751t7QoP6v/TwD/EgKwyYzt1ATkkayqjXNKNFFbfY5HQ36A185o7xisCshtVY0WsknmAYm1bMSeYI11SLmhkbAgZ5zYol8fxtcdYZJ8ul9VjmO1BRGJLd04w6QA59B1qJPr9MA9HG+BweksMAwQ8vVrMCT3j6V9l/VRDNgHSJ+cgFsKKm7GgxQsAL/muuWnkTANm9itOgAsyk19x5IB5J33eD7rtFvpxlRX+qZrVQPO/AHHNZN04m5J8eVRPuy1zAJ06DT/Dmydtk/J/O0SlS9/8rk7/S1r5iduy33dNIzte45IySHSTDgfCsFr6q3VuHL8VzVrTrp6Zz+vA4AT89tcFeeLZXOnE02Kei4rlXbCpE/r4BHh/FKuanX+WRVN3QmjVcocvulyrONmcSMlaAP8gZmCgRPk05/UWVZgtMP6rGldbD4SCbQ0poCJWhfCQWr8C+FlSTqryI2z611qUuyiTp1JEPy0ZaELBUzw0yzg1QjZi4xHoUb1SwpIimEiPK8pQTi1QpTSq2emlhuBkEeCdNdYlIM6syW0BkBvozXKOYbSPVWdXGKW5Y2NY7/RZoQjG4WswZ1PvffAbJIBAewaNoqQawXWqMoNKKOjDqGV+EZRYF3s5SDV0WOpojPm/Fq60DbGejadCLG6w06P4bWOWvbs1JCASfPsR63zSc1CfOJC3Fi3Exs9spThgUs+w/cdpCtgT0aiAJqjv1yCjIIgprR/fyPZuvv01hahGm7SNqsCtBBq8s/yPCRqfxtxWUsH4W0msMeQ11ri8hlO6HS6NLp9PVtRuPixRLjQu5i3u9S/Y577l6qk+zxnZMrZLNRBO+vTNb6r1ZJzS3XdFXS5GD5iOP9wg6FrsW5LtxWM6x/imSB8yRe8nM2mniznkE45m/nuBo+gJp9/aZOIi3IgknkUOmWm6guMp2e+I/pJWVaps9PR7xZv5GIuf8zYr4Y+jZK5u4/xS1yrP7mWRRjxlitgGGHdl7GX6eMfmzp39h0MBk5/w0nlA8BjJrU1/FjXJ9qkXN55d0ZGpRFcFKFHiPbf+HaL2A0Gnr4/QQc03y2EAiit8cZ4Xy6nznd2zgjcNTw4nVdA8CV0VXBppWOQqJKr9XqllpQBLlSH4Qc/IJhViPW1vaL+Ha2TN9IyadnifVLpqzJxuU+nHYZ5qyVbN1uvE1sxMKxfZxQ89Sz0SUAOt0Tv/TOSanQz7rcuWP5W77z9CY1lETP71s+XaZwGO+hWS2L2hIWEaPSrLlVy2S56dFGlswXMXUQuiFZn6dKBrmqihnyi4Nreby87cQrTyp6Kpo0T0W7Xrnps38IiENTzrP4VZQQylH4Q5LUL8hLf23DrgBLIuf57aKcVydO6Ut7rqy3ALyBZNOLy3T7Ib9Z6lVkz/4htwU7p/yOzKhyHWRqDaP3tNhXYdFaBEg53/6TS1okFXkPzQKvch6Qsmqxwffzc5nSki4EX1JC6YQaGBtLhFqqRydwf64dGP3Bobge5IKrMVC1uVaWYGgbkm8yBh25kVoQzYonbp+62TJdnzWvcpT9QxSy99ixYiG/VfTYNNLSQlkkk/tT1aSlg/Lw0mobm/XroHnRuaxVdezXQugQZmL6shbX+eqDjXqQD3n3oJPvGDI97iIzEn864MT/zzJd/KdMNdtPotOfcu6KvzPriycJa0RpLBM80uurwVDfWPQyqbjgdc7+TkepSpoGzVVMi6MCa2JGuHgijxsY3vauiR8LrPWUK394Wv5KDkcwziT5aniPfSMlkzl6m/geYEg3iUjVitTQuTGXhMKantbBugZgxO80qlTQYKnGuBHknPa29iHskahXNK5WrG9lgBHVokNVkrLGkbc3MmutL2OPx7QlSGtlAElW5FFnhd4Yumv3+zWX99rb4lSIk3vWhXoQJRdkd3UVvIOgzyMU4NY4PsYFodNH2pEID1tKOdhAfleTQajirGPZSuzfW0VTfLEZ+P/gKB2dKfVZF80v2JSugjCGAlzLPxYyc9/Kc2ZCZjtoLIO6sNYoR7e6Zb4v8rhUvHNqXNyb8cf0pW7p1/NUCPG3TLmW/b9qkKhRmbdnWFlWpHHeoU8p/3LVBIb9GLMWpgy5QANS2b4KjozcYmOaFXnW1MdOI6AFx7eDMsJkyXQXd5K/BSAd3rTos6lkDG6eQNn5oUwpZ8Dr0JltiUdh8kwU277q4U9z6SDTzqrzFLeYc6L3fw2DWbuBVmVdKpnew6la1GmktbrKgObACPAfFPxUJi786IjH6+6A3Zt03pkp5KVPg7fYN3W3ZWPrA+H6r5HKZFTcR6yC/oFbXVHFKo8hLZGMF3ilfkNywoRZX1IP55vXFufjb+9dvXr9/fX7JL02KSifoqEjKqlL1skSjHs37yHHYiZXqDb8XaN1YR2BYBECSHIRMxwvgPngXJZ6dmiDlnfMDsk1Wi4LDsvbEFlTfslAjTPMZekXriTMLk0Lj0gSKJ/iLQtBM18au2BiigiB8c4yKQoeoMAhhBZ2sw9HVFOsCQzgXyKYTH4prd6r/qhqbdxQjLxka10Fnm9tSLMs640sqWcO60kEtCQr589fhPGvzW1rireQDMEjaDWAgCp1/wFFoozCIWlLzASE8rM9GJ9ggWaBnfQhcUkOKOvJ5jqmhZB61qllkuirSV6deueI8CU5UAyG2trTxUDR1p6pccaRZb3rI/FvPCB6sKdwvzYeOMLpmHuhUbLIfkBNqew6CMXduMoIxtl0yYxtN13aEesJJAPE8YkIS60STmsfrmKdcguweqP5qeSurFKMpO1GE6o48IJatskuWH+ANhbBsNjNhKMWwHcoWt4JsNnP264JJaSqKKf4oTJijwokGB7W8BUYxYP1QzRijOWtql0mK+E7cyhwzbq6WyOBWNxwlG4p68aoD4cW4kzo7GfZJB/vxE0s5F/u+WQWXHwATtSGK0GKAggc4PqItfgnFOKAQvZsyZPwxcNgYhszQIaIeyVEp5E7ASdmeoRVEpygpbGlQvIvZdnBLqI29MXvRfeceKN0ov59V1WSJzA2rHIhV7dMzz2UxK6uF5PSofsxzHcTWm9uzhqmZx3HuUnJhBtTZOMsVRtxw4oF1R4x1h+cxwZY6djIbVLYV8QpmbI7tdGxiWmwkXN3dbA67WeJoUAuayzWbZaA21UxVh7Jy9+9oU/RcU2p9AF12qnY3UxiCfC46g2Hr/ufzgD6xh/l82kF5YktkTHABxTGHkbGOEW6lLI9iqNxZY1y638YmLyn/FKeh2zeUmdrcO3MynNZTxiW8aGH/2kEMIwtgihONuzd2aJc1Nzck7dDt9kgcsJqpWE5Nl5aD1vfNgcmWj1soyO+Wo44oy8zBoAC32+Jatm/AfHxi6mBT8y45Ls7SjEJbAu+BngQSeR/khkQmcyWyJhqJdwiMEyrgZQjWIoEDgwph/MtVnt+JXM0wn39lkxW0RMEXWZpdLmVh0h+g/uw2q1UolbwFLL1tC4URDMvJ04s/7I7M3/Rm1i4qTnEA3nL/XfHEE000WD16P7++fP/23X94wbu1ahHlub9aO244bEKPaxBgOFcZqiAo+URtHJlG4lUJk2B81oVMMUOFBoO1QKBBlSLOS65kVaPt9Uj8rUix/qL8rGrdBIp+qRMKqimFVjZWyq2Ail3eYEiJThqqrCxGNmQXazM/XE/pt9FvanHQvNUXXn4aZSc4e1NJCoNZGEesRBYiVmKhqhuVBmH4AaY3Ek6MMVk3v5Tp+2xh71zJcJ9fufHYfEN/18DfSjm2oDbx9wq37f51tzj6FVv29yPWWFmgNy1/B89GXZ9p/kp2/ROX6F5iKDEhtY+LR0xaxrfxz4aUItGViWmJ+eyfYFmHHj7hd2QA1Pb3JpctO60l5fLu17LuO6nX2HmN3pwXKX8zPmzhWc/L2mYStmk3AVLAvCkTmaP5B9WxqHj1R25+u5FjyqT1JaHqzr9c+Fe15V9HaA5swHiZDMslahwNJ9Brt5zRfYwqUjr86IlHDjoSlw3U1OldPHLw5hi5hPHsIgPFPsLQU8vCD8HV/vjeLkxRoPK64YreDizrxvgjEYxluQyihDh7132XhAx98QiaWZG+IcFhghExw/SdZQXOzsPVbuWdLxhLPfTYf3TOGAj0CnIPQd4L0T1yxn0kvKmA0Qk/Ohm7Qo8xVIv6dcwo/vnPQX4mJgjdOqK1igzFznWrOVvKm8BNWDhz+U7dZHWDmTZ4JsM5/AUYe23YvZm02ww2PE3IzPDLJTPFgSgrheWSvKyBVG4odDLGOgTRgRgygGMYeV7e1riPwAZHezJGFYcava1eFGwu7UUj05To5X2pd3YvWWit8rNZQ5FLXqchj2QNqne/33bvszsTqp9or9pC8wS8TklWlRMUk8Zeb1+n2K5ng2i/8wZlUl3yANlEreaFrd3ve99GyVxWZ02fEmb3ttApmFXTP/xg29L77jPCfxhmcuUhwLXzSuXAczy7yU2N/tiLPKPIPmseOtdyx+hEfn5JpD7cdC19sgoa+ZuVKlpMDwRsplqP9SWLZX/N5mVz44ZlkF/qEu6RYHtbvF022SL7Q4HMzaI1RaXjOz8gzSEMcvG0EbeS7y3S1IUBBMTIKhABywq6RgJDJRNYoc3dUtk6jJDx2nZ27LX5GTEBaYpt1at46I6dEM5wkd7vMWJGd65DZ/5eykIUZWMlxIHOvIxomDWs5y/GrME8g22ujfckXObrV8F/ap5or6BW9dxsAR4zGNUqH4g2YKLSP3kATsSHx/T+OrCJu3G8W7EJ++J+2mbXMMjJqgozrnfsDIYNoYDukp8tXM+zWRNeMDi7ydhuHWsruSfQAFHNOyOHMXqC8dYWvvNEY6esL++bV1beB1YUlEcJ3y2KL4BLhAW1dO8V1i89duUw5ycYxZJzlnFuRp72szRVac9emNkc0p5g8VIWLxSyqJTqUxsDsazUZ9xv3E2GDHIDpjn173CYh1p+y6+2nBzcANyPZYE3FmGAi6BCR1g1Xa3rk6mstzbeJgIva5y8n071pap4/uBeIk7E/njs3UW+lJjCAI6VSscntpK1CV6BnBFT5wEDM991pki84qLQA85XX5whhQYQNJ2eRZmnuhJfczk1eaN5CqvgKetA8OybAR+vkXKyWZbIosnvqHqazWYK9fH9rNBa/1JLwCoVOvKQWiybrFB1DUSDxB+Fskw3B0OyIgmm5QLWLcqYAC2nXX5Q3rKAHcIyZsyZBNyaJ4eGuFKfs3JVa6BpqWpk8egt6QaNTlYV3TSwhooq1LwRorxHlbLiZiRouZrseL749yOHC8bsonojaeYgWfoFt6CghsE6CUS9zhZZLitWwXgiKZBIttCZ5dAU9DW6UQasx5GB+lY662I5epn7wlqbD3YwQhdiC5wnhT2SFfkbKTEl64Wy8bQx8WIZwdb+3cb9tbW3+lza8nojL09bJQzX1nTZKuDvAY7prkvVeAp8p9LSCe304AGRIx20uf6m/qaq9u4dKSwzjDp+mbqZFcol4NiUS+bU+pf6r5XM6z5NjbZgV3Vjtl6PXWplHCChyJMlJZerjnsnza/b1jGhwkSfkrquj9UXTjSsd9MPPXTu+r3HxhdZytf74+CGmaLoyS99Y6Jh3GRNgD3ziYFR9Ha06t4QGDf0MLLiX9+g+/Wr82MD7mg+H0UfiusNrkvBdb6eDswYtCq2KzUk3WylcGZqMhxIyqLh9FHOVNXMvvTWJRvKWBwrVQj1ZZlnSdbkd0SsUIfSDbEozS2k3qySZeFLqkDzyl58TnYPftO6I+4I3figD6jxE7QOVyOLOiMSiT/ZY2HVRGyGQDCNDUYW2btBox/Rxa1vnBNdyrsjAcjiOfs1nmAd4/sOn078hJOo9GiqTH0mfTx762lhwJ0jWmEpLKUNqrob1bzN09ZCcpePCSqwjv4cLuGHE1g/Q8WtE1tTH2GtM5HnPlfc0jB2kAiFIciuI18SLW5bHEdny15W5ecsVUKK/3n59mJYy5kxFcsK4Ti+oLCFASxYTUSW73hLYu7D0S3FmKkbdWmGNkmeRjQ0xiYtIsYO+2tVrpYDdi9oMtmoSyt6hwPHHiV66AjM+stMkoROuVxwkUnUEKrkdO4qGHIfJ/FcmH3EsdhNlVq+hCqY+gaBRuKEmnROdKEd5n1nXDzCyUjNhbp96cTM81xLyDHFHHpt0ft2gLiPdFH60VCbO2Yf2+YEfOGgI8N9vB6IhXPhb5piJPhewtW52OuIUVPqawiH0u9DazomhKhtM62XJBRbiFN8z+E9tv+TVmP/Kt2K/m078r2ZMU4828hYQqPg7/3Fh8l1FIlnYjgJc/fB/m/7GNloHsQBgtAmtN/nqlFrC9y3fH+7bADZO8cyuljWWXGzXalaNbgJ6gXWlCJVMiepWX1pVFXIPL8b1uWqSlSq5+07f4eJZY2R+y5VfolaWIpEYc3IyKDKbjjo4mViELjRDfjixVpTeepxihCwqeapl2CBYgJY+cPVcb9Hvxg04Af83ftsMxx8JcHZvH1dODMvk2KHXW64TFOuEn2arOVC2/FJeoNwBxYK/4aNB/V7dGn8NFbzrEifiqwZwKErzepEVqlKw8YyPuuyCsxvgf58WltLTjw10e1iOgIuTqltt7eRUoZlMbytskaJOpmrhTKmBI4laKOTfeps5SXVrxSc3xI291vgZRjdglV3PM4DEa8aneIFQCyrMs7VohacXQhOeWSnrhs0BgWUaGhVwOaS35kudBMjbQc4petIscvcw7056+D79SoWp1QITYBK9B5zwuGg+LOKO7g/SVqreJSUy4xEIB8ayECr2LD9fjQVtrhuxlnyXUzYNm368DGMxdC1YG29Dx91NO3WsE2/CQgFn14L4v7BDWxd/x6z0aDi1in84WNgj4MsCE399PVvkDyXP3EyHa8kSGjZbDaw9R2jX10ZtfC6qr1RthUpVEQr25/lc6fCqepjh2TXCiq1Jr3pveci/MmFRNTODosgdDv361jFDZ557/PQjjXHFzgdoi5HQGnduDt3FbqnA22k2xZfxRAnbshuxiZnUbjqjbZhDf11ltfGNB019J5x/vfzi/ct/4hXK6UdIGYyaejcdqtE3WR5LmpK7iY+ripZ11kiXp+Lz6qqiZPW5UJp3rtYyiaLszxr7sRtJZdLVRGvLpQCrq9zParfl5UizelMrvLGz/HY8dUcl70bcjXyC0YifKOvAJAu1YjECczH5FLf/dTgVTfl8ldt0Y36fw+x9ud1mAUlAbXgVYBbIotE5S9WcZwrhyMjboZQ1e8pdexX6qZKbbssK4HU7RcxLurPuz6ehOPiDow3Ll2DEY5AOHd9FU3bwwpvKUeh07NGVjeq6TvJhtSI3mEEuFFdJee5AlFleu/XjFdN484AXrjBiWF0O8+0OS5MS2wN8NzDvhoRBPGDmERYc9KK2OyU2aEyu5vK7FGZHfdcj0K6TMQPP8B0N1X+7wr90RCriQeUByBuL92fzy5evXl98Vft7pTdzJtbsq/VxldyoW7L6tNIlMV2OZuRqAJvQJJ59fYX8j4YaIGHDCUwAaJsss8Ki1AUsao2SzZcCwHxL7IGDRAokaQnKvO3kUxTVEq+gfYKVelD/LrvfYY1YDWns1o8sKj0w5otiPZTv1cWPbFl8HOA+eGBgKJ1/d/1KJC+rfUWlWtuUAdZVZT95AO0c43V7C+MmqCLy6qiE+LMtV6x2XDLn20rH64DvYz+Fo47SL/uIiB82ogDBYYvCcnv3FAiRlsDcH74AXE2d7dj8RxfkLoLjvUW5+/C5AwA4OtXv8S9IS3cK13awhffRFykAdpIXx1FHklipN/pJDHn0yNJzKGeDTPpafY7A3TrBdoZVQotsfTEkq8Jxk4ytbwID75bnk+IdIvsz4951z1FYvvHgfgMm0k9Go1+3PZu8R7VezRXtKrDoK9+eh6i8xuAeEb2fkb9hKRJuifjlj0QOxt8KTpHVTgjyf7e5MkLzTr5dskEfDmXBV4Iq/SlzPNYJp9qY59LPPftrFHFAAQsbTXDI6ptsRshxbLMioZjzt4qx56QYCyyNM0VXsGWC4XsnQ6mrjnOXGnc6V5FVpRy19wKk3G1EyXA+v8PBG6eYoF7S4yt41VMUhY1rp9GO1BiUmA2DrpDXb76slRJI2Rxx01o08hSzEEsRDNuqIv9fiMbVYm8LD/V0GGs0GDH8Xa0uDMzQBoGONanq1zZC/IFQI6VUF9UsvKuddFwypjvCVVgBq1qAJRV8KHffs5qIRPYBEm9KyTeoJarRsyyKvDYc3B/PP0TR37cwkfatzz3nyb7AWa7c68XudusXdRcKqebdv/zKA1ouqXa6ib9NtjOYtMuO6uNNTytb62a9zRRfZiot24Nm4j83o+DEBcpRitpxXj4c9axzO91PIc1OpcuRgwdoF0e2pJVZS4k/PNnC+e+5/FJ7x4Yuwc4zzrMGbjP8Pjlh+y6750e389JOnQNnZ28xrRKjetE+VlVVZammIm7LW1K0S/KYvjq7S+Gw/ocyOjtSLAt5EKx0IrGLt45Y6jdFR2Dbbx7RLgd6/bV219o104WA6EGGlnvFMc5E5WX910LACD9QYEToQYBMkHkF3JWCc9dRhvGZMXmWoCOxoXOPSzaAZr+XVb3YRDrJGWqFjgpr2+KslIdBl/YHCWeOQOWlzV3/WTR5lXJwhcc3d+jxKs/9dhWm1/VqiGAyap6uwwq/+zK2g+VcUTpxyxH77qjVg2saVyLMLPDSWS5CK1W/toetbmsGQefbwdn8LX8PXLkZzcCSJoC6Ut0rpiZ/Ritwp0QOm7gHlQ8LeQn1V5Y2mWjEPUqmRvd86KsFED7rIosvOt14tAkmJmcugN/OxuLf+az0rhJgWdEX6bpsL4v1zsAZrNOCHp8fnl9+bKltLowRmjL7IvKayHTlA1IMKCiIqOoOvuDxJ85rCj6FMtKi7dc9K948NsdT+1V+qoqVEo2XlV5CzwMaqCJGHYpKfPakd2evn66ANmH6uMKIZ6U1U91AKVfZV37ojS/+bMpL5G/uKxDb0y9oHxven9v8VzVgOXSu2ZAU20nN5X4QcyqDGSf70yWud/TsmgusfuAAA0EBym/R3Oq3xflqkY+Z7KZ/4iveub7Z//zFrzpBZodZ0uj+KKp3pRgP+avrviiPI3fonaOfGg2ond6hhY5fnII2xUGBmLhiujb2wJzQtMlVlLmq0UBSw7aRGsI4vID0UgMZtDIuGb3jCSBiiPHcGBR1k2ON44UE6FIVdFI7aRIQR5XRfOSWvGm3f/gxirD1lWRAgrxJRoModyOwXv5b1TUeadgSo/ga7YoRiiBHNVKVsm8v/3hP6/qq9V4LMfX2571OYMYTiK/ohsg9r7NcC1umDrYCYVC2kR4OZ36N1CF+tK8l7FtQ3Pm3lXTQ7MZL9UaFdahdPjnM4yAEoVpHQqxRV0ZOmDQCV9XHJKTunnPoyyGol+I7/VPi4KwVbe0WvDepSeQibLis6pqPI25MzscEmWgDQ4RGUolNvJQbS4eJYaxyZJVjnQD9TUVAYwuIvLet2joppS5IaLWfSGF4UerkZyCVnzTHLkR+91ZYgpq1fbDDCMH+pQtlygG2ZkJ0pI7QD0wFPk4F1sGyLNT7G6LHKCXW9Yej4vT2IghAHFCkuY+mTjI8DeHVOBNm1hoUFvkohVqucGznY3c1YfVS5moy4bEnF7v2rsB0B/7ReAwaWrpUfrpVBRGsjEfKfRN3djyFFqq58uW5qsbc8l6MJLvpRPWECQmK3UNJ9dTpz+4Db0uliuPsRclStuojR5RGcxiAs2hvFbWEW7+ZQwdvJQzWWVCLinkQX4HwpiQIl7dsJZEw4BTfFyVnxQ7iz++/awsLinfPEiS/odz5Ir4Ds/2RsBEhM0pOFOI9OVquaxUXYvFHYplIDW8Pp+MhYKlWz8Gr6a66xgdkaB1z+9Q5F4EW61epGwzoPLm2wwFHHK1V/qnBEdH6vbIZDjR10QGh4VcGpuF9ZGaHsYHEzCY/NmzvrVYyNYHazJYFCVaoIDQ4X9I0HP9bfyxT7Ycy6pcen4GWeHqSN6SmzrVcm6NCtRMeF8RYNSpyGBsrJAjTgWUnvrgCnVrEQ9vjhjRpFzeAfb4k0wD/eHQ+AekoeuV8UfYFaobOAXBQRMtZTxbWvoacSknAnfL/KyMP7o0U8Yf1wbp7Ju2rOU6urpRK531nJwSVMqYm5XxR9eUTMdWxUJtYogz1vT8Y5qziT/AbQG9W13ksvSiLM4uX75+TWYmL2Wtfiur9OVcwgl7+wNIZOnsajXePzqE5/F4CP+b7V2txgdj/HEwm12tdsd78GN3fIw/JP3AL3tYbC+N969Wewp/HM+S5GolE/yRHsrZ9ba+6M5q3f4LWWeJL1fYjy5bSubBIXf76nabEtPBJ9iT4Vjbu/pyNHacuvrJfNSUf1suVQXd7kfiyanAd2/KW/MO74bWDZFpJGpfiFtc+8l8IOYqX9oQ3XR5wK/08vB73jcGLHhlgmVHZCvoiDxXtz0yh8Rws20IUTutmA6zQBB1D9q0mdWYHQrWTotXF3qNrVtdBS4tWAzFdVemtgArc5OsU9mKVZElZaqsN249EmeiVmhZiHEeirIYKlMeyoktrYS7cxzB/DI17MENssUUo/zZyClZAy9meAIHKDVq+1AhWKRioWS9Qi1GVoukLBIFp/CRwBCmGTlkUUAfFKKTZqAvEopE0VVInVTZsqm3Z2XR1NtxVd7WaIaS1yWhxFcSSVnMspsV6bj5KgEF+FTR5UtNmN+g+ltbQuhuvsRe8uLdpTW6ewBreO9od4j/O8alPMGlHKf4xDWeTPC5g889fO7jE9b+wYSW+0TCcy/GH/sKnodjeKYH+CpN8Knwh0JGobC+OsKnpA/Q7OEEGjzcRcCHewD4UCKUwxhAHips5XC2e7UaH03wy9HkGJ/45WgHv+zs049DfB7TD2jgmLp/PIYuHe8CZsd72O/jvSN8Yql9erUPnT0+wLIHAPj4CPA7jrFeDF09Tqgojs5xgrVTaPZYYTUF1eR4gk94I7FRuYdv9vDN3iE+j/CJ3ZCIhtzHQjiY8pD+BowkYiGPsDLiIgkLibMjcXZkgvAQI4m4SMQlRlxixCLeVfiEuY5pGOK9PXxCtXj/AJ8ALsZRiHEUYmw5xv7HyRifWB47nuziTCd7Y3we0I8jfEr6AYUTHNwEm0gQeILAE+xQgvSXIOUlCZZJ8D02lKRYN8X32LcE+5Zif1LqSYo9SbGxFPuQYjMpNpMmEp/QTJruYIUUKyDUFPc4tTvB594Q/wc11N4h/tiDllSM32P6Hh/jM8YnIKuSI/yAOM8mR/iEQrPdfXwe4hPfHCLOs0MAOztCIp0d7eHzAJ9Ylnbb2TH9QLqeYVMzGKPJeCcdwv92x/jcoR+H+DzGp8Rnik8Fz/0jfOLXfYUVDrA2IjQZH+7BEyZ8Mj7axye2dIQwjuG5uz+7Wk0OJ9jc4QQqHFLbh7v4Y38Hn7vwPMS/D/Hv+BALAcOZHGIHDpNjfJXi9xQ+HI1hRUyOxvhDAqLHOzAMk+OdHXwe4hP6cbyLb3YRyPFufLWayMkhPuGzBGKbyH2YlYkETjWR2FkJhDGRB/v44SCB5+Eu/jikH9DDGHnHJB4DcjF2Ld49wFc4rrimJjGs6Ul8gFjH2NH4aIzPCTwljkws9/F5hE/oVLKTwIdkdxefB/gE3JMUm03SHXzu4Q81xucO/TjCJwxQmmDhVEH9dIbkkILUtTMeJ/hM4Ykgd8az8dVqJ1Ez+JHMJlernVThl5REuB2JUtsO/jg+xqe8WsmDA6giD2Ay5QEMkTw4TOEJEOUBgJJHwO7k0fgAnzE8d/bxiW+AYcojbE4eYYXjHRhMeQyMWh7jOpPH+/gFF4Q8BjKUx/EuPqkwLDqJDFlKZPRS7ih4wtKVEghCSlhuUsKYSrm3i0+sAHuKlPEOVov38HmIzyN8IiDgRFLCTihjtY/PI3ymV6uUJdYZjNcsnqir1Ywk2pkawyu1Qz+gz7PZscInS7euYOXu1SS/2oAEGKkAhF4K8fLsVBweHKFhlLfDW8nNSE+v3v7i30XYW5S86TfyZoD24Oi9gDl2L+QCtbh3uWehyKHNyfmCjpBs3whAHKnUAImEGpkfmFiM/7ZluRU1wj9GSV2/pyjP+HsaXm0yot4Fp1AjNNFIX86zHJ2uXRQB3AXGeKeqUWg0pd93h9mkj/7lnN8eF7FOkfq20ztQVTpyGQe+d1F8R7bbFXtqeYoVN5Y3Pi7K1JuVqj0rCE8fyKtRrZrzIu1zXTq6pBTb2y2DiiS3Tb87lTnLkJ3cQ8iuxTEu0ztnbjxkSYVUjRblZ/W+ZOrC3K6oViJNGo6ANv4i7ZJylooJcFuNkjLP5bJWfTecHAKHAemZQ0TPHwwoQKPhFdkwKB3uvxyVtN9WZaPGHa19MXTpBYbW1gRGH58B/Q2H+MNc4I8cyH1Fjtr4Yx3drUHprEjP0rSvw0+Hd8EB/hyw1SN5xzWfOoSe1XV4ZWTe2sy63CZ23D31UshhNwvyLqolz4q0KrNU8KlIR54gVGvWynAYzCxP0R4KHRQBlIncoiMqQxuWhixzoXcG48gP3RZ8JVwj7V7vGWa3uzGZRAEC87L2whbz11OhQ+O2juYmwPK6nkQdZEhmYed545nm6Le4sNxV6X2YeoENvSo//ODDGFVlueatD1MPaojBQ/U82m594dD9b/NUVcbHA07Fr8/pGrwWq6JeqiSbZSolZTbb2pUrdIEOnAp99LKCjuiJrFUt+imd9PNSpnhllRUiQ/P1SF8BYNyQTP3OqIifkADMXLhrQU8L8zyTibBrPtYyO4+rTp2LPieApZs139Ys1K14p27Ovyz7vf5/fr26qqOe2BJJXost0es/P/k3fHd1Vf/YY26LrHzxMm8ZBdiXwXZATbrB7ylEPt0SBOIA2bM3FOXcw3qkvqikz7UdQQNL+/eActZgaGIuzNbeWNBk/MdfNuO/vXf1kGoBGQ+EAwfT/lNrzxni5FpsMQInotfz7eQZvTTtGD/n7T8zgJS8xh85Egd55KKwi1unQn8Uz0VP9AhxooO28vJjmRWIqKr7ciBij7MA9jLImbXm3sTPWu1e4qDx9w8/CKcb+I47EkeRiAHrHqackTYMQJcDym+vL169/W342+tX5+SMcmmvwGslFqsa7YTJOCwViawUquoGIlaJXNVKFDL7rPI73wzQMZhRZPOnZDLXNoC3WZ4Lqm0tA9HuhmJqxWwAdSOrWN6gTQdFpBr5FpZldS6TuSWSvudG8MQXp25Uw6yifnH30orfoUFbfKfp7DH1+44hz6b5ZKjdqciRbBfiVJf6kF2PLFg/HmQkZjavknfFfJOXscxrHSVVedlk7XmmqFeV+iuWNfZs7rC1wPhDpKc5hEBfu3CwmmpP3OoGY4Oa/6atvW+zIi1vRaXq7A9Vo4l9ocgorFKzStVz3jw0IY2sUI113mcLHbW9LPoEbiB69LE36EorTunLTGU3YYnz0rNKagMRQWlrtMtUElBvWbzD8jbE4UBMxsbQOZquG5q8hK13Viar2vdAmJe3ru2trEWcAytLW4MB77uHogvPF7kJBenHF/rL+dn7v707F6/O35+/fP/67YU+X6tGJY1IK3kzlEU6TKtyqRMQVvLmrEhfVb7joUMJJpXWjyBo/Cg+ZRSw2oNmPFqzQrw+PxgekefEaw0kKVd5WjxtxI1qRIbDg+5zd6qxwdQ6ZJPjrjsYytnzWeA1ev9pmn1+6h8weoDbjYxzhQm+oOzXr/QWOqpf4tD1I+Np8sdtvbykfqjUW7Z/qKr8LUubuVYn0KWKd8nr1W7bjlEKKryUBqwxsE9vIHqo7Ip7TjTmjpMQNzfwqn4AcAOxTpvQ+9KLriPPpojBOAezERlRUUoZ8eRU53aEf0GHEHsuj2MhfjqlQNTOBwb0TOzgHtk1pUfePTxd5WJSOr+9593DJE6Mi6j3dTyW457OB9hLs3qZy7sTkRV5VqhhnJfJp6m4BbRPxGT5ZSoWsrrJCkpecSKGk+UXPQdsH9KcNU2VxatG9XvJYghHNmgqsOop+JhmlqCSzapSw5SW3Ovzp7VIqtVicSeSPAMpBvOCVgq6qZNyYJoOaIAJMZbpiyzN3qmkqaeBsfQL51sXGbp1tZuyWUQhYCZLVGdtJry1VHZ2tRof7KizniY15PxjAIh6k+ZLMxDjgZhEsIe/KFekCcSxeEcmOLbaxK82GYidB6oFugA9II64WaGVYzUe5Zgg/hT+rCgFmMda0MaILKL04R1dvIMT1v/YOTziRdIxqOJU9KsJwRdD05T4Sex6nPpSoWqvxylc8W53rtjGyokGgE5VdTmwobR0HPUc4/Dgxnsr72xoLEq4852TjNlk1w1cDs03cSp6V8VVEeuEsttXxbaxq39yKnbF89Aa0hWsjeVjpWoKLvDheiDybltFPrNDnZ9ORcAgi7zLPLLoMo/MjWVkvskokgMAaZtdPCZhICJ+w9F1i1wMxYTikl9VPfFc0BtxIorcc0iuTBJBi2DV83CrkJP6kZ5oaJw0eebEVjVu8m60rzyFVhxjx1Z47hBaAOAUsHfrt+M/EQQkSXHSmtw/bQ5nHDamiuqqeP4VaGNq3TPnsrZG8acsE8GatW8d4rF2UZ5SoWmZCz459V6eF+l6FQOv3/uwM0FjRsmNe9movC1U9UqzNdNSoKEO27x3GAvCAt6CIUlI2aUFhIh6sMGEhGphhI9KnRfpr2gg0e9h/9+X50XaG1AhhDX2vWLnsn5ZLilYspduObwPwX0yzT73HJ7YK4ukXN6hHKQ61Hnh9sfFUWrHs0iwCWqvrhEVxFWkEeoh3pEjZsUy/V9luVCp5phaMg+2OafQmo3OBdOx1Tmfp6Gw8XgxC+SoyK1fLdBTG8WEB7e0WVUu3jHR0c5GapNH7IidHRGnZHwt47pPqNC+NrQt4YtIPPPzC/37+X+Ii7Nf6H4NMPuk7uAIHSh6nLc01Lsnonde0P3C0YnovZDJJzRl7g3E8YnovZdxbyAmbrHJwYnoXc6zGQhMk8MT0XvZVDn8DQDO8qZH0fAnUP9XuaoB1s4Yysll/aZMPsFvqHdeJ72B2N0BeNzm7i5WulF/W8KvPf71qryFydrdRzxSbmEXMPm5XGBFAPhGIVK7gAgBABzewR7dG4g9wIEh7SHkKkOPHnwBoF9jmgaGvgfQX2Ewvt5A7AOkaW8gDiYnoncKwwN//FLCOj7esX/u6j9pEMYA5UcYnfEhV5yMAdYQ/poASiP8C6Btw184NLpdAnK4qyscHTAakyMD7gi6O8C/DOBjA/jYAEYs/zcMPk7NB/gL5+Xqihva2YHC1/gBCj+F/u7u4AzheMKPXTuK8HPPjjv83HcG/GB353An7Ay83LXzBj/NrOKvA5cC4MWhTwQHu7vjHTtZWknY7zrdssvbJ3VXr9UeTcasMdKL40MmtsTe0bU49V8dH8ArcjvrZ1ZjcJYv5zJWTZasaehgH1s6FcftpixIjJH1ki/ZXfh/0YyzG/qEgU92uvoxmUxaHcF5gre9v/RMEkrNw7e3xYvXr16Ln8/f/Hr+zr+vzxpVyUZh2j9OfEd5+twQWr6eED/bfdJJNNzLGyNZubFxnb20a7oQ4PpofUvyRsBSQYxW+DTieJJNCSdXfNOU4hlFK/v6laOWnQbfT085/NifTuJ7E9DawG0HtGYAHMMaf+Xqs0LpdiKei17V5KjzdsaCOk5j4YYZvA/s23WA4O4xDQ260wwWKSLkyFYORt+LHfHc9PhEmF5NfeUigMIF/jhYOKAnGu409IvJCoV46WTTblZIP23k1EvoKJ7bPtFcj68jcQJEch828M6kh+7KPRm0so5yTXZJ7xSiN3EzJnnN+HRFLQAYdK+fLCi98oWLER9mdM78ZDHCKNJUzq4TSufazuvq4M5FntBRJiIQfLC5KPmzA7I1Fq0SeCn2RA/9WJwIPerelNvhZgjixM6xB5RH7teyRuQvBsJYewcjhgmNusZLp394xLjxsZTzQJwKbZiBsa/P0DwFR8oNvoFAqQZ6DvYnHGfHyVkvzNBa/fP9o0isPaghhW0aY2fNnIQ03j2+WmXJAEwOcaq+YfiRYC8XmmrhpO7MQc3eXz5pm2i904eI20mvP/3GpQnsOhggPycXuYJWdXNRFr9datEaOPZ44Iy29vS9utyOPF1ARrVsoOFTB13cHijW8E+nbjPmfZCAD2bCVh8QdFpKtnZXWHus1GYmfLR8gVzws8r1Rhzei0JraVaZPVEPlh1OqTMwp1nVcVw0cQDDIu1DrxQ/6RtQPgxmafa2mdMNkRuQDdD+VVbNWaPxdgjL1NoQjGVAe+SjZAIKDdslEpiQrxhhGqN1UBzXZ4SP78PHqfxsnFgkDvTk4eiv+CIKQwDzbh7eGbgbfdYZFHbdHGOSfngx4F4hGJ7YjsDlBuMnOsBs5A0yDaUX6tfvd4eS6nGQsw6oQWsbwpibkj7pw9n+dWE2PqSdgUizaiDiu78VWeNJoPqV5+KcZpUxoGKlnHnl6DCfURbA0DTWMg9WMOKs+5yXXZVtJCD2x8HAruinhEysKbE74intjvndU7QbWt3MRZwNTRZnmXPoLvWlEcOhyEZqhFF06hotgtSs0alBFvKT0mmX6rISNyV+HmDMEoJCuasK8e79G4Q4Qqf8psqST3ckQbPG+unH1WJZPx2wwzCUl0XKkTTfvyN00JKJbBDQUYidj8pZowpRqf9aZRWHRWeM6DLpO53SGvu/oDDmshBlQV5PA2+Q9PBQaV1mFJLF37kYEwabg3aQhuZOm7YZ+G7oBqC/KW+yZDP4aaA393kdQNSGnAN9VoGXH5Zlfe3c2WhP0hapc5MtYRtIRZwEqFi+6YYrQHNmauCZI6T/8INu9ictrkdtR9EAAJkNMoSvX0Xw2iYPMhYQHeOh/WeZfXY3KsLh4jXrBPnmen0YimeC8pA+6oSzThH/YItIJnQOauVpMfvFenQihzmun3JziBxOAjILsnE9AgwdFdtw1gX6fiTVu2mvNAIkGjqsFgUJLs881pDh49gsE0pkXK1dtu1RjQ5/YhpoSdfPSRw+CZ2gt7cFEKjhu8SC0PAqvymrrJkv7CXfvGmWJ9vb7BU6Kqubbbr7rbeb6hj+G052R/NmkZvYbAEUjG+CaW3xPJ3BOEcCs8aiOdRIh8As1BCdSaGhWmAQIwDpp4WtT5g3i/6b6EQIAQeFYVMO8XzAEZBE/x1+w3fwEQpxVCzRP8OK3jdxVsk4S6jIRPTPL6DI+aoql0oWrGSjr1uif37Z8VVcqqWsZFNyue9F//x9V7n3qlpkhS1YiP4ZNkc4eI0NRP8lNvayXCzKdU0tRP/i8pfoRFyUxfByKROYy19k9YkzaIj+C2wAVfWyuhMXatVUesOtRf8FjtavspI3lVzOQ/iN6CMS4lLdoNFu8P1W9H/DAr/Ns0ahcp3jcYn+W2yZhCVutvYCaulb6pnjcYxBPpEqyVe4mas7IZdLpV2E+znPOl2EY95FN9dJzbo7Qdl2SHWETInNmTksVqRzx9EunBUc4RQbLBMQq/W2PHIFfr1gTruVsi99kiUyLlPFPsRj2O7HX2Yze4Qpb99jyVPRi/W/pm5u69j7V9d1c3tx8f3331/gv8HF4GJi/tG7izdr/z3mOzcUr/03gOaxfYL3/feTCf45ebMJ/IZm6Xtv+tjh+3Iw5iE8cMZQ4vIxw1g5/wbVxcUC/1X/yL+F949fFubf90UBhar/jn+L9j/sGQ+ePTvPZQVD0U8cZyYddSjFPLbjL7NDq/xjAtT7UKJ9HjxHrvGX/eMx5vAAGHCWNLD2Z3sGWO9dr6MqTFpn1QNrJOpOoouKGNKsd6F0oFQ33COZWJSqLpR2xutw2hmPY1v5tl0Zi55yUaed2C+qX7/pab2HJ5u/O9fxAtYG+Bgf0o8jmZA7ow7XwdwUAQBXuN4eiKy+bKoSGdP2hzfvKniHLk71WY37HL6PJ4X74WK1wPfw1qzBFzpIQq0olDL7BVG4BLT1od2Ew0KghZpQvKejrSgmNnozcsP9qIq8dmhAfJLFO5elLPp87g+Tc3I8P9aDCUfFw184Hwtp9PENmgw1pTf0YciWuqn89fGEJobM4eFrlzqI2bai2HZaMzNghuXGIQ6VOlCCdDq5KoIY7VibrGI4JJBZx9CI45+a4WHcJp//bTIS51/kIisUnVOLshjWLAosZPWJRAS93dEgVqtiwKddbebKib7grAwIlJSS6+LyF50Mxn2t8yN79Ylzm2xeUDXD+OWYNQwlZq7uIJE1aNVvAd2oxmuuLqvRmgEFLMSppa5gcIMkSjyuONKOqsy6wKLxx6IXmSKYQ0999s8julWcTXPCcOZjZyQuUe0qYpl8upVVWtPVG84OpUpMFAVUMRJioUU/hrIqmiynHDyYM7amxY1o9t8NxJuBOHuDwk9dVhjkDPVJOPhoaKfvb9+Yb4N1cxwgAfNthGIGQ19GTi93R4Ly/mFWtLM36IXxbt1MsZbyXzlRkx6rNPFX5U9br+gFs4ZW2swjaYFjCN5I/MnI8ep0mwiBvutNHY2eMxh7GCiHgtqY0ay1qCxi1dwqhck+w8GuwxXIgX6DYg78hM4DLegWEDTD0PUUY84+7JfTCh3P7paqc9YmA5fS6w/jazNrmKnqH585TPPPwM1Ecp2tyTW/9Id+0jWfBuLAg+iAclOTm8TJbstfv1pEil60Ye0/tOz3KVjSf60Ur24zhY059tVCph9losjLQhcIl1ntkUKet2jGXYcHIzpi3Wa1GliqqDGAktuyyeRI5S0IliZGG/KL/HMrdRDM5cXGufy+F94iMD4UiRbtPqb4g2jR0I4q0muGAMiSm/y9A4kce5a5TADpfuaQ3ZDJ7kkvovQ+G8ADaT5H4jnx+xKk+MsoxZ8qUs7pR3A+XqMNH6Lh1oXhpmC1k677A2fKD/+bNpg1m4t4Qxk6GRDvLQ35iT92g3nzf3+n0FvDG8NfOjnLm0fvFM4+0TEtF5OQARSs9BCN/MSXBEb3xkNjq9erqmJ7SjMX6ksD2OBwwt9lQfmp6yxVNUY81bzdwjEtjOywAytgFZPmMDLByGsE/U7c4g2IyNxdRFULvX9klciKWc49K0zPRgLvxYflbIgi3bBaFaIPRGPhYBbYIg3KKCAsTNxaqxRkRCMSOqcLh89d7IzEWXEnKrXgPPPe6JKz2iJWKQ6gHYRvZGo095ojmslHL9lvZkudgICNROv5E+e8Rfb03OVOJ3ZNREzW06Cuds92uNdzl3k9AoRlj4zI169C+2HDQjpxT/j/bRzvZ057lCq8tEBeh6uJTeCBgrTm2b9Zw5y8FlC8ynIKdbhEpWHSrGROpFdzxFlxicEDbcahssjvRDOvlLPIuEZ/zI4+Oh2S1mxT/iCUr9JS1cXTBqnUAlBflnmWZI1Ds25geXTGxMQpgDBgazWVpbtGgX3ndwNMNoyJico8L291+hNab7Gs3QEaOadYbYzywc1bvm6thOvE1S5sXCquLU3WIp6trcwKlqiJWQtWL1d30ZB5BB6cC3VrVQljc5eTRdHGq357n5kNgBOd+iYXD+Fr9pIntKI6cPQWybKsaZlk0zCfujuqF6uF2/uP4aAaw8+yFj+JjxEjzancJHmueSMyYVuCj1E0DQChd4Ceoo/hV+72R0bbnaQ2ljp/8X0A5EH0dgaMQieGNEEBajyZgFpnEnl3hLJvGCGfYjrSDrdNs9CbFLPu101lku5f1VvbkTdxpiarrBY2VMbULzRaFfU8mzUtsh4P3FpRNO1A0JpqPoDiVb31bwGKTt2mFMONOHauOzohOrXwVTei7ZF0Lo43jMNkYIeyKb0fvuNw0MCTUxGOTfRgn3wY2BvTJd8QDkuy0jG0PH//87k4v3gFvxyvFe1dfCp6+6PJ4WhM2lGG5wZzuI+m3/2fAAAA//9hL59HaG4FAA=="),
-	"js/playground-login.js":      decompressBase64("H4sIAAAAAAAC/4xTTW/bOBA9S79iopMMeGUnlwUU7CHxBm3RtAlqt2iPNDmy2NCkwhlaNQL/94KU7Xy1QC4CNPPm4715nExg5rqt16uW4Wx6+i8sWoR3Di4Ct85TBRfGQEoTeCT0G1RVPpnAV0JwDXCrCcgFLxGkUwiaYOU26C0qWG5BwOX8/3+ItwZjldESLSFwKxiksLBEaFywCrQFbhGuP8yuPs+vqrWCRhus8rwJVrJ2FoxbaXvr3brjcgQPeUa9MOVDnmWs2WANRVwaLWspUsEXvA/aoyrGEYO/uIbi1qAghM67jVaYRqa+0Ami3nlVJ7S2XYjwQzQFpbON9uvLwOzsYug3D8u15tfpmRFENRRLE3CIJAy1rp8JK9EMwRoaYQhjShjj+pvApBXOjJZ3L3NXJEWHH3H7JNF5nA1jazgIVR6WTiJlmUcO3oLFHqJ6mrA8Qj2SMxscg8efKHlfkU0m8A29brbDoaJK4rm27O7QVgm8ER483sN/acT3T9fvmbuoPRKXo/OE8XhfuQ5tWdzezBfFGIpJkr0YD1yewAiteqQwxKnXLFsoU54FBzpsKuM1z6bTOv1lez6HsXvuw4/CRgTDR2QkXBbR73s3qKMJQGkF1jGsRZybbB9JSucVnRR/6r6L313K7MZ5thtVUa/yL0ch5IVeowv8iBjBAxg36Ft5NE6ocnQOuzGcTqfT1Pno+KPl50FKJErmOnj8hwsgPIJ1fTT3CuPrOtlDtl0so6dlbzHuKz77A7xeOAkAb8PF7wtwjO3yZ2/9PP8dAAD//zRp9o+nBAAA"),
-	"js/playground.js":            decompressBase64("H4sIAAAAAAAC/+x8/28bN7L4z/ZfMd0PLh8Zkdd22l4PcpQicXJNXtOkiJ32DknwQO2OJJ655JbkSlET/+8PM+R+k2RbbvsersAdro69Sw6H832Gwz06gjNTrqyczT08OD75Bi7mCN8ZeFz5ubEuhcdKAb92YNGhXWCe7h8dwVuHYKbg59KBM5XNEDKTI0gHM7NAqzGHyQoEPDl/euj8SiHNUjJD7RD8XHjIhIYJwtRUOgepwc8RXr44e/bq/Fla5DCVCtP9/YWwkONUVMq/eApjODmFoyP4oXIeCuGzOU9bCFUhwXBaliV6l87M/v600pmXRgO6TJT4/OKHlwPn7QF82t8jsJNqCmN49+F0f29qLAzomYQxOG9ThXrm54cnpyDh0RiOT0EeHvLMvUk1TSvt5nLqB++Se/8vGdKMd/JDms2FPTM5PvaDgyEkp8mH9F9G6kGSHByc7u9d7e9Z9JXVtHLz5nT/KuzSVL6s/Ovp1KEHWrLz9KUsJD08efjw5G9MgQdf//V7+aTdY6ZQ2Nc8eNDsUBNLxpCbrCpQ+3SG/plC+vXJ6kU+SALwH4VGwmNvOZcKYUCz0qm0zp/NpcrDrvmhxcIskJ9ujAob3NzE1T5xPjePK29cZo1SEP5xzLpSzBBE5U0hvMyEUiuQU1hIXJZGak8CJZRFka/AIr0maJkyJEWGIUyM96YI0hjgsYRekGgSQAfTISznaBGmUIgVFJUXHnl0SwCWHlMgLMUqbcnaxXswbQhryl3JGsaLdvNjGJgyDX9cmBIewfEB3Lu3v7fXPn+OrJGHPDRTErWPj+5Dd/IBPISTYyL9dEA/5RQG7VKBcb3FxrC2BnMtsEiUJeo8iBDk0pVKrBwUblYrZ9gWlEQt4QCln6MF53NTedKBHK0dgrEMzIEA54WvHFRlLjx2iNpdaVC42RAy5RjbPrnj+CDPe0dH8Ey7ykbz0cEoN+hAGw/4MUPMAx+d/BXTMI1Fgf5flMZ6oT2JTmlxgdqD0CCyTOaovVAg9VRq6RGUMSVMrSkCiMp7tGoFymSXUs+gKhmBwJv/72BizdKhpQWJCZlyMB5DEoiTwOfP0HmE1iZhTzy4pzOPuhp/AMFgsMa/1moFpSWlQGuNBaMzJBi9+ffHxLJov053WYLx2JsLnSs896ZkSdrb2yPOjyG5QFtILTzturRmZkWRpmkNMpIcc0hINlu4qTfn3ko9GxzAfUhgsvLoDt7rJABnahAxSELCs6t9+i+Q+7FakvBh4HdPjhwIi0BSBBqXoKTGTaoz1Li1uxrCVIlo0xry8fR79xhMKrVGS84kddXEeTs4PDmAL8aQvNf1kjXt3muiSuFm/Q1uwyizKDxGpAaJK4VmmxwMb6aEc69EQVMy5ZrnDS4w7nq5ws147m77DerYWnU25Qe15Y4u9ekTEEqZpYMS7dSQTMzg7M3bp2BKtIL01BFXSCviFMiFFxPhMGVP1gIaE5WSXyq0qycr2lUygkbZfwk0pBkWf4Exc/kfP7x87n35Bn+p0PkgofSORn8LyT2GNU7uo6Yo5O2bF2emKI0mUv7X+etXqWNRlNPV4JeDAxhBwiJn8ZfUlKgHyXfPLpIhJEd1/PBtRG6sRYH3FAn0+OT4mLj5yxCmQjmmEkNwqPOAkVtKikgG/JRlMOwlEw7hwfHxqBZHR8LPiJXCOuQJFl1ptMML/OiDBsZg4VNSY5WMaObnz/DuwxASc5mMwNsKr5jVIUZqlogSeB5UhxBvsVrTzVHzuosDo+CWQg2Sc1OgnxPDl2Qxl9bo2SgZApvuhI1RsoYyI8d0Yuyu9veuhi3PfzC5nErM/335XkQMG97/h/W/k/XBwHU57nbleMuvH1+f9xmWbGXJGu/dwR159FtYRBz6k7LGorcSFz3myPzu3FnXpqPkvsw72/oj1GcX1rg0C941/vL5c1T83ZjGi351/NXoGtoNIdHG/53y1j8fr0MQ9XvV8O06o+E+uPR2Zv8Oxexv7E9G9RwV+t+tX0+fvXx28WyT8n+kkv2JyRzrKJhLb+xpm2469FV5ZnL8QRKskE6GUTCG9kVK6R7h9tiiGFwbO5NJeWI+JgdDpp6fY4EjSChdTob7e3uUkbyqiglaFwhID7kE9jjzcoEvpcb2hReTc/krjuAr+ktqykPfaul7D36Wfn4hJh14lOVPTVZ1Hs04R3UjeJe0ezokbHTAhgRHOlehSz4MQ4gfyZA69ITFIDk5Pv4Ljfvm+C9JmwIQAX/GiTPZJXoK+DWl95nRGgOJYymGC4QWDP1YNuOFziGkl45LA0qByELC0GTyceZSKgUksZ3sOmQPEVwoitlKa5KXcZABTo8fW4QlQlZZi9qrFeBHzCpOW10jYHWCwqt9y7DiLjBvoK1JTrPxtq7mMmI6xaFLqXOzTJXJOAVKS2u8yYyCMSWic+9LN0o4Vl06N0ooBF26ETkjglNZRV4qQLsPyRGr8zrMuXGe3zYkpfnxj2g4fsbJeUCysurgdL95nxpN/KLN9cspvYIhu70OIUimuDS0T9n4G5xJ59FyOW0iskuYGrJaGcoFUbVA58QMHddLOgROu1jEQV1EuADTmsFgUzrend+nlEUygqU1GTr3QwBUJ7nX4ujNWl3RYtxiD69QS9wgD9cTaorEtL5Xt0qeSteSjHced52m7zXpUF2GiBZqb4uk7e059BeyQFP5Xr1rU/SuhnByfHx80KnY9QlS6xiEulbDlMxwrr7Bm0bKt9CV9lu7i8LN0qCv/Ji9RdKRnmS0TZomFsXlaT28EPaS7J7jwcRssktrwRwt1DC7V5E/PgUJD8OcurQF8v79ToUnl4sbyim5XEQXkstFr5qSBLP5g7CXaJNmSLewktzTE1eehpetwfyuM3HAqL2THw5PWiM7JKQa7vcJEmTj3AvrKQ0mX93YtKh811dvJpX3Rp97UyYHaS6dmKi+UG1fy5TlxlrNlDsvVmO5ba23McjcX9OZmsNrytEHEWach8rpzSB4yE0g0NrbQHAxdhOExdJY/6M1U6nCTm6qTccwjqbcINPhKOAGMRVRSEU6tzgl0TvKV1oUMjsKMRQtkMo8DvLCzviYJfnviRL6MonPe/LdLbGKXq1vDQuKfF6ZnJIqXkaLAg/YlcTUqxR6p4rlHv16PRL8dhc8kvf+O9RoBZnMgNQIwlHaFiji4K7QdQ3r7mVSWiVo9hbRmRpbiCC65EWixZih/0moCgdcLG7kIojP0RH8PEcNYSoFLUPwdhXPKdhihwMPK2dSC0VhjjN83MC8CX/CGNrFzvjRoBU8z+c/7YBzluQXemoGB6k35Zp5C8j25bd9G6F/SsjwJaMaH/prCEk2bx9l86v+5HgYNTgeEkob9rGTaWRGO6MwVWY2SCp9qc1S134tZBUd31T7xU6pGcbw6aoTzikj8vPwqs6/iEEyJ47oSim4d6+em8ocxmOgYTHrCAZvP6o6AW8q2mldQSFYnz+3B9b1odwXFn1qLhtY0ew264/D+s1B10sjQggcITU74nM1Cvg8FqXiYzVKzii7JNhpi/3p9hek1fQqBplz6byxq7Ss3JySORwQHkNIOMtMbj5FiDC5gn+QhkP4cSi1XAEqh7yd3VZaT2N/y8pb9nm1f5tnexpS855v67CE2Bn+6jJ1f0NRumtn8RAljuHYKEQLgzo2WH/9PFCH1XU9liIZiR47CEgdvG4rqXwK6jAim2vK5CoQcrcZ3aDu6qAOrltl6uzxtOllCGpx1Wm3cGKBtZo1StZXq1bYe1CIXXw4FtzGXdgfM6pY+Nuwuad9LMIC47BQR+nD9DGD2cSP0sxXBrK50DMcgjO1Orsqowg60GubfQhVt8EnmY86BmbI64/455DXHPHPq1vMxl5/GxBlvb8JhkSS+lvkf5NbpAf9pz0jt7OStzDWlP1acbJIdvuldD6UlD41JI48Xwi1g7Cco7DZfE1c5jJHbisiNCPRGRyZsUYAeqxcO8IKDvYmZu01izQU6xvIa1Yg6R58ChKyEOrq1oViJnxGWgym8lxpKS0upKkcqEBAl+7WGhTRiWT/g9qDGL8X3Khjkc/xtWl6toYgdS4z4ZH0aQ7cWZZG89danjoBJB4dt8UDJW+ITZUMnkzJ3ULDV6TZrlLeUQTm5mYZQ0Ul+3EtEfVZUfpVsou7aojZw0LJWKvtG0Km1WMeB0Iz+0B6LLj2ggu0q0aX9mN+U+tIl1rDKOMdFSuMxUad6hqfxalFN4/PaSXLzRZ+bk01m7MsxRarBhUHDjlYDZ1X1pRoQ3uWcI6tkgvYimwOM7lAzdPSrm53V22Vu1J3lM1t5YJKpRlRmHi6pWzQSE1v3Dv54TpGv/BYMJ95HbKTnOO90H6gJEfJjg1bo6jBTrYWLwb7SvY0Rck1PdlZTrtu4KCZ2eJ9fwwJ1Jifo8KMK4ccbrf4tXYcrp/9NAxK6ii74WBPmGh/jYYHOSliV+hkFZytYzM8pAEaKERJf5cRrw32F8Fgr4XqLS6hBE4SzKy2JIoOfkVrIiqqjriZFyTY6W8URUJpm+h1DFa04j2/dp05/x6xjB1zpGkYVndQaS9V3YTXM6jEEjAWlghzsUBATRrMwLyBqVSKN4raS4u1X0gb+16pTsPgfdaObgfimBWm20PY7qlSbfNURyHaiK9v5LYo0jUQDurRdV9GUNvNofX70x08N0djFIXVk0bNb7c721vc0tpGb7LPvTjqajMQaloza9sc/uIGS/Qk0h2DXLmOOV7IHPO2J3q9AbNGZ8NV/KFWeI0+awbYtRyqTe9dvLnRmZJ8CBKK35Q338HRu47p7Jlw7h2Q+drzjvC5nqTd6C0470g3fcFOpro7uWOod7PUvdk1b+l/SqZ8IJnGNl/CWRuNPKlSW8KTvtFvid05w9lU5nB+E6qUfWUOwc3CyJzktCg9yDoqlI5sGOcwfDpYiBVMEJRxPt1IJb/YTE7qFG4jBeyY3l6G2jTZ1m/XykTd9+vxShu4tQb7fG6WsBRWx45Z2h0pRmmckxMVtgJLYy/T/XACzsfI0iscQcKVn7g6Hyd7/OhHkFw0Fr7ShH4e09Cm6TIefDZ0eE/hwMXcOGxG8ulqpCURXGgTWrhjhi8du0HMU/jRmgwx/zagsCoJtbgnfkQx8ZnQGaonnEW2x9CZ0VNpi/D4IiD/T3TJxrszkt8RJBbz8CDhQ+mU3PFgy2ncdq71DfJ1vGtHbeHg1bB3+BdbX9eE/Q2SqehcrRAFPjEfd65SnPYqG3F2L4y5JrvmvmOa9UVrPthqhbyrCwj6I0LPh1CD5IVeCCVzeF236lI6fia0Nj4KR1giBvhrRcc06bdhdAQ+dEfHYkqSdEspNc5tqef341o5pFwIKeNijO+A2ufPUP+1ScaeDm+3MbuYj23C1eb5fEod6KxBG32IH6XzHZ3lyy4aZFEq8jLBWaVNk1A/mglv6wIB/bw2aGHitiVxCnv6teKrm3cG9R2K0J7hjQ2nESLzlVBsq3nzvXj2GqfxCpeDeLy8Zvle4XKb4fsxxDGx7M27TUJnTFnRexp2F5NUm52JqrC1O3ulxbMwrNOk1QpIHZURDj9aU0iHrY2y6Ixa4BAs/guz5kpFR/oO4Nv4cpCwy86CQE8wCPMXyQEQUxhOPLMJ7LzaZhRbvHYxjDceWPSiz5sj3t2ksC5krhfEdwypexa8L6XX2fxbE6ld7Pu5WOA1gkmv/iOZ/1eSeb1EbUR0/+YiFSrrNx2B3FRUZyLe7hLjEZ/QTVxo7B0c+BZxD2hvj0FrH1CiLYQOfXYRg87Fn3+n8PHTNWIWsO7wZEfnecXeMNTYu3edpAY+/efCF8XwUyGV2yaBHEz8b8rdmyqGzRstRbccSLZNeO2ZoK1I2ih526aAbR/i1qPFws0OtqL4d+5z+OOwjC0Xfzyi4UJkHfSvLxuOWH8r7OeoNmDz4Tn9Sjn9w1wugBP18Xv2JodCyZkegcKpP+U226kyy8PViDsdT6EQHw/nXJMbwYNjLE7fJ496ACePflRiNbOm0vnDo8kjjjhhiRMQZalkaDgNeXdon+XrlhPprbAr+C7m5cpwQyWnmGwTvDGqLjiFa91iIpX03MBCVol7gFktFsLycRQDaipP70MjdXearXSzYKhqtYj4ubT5YSmsX0EpsksxwwBDxM7hNTgenXeMwwR1Ni+EvXRpnzD20U1/X/DNco2qzraJA3wwRPSLJVRKn2Jq3t0cr8tlqtiBTBmM9nbFBIk90N2s/bFexUJkFu9VegMiHqB0U4VedyuvzPMpkG4Wj19ZCF6Vv8nQoEL7eEioPnqFy4dH/Bt5j/CMo57Hrn4eDosdL8CtSgyRwOjOclxMXzWFBqmll0LJXzGHpfTzcDO79k4EuJWh5mlEmNNSRjgcGXAPq7FMzVxazMj3COXR1nupSaPEBBXmcR/vk6d9b/g+iXu6kwQ8E9m8x9c6WdO5XMic8qDvTH1BmpGsiRAVKe/cmScJ4KdiorhUL1hEwVXS1yTB0PmlM07LRdxNIaSuWdKYE6bKKo64QOdbZhKs8PxJLfnr04n0ni95Iyy5H39V8pr1ZiTttcbKYWdiGysW8iPmp0GWpRuC0WoFRmMEFnxlqDYVYgWc+bJIyN6VYV7lpXBerYZ85NjIcVE5XqgzZ5Mi0RbcibEviH452tBrFyL6qdRoYWaF1KzN2lujwrUG/uJEJVXOPCbjgnohrdEFas82KEPrhdRQiBmpJmSmCCc2UbJLJTKShnDxwZuyLrt0vqPSoXYz3YvLEF6Qs4ubPzoqG3s+8oJs5OyEfjyIJGGMwscvwgwvZjW1+IC9QOBvH8ipRAtTo5RZ1t9u4XMFEj474wpXEIK/Gwv4URSlwlGPsCVf1Q/eqhR5LvXskOzkCE42XVEPc/5kjGORmpmT9Cv++TX//Ot73RvKtBd25uDQigzDBwW2AiUNCwNJt9JF/JdY9oyvC69BLktrppCVFRRY9OE+PCotrjsEh4HFLYf4gnw4fGnAkli18hJtQX1Aw+oVDGOJGRl8KIUVBenjmoM6yuWCMQjxeh2st+4cKJxIhjD3hRqFu1O3ZZftJf+wnXicVN+gcI0+zI0/vMRVcERtI0BtCETwtXPhCRbFA1bOZmiDHL19EZ0HGzSHLt1vSqZGX+IqN0u9/VoIt8/yIUJd1U+eaY+Wq3nhxSWuzuK7ky8P4BPwZ0I8WvgeV3UHLo/kL/Z8j6s46E3VONP95vsXHDqfAkCEHj8SEj0IvWqTgljZaxcQisC3qGXeqna9EPJuLlmHwqe7LNkUNm9bsXPdr0enzo2/b74e9YLc09t3Tft43sx/MOoFsjvO/76Z/7dRtya4C9W5Aa+e/7cvR73azY7rnzc3fP86WkvUT3eZ/xRVzQk+7rl4/fT1IHca/cEIHjcWIJyZc/yLOgfnc7l2Te7bLifjIVkoAvT6zuHT1f7VmsZU5XZ96bO8gdm5t9M9kxj11KOjSA++uUW/+Esj5NWRNe2SNW2vf1ASer67iExUFfvRr7pFiB5iscHjBtT6OMSle+v0GpFvwWG9ZL2OVocT1+L05deN3clrhO72kSjG89YPNfXJdnWz6Q6fzuncsOwmKXVQxe0h/d6ttlGmiXO5saA+GLyoI7zugS1+9FbU34wCV4oMh9y30MtQpkjCSTE8d6i0fQmxedRoi07+SqzrdBWd7u/eVmd085GtHoQb6BQ/akNEqFyIAiMWrMXE4oJv0rL/Dkzju3dCowOLSni54G+RcZcbh7jhxmpuxWwm9ex5fc1q2Dz6qb15de3maOwTYZ/ztgpTOVz3kyR13UVCj/LVrSB/2g3kT9tAxnl9A7SOSLyUu7nbLZAKs8DrnX8DNfita8xzLPFlxXZ9c09WZ3WvxKBzNzo5eHfcdJzMY3dT7UnZfP8TDiErCNQTCrGknp3x8zeY+fbSTFbEbooGxiD+xt92+7aGPYLj9mSyIc4uO1vK3M/XcPtHuMURXgzCL4/gwVe8YvhzRH9uHRY1jm85/kzPDh98EydueQGjAPDGHleK9ONNqUCNetHbp3bkMkzl6souM/nTlL1VC2FnUr/sALh/crxxFim17Fzn5q6VTgf4xqVu4ef1cXZylLTdS8JaXmX78NSVSvpBfY2m3xsjrH0nbPuhyQ/D+CW/0JnpXolXoYEhfCaug2Ede2x81uA0PuvcGq7vEWy0RRDUjaJ0kM619uDtt6tDY68Ti7XiS8o4vKCwYCHUoHP+M4Qvj/n+MntK7l/+8hgcZkbnrrlAYPQEp8ZipblauGZkesdJp3BFoN6WlFmIGfJXISuL+1f7gb2n+/8TAAD//6bNxU3gVAAA"),
-	"js/sweetalert2.js":           decompressBase64("H4sIAAAAAAAC/+x9f3MbN5Lo//oUsHbPM7T4Q1I2yUW0suvYzjtX4pWf7U2qnlZ7Bc2AIqLhYDIARXEtffdXaPzGYEhK9u27q3r5IxYHjUaj0QAaje7G5NmTPfQM8RUhAlekFcfo5k/jo/G38ut7UhHMSYmWdUlaJOYEvX3zEf1MC1JzMt5DzyZ7+WxZF4KyGuVXFbvE1RDNcCFYux6gT3sIiXVD2AyR24a1gqPT01OUscvfSCEy9PSpKV6wclkR9ESWysZmtCZlhv6sC8a2ukGeD9CJw64qKOSGHkCvCsZ4UaI/6x+5JU8i0ESPP3gM8BoZTPfuczGnfIhcPwfoE8qWnCAuWlqIbLq3h9ANbhFf4epdS2b0Fp2iTP46HrnSxpQYTDkVZMEVmxRIS/iyEugUfbqfwscZa1EuSyiiNQrgkYY+h6/n9OICnfoUHCBToFDd76k6YtnWuqosuA+of1lhzonks6I2P4daWcFqgWlN2myoPixYiSvzg92QtsJr87OoGCf2B6sFqYX5yRtcOCwFq2e0XdifuC6IxUoLVtu/F/jKoqR1s3QISSVlSf9qcUmZRTcnxfUluzW/BbkVuCXY/L7BFS2xHAnStqzN9hC6GFh2yOY/rpsEM/iyKAjnBs0KtzWtrxx1M0vB70vCQRb171Q7JZnhZSXe4RYvZFtqdAUVFTlBma4pSXe/5mJReWXrhpygellV6nex5IItYCQdEK7pArp6gkS7JPpjVbHV2VJwWpKXFS2uO4WveYEb8hNZ+yV8zlYv1cj9sBQixAmFMI6mbIYrrgubluiKAcE+ro+qp2c/ZYnCl6xi7QnK/vDV4b9/XX6TBFH93r9q8Vp92ddQHlG6kZe+vPnlth2Mcaq4r41L+ME/iHVF66ug6y25IS0nCpwHRTNWLLmiJfgOrJRzKcFJWfYzwyVpz2rLUq8cJszf2srnM3z7lZZi3vn6H4RezUXns+6o+yrogrT+h5VC9/XhoR5hXJbQ9WP94RIX11ctW9al5OdsNtP8hEmsMaHJRM3ODN2hjCwwreCvBnO+Ym0JP/REl3+qWS7/slNc/rDzW/6Y0YpkrqV3FS7InFWlJD/zafgFV0sSfTtr5FThJ+jTvff1xVKwjy1d+OIOBR02KXAhWnq5FCTG84tad1jASFafNaQOv8Dgq0/RSk2I+I+Pb3+W28zzkt6gQlJwup+hA38ZH+uFGR2gbB8JfPmmLsnt6f7oaP/755OS3nyfoQO1Mm3CAmu9wsHFuiKn+yXlTYXXJ6hmNYkxG5xbsMr1VSJFssAutmNYIqExD5FExRtcG1y3owVur/e/Dz5WUgWoyEzIzsmCRHErxdyVq398ggOuPK4HZtFXnfjzl0CptxiF8cmXwCh3KYWOfgl0ekvcNm4wBoI2dgRQYgRZfbXfHZqIrsbNZyPLyC+f0dtYxpPDSxdXvV2VK2Dco+z5/Pj755P58a4M0/qPwpNoXy4IvRRAYYcCtRL2VdKluj31a1diYWHtI7XCl6SSGmmik3oZVotEHyd8oGhkVU+lJnO6b+D2ES13x5I9nwCBwSezI/SRZMt1l83vXfkVaZB9nJu3vYMF6nC3L16bSqewCnjMOVW8QfqkaqAaOPvp+USBPwwHaCYKhdJSEmgSU8uf2R2ccmdTKJ9KnYJPowlv8Nk9j9wKUpf+6QkP0aU5DNlz0jVZy5PSpTsl0RnKL8dzzM9W9buWNaQV6/yarAcOBCF8fk3W8gB1CX9MdcG9Pjn5RydsTk17CE2eyZJn6AMRaC432yHChaA3BOG6VHrdiAssCAcKtXqIcs6WbUFO0FyI5mQyWa1WY04FaRitxbhgi8lv+AbzoqWNGF2RmrRYkFEl9y7SjkrcXpN2VEgddQDtTzSP4NPPywWt5TD5vJqT2yGqlgvT58kEaS2EoDm5hZNsfaUOF0SeUT/AB1lvMG4JrLb55Pwfh6Pv8Gh2MbmiQ5RlA8UnyeE5uR1XpL4Sc/QcfeNYq7DNye354QU6CP846vxx7P7wD63VcoFO4f93d+hwume68JLVN6QVSDBUkoIucAV8L+a4viISntaMU7F25+urS6ky/SHrnK5P0eEUUfQcfTVF9ODAdQD4Ko+AuOXkTS2gp3x5yUWbU/QMHQ/R8WCIjr4ZGKGR0G+xmI9B683hzwWt9R/4Nj8cogIdoLxAz2BMBkN0/PXXg8FYMM12D5sk+eAU5dnhoZxExcA0Xmh+D6YJIW2vLt3h3kgpLJ1ytG5wS/FlRRDlzqwhF9+xkUgucHEtBXpWsRVIpNFq+OTr7+R//z6Zs9WowPWIjgDxiM5GeOQJrmllRPnItDKSrQQyS/mPhgJPXs0fH9lLidsMh+6dtvpEQJH5JzRuLEhJ8e9L0q7flLGdJHOFar2ZTNB7siCLS9IimL5ySSkwJxyt5qQliDUE9DEpbXNclxX8QEpRXtGqQjNalhVBKyrmiIqxUdzVWmAO+U1Lbihb8l9pXbLVT2T9iq2CI4ABeAGLyuuKLEgtogOBGd23uKbNspLUvjp7G/CYqIo/rGH59fkMy/Nf8YJEHC5ZsZRVxsCVD6BEsDbPxiCDtlLI4ysi3gIHvAYivCEleeeU0cV4po8wj8Kpzz9drIH54nG49daawO1ZCh6JGjAkMDtjwCMRSwRdvD/KjUpOVS1hgYh41gnfVGn2slN0HvMzHwxjPuSDC7dXJDBa08lY20nyQcJqaWAKVhdY5C/aFq/HTcsEg8WLV7Qg4wJXVa6RGonMB6Egv6iqPFPYTmom8nMQ6X+cGjXFLQ0Xg6E2VQAcaKhzWpakliVGXxwibZ2A/RgNIhbPMe/MPDlKQ9Q3/2TpGAp/plyMtfbH876pByx9A0q0b1+WHwxm+DEGwHzg9tDeBR9Pjr/609ffHX09Ofrqq6M/HX9th/4GprjCd4OrJZl6DcAHuclmqa83uAoJx2W5K2uk6DyRZVILeNIpNrzz5QY2b2vStlXGvKmoyCd/5wcTLWcaajxj7WtczPP+FRLFg4PLMhoXhO6j8WnJgt2Q/+k9Vb3Y2lk59+e0KhP7TU+HU4qYalvi+SsrCdfaTqSdgeqpZ1ce1TinF35TvrofzDK/Qqz4+936Tz5nq7gzBiugAuvYmDW4oGLtzQGvUFvOZOFlxYrrSE1JNcF9sYAP6OlTBPJhuBJLhqJV1/bFpI/Rjr0HB9Rh89Cc0wsPk8eVOS3JF+RKzWoSMSXVwqOYIhF9AaY4NH1MURPlg+ybOXJ2Z0GjS+KeaJ4oHO8ioIBtIUhuEWqSEKk46a9n7dOdivFs/siat7i9onXfOBsl85Udx+7gdga9IjO5W2UjeZxobneaK7a1OVxYmIaKipJaqEsMDZRoaFMLHvVTfyPOs5FUCrzTH7Q7kee+o8OBVBGa2yzejXFJ3tTdEae1IO0NrvwRP0jMkefoyFvidCXYcvWfd3fo6Jtpd2DdJDtMlCbXHsPQCnPJpIOarNArLKwCpkoFleerrsppymuyOrNtp7p0gHKHGY2guQGaoKNDS2lPT3IP9/eSMX9GR+jEa9HVT/XBFu7EbLhaI+IjXRC2FLnstjdsrqV7s0+YTxIyT84fKQxnoV72MGn4Hh0+QBpkk455XXRfbsy1meUUdQZ2+jDZGBlUE5Sbb8+kZAy2iMYXk4GAxTvLQLi+hlvDI2WFtuoi3ud7zUri2e0+4mtSo1nLFr75sGY1uxTtktMbAur78eHR0eToaHL83aRp2VWLF1hQeTZaj2Qro6JlnF+2bMVJOypkmyNyQ2oxWlEx98w4E9vuXDZLb0kJG6ZgJV5nHL2ct2xBkMY0tpKsbTRv2ZKT1xJxZJ5x/J5M0N+akWAjsEbipmkZLuaeIC7IjdBi5tDlGRCdDX2ppGR1glZgTRnar5fLy8uKhFfu7kZfHnvVha4ZJjt0kvGwaGJRzFWjkpRwe5V9tdaSoiVYKPqC/v2Iq+oSF9dep1SfUjXzzPWSZ5YaciPGtKYi6r3qlPpnE+U7E34GzlmOfMAlRUY3zGrVdBebHnKooKF6B90HSs8FLlgDTUr9Bl/h2EToz4k3tdyhBS2WFW6HaIGvCeLLloDLGtyxoEvcopIRXmcC8aJlVQWFC0xrLTEd2SVjSUPQfE9vOpBuJSXjpoWJ9Ur5+eRdvunmAUpqsf7v+OIiM34oUqYz71ATVPKB0ClId4rF1inodV3qOYryznoPewHh4nWVEFhlMMqzkt5kAzO3RItrbnDKw5czecLdzq/k8pqKF6b17ARlq/DT67rM3EzN3rJ/BtCWcBLCnQVQzEeHWF+lBQ9qvf3QRwXuJSFc4UOnvS4zwuNrtzweczpAT59aMtRQqA3snF6Az6R1mUwccrv4E+dcX+WFpQQU2gFsnsYgzomAKdPgK4IEQ1Rwa6BWlm17+uFEvGvJzQewnSdUCFjVtanY2eime54gs/qarEu2knNemc3HSXO5MytGUIHNXE6rTeXKLua4tx02NFAWFcGt0RigZ2Ohfnmmtvdw7ELlusYLtRUjNYtKdT2BwEbpHXFw6c+4KyKMffaH9Ud8JccyzyRUNjg/1EMa3nWkq/+wflPm/oWId4/nPvt3eLjUZ0aw6/hAqWUF+m/9GdXFbf7pfhi6Og6m8Q2qXHaVTRUuVjDIDEesVhiDKw0pYRINEaQNLEyNQr5F0MJ5CnXkXA0ra0NcQHU8N6GGnJ8aCXgwk1vRYgWf+TOyYDVnFQF3njwDl+MX4HJ8gv5WX9dS2BvTKbSvz51Y3d47RSCYs5OJZIW9eSrFfGh88ICJCzi3j+QRGOCVbCrtF8DV1absmfqpDrTTDrBC9LM6So+CKhN03FvNuf+5htw3NzdwWZqJ4U8GVHD++ROi4PyvDGxIfdsXEGtYrMHHUh7lYVlK5KTgPAuLqSTGn0beDDTWkq+nsnP/Fk7N/y3B3+LbX9P8t7aG4PszlCusE3kwUhfPR4cRxbSuSascA40bxl8UN3nRElKDSOQLfDvSfptSxhI0wWgO0KfATWTc6xcYAiKUaex4KRh6QhcNawWuxTSCkkKpSVD8OkDZv22Ab5WP6u4VPOE/QYd9gNm957VyrwcZ1jzcNKQu1ZqnWTzwbFB/BCdtKQQg8NFV6fzYyBPAGj+wHuhxj99YhAIu2UStVrPo0s2HVPqfBQxu4jw47X60K00KPGgouJHcsWf6EtIeLCUX4YdiqC/FZm6oAnWZkf29zgbj3xit8+z5Zft9Ngg8QiTX7HZmqss95e7OYJuLRRUpYUrj98qDSBV/ETcjGZCZZdPAyHDodpOotbTBOYSOLjmidn2p9Co5W3RodkgaKrYhS5kvYixJRvgcvLsLBqB3+KKdTTm3538MZ0DUPBhaYhAnBuBepi54Y1mIvOo9nU+16hVtarkL5lqHAAwE8u5tiPYqSvufBMvoNCbTi+JwJJrbUqViDlECNibmTaFZAHQnZijchY9Tfr6DQYcqOU9C3yhwgfyodkp7cgiFXHsJQwST8Rj2pTvCDzPPAIazwG/MHWq1macl+Dohtr4qZ+untDJw4exRyyAyTEfaWL1Ms2Man7gCNtx7vPojeE/vuk4aV+tx4Gp97jXtJrySXRg0a+6cTJA6yRKobSBXVBTzngFF4OXkIpxOPP5a2YNmhuYETLJg1QmhOn0UtMkGru5INzSC7w/AU7H6KolIFWyQC9VBFYi1S/dGADqCYLSHUKhCFAIazaet1JmIso30NcuKk5EB3YRUH2BO+iD0YtFZwMAB3tvr4esb8IrfWYYldHcZMdFJTvJ87GNOXPBMnvG2yOxSZ2uGku/VdhMgbhB023Ar720UNNioWVV/2r8fetjiK1aNcBDxvEukusTckUp1GxmRqTE8kk6NsktoUK1vLwMCpj1dizYzX6Y93GFn/E3NHn2TG3IgAZE8Kw/2DfqApyD7MmnU6O4FKq0rWpORf4+aJsug6BKlXfM3UOXr9743Q8e5IPfOBkOUaTqzLaqTrtChTMcoKsN56zyS+kgDz4ut3FTNBkeIiDA1lUMIS9TrkgplG2K1vrpRzt4BG5W+5XUupZx24k2ne9FwpypF4aPuzPGBCOsNKZj2BCSlF/moHPN5PMZhtKgvd4782IoCkak9XYGyaZ/w9uOIA19j1r8oS9tBNc2ME1mH233rggZRmL1p70ltskfe7Pc61NsKQHQaMfWGyR7rJjrCr0Zl1zFL90nZlUq3miapiqCiWeG5Dm5BHkIm8T9AwhIwrC1J+zOZiW0w7+UeYoDc2XgHmeyCJBpNgiTb9JbbDx/cfVc8qrYAzh7yZDFI8l8fu7KajdxlUHrY4qNauk7Hi9/ePfHA3M0aUnd86y26IZIzd9FURJBdrlhkz21l11PltpQ7p/t84NkYE12SC/YI0lykhdDAyaU/hEut/H67weUKlLrwgM23M76RF/sF0bJgaLuhnF46269kjWMmmPPrZVV5qUq80r7rYAeivNFVAhG/B92Bl7uIMVgK1mQj45Rn7z/QJeRiYTXcvBVs0SzlNqMUtkBaZvT2F9KCu8c7xml8c77T5UjX9v+RNQrQugPmccyGDSaCglekIXWJawEtOslrr2B4+PnhBbAwcXvZfyZH5LYhheAIC1QRzAU6Qtior098OYzP4vfOctskLqe8WyvDA3NW1iPvE257ZI/MELnlHdl8yyU6DSpPO0BSrwmAji7Q3V1gVvQtJAHosQXdi453AYHalOkI1H1XeIdh7zrNeldaUWf8osjVyijyKi4BbsZUbgdzXabLbEIEAJLzLTT79MB6viASbWww1RJQkxV617IF5cT5NrSEs+qGDFFLQm8X33ENsL4nVxDCOPnHOR7988Xo/xyOvhv/5+ji4C/e79HFwd/H+vfFp+PhN/d/nEwjjODeazGOBeFC091tHXL7SALzQYwmYcw1NWRP8uxNDVYuRb1c71rCedbFE/y+D03HvhmtR6g6RoXNZjQ1a0kJqyjyJtMT9NoU7as5tI9Yi/aVuO4P0RUTYHJLTcJNpjdNeXBRbG6HnbqnNlXw7tP3zduXxkcJlrVKs1plUekekuGzLwyBI4HUdZ1XYtIrE6nMIMB1dc2iCNf4dUKRYKiN1EDbvpTcD1FAVsceoH3DO0FHPkGRsTGIRwoWTxU9dZKav1E0idm4uykPgn4pvCpRTBLtbvYrmxBBB4FBpKtUpdHdXTShHodwRlsuRhCDkiU6YPPb7N6HP/RlTEigtxlzHst5gyDAnbA3PgSnkpIN/rO+5P2iA8t6vZT15mMr+CvqruK5eRRUhJuWDO2dfvggWYwx+DFzKtq3iw7yG23AJstNAItFCR+l2tXFumnQfP6YJEgBlWPR0kU+QCfdqMBoBPfS2kGwaGxd5baNq8KfGE7kBUrmsZwFV6ZDdJhe8oy9yRM56G3PhVYiT1fvim1gaX2VD5JbsIfYpVJL6kquWJE3TChyg7GYkzoP+WP9qCyCX+LuPXKr8dWaCLtUYBUXwwr3wzRxoGNsJEkeORO89FSxNIrOgPxiU7y8BsVG1duiTXm/NgcMPGK/VgyMudWdYqDcLDnpKjfapFArU5dx/HXMDd3XtS4sD0sAeXcX+BqHsR4Ct1cEwrLG+s+7O0TGvC2CU3g0mTZ7tFjg7U4tIRmkfGknq9/S6SlyxPnGSxNlrUr7cCrb86lPT4jR2Sk3IoSt7w3/RRkg5JnORrImjBOdTYt07m7N1rCQw85uSHjB6ZUtm2jn2MHA6YNG7E3Noh0MjGG2mrzXoj1Eo8PxUd9BKKAnyingd36b4bGHmtg23kfMXv+v6DY2HKWl+O8wFFtvE/6LmL75BuLz2VuyVf0/TtSP/zuJ+vGXEHUVMBSOA5yHKSi8Ng3w3hbuo6dPo1UzPWj9+nykP5rTQ3CaCCIG+9BaM1QKf7CvlxRSqySU1I3mrdwRuKvu1lGT0rRF9JF6C3mejHtE9YBGGtuumttj6dqoyX2WRpcW8E1fEyh6jXU7cvR+b1frn0EHd1fdKds/9VQK5L2dlptd5t8jjwXKCqWpiU8Be5sXmc7RtasMgwOTS1nU5wapILIgDJoGDo1eigiDL52ZBNnyc3phwyHlf6eh6j3tqWC1uIdVWIqHtaBDrJIV7vdC4yWtr8xV1BoVsY8tilJU5QMXBdpnHnyQrFgpgfzy7ng+3USmSYEMhDg67U3jNhr9W+I4XfoXsr2a9Pkbj3NBBIBxuO8/MvlhAD50dGhyjHuv8m5ZRw86QzUhJUFl12QT5jKPneMdOeMGt6QWOjSFk1b8QGasdW5RADWMam3yXNsRuw82DPkwSAk3JECzmfz2wj0LcZ0hLad1SW6HiNZFC4fZ+JA8S+RRS6VXM3z0E6G5sYeQLtwWc1hx9BHQZHXj4PSkc/yKOUE1uRWoYVxBLbCwofPpxDYdGvuWL4Sgu5BoQv574Pod6EeTCWpZVcFKJRgCgzY8PdExxwG209NeEuLNxLR/GLd3xWRLkOkhasjbulx7o6M+zH2UoBE6Cg2DIQElEaRd0Bpa0iODKDeDNYSRmUmurORiRDm6ZG3LVqRUiRt++50s2zX6o0oVMaY8z07sWR/RRaNQOl8VzxBTpeg+hx5dxCZQUo3ZbMaJUGFdd3fIflFeovoTLNyU1OI9KQTPBz0DYtNmhSGwHeNpPMPsTIIZRnTk7mcZmq7J+qUK6SNj8zeYmlZzWsyje+jz74bo6Ksh+up4iI6/vRgDs85mua44SErJZIJesToTqGRoxdprxGrZKkcrgkooKHBLEL5kSzHei5mUNN+qoDFQqZy3Sr+l7L9gbYHMjaJ+o+V/dATxiT/SugSR1YmgR6hmAqSYcim8GNI1VJSLoSxBuEYYci9+sZVmhvKYMadJMb+IZdLrDZ1u1hPvU2MymaCPL34IxMXK1ukp+q5L6BMy5nM6Ez+RdUyMVEDWRQXh8XZxDlQke8WhdhRD/RCFNqWknh9jb1pysyP2UYTeX89SiTZy4h+CE9TEfDr6Ssqv/+Wr486qO0O5G67kwqxcff/68fX7yYd3L16+VmobKRFTahd4Dug+j/tP/skMo95p1KTZiRURsru7hY8jVDfIA0wm3n7l8+74W89FJnyIJ+Gh+OiDl1FACS+yXdfyTbkY0Gkne8M0TE/iZ3UINgNfFdX3NjanxM5mu0g/7HMg3cHauQFT4Ge6FZXTMp89M9ifoQ9ztkK8ATdzRGsuCC6laIcxAaDqafNRIobBePz13yKCe3RkUHFFyeNO149ZS3VWqYq+oHScKRMwESd1d8pOAF8wE/vAvKPev4qd3kViyE6NaQs/k97bG1iacp99EFeD4MMNbA2DFC1fO/LygzWaJPv3ryBEAz+aks8StA5DtqYOfwBbNnX3M9vZsTcb3be2+VlYLwsXt6vdXLr+GvCdvzTPmNgE0t4h3v05TVZGpzGWhAlPPQ4Y7GYp5VQh6tdIkYY4pxcbhHpLlL3qYX/1+x3E4P8P0IMGKI4Kf9j4hLU3Dk/iJiFwoogvJeDsd9OpsJvXYPTOkL8RRCiD0DUA9tzdIL4uqjCIzpmbxGmD85RVBaBgaCKsBxuXIEgUtoGJ/wL+qajEz+BJj6eZv4/3sGSLgAXbr2txm4/TFvaGRl9NmTIn7kUdNo+fnpsHV91jq/ahVf+RVc+d9GIaI+u7RHEt9U11i8Ikk/cG9txVP6cXnvnLG7GUu6nD5w+1zqVlo0m4LVnNaUXMUurKjbWwkz5WAcax1XH188OLce3S6IfLlV0TJbyXHyZ6WjLVbhAjLqsPeyqfy8KLtKuhZkZhk5g4/H74pePktDf0oxP33V0uuuBJolR+3XDtCbwzG6aewHnjveI53dvN29e5RHuuCjpdBIStxF/t86RxQcc3d4s4bvJ+jl/QiJwFwt1O79jubcSogvcKareaTnfmw/tA6i2ALnnJDBYJh36VtQ/uLU4f6dSvPvUmXupKntffWHsAaQkY1ZcbjjV+yKZ12nB1U+Him9gdVo4fTEnBbFBwQkAbfJ4C1OwLUj55DEqrTSg5pzqvzOjvXXdrvYopJip/G1qjTXVMxKsOW3zYsCBd0bLVa7gH0h89n65zr+rFdG+rP9BSKdherZTtUTe6caC6/iWJoVOIOq4S/i81ZRMTySJM3eNsndzdSAZ7BNhtagNoeBUuv2yY2F9EBqGRB4ggJHg8miZKAJM5mfUJaOKgE9T/Gd5T7a8Pz5luq/+hwZsmCW9wYoo48m2OSzWmGwDrbroFKN1QxUxBx/cNwLRMYUcHSD3AkdPy4GCw+yx0bSajK127Jupmt3kYMr5/6XDNX0x7UQTT2ZE0eEgNS8umWjM4KgXMTkL3oN9hjenO6JRdYDdrgBrW4PzfHUN9+D+8SC1h0R3L1gUtGd+lnrjQjw3vtKwlA+18NGbF+Jy4PR+VnWFH/SBOvn9grCK47k6YDr2VXpkMpp5Mu93FRQ4cVO4ZN4vQzyYNFcJo3nAAqy0LZZcORcMD1TJLnD8Lqs4EUAKfHJykcCVjLFVOff349OmjIy3Nx91OBxb6wQcE1eckCak+18uqOnlQProHBo8DrX7kuCRNxY3DUU39ac5n+0O0r/Qd+Zd9XnyoqskeKXh5bNMB5/tekkf3CvuGLHv3fZGNyuiqz0NeDlqvsGuRjZEYjYfWXOC6IGxmIs8fGkwYeptrvMqXfCdlarcQu5S6FqIN5no3Rq6bkzfgQ19u3p6WEyg2h+U9Rhw1Yk8qFYFStPRgdZIZbCGs40fVzeviOG9TBHWSGlnzCqvPGhJmCFWej+CuBo57xplNjRayj5YP9iLfktGR81nRD0DqlyIct6x+7qeGcRKUey+4vGTN2uV2MB4uFStwJT9z+2a1Tc8AX71UJNPtiRvggQNV2Mkz4gk2raln4u1LonTvcPbH7flppPxHMfLkq91h1hy5D1VrnTxI9tbjsk4a9L8qdokrx1up6BVzTOFxatcjhdhllPL6+vuShPHzXJCGR6/RPizTRe6GWpAmp0NU6OePuq/G3YoPgjToNApHB6O6fRhc0xROdK8uFOc0vYpQ9FwBJFWSGIv3NIqvg0hEBrRvxXUA0XLaWUKBKwdHHl+C1XDoBqOkfEE57/phgt+OKd2yln7a25hWxq4yg/ww8aBrn6gJpiMAHAdS8qVgTtPeSb7cPSyDWcpNopuNrOOf0k1ElkIUR93a128gIzMOMrTpPPoq+/Cbz0/vPNaoEuT5rXTTL/dBPiYF8864tqZhdiyCrfRLMMi/wQsItS1sTN6crvKw9M2uVzr98pfol0aVItNrJZX42aZ6nznhRJQjvmwa1gpSDvV3eA+thseK6m4iQPsI2NOn6ElnSwvTF7oVSfUZl6ruz5QLUpO2i3XoawCSGUw/cfcjrSmfkzLvZj5STNiKOI0vuqPtdMhfCwaJVxz9dH0uP+LZUkQJEg/TV1Xp/d4t0NHiPJmgMzEn7QrURBghRBfwNIsg1XqvnzL1LZE8sV/n+H+a6DC5kdDiGmXa4ynzvPK62wktrl92U7AYkroBUWPvicGdCVFhiFvpMJkgkmQEkVb9VLwoS2Qe0jGxaoKhV2dvU+1K1TTVoHdeMlYSNWg2t2LWTa5YsaswtWJLfl/SlnCHQzAkm6S4ov8kcXLF/uckA3vRS3NdnMfv24CL0KDnue5O0kZgw68tbrY9Q6iThBrwTUmJFQk23aOp4VuO4Kv8W0PZpi9ZuQ5sRba+v0X0aTDqPYjH3QsrneOxd6kqNNBZNh9ljVShLJ9jwQreFLjakQl+gn/FvTGrab+L3A7eML4DjkV5TdbLZkNSHPXMZu+jnxB7BSBhjERv8Ktb1fI42b0hTd/Ysdq+u/wF+mttnl8WrbU0ftHBscIyZnXF4C24hEVEQ+uAg45ikrWEy7VsmKrrv6NrlmkvP682XJr0sfK0TXAxB6NTo8D+ol7s+6Re0b1HS05albEhtZxzYp6FDW4zXSV/fX/S/YyQmLdsBQd0xbPMAUkVUK/oZdbZ+/Vu4YE/SRrVNjUwx2CpuSQII1MxWLZ1ilkvte7QazHFZnXQ2s7oiJEgPylWmo7s/CalochDfkNarnwRsj+Nj8bfqky7WsA8K8up+wZLvityO/4zqaVX9LLF7VoOEK1/U8ZCPBOkVW+rSrZK+Sblnu5pntzw7U7UElyu9WOrchALrZSBvbkXyuYFuyGQkTfY2LYaxiL91eXc7ky4V2dv9bNskAivzLyjAKtfsUVQGhwDPNq7J4EU4i6++GnsDZa++xD23j2BG0wZbQnrUYp16bhpmWCyxrhktVxWewru7lJLo3m7d075uMCimKfNSfC2iZATQ9OkLENgI+e0IrWovCDNyQRejOcnk8kVFfPlJTwUX9GF5NcE2ALvXB1PKOdLwidH334bn1pggvhDv+FN03cVwZB/rlqWxLKtYdV6RqsK/fD6x7P3rxGti2pZWoOlomD8G2QgePP66PDAnGNVPoJynDk7qGZTMNHu5fnn/wYAAP//5vNn39eiAAA="),
+// staticFile is the contents of a static file along with a pre-compressed
+// variant for clients that accept gzip encoding.
+type staticFile struct {
+	data []byte
+	gzip []byte // Nil if compression does not reduce the size
+	etag string // Strong entity tag of data; empty if unknown
+}
+
+// staticFS is a mapping from file paths to the static files in publicFS.
+var staticFS = loadStaticFS(publicFS, "public")
+
+// loadStaticFS reads all files with a known MIME type under root in fsys
+// and compresses them. The keys are the paths relative to root.
+func loadStaticFS(fsys fs.FS, root string) map[string]*staticFile {
+	m := make(map[string]*staticFile)
+	err := fs.WalkDir(fsys, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || mimeFromPath(p) == "" {
+			return err
+		}
+		b, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		m[strings.TrimPrefix(p, root+"/")] = newStaticFile(b)
+		return nil
+	})
+	if err != nil {
+		panic(err) // The embedded files are always readable
+	}
+	return m
+}
+
+func newStaticFile(b []byte) *staticFile {
+	h := sha256.Sum256(b)
+	f := &staticFile{data: b, etag: `"` + hex.EncodeToString(h[:16]) + `"`}
+	var bb bytes.Buffer
+	zw, _ := gzip.NewWriterLevel(&bb, gzip.BestCompression)
+	zw.Write(b)
+	zw.Close()
+	if bb.Len() < len(b) {
+		f.gzip = bb.Bytes()
+	}
+	return f
 }
 
-func decompressBase64(s string) []byte {
-	r := strings.NewReader(s)
-	rx := base64.NewDecoder(base64.StdEncoding, r)
-	rz, _ := gzip.NewReader(rx)
-	b, _ := ioutil.ReadAll(rz)
-	return b
+// acceptsGzip reports whether the Accept-Encoding header value permits
+// a gzip encoded response.
+func acceptsGzip(accept string) bool {
+	for _, s := range strings.Split(accept, ",") {
+		coding, params := s, ""
+		if i := strings.IndexByte(s, ';'); i >= 0 {
+			coding, params = s[:i], s[i+1:]
+		}
+		if strings.TrimSpace(coding) != "gzip" {
+			continue
+		}
+		q := 1.0
+		if v := strings.TrimSpace(params); strings.HasPrefix(v, "q=") {
+			q, _ = strconv.ParseFloat(v[len("q="):], 64)
+		}
+		return q > 0
+	}
+	return false
 }
@@ -0,0 +1,92 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestAcceptsGzip(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"", false},
+		{"gzip", true},
+		{"deflate, gzip", true},
+		{"gzip;q=0.5, br", true},
+		{"gzip; q=0", false},
+		{"br", false},
+		{"x-gzip", false},
+	}
+	for _, tt := range tests {
+		if got := acceptsGzip(tt.in); got != tt.want {
+			t.Errorf("acceptsGzip(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestServeStatic(t *testing.T) {
+	pg := &playground{}
+	const name = "js/playground.js"
+	f := staticFS[name]
+	if f == nil || f.gzip == nil {
+		t.Fatalf("missing compressed static file %s", name)
+	}
+
+	serve := func(hdr map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", "/"+name, nil)
+		for k, v := range hdr {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		pg.serveStatic(w, r)
+		return w
+	}
+
+	// Identity encoding.
+	w := serve(nil)
+	if w.Code != http.StatusOK || !bytes.Equal(w.Body.Bytes(), f.data) {
+		t.Errorf("identity response = (%d, %d bytes), want (%d, %d bytes)", w.Code, w.Body.Len(), http.StatusOK, len(f.data))
+	}
+	if got, want := w.Header().Get("Content-Length"), strconv.Itoa(len(f.data)); got != want {
+		t.Errorf("Content-Length = %s, want %s", got, want)
+	}
+	etag := w.Header().Get("ETag")
+
+	// Gzip encoding.
+	w = serve(map[string]string{"Accept-Encoding": "gzip"})
+	if got := w.Header().Get("Content-Encoding"); got != "gzip" {
+		t.Errorf("Content-Encoding = %q, want %q", got, "gzip")
+	}
+	zr, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatalf("gzip.NewReader error: %v", err)
+	}
+	if b, _ := ioutil.ReadAll(zr); !bytes.Equal(b, f.data) {
+		t.Errorf("decompressed body mismatch")
+	}
+	if w.Header().Get("ETag") == etag {
+		t.Errorf("ETag of gzip variant must differ from identity variant")
+	}
+
+	// Range request.
+	w = serve(map[string]string{"Range": "bytes=0-9"})
+	if w.Code != http.StatusPartialContent || !bytes.Equal(w.Body.Bytes(), f.data[:10]) {
+		t.Errorf("range response = (%d, %q), want (%d, %q)", w.Code, w.Body.Bytes(), http.StatusPartialContent, f.data[:10])
+	}
+
+	// Conditional request.
+	w = serve(map[string]string{"If-None-Match": etag})
+	if w.Code != http.StatusNotModified {
+		t.Errorf("conditional response status = %d, want %d", w.Code, http.StatusNotModified)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// wsClient is a connected websocket client.
+type wsClient struct {
+	ID         int64     `json:"id"`
+	RemoteAddr string    `json:"remote_addr"`
+	User       string    `json:"user,omitempty"`
+	Connected  time.Time `json:"connected"`
+
+	ex *executor
+}
+
+func (pg *playground) addClient(c *wsClient) {
+	pg.clientsMu.Lock()
+	defer pg.clientsMu.Unlock()
+	pg.clients[c.ID] = c
+}
+
+// removeClient removes c unless another connection has since registered
+// under the same ID by resuming the session of c.
+func (pg *playground) removeClient(c *wsClient) {
+	pg.clientsMu.Lock()
+	defer pg.clientsMu.Unlock()
+	if pg.clients[c.ID] == c {
+		delete(pg.clients, c.ID)
+	}
+}
+
+func (pg *playground) lookupClient(id int64) *wsClient {
+	pg.clientsMu.Lock()
+	defer pg.clientsMu.Unlock()
+	return pg.clients[id]
+}
+
+// serveStatus provides endpoints to monitor the server.
+//
+// A GET request to "/admin/status" returns a JSON dict with the following:
+//
+//   - clients: list of connected websocket clients, where each reports
+//     the "state" of its executor (e.g., "idle", "building", or "running")
+//     and the number of "state_seconds" it has been in that state.
+//   - blobs: the "count" and total "bytes" of blobs held in memory.
+//   - database: the "backend", number of "snippets", and "size" of the
+//     database file in bytes.
+//
+// A POST request to "/admin/clients/ID/stop" stops the task (e.g., a run)
+// that the client with that ID is currently performing.
+func (pg *playground) serveStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" {
+		id, _ := strconv.ParseInt(strings.Split(r.URL.Path, "/")[3], 10, 64)
+		c := pg.lookupClient(id)
+		if c == nil {
+			httpError(w, r, "client not found", http.StatusNotFound)
+			return
+		}
+		c.ex.Interrupt()
+		pg.logf(r, "stopped task of websocket client %d", id)
+		return
+	}
+
+	type clientStatus struct {
+		*wsClient
+		State        string  `json:"state"`
+		StateSeconds float64 `json:"state_seconds"`
+	}
+	now := time.Now()
+	cs := []clientStatus{} // Marshal as an empty list rather than null
+	pg.clientsMu.Lock()
+	for _, c := range pg.clients {
+		state, since := c.ex.State()
+		cs = append(cs, clientStatus{c, state, now.Sub(since).Seconds()})
+	}
+	pg.clientsMu.Unlock()
+	sort.Slice(cs, func(i, j int) bool { return cs[i].ID < cs[j].ID })
+
+	dbStats, err := pg.store(r).Stats()
+	if err != nil {
+		httpError(w, r, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(map[string]interface{}{
+		"clients":  cs,
+		"blobs":    map[string]int64{"count": int64(pg.bs.Len()), "bytes": pg.bs.Size()},
+		"database": dbStats,
+	})
+	w.Write(b)
+}
+
+// servePprof serves the runtime profiles of the server process under
+// "/debug/pprof/" in the format expected by the pprof tool.
+// See the net/http/pprof package for details.
+func (pg *playground) servePprof(w http.ResponseWriter, r *http.Request) {
+	switch strings.TrimPrefix(r.URL.Path, "/debug/pprof") {
+	case "":
+		http.Redirect(w, r, pg.basePath+"/debug/pprof/", http.StatusMovedPermanently)
+	case "/cmdline":
+		pprof.Cmdline(w, r)
+	case "/profile":
+		pprof.Profile(w, r)
+	case "/symbol":
+		pprof.Symbol(w, r)
+	case "/trace":
+		pprof.Trace(w, r)
+	default:
+		pprof.Index(w, r)
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// templatesDir is the directory in DataPath holding the templates that
+// are added to or replace the built-in templates.
+const templatesDir = "templates"
+
+// reTemplateName matches valid template names, which are part of the URL
+// of the template.
+var reTemplateName = regexp.MustCompile(`^[-_a-zA-Z0-9]+$`)
+
+// snippetTemplate is the code that a new snippet may start out with.
+type snippetTemplate struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Code        string `json:"code,omitempty"`
+}
+
+// builtinTemplates are the templates available on every server.
+var builtinTemplates = []snippetTemplate{{
+	Name:        "hello",
+	Description: "Hello, world",
+	Code:        defaultCode,
+}, {
+	Name:        "table-test",
+	Description: "Table-driven test",
+	Code: `package main
+
+import "testing"
+
+func Reverse(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"a", "a"},
+		{"Hello, 世界", "界世 ,olleH"},
+	}
+	for _, tt := range tests {
+		if got := Reverse(tt.in); got != tt.want {
+			t.Errorf("Reverse(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+`,
+}, {
+	Name:        "benchmark",
+	Description: "Benchmark harness",
+	Code: `package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func BenchmarkConcat(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var s string
+		for j := 0; j < 100; j++ {
+			s += "x"
+		}
+	}
+}
+
+func BenchmarkBuilder(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var sb strings.Builder
+		for j := 0; j < 100; j++ {
+			sb.WriteString("x")
+		}
+		_ = sb.String()
+	}
+}
+`,
+}, {
+	Name:        "http-server",
+	Description: "HTTP server",
+	Code: `package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+)
+
+func main() {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "Hello, %s!", r.URL.Query().Get("name"))
+	})
+
+	// The server listens on a loopback port for the duration of the program.
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/hello?name=gopher")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(resp.Status, string(b))
+}
+`,
+}, {
+	Name:        "fuzz",
+	Description: "Fuzz target (requires Go 1.18)",
+	Code: `package main
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func Reverse(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
+
+func FuzzReverse(f *testing.F) {
+	f.Add("Hello, 世界")
+	f.Fuzz(func(t *testing.T, s string) {
+		if !utf8.ValidString(s) {
+			t.Skip()
+		}
+		if got := Reverse(Reverse(s)); got != s {
+			t.Errorf("Reverse(Reverse(%q)) = %q", s, got)
+		}
+	})
+}
+`,
+}}
+
+// loadTemplates returns the built-in templates along with those read from
+// the files in dir and the files in paths, keyed by template name.
+// The files in dir are named by their base name without the extension.
+// Later templates replace earlier ones of the same name.
+func loadTemplates(dir string, paths map[string]string) ([]snippetTemplate, error) {
+	m := make(map[string]snippetTemplate)
+	for _, t := range builtinTemplates {
+		m[t.Name] = t
+	}
+	add := func(name, path string) error {
+		if !reTemplateName.MatchString(name) {
+			return fmt.Errorf("invalid template name: %q", name)
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		m[name] = snippetTemplate{Name: name, Code: string(b)}
+		return nil
+	}
+
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, fi := range fis {
+		if !fi.Mode().IsRegular() || strings.HasPrefix(fi.Name(), ".") {
+			continue
+		}
+		name := strings.TrimSuffix(fi.Name(), filepath.Ext(fi.Name()))
+		if err := add(name, filepath.Join(dir, fi.Name())); err != nil {
+			return nil, err
+		}
+	}
+	for name, path := range paths {
+		if err := add(name, path); err != nil {
+			return nil, err
+		}
+	}
+
+	ts := make([]snippetTemplate, 0, len(m))
+	for _, t := range m {
+		ts = append(ts, t)
+	}
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Name < ts[j].Name })
+	return ts, nil
+}
+
+// lookupTemplate returns the template with the given name.
+func (pg *playground) lookupTemplate(name string) (snippetTemplate, bool) {
+	for _, t := range pg.templates {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return snippetTemplate{}, false
+}
+
+// serveTemplates provides an endpoint to list the templates, which are
+// returned without their code, and endpoints to retrieve a single template
+// or to create a new snippet from it.
+//
+// Creating a snippet from a template supports the "name" URL query parameter,
+// which is the name of the new snippet. It defaults to the template name.
+func (pg *playground) serveTemplates(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/templates" {
+		ts := make([]snippetTemplate, 0, len(pg.templates))
+		for _, t := range pg.templates {
+			t.Code = ""
+			ts = append(ts, t)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		b, _ := json.Marshal(ts)
+		w.Write(b)
+		return
+	}
+
+	t, ok := pg.lookupTemplate(strings.TrimPrefix(r.URL.Path, "/templates/"))
+	if !ok {
+		httpError(w, r, "template not found", http.StatusNotFound)
+		return
+	}
+	if r.Method == "GET" {
+		w.Header().Set("Content-Type", "application/json")
+		b, _ := json.Marshal(t)
+		w.Write(b)
+		return
+	}
+
+	s := snippet{Name: t.Name, Code: t.Code}
+	for k, v := range r.URL.Query() {
+		switch k {
+		case "name":
+			s.Name = v[0]
+		default:
+			httpError(w, r, fmt.Sprintf("unknown query field: %v", k), http.StatusBadRequest)
+			return
+		}
+	}
+	var err error
+	s.ID, err = pg.store(r).Create(s)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(requestError); ok {
+			status = http.StatusBadRequest
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+	pg.logf(r, "created snippet %d from template %s", s.ID, t.Name)
+	pg.audit(r, auditCreate, s.ID, "")
+
+	w.Header().Set("Content-Type", "application/json")
+	b, _ := json.Marshal(s)
+	w.Write(b)
+}
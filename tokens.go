@@ -0,0 +1,186 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// apiTokenPrefix is prepended to every API token so that leaked tokens
+// are easy to identify.
+const apiTokenPrefix = "pgt_"
+
+// apiToken is a long-lived credential for non-browser clients.
+// Only the SHA-256 hash of the token is stored.
+type apiToken struct {
+	ID      int64     `json:"id"`
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+	Hash    []byte    `json:"-"`
+}
+
+func (t *apiToken) MarshalBinary() ([]byte, error) {
+	type at apiToken
+	bb := new(bytes.Buffer)
+	enc := gob.NewEncoder(bb)
+	err := enc.Encode((*at)(t))
+	return bb.Bytes(), err
+}
+
+func (t *apiToken) UnmarshalBinary(b []byte) error {
+	type at apiToken
+	br := bytes.NewReader(b)
+	dec := gob.NewDecoder(br)
+	return dec.Decode((*at)(t))
+}
+
+// newAPIToken generates a new random token and returns it with its hash.
+func newAPIToken() (token string, hash []byte, err error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", nil, err
+	}
+	token = apiTokenPrefix + hex.EncodeToString(b[:])
+	return token, hashAPIToken(token), nil
+}
+
+func hashAPIToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
+
+func checkCreateToken(t apiToken) error {
+	switch {
+	case strings.TrimSpace(t.Name) == "":
+		return requestError{errors.New("token name cannot be empty")}
+	case len(t.Hash) != sha256.Size:
+		return errors.New("invalid token hash")
+	}
+	return nil
+}
+
+// bearerToken returns the API token in the "Authorization: Bearer <token>"
+// header of the request. It returns an empty string if there is none.
+func bearerToken(r *http.Request) string {
+	token := r.Header.Get("Authorization")
+	if len(token) < len("Bearer ") || !strings.EqualFold(token[:len("Bearer ")], "Bearer ") {
+		return ""
+	}
+	token = strings.TrimSpace(token[len("Bearer "):])
+	if !strings.HasPrefix(token, apiTokenPrefix) {
+		return ""
+	}
+	return token
+}
+
+// isTokenAuthenticated reports whether the request carries a valid API token.
+func (pg *playground) isTokenAuthenticated(r *http.Request) bool {
+	token := bearerToken(r)
+	if token == "" {
+		return false
+	}
+	_, err := pg.store(r).LookupToken(hashAPIToken(token))
+	if err != nil && err != errNotFound {
+		pg.logf(r, "token lookup error: %v", err)
+	}
+	return err == nil
+}
+
+// serveTokens provides endpoints to manage API tokens.
+//
+// A GET request to "/admin/tokens" returns a JSON list of all tokens
+// (without the secret token values).
+//
+// A POST request to "/admin/tokens" with a JSON dict holding the "name" of
+// the token creates a new token. The response is a JSON dict with the "id",
+// "name", and "created" fields of the token and the secret "token" value,
+// which is never shown again.
+//
+// A DELETE request to "/admin/tokens/ID" revokes the token with that ID.
+func (pg *playground) serveTokens(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// Parse out the ID.
+	var id int64
+	if r.Method == "DELETE" {
+		ss := strings.Split(r.URL.Path, "/")
+		id, err = strconv.ParseInt(ss[len(ss)-1], 10, 64)
+		if err != nil {
+			httpError(w, r, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Read and parse the JSON token.
+	var t apiToken
+	if r.Method == "POST" {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			httpError(w, r, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := json.Unmarshal(b, &t); err != nil {
+			httpError(w, r, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Perform the operation.
+	var v interface{}
+	switch r.Method {
+	case "GET":
+		var ts []apiToken
+		ts, err = pg.store(r).ListTokens()
+		if ts == nil {
+			ts = []apiToken{} // Marshal as an empty list rather than null
+		}
+		v = ts
+	case "POST":
+		var token string
+		if token, t.Hash, err = newAPIToken(); err != nil {
+			break
+		}
+		if t.ID, err = pg.store(r).CreateToken(t); err != nil {
+			break
+		}
+		t, err = pg.store(r).LookupToken(t.Hash)
+		v = struct {
+			apiToken
+			Token string `json:"token"`
+		}{t, token}
+		pg.logf(r, "created API token %d", t.ID)
+	case "DELETE":
+		err = pg.store(r).DeleteToken(id)
+		pg.logf(r, "revoked API token %d", id)
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		if _, ok := err.(requestError); ok {
+			status = http.StatusBadRequest
+		} else if err == errNotFound {
+			status = http.StatusNotFound
+		}
+		httpError(w, r, err.Error(), status)
+		return
+	}
+
+	// Compose and write the JSON response.
+	if v != nil {
+		w.Header().Set("Content-Type", "application/json")
+		b, _ := json.Marshal(v)
+		w.Write(b)
+	}
+}
@@ -0,0 +1,558 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	traceBatchSize     = 512             // Maximum number of spans per export
+	traceFlushInterval = 5 * time.Second // Maximum delay before spans are exported
+	traceQueueSize     = 4096            // Spans beyond this are dropped
+)
+
+// Span kinds and status codes as defined by OpenTelemetry.
+const (
+	spanKindInternal = 1
+	spanKindServer   = 2
+	spanStatusError  = 2
+)
+
+// tracer records spans and exports them in batches to an OpenTelemetry
+// collector using the OTLP/HTTP protocol with JSON encoding.
+//
+// A nil *tracer is valid and records nothing, such that instrumented code
+// need not check whether tracing is enabled.
+type tracer struct {
+	endpoint string // URL of the collector (e.g., "http://localhost:4318/v1/traces")
+	service  string // Name of the service reported to the collector
+	client   *http.Client
+	log      logger
+
+	mu      sync.Mutex
+	queue   []*span
+	dropped int
+	flushC  chan struct{}
+	closeC  chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newTracer(endpoint, service string, log logger) *tracer {
+	t := &tracer{
+		endpoint: endpoint,
+		service:  service,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		log:      log,
+		flushC:   make(chan struct{}, 1),
+		closeC:   make(chan struct{}),
+	}
+	t.wg.Add(1)
+	go t.exportLoop()
+	return t
+}
+
+// Close exports all pending spans and stops the exporter.
+func (t *tracer) Close() error {
+	if t == nil {
+		return nil
+	}
+	close(t.closeC)
+	t.wg.Wait()
+	return nil
+}
+
+type spanContextKey struct{}
+
+// span is a single timed operation within a trace.
+type span struct {
+	tr       *tracer
+	traceID  [16]byte
+	spanID   [8]byte
+	parentID [8]byte // Zero if this is a root span
+	name     string
+	kind     int
+	start    time.Time
+
+	mu     sync.Mutex
+	end    time.Time
+	attrs  []spanAttr
+	errMsg string
+}
+
+type spanAttr struct {
+	key   string
+	value interface{} // Either string, int64, or bool
+}
+
+// Start starts a span that is a child of the span in ctx (if any) and
+// returns a context holding the new span.
+func (t *tracer) Start(ctx context.Context, name string) (context.Context, *span) {
+	return t.start(ctx, name, spanKindInternal)
+}
+
+// StartRequest starts a server span for the HTTP request. If the request
+// carries a W3C "traceparent" header, then the span continues that trace.
+func (t *tracer) StartRequest(r *http.Request, name string) (context.Context, *span) {
+	if t == nil {
+		return r.Context(), nil
+	}
+	ctx := r.Context()
+	if p, ok := parseTraceParent(r.Header.Get("traceparent")); ok {
+		ctx = context.WithValue(ctx, spanContextKey{}, p)
+	}
+	ctx, s := t.start(ctx, name, spanKindServer)
+	s.SetAttr("http.method", r.Method)
+	s.SetAttr("http.target", r.URL.Path)
+	return ctx, s
+}
+
+func (t *tracer) start(ctx context.Context, name string, kind int) (context.Context, *span) {
+	if t == nil {
+		return ctx, nil
+	}
+	s := &span{tr: t, name: name, kind: kind, start: time.Now()}
+	if p, ok := ctx.Value(spanContextKey{}).(*span); ok {
+		s.traceID, s.parentID = p.traceID, p.spanID
+	} else {
+		rand.Read(s.traceID[:])
+	}
+	rand.Read(s.spanID[:])
+	return context.WithValue(ctx, spanContextKey{}, s), s
+}
+
+// parseTraceParent parses a W3C trace context header of the form
+// "00-<trace-id>-<parent-id>-<flags>" into a remote parent span.
+func parseTraceParent(h string) (*span, bool) {
+	ss := strings.Split(strings.TrimSpace(h), "-")
+	if len(ss) != 4 || ss[0] != "00" || len(ss[1]) != 32 || len(ss[2]) != 16 || len(ss[3]) != 2 {
+		return nil, false
+	}
+	p := new(span)
+	n1, err1 := hex.Decode(p.traceID[:], []byte(ss[1]))
+	n2, err2 := hex.Decode(p.spanID[:], []byte(ss[2]))
+	if err1 != nil || err2 != nil || n1 != len(p.traceID) || n2 != len(p.spanID) {
+		return nil, false
+	}
+	if p.traceID == ([16]byte{}) || p.spanID == ([8]byte{}) {
+		return nil, false
+	}
+	return p, true
+}
+
+// SetAttr sets an attribute on the span, where value is a string,
+// an integer, or a bool.
+func (s *span) SetAttr(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	switch v := value.(type) {
+	case int:
+		value = int64(v)
+	case string, int64, bool:
+	default:
+		value = fmt.Sprint(v)
+	}
+	s.mu.Lock()
+	s.attrs = append(s.attrs, spanAttr{key, value})
+	s.mu.Unlock()
+}
+
+// SetError marks the span as failed if err is non-nil.
+func (s *span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	s.errMsg = err.Error()
+	s.mu.Unlock()
+}
+
+// End completes the span and queues it for export.
+func (s *span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.end = time.Now()
+	s.mu.Unlock()
+
+	t := s.tr
+	t.mu.Lock()
+	if len(t.queue) < traceQueueSize {
+		t.queue = append(t.queue, s)
+	} else {
+		t.dropped++
+	}
+	full := len(t.queue) >= traceBatchSize
+	t.mu.Unlock()
+	if full {
+		select {
+		case t.flushC <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (t *tracer) exportLoop() {
+	defer t.wg.Done()
+	ticker := time.NewTicker(traceFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.closeC:
+			for t.flush() {
+			}
+			return
+		case <-ticker.C:
+		case <-t.flushC:
+		}
+		for t.flush() {
+		}
+	}
+}
+
+// flush exports a batch of queued spans.
+// It reports whether more spans remain in the queue.
+func (t *tracer) flush() (more bool) {
+	t.mu.Lock()
+	batch := t.queue
+	if len(batch) > traceBatchSize {
+		batch = batch[:traceBatchSize]
+	}
+	t.queue = t.queue[len(batch):]
+	dropped := t.dropped
+	t.dropped = 0
+	more = len(t.queue) > 0
+	t.mu.Unlock()
+
+	if dropped > 0 {
+		t.log.Printf("tracing: dropped %d spans", dropped)
+	}
+	if len(batch) == 0 {
+		return false
+	}
+	if err := t.export(batch); err != nil {
+		t.log.Printf("tracing: export error: %v", err)
+	}
+	return more
+}
+
+// export sends the spans to the collector.
+// See https://opentelemetry.io/docs/specs/otlp/#otlphttp.
+func (t *tracer) export(spans []*span) error {
+	type (
+		anyValue struct {
+			StringValue *string `json:"stringValue,omitempty"`
+			IntValue    *string `json:"intValue,omitempty"` // int64 is encoded as a string
+			BoolValue   *bool   `json:"boolValue,omitempty"`
+		}
+		keyValue struct {
+			Key   string   `json:"key"`
+			Value anyValue `json:"value"`
+		}
+		status struct {
+			Code    int    `json:"code,omitempty"`
+			Message string `json:"message,omitempty"`
+		}
+		otlpSpan struct {
+			TraceID      string     `json:"traceId"`
+			SpanID       string     `json:"spanId"`
+			ParentSpanID string     `json:"parentSpanId,omitempty"`
+			Name         string     `json:"name"`
+			Kind         int        `json:"kind"`
+			StartTime    string     `json:"startTimeUnixNano"`
+			EndTime      string     `json:"endTimeUnixNano"`
+			Attributes   []keyValue `json:"attributes,omitempty"`
+			Status       status     `json:"status"`
+		}
+	)
+	newKeyValue := func(k string, v interface{}) keyValue {
+		kv := keyValue{Key: k}
+		switch v := v.(type) {
+		case string:
+			kv.Value.StringValue = &v
+		case int64:
+			s := strconv.FormatInt(v, 10)
+			kv.Value.IntValue = &s
+		case bool:
+			kv.Value.BoolValue = &v
+		}
+		return kv
+	}
+
+	var ss []otlpSpan
+	for _, s := range spans {
+		s.mu.Lock()
+		o := otlpSpan{
+			TraceID:   hex.EncodeToString(s.traceID[:]),
+			SpanID:    hex.EncodeToString(s.spanID[:]),
+			Name:      s.name,
+			Kind:      s.kind,
+			StartTime: strconv.FormatInt(s.start.UnixNano(), 10),
+			EndTime:   strconv.FormatInt(s.end.UnixNano(), 10),
+		}
+		if s.parentID != ([8]byte{}) {
+			o.ParentSpanID = hex.EncodeToString(s.parentID[:])
+		}
+		for _, a := range s.attrs {
+			o.Attributes = append(o.Attributes, newKeyValue(a.key, a.value))
+		}
+		if s.errMsg != "" {
+			o.Status = status{Code: spanStatusError, Message: s.errMsg}
+		}
+		s.mu.Unlock()
+		ss = append(ss, o)
+	}
+	b, err := json.Marshal(map[string]interface{}{
+		"resourceSpans": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{
+				"attributes": []keyValue{newKeyValue("service.name", t.service)},
+			},
+			"scopeSpans": []interface{}{map[string]interface{}{
+				"scope": map[string]string{"name": "github.com/dsnet/playground"},
+				"spans": ss,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := t.client.Post(t.endpoint, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+	return nil
+}
+
+// tracedStore records a span for each operation on the underlying store
+// as a child of the span in ctx.
+type tracedStore struct {
+	snippetStore
+	tr  *tracer
+	ctx context.Context
+}
+
+func (db tracedStore) span(op string) *span {
+	_, s := db.tr.Start(db.ctx, "db."+op)
+	return s
+}
+
+func (db tracedStore) QueryByModified(lastTime time.Time, lastID int64, limit int) ([]snippet, error) {
+	sp := db.span("QueryByModified")
+	defer sp.End()
+	v, err := db.snippetStore.QueryByModified(lastTime, lastID, limit)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) QueryByCreated(lastTime time.Time, lastID int64, limit int) ([]snippet, error) {
+	sp := db.span("QueryByCreated")
+	defer sp.End()
+	v, err := db.snippetStore.QueryByCreated(lastTime, lastID, limit)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) QueryByID(lastID int64, limit int) ([]snippet, error) {
+	sp := db.span("QueryByID")
+	defer sp.End()
+	v, err := db.snippetStore.QueryByID(lastID, limit)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) QueryByName(name string, limit int) ([]snippet, error) {
+	sp := db.span("QueryByName")
+	defer sp.End()
+	v, err := db.snippetStore.QueryByName(name, limit)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) QueryByStarred(limit int) ([]snippet, error) {
+	sp := db.span("QueryByStarred")
+	defer sp.End()
+	v, err := db.snippetStore.QueryByStarred(limit)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) Create(s snippet) (int64, error) {
+	sp := db.span("Create")
+	defer sp.End()
+	v, err := db.snippetStore.Create(s)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) Import(ss []snippet) (map[int64]int64, error) {
+	sp := db.span("Import")
+	defer sp.End()
+	v, err := db.snippetStore.Import(ss)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) Retrieve(id int64) (snippet, error) {
+	sp := db.span("Retrieve")
+	defer sp.End()
+	v, err := db.snippetStore.Retrieve(id)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) Update(s snippet, id int64) error {
+	sp := db.span("Update")
+	defer sp.End()
+	err := db.snippetStore.Update(s, id)
+	sp.SetError(err)
+	return err
+}
+
+func (db tracedStore) Delete(id int64) error {
+	sp := db.span("Delete")
+	defer sp.End()
+	err := db.snippetStore.Delete(id)
+	sp.SetError(err)
+	return err
+}
+
+func (db tracedStore) Batch(ops []batchOp) error {
+	sp := db.span("Batch")
+	defer sp.End()
+	err := db.snippetStore.Batch(ops)
+	sp.SetError(err)
+	return err
+}
+
+func (db tracedStore) SetGist(id int64, gist string) error {
+	sp := db.span("SetGist")
+	defer sp.End()
+	err := db.snippetStore.SetGist(id, gist)
+	sp.SetError(err)
+	return err
+}
+
+func (db tracedStore) ToggleStarred(id int64) (snippet, error) {
+	sp := db.span("ToggleStarred")
+	defer sp.End()
+	v, err := db.snippetStore.ToggleStarred(id)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) CreateToken(t apiToken) (int64, error) {
+	sp := db.span("CreateToken")
+	defer sp.End()
+	v, err := db.snippetStore.CreateToken(t)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) LookupToken(hash []byte) (apiToken, error) {
+	sp := db.span("LookupToken")
+	defer sp.End()
+	v, err := db.snippetStore.LookupToken(hash)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) ListTokens() ([]apiToken, error) {
+	sp := db.span("ListTokens")
+	defer sp.End()
+	v, err := db.snippetStore.ListTokens()
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) DeleteToken(id int64) error {
+	sp := db.span("DeleteToken")
+	defer sp.End()
+	err := db.snippetStore.DeleteToken(id)
+	sp.SetError(err)
+	return err
+}
+
+func (db tracedStore) PutSession(s session) error {
+	sp := db.span("PutSession")
+	defer sp.End()
+	err := db.snippetStore.PutSession(s)
+	sp.SetError(err)
+	return err
+}
+
+func (db tracedStore) LookupSession(id string) (session, error) {
+	sp := db.span("LookupSession")
+	defer sp.End()
+	v, err := db.snippetStore.LookupSession(id)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) ListSessions() ([]session, error) {
+	sp := db.span("ListSessions")
+	defer sp.End()
+	v, err := db.snippetStore.ListSessions()
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) DeleteSession(id string) error {
+	sp := db.span("DeleteSession")
+	defer sp.End()
+	err := db.snippetStore.DeleteSession(id)
+	sp.SetError(err)
+	return err
+}
+
+func (db tracedStore) AppendAudit(e auditEntry) (int64, error) {
+	sp := db.span("AppendAudit")
+	defer sp.End()
+	v, err := db.snippetStore.AppendAudit(e)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) QueryAudit(beforeID int64, limit int) ([]auditEntry, error) {
+	sp := db.span("QueryAudit")
+	defer sp.End()
+	v, err := db.snippetStore.QueryAudit(beforeID, limit)
+	sp.SetError(err)
+	return v, err
+}
+
+func (db tracedStore) AppendBenchmarks(br benchRun) error {
+	sp := db.span("AppendBenchmarks")
+	defer sp.End()
+	err := db.snippetStore.AppendBenchmarks(br)
+	sp.SetError(err)
+	return err
+}
+
+func (db tracedStore) QueryBenchmarks(snippetID int64, limit int) ([]benchRun, error) {
+	sp := db.span("QueryBenchmarks")
+	defer sp.End()
+	v, err := db.snippetStore.QueryBenchmarks(snippetID, limit)
+	sp.SetError(err)
+	return v, err
+}
@@ -0,0 +1,169 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	webhookTimeout  = 10 * time.Second
+	webhookAttempts = 3 // Number of deliveries attempted before giving up
+)
+
+// webhookClient is the HTTP client used to deliver webhooks.
+var webhookClient = &http.Client{Timeout: webhookTimeout}
+
+// runEvent is the JSON payload of the webhooks fired when a run finishes.
+type runEvent struct {
+	Event     string      `json:"event"`                // Always "run"
+	SnippetID int64       `json:"snippet_id,omitempty"` // Omitted if the run is not of a saved snippet
+	Status    *exitStatus `json:"status"`               // Null if no program ran (e.g., the build failed)
+	Duration  float64     `json:"duration"`             // Wall-clock duration of the run in seconds
+	OutputURL string      `json:"output_url,omitempty"` // Output and status updates as plain text
+	Reports   []runReport `json:"reports,omitempty"`    // Profiles and other reports of the run
+	Text      string      `json:"text"`                 // Summary for chat services (e.g., Slack)
+}
+
+type runReport struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// checkWebhook checks that the webhook has a valid HTTP or HTTPS URL.
+func checkWebhook(h webhookConfig) error {
+	u, err := url.Parse(h.URL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook URL: %q", h.URL)
+	}
+	return nil
+}
+
+// notifyRun fires the webhooks with the result of a finished run.
+// The output of the run is stored in the blobStore so that it can be linked,
+// while the reports remain available until the next run of the session.
+func (pg *playground) notifyRun(rr runResult) {
+	if len(pg.webhooks) == 0 {
+		return
+	}
+	ev := runEvent{Event: "run", SnippetID: rr.snippetID, Duration: rr.duration.Seconds()}
+	if rr.status != "" {
+		ev.Status = new(exitStatus)
+		json.Unmarshal([]byte(rr.status), ev.Status)
+	}
+
+	// Links are relative to the playground unless ExternalURL is set.
+	blobURL := pg.externalURL + pg.basePath + "/dynamic/"
+	if rr.output != "" {
+		id, err := pg.bs.Insert(blob{data: []byte(rr.output), mime: "text/plain; charset=utf-8"}, nil)
+		if err != nil {
+			pg.log.Printf("webhook output error: %v", err)
+		} else {
+			ev.OutputURL = blobURL + id
+		}
+	}
+	for _, data := range rr.reports {
+		var rp struct{ Name, ID string }
+		if json.Unmarshal([]byte(data), &rp) == nil {
+			ev.Reports = append(ev.Reports, runReport{Name: rp.Name, URL: blobURL + rp.ID})
+		}
+	}
+	ev.Text = summarizeRun(ev)
+	b, _ := json.Marshal(ev)
+
+	for _, h := range pg.webhooks {
+		pg.wg.Add(1)
+		go func(h webhookConfig) {
+			defer pg.wg.Done()
+			pg.sendWebhook(h, b)
+		}(h)
+	}
+}
+
+// summarizeRun returns a one-line description of the run.
+func summarizeRun(ev runEvent) string {
+	s := "Run"
+	if ev.SnippetID != 0 {
+		s = fmt.Sprintf("Run of snippet %d", ev.SnippetID)
+	}
+	switch st := ev.Status; {
+	case st == nil:
+		s += " stopped before running a program."
+	case st.Reason == "exit":
+		s += fmt.Sprintf(" exited with code %d after %.3fs.", st.ExitCode, st.Duration)
+	case st.Reason == "signal":
+		s += fmt.Sprintf(" was terminated by %s after %.3fs.", st.Signal, st.Duration)
+	case st.Reason == "timeout":
+		s += fmt.Sprintf(" timed out after %.3fs.", st.Duration)
+	case st.Reason == "outputLimit":
+		s += " exceeded the output limit."
+	case st.Reason == "diskQuota":
+		s += " exceeded the disk quota."
+	default:
+		s += " was stopped."
+	}
+	if ev.OutputURL != "" {
+		s += " Output: " + ev.OutputURL
+	}
+	return s
+}
+
+// sendWebhook posts the payload to the webhook, retrying with exponential
+// backoff on failure. If the webhook has a secret, the X-Playground-Signature
+// header holds the hex-encoded HMAC-SHA256 of the payload keyed by the secret
+// (e.g., "sha256=5d41...") so that the receiver can verify the sender.
+func (pg *playground) sendWebhook(h webhookConfig, payload []byte) {
+	var err error
+	for i := 0; i < webhookAttempts; i++ {
+		if i > 0 {
+			select {
+			case <-pg.ctx.Done():
+				return
+			case <-time.After(time.Second << (i - 1)):
+			}
+		}
+		if err = postWebhook(h, payload); err == nil {
+			return
+		}
+	}
+	pg.log.Printf("webhook %s error: %v", h.URL, err)
+}
+
+func postWebhook(h webhookConfig, payload []byte) error {
+	req, err := http.NewRequest("POST", h.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Playground-Event", "run")
+	if h.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(h.Secret))
+		mac.Write(payload)
+		req.Header.Set("X-Playground-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
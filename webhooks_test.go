@@ -0,0 +1,110 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhooks(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+	pg.externalURL = srv.URL
+
+	type delivery struct {
+		header http.Header
+		body   []byte
+	}
+	deliveries := make(chan delivery, 10)
+	fails := 1 // Fail the first delivery to exercise retries
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		if fails > 0 {
+			fails--
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		deliveries <- delivery{r.Header, b}
+	}))
+	defer hook.Close()
+	pg.webhooks = []webhookConfig{{URL: hook.URL, Secret: "secret"}}
+
+	stopped := make(chan struct{}, 1)
+	ex := newExecutor(pg.bs, pg.exConf, func(action, data string) error {
+		if action == statusStopped {
+			stopped <- struct{}{}
+		}
+		return nil
+	})
+	defer ex.Close()
+	ex.SetSnippet(7)
+	ex.Start(actionRun, `package main
+		import ("fmt"; "os")
+		func main() { fmt.Println("hello, webhook"); os.Exit(3) }`)
+	select {
+	case <-stopped:
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for run to stop")
+	}
+
+	var d delivery
+	select {
+	case d = <-deliveries:
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for webhook")
+	}
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(d.body)
+	if got, want := d.header.Get("X-Playground-Signature"), "sha256="+hex.EncodeToString(mac.Sum(nil)); got != want {
+		t.Errorf("X-Playground-Signature = %q, want %q", got, want)
+	}
+	var ev runEvent
+	if err := json.Unmarshal(d.body, &ev); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if ev.Event != "run" || ev.SnippetID != 7 || ev.Status == nil || ev.Status.ExitCode != 3 || ev.Duration <= 0 {
+		t.Errorf("unexpected event: %s", d.body)
+	}
+	if !strings.HasPrefix(ev.Text, "Run of snippet 7 exited with code 3") {
+		t.Errorf("event text = %q", ev.Text)
+	}
+
+	// The output remains available after the session runs again.
+	ex.Start(actionRun, "package main\nfunc main() {}")
+	<-stopped
+	<-deliveries
+	if !strings.HasPrefix(ev.OutputURL, srv.URL+"/dynamic/") {
+		t.Fatalf("output URL = %q", ev.OutputURL)
+	}
+	resp, err := http.Get(ev.OutputURL)
+	if err != nil {
+		t.Fatalf("http.Get error: %v", err)
+	}
+	defer resp.Body.Close()
+	if b, _ := ioutil.ReadAll(resp.Body); !strings.Contains(string(b), "hello, webhook\n") {
+		t.Errorf("output = %q, want the program output", b)
+	}
+}
@@ -0,0 +1,444 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Remote workers build and run snippets on behalf of the playground so that
+// heavy loads (e.g., benchmarks) do not run on the host serving the UI and
+// the database. Each worker connects to the "/workers" websocket of the
+// playground and is then sent jobs, one per run. Messages in both directions
+// are JSON workerMsg values. The playground starts a job with the run action
+// and may later send the stop or signal actions for it. The worker sends all
+// messages of the run for the job as they would be sent to a client, ending
+// with statusStopped. Before each reportProfile message, the worker sends
+// the blob of the report with the workerBlob action, and it sends the
+// benchmark results of the run with the workerBench action.
+const (
+	workerBlob  = "blob"  // Data is a JSON dict with "name", "mime", and "data" fields
+	workerBench = "bench" // Data is a JSON dict with "go" and "results" fields
+
+	workerRetryPeriod = 5 * time.Second
+)
+
+// workerMsg is a message between the playground and a worker.
+type workerMsg struct {
+	Job     int64  `json:"job"`
+	Action  string `json:"action"`
+	Data    string `json:"data"`
+	Snippet int64  `json:"snippet,omitempty"` // Only set for the run action
+}
+
+// workerPool is the set of connected workers.
+type workerPool struct {
+	mu      sync.Mutex
+	workers map[*workerConn]bool
+}
+
+func newWorkerPool() *workerPool {
+	return &workerPool{workers: make(map[*workerConn]bool)}
+}
+
+// Pick returns the worker with the fewest on-going jobs, or nil if no worker
+// is connected. It is safe to call on a nil pool.
+func (wp *workerPool) Pick() *workerConn {
+	if wp == nil {
+		return nil
+	}
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	var best *workerConn
+	bestJobs := -1
+	for w := range wp.workers {
+		if n := w.numJobs(); best == nil || n < bestJobs {
+			best, bestJobs = w, n
+		}
+	}
+	return best
+}
+
+// Len reports the number of connected workers.
+func (wp *workerPool) Len() int {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	return len(wp.workers)
+}
+
+func (wp *workerPool) add(w *workerConn) {
+	wp.mu.Lock()
+	wp.workers[w] = true
+	wp.mu.Unlock()
+}
+
+func (wp *workerPool) remove(w *workerConn) {
+	wp.mu.Lock()
+	delete(wp.workers, w)
+	wp.mu.Unlock()
+}
+
+// workerConn is the connection of the playground to a single worker.
+type workerConn struct {
+	name string
+	conn *websocket.Conn
+	wmu  sync.Mutex // Serializes writes to conn
+
+	mu      sync.Mutex // Protects jobs, lastJob, and closed
+	jobs    map[int64]chan workerMsg
+	lastJob int64
+	closed  bool
+}
+
+func (w *workerConn) numJobs() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.jobs)
+}
+
+func (w *workerConn) send(m workerMsg) error {
+	w.wmu.Lock()
+	defer w.wmu.Unlock()
+	w.conn.SetWriteDeadline(time.Now().Add(websocketWriteWait))
+	return w.conn.WriteJSON(m)
+}
+
+// Start starts a run of the code on the worker. The messages of the job
+// are received from the returned channel, which is closed if the worker
+// disconnects. The job must be finished with Finish.
+func (w *workerConn) Start(snippetID int64, code string) (int64, <-chan workerMsg, error) {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return 0, nil, errors.New("worker disconnected")
+	}
+	w.lastJob++
+	id := w.lastJob
+	c := make(chan workerMsg, 64)
+	w.jobs[id] = c
+	w.mu.Unlock()
+	if err := w.send(workerMsg{Job: id, Action: actionRun, Data: code, Snippet: snippetID}); err != nil {
+		w.Finish(id)
+		return 0, nil, err
+	}
+	return id, c, nil
+}
+
+// Finish forgets the job so that later messages for it are dropped.
+func (w *workerConn) Finish(id int64) {
+	w.mu.Lock()
+	delete(w.jobs, id)
+	w.mu.Unlock()
+}
+
+// dispatch passes a message from the worker to the receiver of its job.
+func (w *workerConn) dispatch(m workerMsg) {
+	w.mu.Lock()
+	c := w.jobs[m.Job]
+	w.mu.Unlock()
+	if c != nil {
+		c <- m
+	}
+}
+
+// close closes the channels of all on-going jobs.
+func (w *workerConn) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	for id, c := range w.jobs {
+		close(c)
+		delete(w.jobs, id)
+	}
+}
+
+// serveWorkers provides the websocket endpoint that remote workers connect to.
+// Workers authenticate with the WorkerToken in the Authorization header
+// (e.g., "Authorization: Bearer <token>") and may name themselves with the
+// X-Playground-Worker header.
+func (pg *playground) serveWorkers(w http.ResponseWriter, r *http.Request) {
+	if pg.workerToken == "" {
+		httpError(w, r, "not found", http.StatusNotFound)
+		return
+	}
+	token := r.Header.Get("Authorization")
+	if len(token) < len("Bearer ") || !strings.EqualFold(token[:len("Bearer ")], "Bearer ") ||
+		!hmac.Equal([]byte(strings.TrimSpace(token[len("Bearer "):])), []byte(pg.workerToken)) {
+		httpError(w, r, "unauthorized", http.StatusUnauthorized)
+		pg.logf(r, "worker authentication failure at %s", pg.remoteAddr(r))
+		return
+	}
+	upgrader := websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: 1024}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		pg.logf(r, "unexpected websocket error: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	// Close the connection upon shutdown.
+	ctx, cancel := context.WithCancel(pg.ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	wc := &workerConn{name: r.Header.Get("X-Playground-Worker"), conn: conn, jobs: make(map[int64]chan workerMsg)}
+	if wc.name == "" {
+		wc.name = pg.remoteAddr(r)
+	}
+	pg.workers.add(wc)
+	pg.logf(r, "worker %s connected (%d connected)", wc.name, pg.workers.Len())
+	defer func() {
+		pg.workers.remove(wc)
+		wc.close()
+		pg.logf(r, "worker %s disconnected (%d connected)", wc.name, pg.workers.Len())
+	}()
+
+	// Detect half-open connections as for websocket clients.
+	conn.SetReadDeadline(time.Now().Add(websocketPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(websocketPongWait))
+	})
+	go func() {
+		t := time.NewTicker(websocketPingPeriod)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(websocketWriteWait)); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	for {
+		var m workerMsg
+		if err := conn.ReadJSON(&m); err != nil {
+			return
+		}
+		conn.SetReadDeadline(time.Now().Add(websocketPongWait))
+		wc.dispatch(m)
+	}
+}
+
+// runOnWorker runs the code on the worker, relaying the messages of the run
+// to the client, and returns the JSON exit status of the last program run.
+func (ex *executor) runOnWorker(w *workerConn, snippetID int64, code string) (stopped string) {
+	ex.setState(execRunning)
+	id, msgs, err := w.Start(snippetID, code)
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to start run on worker %s: %v\n", w.name, err))
+		return ""
+	}
+	defer w.Finish(id)
+	ex.mu.Lock()
+	ex.worker, ex.workerJob = w, id
+	ex.mu.Unlock()
+	defer func() {
+		ex.mu.Lock()
+		ex.worker, ex.workerJob = nil, 0
+		ex.mu.Unlock()
+	}()
+
+	done := ex.ctx.Done()
+	for {
+		select {
+		case <-done:
+			w.send(workerMsg{Job: id, Action: actionStop})
+			done = nil // Wait for the worker to report that the run stopped
+		case m, ok := <-msgs:
+			if !ok {
+				ex.sendMsg(statusUpdate, fmt.Sprintf("\nWorker %s disconnected.\n", w.name))
+				return ""
+			}
+			switch m.Action {
+			case statusStarted:
+			case statusStopped:
+				return m.Data
+			case workerBlob:
+				var b struct {
+					Name, MIME string
+					Data       []byte
+				}
+				if err := json.Unmarshal([]byte(m.Data), &b); err == nil {
+					ex.reportBlob(b.Name, b.MIME, b.Data)
+				}
+			case workerBench:
+				var b struct {
+					Go      string
+					Results []benchResult
+				}
+				if err := json.Unmarshal([]byte(m.Data), &b); err == nil && snippetID != 0 && ex.recordBench != nil {
+					ex.recordBench(snippetID, b.Go, b.Results)
+				}
+			default:
+				ex.sendMsg(m.Action, m.Data)
+			}
+		}
+	}
+}
+
+// runWorker connects to the playground at CoordinatorURL as a remote worker
+// and runs the jobs it is sent until interrupted, reconnecting as needed.
+func runWorker(confPath string) {
+	conf, logger, closer := loadConfig(confPath, false)
+	defer closer()
+	if conf.CoordinatorURL == "" || conf.WorkerToken == "" {
+		logger.Fatal("CoordinatorURL and WorkerToken must be set")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+		logger.Printf("received %v - initiating shutdown", <-sigc)
+		cancel()
+	}()
+
+	exConf := newExecConfig(&conf, logger)
+	defer exConf.vms.Close()
+	exConf.cache = nil   // Runs are cached by the playground
+	exConf.pprofUI = nil // Profiles are only served as blobs
+	name, _ := os.Hostname()
+	for {
+		err := serveWorker(ctx, conf.CoordinatorURL, conf.WorkerToken, name, exConf, logger)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Printf("worker connection error: %v; reconnecting in %v", err, workerRetryPeriod)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(workerRetryPeriod):
+		}
+	}
+}
+
+// serveWorker runs the jobs sent by the playground at coordinatorURL over
+// a single connection until it is closed or ctx is canceled.
+func serveWorker(ctx context.Context, coordinatorURL, token, name string, exConf execConfig, log logger) error {
+	if err := checkCoordinatorURL(coordinatorURL); err != nil {
+		return err
+	}
+	u, _ := url.Parse(coordinatorURL)
+	u.Scheme = strings.Replace(u.Scheme, "http", "ws", 1)
+	u.Path = path.Join("/", u.Path, "workers")
+	hdr := http.Header{"Authorization": {"Bearer " + token}, "X-Playground-Worker": {name}}
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, u.String(), hdr)
+	if err != nil {
+		if resp != nil {
+			err = fmt.Errorf("%v: %s", err, resp.Status)
+		}
+		return err
+	}
+	defer conn.Close()
+	log.Printf("worker connected to %s", coordinatorURL)
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	var wmu sync.Mutex
+	send := func(m workerMsg) error {
+		wmu.Lock()
+		defer wmu.Unlock()
+		conn.SetWriteDeadline(time.Now().Add(websocketWriteWait))
+		return conn.WriteJSON(m)
+	}
+	bs := newBlobStore()
+	var mu sync.Mutex
+	jobs := make(map[int64]*executor)
+	defer func() {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, ex := range jobs {
+			ex.Close()
+		}
+	}()
+
+	for {
+		var m workerMsg
+		if err := conn.ReadJSON(&m); err != nil {
+			return err
+		}
+		mu.Lock()
+		ex := jobs[m.Job]
+		mu.Unlock()
+		switch {
+		case m.Action == actionRun && ex == nil:
+			id, conf := m.Job, exConf
+			conf.recordBench = func(_ int64, goVersion string, rs []benchResult) {
+				b, _ := json.Marshal(map[string]interface{}{"go": goVersion, "results": rs})
+				send(workerMsg{Job: id, Action: workerBench, Data: string(b)})
+			}
+			var ex *executor
+			ex = newExecutor(bs, conf, func(action, data string) error {
+				if action == reportProfile {
+					// Send the report itself since the blob is only
+					// in the blob store of the worker.
+					var rp struct{ Name, ID string }
+					json.Unmarshal([]byte(data), &rp)
+					if b := bs.Retrieve(rp.ID); b.data != nil {
+						bb, _ := json.Marshal(map[string]interface{}{"name": rp.Name, "mime": b.mime, "data": b.data})
+						return send(workerMsg{Job: id, Action: workerBlob, Data: string(bb)})
+					}
+					return nil
+				}
+				err := send(workerMsg{Job: id, Action: action, Data: data})
+				if action == statusStopped {
+					mu.Lock()
+					delete(jobs, id)
+					mu.Unlock()
+					go ex.Close() // Close waits for the run to return
+				}
+				return err
+			})
+			mu.Lock()
+			jobs[id] = ex
+			mu.Unlock()
+			ex.SetSnippet(m.Snippet)
+			ex.Start(actionRun, m.Data)
+		case m.Action == actionStop && ex != nil:
+			ex.Interrupt()
+		case m.Action == actionSignal && ex != nil:
+			ex.Signal(m.Data)
+		default:
+			log.Printf("worker: unexpected %s action for job %d", m.Action, m.Job)
+		}
+	}
+}
+
+// checkCoordinatorURL checks that the CoordinatorURL is an HTTP or HTTPS URL.
+func checkCoordinatorURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid coordinator URL: %q", s)
+	}
+	return nil
+}
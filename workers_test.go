@@ -0,0 +1,166 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWorkers(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pg, err := newPlayground(nil, "bolt", tmpDir, execConfig{gc: "go", fmt: "gofmt"}, testLogger{t})
+	if err != nil {
+		t.Fatalf("newPlayground error: %v", err)
+	}
+	defer pg.Close()
+	pg.basePath = "/play"
+	pg.workerToken = "secret"
+	srv := httptest.NewServer(pg)
+	defer srv.Close()
+
+	// Workers must present the token.
+	for _, token := range []string{"", "wrong"} {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := serveWorker(ctx, srv.URL+"/play", token, "worker", execConfig{}, testLogger{t})
+		cancel()
+		if err == nil || !strings.Contains(err.Error(), "401") {
+			t.Errorf("serveWorker with token %q: got error %v, want 401", token, err)
+		}
+	}
+
+	// Start a worker whose runs are distinguishable from local ones.
+	ctx, cancel := context.WithCancel(context.Background())
+	workerDone := make(chan error)
+	go func() {
+		conf := execConfig{gc: "go", fmt: "gofmt", goEnv: []string{"WORKER=remote"}}
+		workerDone <- serveWorker(ctx, srv.URL+"/play/", "secret", "worker", conf, testLogger{t})
+	}()
+	for i := 0; pg.workers.Len() == 0; i++ {
+		if i == 100 {
+			t.Fatal("worker did not connect")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	var mu sync.Mutex
+	var out strings.Builder
+	var reports []string
+	stopped := make(chan string, 1)
+	ex := newExecutor(pg.bs, pg.exConf, func(action, data string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		switch action {
+		case appendStdout, appendStderr, statusUpdate:
+			out.WriteString(data)
+		case reportProfile:
+			reports = append(reports, data)
+		case statusStopped:
+			stopped <- data
+		}
+		return nil
+	})
+	defer ex.Close()
+	run := func(code string) (status exitStatus, output string) {
+		t.Helper()
+		mu.Lock()
+		out.Reset()
+		reports = nil
+		mu.Unlock()
+		ex.Start(actionRun, code)
+		data := <-stopped
+		json.Unmarshal([]byte(data), &status)
+		mu.Lock()
+		defer mu.Unlock()
+		return status, out.String()
+	}
+	const code = `package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	fmt.Println("worker:", os.Getenv("WORKER"))
+	os.Exit(3)
+}`
+
+	// The run happens on the worker and its output is relayed.
+	st, output := run(code)
+	if !strings.Contains(output, "worker: remote") || st.Reason != "exit" || st.ExitCode != 3 {
+		t.Errorf("remote run = (%+v, %q), want exit code 3 with remote output", st, output)
+	}
+
+	// Reports are copied into the blob store of the playground.
+	run("package main\n\n//playground:gctrace\n\nimport \"runtime\"\n\nfunc main() { runtime.GC() }\n")
+	mu.Lock()
+	gotReports := reports
+	mu.Unlock()
+	if len(gotReports) != 1 {
+		t.Fatalf("reports = %q, want one report", gotReports)
+	}
+	var rp struct{ ID string }
+	json.Unmarshal([]byte(gotReports[0]), &rp)
+	resp, err := http.Get(srv.URL + "/play/dynamic/" + rp.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("report %s: status %d, want 200", rp.ID, resp.StatusCode)
+	}
+
+	// Stopping the run stops the program on the worker.
+	ex.Start(actionRun, "package main\n\nimport \"time\"\n\nfunc main() { println(\"sleeping\"); time.Sleep(time.Hour) }\n")
+	for i := 0; ; i++ {
+		mu.Lock()
+		started := strings.Contains(out.String(), "sleeping")
+		mu.Unlock()
+		if started {
+			break
+		}
+		if i == 200 {
+			t.Fatal("program did not start")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	ex.Interrupt()
+	select {
+	case data := <-stopped:
+		json.Unmarshal([]byte(data), &st)
+		if st.Reason != "stopped" && st.Reason != "signal" {
+			t.Errorf("stopped run reason = %q, want stopped", st.Reason)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("run did not stop")
+	}
+
+	// Once the worker disconnects, runs are local again.
+	cancel()
+	<-workerDone
+	for i := 0; pg.workers.Len() > 0; i++ {
+		if i == 100 {
+			t.Fatal("worker did not disconnect")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if st, output := run(code); !strings.Contains(output, "worker: \n") || st.ExitCode != 3 {
+		t.Errorf("local run = (%+v, %q), want exit code 3 with local output", st, output)
+	}
+}
@@ -0,0 +1,529 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// maxUploadSize is the maximum number of bytes of a file uploaded into
+// the workspace.
+const maxUploadSize = 16 << 20
+
+// maxSnapshotSize is the maximum number of bytes of files restored from
+// a workspace snapshot.
+const maxSnapshotSize = 64 << 20
+
+// snapshotSource is the name of the Go source of the editor in a snapshot.
+const snapshotSource = "main.go"
+
+// sourceNames are the files in the workspace that hold the Go source of the
+// editor during a run, which are replaced by the source of every run.
+var sourceNames = map[string]bool{"temp.go": true, "main.go": true, "main_test.go": true}
+
+// handleSnapshot archives the workspace along with the Go source in code
+// into the blobStore and replies with the ID of the snapshot. Unlike reports,
+// snapshots are not deleted when the executor is closed so that they can be
+// restored by a later session.
+func (ex *executor) handleSnapshot(code string) {
+	defer ex.wg.Done()
+	defer ex.sendMsg(statusStopped, "")
+
+	b, n, err := archiveWorkspace(ex.tmpDir, code)
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to snapshot workspace: %v\n", err))
+		return
+	}
+	id, err := ex.bs.Insert(blob{data: b, mime: "application/gzip"}, nil)
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to snapshot workspace: %v\n", err))
+		return
+	}
+	ex.sendMsg(actionSnapshot, id)
+	ex.sendMsg(statusUpdate, fmt.Sprintf("Saved snapshot of %d files.\n", n))
+}
+
+// handleRestore replaces the workspace with the snapshot of the given ID and
+// replies with the format action to replace the Go source in the editor.
+// The restored files are kept across later runs until the next restore.
+func (ex *executor) handleRestore(id string) {
+	defer ex.wg.Done()
+	defer ex.sendMsg(statusStopped, "")
+
+	b := ex.bs.Retrieve(id)
+	if len(b.data) == 0 {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Snapshot not found: %s\n", id))
+		return
+	}
+	fis, _ := ioutil.ReadDir(ex.tmpDir)
+	for _, fi := range fis {
+		os.RemoveAll(filepath.Join(ex.tmpDir, fi.Name()))
+	}
+	ex.keep = nil
+	code, names, err := restoreWorkspace(ex.tmpDir, b.data)
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to restore snapshot: %v\n", err))
+		return
+	}
+	ex.keep = make(map[string]bool)
+	for _, name := range names {
+		ex.keep[strings.SplitN(name, "/", 2)[0]] = true
+	}
+	if code != "" {
+		ex.sendMsg(actionFormat, code)
+	}
+	ex.sendMsg(statusUpdate, fmt.Sprintf("Restored snapshot of %d files.\n", len(names)+1))
+}
+
+// handleDownload archives the workspace along with the Go source in code as
+// a zip file in the blobStore and replies with the ID of the archive, which
+// is served like reports and likewise deleted with them.
+func (ex *executor) handleDownload(code string) {
+	defer ex.wg.Done()
+	defer ex.sendMsg(statusStopped, "")
+
+	b, n, err := zipWorkspace(ex.tmpDir, code)
+	var id string
+	if err == nil {
+		id, err = ex.storeBlob("workspace.zip", blob{data: b, mime: "application/zip"})
+	}
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to download workspace: %v\n", err))
+		return
+	}
+	ex.sendMsg(actionDownload, id)
+	ex.sendMsg(statusUpdate, fmt.Sprintf("Archived %d files for download.\n", n))
+}
+
+// handleUpload writes the file in data, a JSON object with the name and the
+// base64-encoded contents of the file, into the workspace. Like restored
+// files, uploaded files are kept across later runs until the next restore.
+func (ex *executor) handleUpload(data string) {
+	defer ex.wg.Done()
+	defer ex.sendMsg(statusStopped, "")
+
+	var f struct {
+		Name string `json:"name"`
+		Data []byte `json:"data"`
+	}
+	err := json.Unmarshal([]byte(data), &f)
+	switch {
+	case err != nil:
+	case !validFileName(f.Name) || sourceNames[f.Name]:
+		err = fmt.Errorf("invalid file name: %q", f.Name)
+	case len(f.Data) > maxUploadSize:
+		err = fmt.Errorf("file too large: %d bytes", len(f.Data))
+	}
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to upload file: %v\n", err))
+		return
+	}
+	p := filepath.Join(ex.tmpDir, filepath.FromSlash(f.Name))
+	if err := os.MkdirAll(filepath.Dir(p), 0775); err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to upload file: %v\n", err))
+		return
+	}
+	if err := ioutil.WriteFile(p, f.Data, 0664); err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to upload file: %v\n", err))
+		return
+	}
+	if ex.keep == nil {
+		ex.keep = make(map[string]bool)
+	}
+	ex.keep[strings.SplitN(f.Name, "/", 2)[0]] = true
+	ex.sendMsg(statusUpdate, fmt.Sprintf("Uploaded %s (%d bytes).\n", f.Name, len(f.Data)))
+}
+
+// writeSnippetFiles writes the data files attached to the snippet with the
+// given ID to the testdata directory of the workspace and returns their number.
+func (ex *executor) writeSnippetFiles(snippetID int64) (int, bool) {
+	if snippetID == 0 || ex.snippetFiles == nil {
+		return 0, true
+	}
+	files, err := ex.snippetFiles(snippetID)
+	if err != nil {
+		ex.sendMsg(statusUpdate, fmt.Sprintf("Unable to load attached files: %v\n", err))
+		return 0, false
+	}
+	for name, b := range files {
+		p := filepath.Join(ex.tmpDir, "testdata", filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0775); err != nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+			return 0, false
+		}
+		if err := ioutil.WriteFile(p, b, 0664); err != nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+			return 0, false
+		}
+	}
+	return len(files), true
+}
+
+// maxArtifacts is the maximum number of files created by a run of a program
+// that are reported as artifacts.
+const maxArtifacts = 16
+
+// toolOutputs are the files that programs write at the request of the
+// executor (e.g., profiles), which are not reported as artifacts.
+var toolOutputs = map[string]bool{
+	"cpu.prof": true, "mem.prof": true, "block.prof": true, "mutex.prof": true,
+	"trace.out": true, "cover.out": true,
+}
+
+// workspaceFiles returns the set of names of the files in dir
+// that walkWorkspace visits.
+func workspaceFiles(dir string) map[string]bool {
+	names := make(map[string]bool)
+	walkWorkspace(dir, func(name, _ string, fi os.FileInfo) error {
+		if !fi.IsDir() {
+			names[name] = true
+		}
+		return nil
+	})
+	return names
+}
+
+// reportArtifacts reports the files that a program created in the workspace,
+// which are those not in existing, as blobs so that the client may display
+// them (e.g., charts rendered as PNG images). The files remain in the
+// workspace like any other.
+func (ex *executor) reportArtifacts(existing map[string]bool) {
+	var n int
+	walkWorkspace(ex.tmpDir, func(name, p string, fi os.FileInfo) error {
+		switch {
+		case fi.IsDir() || existing[name] || toolOutputs[name] || fi.Size() == 0:
+			return nil
+		case n == maxArtifacts:
+			ex.sendMsg(statusUpdate, fmt.Sprintf("\tDropped report: %s (more than %d artifacts)\n", name, maxArtifacts))
+			return nil
+		case fi.Size() > ex.reportLimit():
+			ex.sendMsg(statusUpdate, fmt.Sprintf("\tDropped report: %s (file too large: %d bytes, limit is %d bytes)\n", name, fi.Size(), ex.reportLimit()))
+			return nil
+		}
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("\tDropped report: %s (unexpected error: %v)\n", name, err))
+			return nil
+		}
+		n++
+		ex.reportBlob(name, artifactMIME(name, b), b)
+		return nil
+	})
+}
+
+// artifactMIME returns the MIME type of an artifact by its extension or,
+// if unknown, by its contents.
+func artifactMIME(name string, b []byte) string {
+	mime := mimeFromPath(name)
+	if mime == "" {
+		mime = http.DetectContentType(b)
+	}
+	return scriptlessMIME(mime)
+}
+
+// archiveWorkspace returns a gzipped tarball of the files in dir along with
+// the Go source in code, and the number of files in it.
+func archiveWorkspace(dir, code string) ([]byte, int, error) {
+	bb := new(bytes.Buffer)
+	zw := gzip.NewWriter(bb)
+	tw := tar.NewWriter(zw)
+	n := 1
+	if err := tw.WriteHeader(&tar.Header{Name: snapshotSource, Mode: 0644, Size: int64(len(code))}); err != nil {
+		return nil, 0, err
+	}
+	if _, err := io.WriteString(tw, code); err != nil {
+		return nil, 0, err
+	}
+	err := walkWorkspace(dir, func(name, p string, fi os.FileInfo) error {
+		if fi.IsDir() {
+			return tw.WriteHeader(&tar.Header{Name: name + "/", Typeflag: tar.TypeDir, Mode: 0755})
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: int64(fi.Mode().Perm()), Size: fi.Size()}); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, f); err != nil {
+			return err
+		}
+		n++
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, 0, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, 0, err
+	}
+	return bb.Bytes(), n, nil
+}
+
+// walkWorkspace calls fn for the directories and regular files in dir with
+// their slash-separated names relative to dir. The sources of the editor are
+// skipped since they are replaced by every run, as are built binaries since
+// they are large and can be rebuilt.
+func walkWorkspace(dir string, fn func(name, p string, fi os.FileInfo) error) error {
+	return filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil || p == dir {
+			return err
+		}
+		name := filepath.ToSlash(strings.TrimPrefix(p, dir+string(filepath.Separator)))
+		switch {
+		case sourceNames[name]:
+			return nil
+		case !fi.IsDir() && (!fi.Mode().IsRegular() || fi.Mode()&0111 != 0):
+			return nil // Skip binaries and special files
+		}
+		return fn(name, p, fi)
+	})
+}
+
+// zipWorkspace returns a zip file of the files in dir along with the Go source
+// in code, and the number of files in it. Unlike a snapshot, the archive is
+// laid out so that it can be built as is: the file tree of the snippet, if
+// any, is unpacked, and the main source is named main_test.go if it has no
+// main function, like when it is run.
+func zipWorkspace(dir, code string) ([]byte, int, error) {
+	main, files, err := splitFiles(code)
+	if err != nil {
+		main, files = code, nil // Keep the source as is
+	}
+	name := "main_test.go"
+	if f, err := parser.ParseFile(token.NewFileSet(), name, main, 0); err != nil || f.Scope.Lookup("main") != nil {
+		name = "main.go"
+	}
+	files = append([]workspaceFile{{name: name, data: main}}, files...)
+
+	bb := new(bytes.Buffer)
+	zw := zip.NewWriter(bb)
+	seen := make(map[string]bool)
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return nil, 0, err
+		}
+		if _, err := io.WriteString(w, f.data); err != nil {
+			return nil, 0, err
+		}
+		seen[f.name] = true
+	}
+	n := len(files)
+	err = walkWorkspace(dir, func(name, p string, fi os.FileInfo) error {
+		if seen[name] || fi.IsDir() {
+			return nil // Directories are implied by the files in them
+		}
+		h, err := zip.FileInfoHeader(fi)
+		if err != nil {
+			return err
+		}
+		h.Name, h.Method = name, zip.Deflate
+		w, err := zw.CreateHeader(h)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if _, err := io.Copy(w, f); err != nil {
+			return err
+		}
+		n++
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, 0, err
+	}
+	return bb.Bytes(), n, nil
+}
+
+// restoreWorkspace extracts the snapshot in b into dir. It returns the Go
+// source of the editor, which is not extracted, and the names of all other
+// extracted files.
+func restoreWorkspace(dir string, b []byte) (code string, names []string, err error) {
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return "", nil, err
+	}
+	tr := tar.NewReader(zr)
+	var size int64
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return code, names, nil
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		name := path.Clean(h.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return "", nil, fmt.Errorf("invalid file name: %q", h.Name)
+		}
+		if size += h.Size; size > maxSnapshotSize {
+			return "", nil, errors.New("snapshot too large")
+		}
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		switch {
+		case h.Typeflag == tar.TypeDir:
+			if err := os.MkdirAll(p, 0755); err != nil {
+				return "", nil, err
+			}
+		case h.Typeflag != tar.TypeReg:
+			return "", nil, fmt.Errorf("invalid file type: %q", h.Name)
+		case name == snapshotSource:
+			b, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return "", nil, err
+			}
+			code = string(b)
+		case sourceNames[name]:
+			// Ignore stale sources, which are replaced by every run.
+		default:
+			if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+				return "", nil, err
+			}
+			f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.FileMode(h.Mode).Perm()|0600)
+			if err != nil {
+				return "", nil, err
+			}
+			_, err = io.Copy(f, tr)
+			if err1 := f.Close(); err == nil {
+				err = err1
+			}
+			if err != nil {
+				return "", nil, err
+			}
+			names = append(names, name)
+		}
+	}
+}
+
+// workspaceFile is a file of a snippet other than its main Go source.
+type workspaceFile struct {
+	name string // Slash-separated path relative to the workspace
+	data string
+}
+
+// splitFiles splits a snippet into the Go source of its main file and the
+// other files of its file tree, if any. Similar to the txtar format, each
+// file after the main one starts with a line of the form "-- name --",
+// where name is the slash-separated path of the file relative to the root
+// of the module (e.g., "go.mod" or "internal/helper/helper.go").
+func splitFiles(code string) (main string, files []workspaceFile, err error) {
+	var cur *workspaceFile
+	var bb strings.Builder
+	flush := func() {
+		if cur == nil {
+			main = bb.String()
+		} else {
+			cur.data = bb.String()
+			files = append(files, *cur)
+		}
+		bb.Reset()
+	}
+	seen := make(map[string]bool)
+	for _, line := range strings.SplitAfter(code, "\n") {
+		if s := strings.TrimRight(line, "\r\n"); strings.HasPrefix(s, "-- ") && strings.HasSuffix(s, " --") && len(s) > 6 {
+			name := strings.TrimSpace(s[3 : len(s)-3])
+			switch {
+			case !validFileName(name):
+				return "", nil, fmt.Errorf("invalid file name: %q", name)
+			case sourceNames[name] || seen[name]:
+				return "", nil, fmt.Errorf("duplicate file name: %q", name)
+			}
+			seen[name] = true
+			flush()
+			cur = &workspaceFile{name: name}
+			continue
+		}
+		bb.WriteString(line)
+	}
+	flush()
+	return main, files, nil
+}
+
+// validFileName reports whether name is a clean slash-separated path
+// within the workspace.
+func validFileName(name string) bool {
+	return name == path.Clean(name) && !path.IsAbs(name) && name != "." && name != ".." && !strings.HasPrefix(name, "../")
+}
+
+// joinFiles is the inverse of splitFiles.
+func joinFiles(main string, files []workspaceFile) string {
+	var bb strings.Builder
+	bb.WriteString(main)
+	for _, f := range files {
+		if bb.Len() > 0 && !strings.HasSuffix(bb.String(), "\n") {
+			bb.WriteByte('\n')
+		}
+		fmt.Fprintf(&bb, "-- %s --\n%s", f.name, f.data)
+	}
+	return bb.String()
+}
+
+// writeFiles writes the files of the file tree of a snippet to tmpDir.
+func (ex *executor) writeFiles(files []workspaceFile) bool {
+	for _, f := range files {
+		if err := os.MkdirAll(filepath.Join(ex.tmpDir, filepath.Dir(filepath.FromSlash(f.name))), 0775); err != nil {
+			ex.sendMsg(statusUpdate, fmt.Sprintf("Unexpected error: %v\n", err))
+			return false
+		}
+		if !ex.writeFile(filepath.FromSlash(f.name), f.data) {
+			return false
+		}
+	}
+	return true
+}
+
+// setupTree prepares the workspace for building a snippet with a file tree as
+// a module. In module mode, go.mod is managed by setupModules. Otherwise,
+// downloads are disabled so that the tree may only import the standard
+// library and its own packages, which are under the module path declared by
+// the go.mod of the tree or "playground" if it has none.
+func (ex *executor) setupTree(files []workspaceFile) bool {
+	if ex.modules {
+		return true
+	}
+	ex.env = append(ex.env, "GO111MODULE=on", "GOPROXY=off")
+	for _, f := range files {
+		if f.name == "go.mod" {
+			return true
+		}
+	}
+	if !ex.writeFile("go.mod", "module playground\n") {
+		return false
+	}
+	bb := new(bytes.Buffer)
+	if !ex.runCommand(bb, ex.gc, "mod", "tidy") { // Adds the go directive
+		ex.reportBadLines(bb.Bytes())
+		return false
+	}
+	return true
+}
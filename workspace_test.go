@@ -0,0 +1,502 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWorkspaceSnapshot(t *testing.T) {
+	bs := newBlobStore()
+	conf := execConfig{gc: "go", fmt: "gofmt", cache: newRunCache(10)}
+
+	// newSession returns an executor along with a function that waits for
+	// the on-going action to stop and returns the messages sent since.
+	newSession := func() (*executor, func() []message) {
+		var mu sync.Mutex
+		var msgs []message
+		stopped := make(chan struct{}, 1)
+		ex := newExecutor(bs, conf, func(action, data string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			msgs = append(msgs, message{action, data})
+			if action == statusStopped {
+				stopped <- struct{}{}
+			}
+			return nil
+		})
+		return ex, func() []message {
+			select {
+			case <-stopped:
+			case <-time.After(time.Minute):
+				t.Fatal("timed out waiting for action to stop")
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			got := msgs
+			msgs = nil
+			return got
+		}
+	}
+	find := func(msgs []message, action string) (string, bool) {
+		for _, m := range msgs {
+			if m.action == action {
+				return m.data, true
+			}
+		}
+		return "", false
+	}
+
+	const writer = `package main
+		import ("io/ioutil"; "os")
+		func main() {
+			os.MkdirAll("testdata", 0755)
+			ioutil.WriteFile("testdata/hello.txt", []byte("hello, snapshot"), 0644)
+		}`
+	ex1, wait1 := newSession()
+	ex1.Start(actionRun, writer)
+	wait1()
+	ex1.Start(actionSnapshot, writer)
+	msgs := wait1()
+	id, ok := find(msgs, actionSnapshot)
+	if !ok {
+		t.Fatalf("no snapshot ID in messages: %q", msgs)
+	}
+
+	// The workspace may also be downloaded as a zip file.
+	ex1.Start(actionDownload, writer)
+	msgs = wait1()
+	id2, ok := find(msgs, actionDownload)
+	if !ok {
+		t.Fatalf("no download ID in messages: %q", msgs)
+	}
+	if b := bs.Retrieve(id2); b.mime != "application/zip" || len(b.data) == 0 {
+		t.Errorf("download blob = %d bytes of %q, want a zip file", len(b.data), b.mime)
+	}
+	ex1.Close()
+	if b := bs.Retrieve(id2); b.data != nil {
+		t.Errorf("download blob not deleted with the executor")
+	}
+
+	// A later session restores the files written by the earlier session,
+	// which are kept across runs.
+	const reader = `package main
+		import ("fmt"; "io/ioutil")
+		func main() {
+			b, err := ioutil.ReadFile("testdata/hello.txt")
+			fmt.Print(string(b), err)
+		}`
+	ex2, wait2 := newSession()
+	defer ex2.Close()
+	ex2.Start(actionRestore, id)
+	msgs = wait2()
+	if code, _ := find(msgs, actionFormat); code != writer {
+		t.Errorf("restored source = %q, want %q", code, writer)
+	}
+	if data, _ := find(msgs, statusUpdate); data != "Restored snapshot of 2 files.\n" {
+		t.Errorf("restore status = %q", data)
+	}
+	for i := 0; i < 2; i++ {
+		ex2.Start(actionRun, reader)
+		msgs = wait2()
+		if out, _ := find(msgs, appendStdout); out != "hello, snapshot<nil>" {
+			t.Errorf("run %d output = %q, want the restored file", i, out)
+		}
+	}
+
+	ex2.Start(actionRestore, "deadbeef")
+	if data, _ := find(wait2(), statusUpdate); data != "Snapshot not found: deadbeef\n" {
+		t.Errorf("restore of unknown snapshot status = %q", data)
+	}
+}
+
+func TestWorkspaceUpload(t *testing.T) {
+	var mu sync.Mutex
+	var status string
+	stopped := make(chan struct{}, 1)
+	ex := newExecutor(newBlobStore(), execConfig{gc: "go", fmt: "gofmt"}, func(action, data string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		switch action {
+		case statusUpdate, appendStdout:
+			status += data
+		case statusStopped:
+			stopped <- struct{}{}
+		}
+		return nil
+	})
+	defer ex.Close()
+	do := func(action, data string) string {
+		t.Helper()
+		ex.Start(action, data)
+		select {
+		case <-stopped:
+		case <-time.After(time.Minute):
+			t.Fatal("timed out waiting for action to stop")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		got := status
+		status = ""
+		return got
+	}
+
+	if got := do(actionUpload, `{"name":"testdata/in.txt","data":"aGVsbG8sIHVwbG9hZA=="}`); got != "Uploaded testdata/in.txt (13 bytes).\n" {
+		t.Errorf("upload status = %q", got)
+	}
+	const reader = `package main
+		import ("fmt"; "io/ioutil")
+		func main() {
+			b, err := ioutil.ReadFile("testdata/in.txt")
+			fmt.Print(string(b), err)
+		}`
+	for i := 0; i < 2; i++ {
+		if got := do(actionRun, reader); !strings.Contains(got, "hello, upload<nil>") {
+			t.Errorf("run %d output = %q, want the uploaded file", i, got)
+		}
+	}
+
+	for _, name := range []string{"../escape.txt", "/etc/passwd", "main.go", "a//b"} {
+		data := `{"name":"` + name + `","data":""}`
+		if got := do(actionUpload, data); !strings.HasPrefix(got, "Unable to upload file: invalid file name") {
+			t.Errorf("upload of %q status = %q, want invalid file name", name, got)
+		}
+	}
+}
+
+func TestSnippetFilesRun(t *testing.T) {
+	var mu sync.Mutex
+	var out string
+	stopped := make(chan struct{}, 1)
+	conf := execConfig{gc: "go", fmt: "gofmt", cache: newRunCache(10)}
+	conf.snippetFiles = func(id int64) (map[string][]byte, error) {
+		if id != 5 {
+			return nil, nil
+		}
+		return map[string][]byte{"golden/want.txt": []byte("hello, testdata")}, nil
+	}
+	ex := newExecutor(newBlobStore(), conf, func(action, data string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		switch action {
+		case statusUpdate, appendStdout, appendStderr:
+			out += data
+		case statusStopped:
+			stopped <- struct{}{}
+		}
+		return nil
+	})
+	defer ex.Close()
+	run := func(code string) string {
+		t.Helper()
+		ex.Start(actionRun, code)
+		select {
+		case <-stopped:
+		case <-time.After(time.Minute):
+			t.Fatal("timed out waiting for run to stop")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		got := out
+		out = ""
+		return got
+	}
+
+	const test = `package main
+		import ("io/ioutil"; "testing")
+		func TestGolden(t *testing.T) {
+			b, err := ioutil.ReadFile("testdata/golden/want.txt")
+			t.Logf("%s %v", b, err)
+		}`
+	ex.SetSnippet(5)
+	for i := 0; i < 2; i++ {
+		if got := run(test); !strings.Contains(got, "hello, testdata <nil>") || strings.Contains(got, "replayed") {
+			t.Errorf("run %d output = %q, want the attached file without caching", i, got)
+		}
+	}
+	ex.SetSnippet(6)
+	if got := run(test); !strings.Contains(got, "no such file") {
+		t.Errorf("run of another snippet output = %q, want no attached file", got)
+	}
+}
+
+func TestArchiveWorkspace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]os.FileMode{
+		"main.go":         0644, // Replaced by the source
+		"main_test.go":    0644,
+		"go.mod":          0644,
+		"gen.go":          0644,
+		"main":            0755, // Binaries are omitted
+		"testdata/in.txt": 0600,
+	}
+	for name, mode := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(p), 0755)
+		if err := ioutil.WriteFile(p, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, n, err := archiveWorkspace(dir, "package main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 4 {
+		t.Errorf("archiveWorkspace reported %d files, want 4", n)
+	}
+
+	out, err := ioutil.TempDir("", "workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(out)
+	code, names, err := restoreWorkspace(out, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != "package main" {
+		t.Errorf("restored code = %q, want %q", code, "package main")
+	}
+	sort.Strings(names)
+	if want := []string{"gen.go", "go.mod", "testdata/in.txt"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("restored names = %q, want %q", names, want)
+	}
+	if got, _ := ioutil.ReadFile(filepath.Join(out, "testdata", "in.txt")); string(got) != "testdata/in.txt" {
+		t.Errorf("restored testdata/in.txt = %q", got)
+	}
+
+	// Snapshots must not write outside of the workspace.
+	bb := new(bytes.Buffer)
+	zw := gzip.NewWriter(bb)
+	tw := tar.NewWriter(zw)
+	tw.WriteHeader(&tar.Header{Name: "../escape.txt", Mode: 0644, Size: 1})
+	tw.Write([]byte("x"))
+	tw.Close()
+	zw.Close()
+	if _, _, err := restoreWorkspace(out, bb.Bytes()); err == nil || !strings.Contains(err.Error(), "invalid file name") {
+		t.Errorf("restoreWorkspace with escaping name: got error %v, want invalid file name", err)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(out), "escape.txt")); err == nil {
+		t.Errorf("restoreWorkspace wrote outside of the workspace")
+	}
+}
+
+func TestZipWorkspace(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]os.FileMode{
+		"main_test.go":    0644, // Replaced by the source
+		"go.mod":          0644, // Replaced by the file tree
+		"cpu.prof":        0644,
+		"main.test":       0755, // Binaries are omitted
+		"testdata/in.txt": 0600,
+	}
+	for name, mode := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(p), 0755)
+		if err := ioutil.WriteFile(p, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const code = "package main\n\nfunc TestMain(t *testing.T) {}\n-- go.mod --\nmodule example.com/x\n"
+	b, n, err := zipWorkspace(dir, code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := ioutil.ReadAll(rc)
+		rc.Close()
+		got[f.Name] = string(b)
+	}
+	want := map[string]string{
+		"main_test.go":    "package main\n\nfunc TestMain(t *testing.T) {}\n",
+		"go.mod":          "module example.com/x\n",
+		"cpu.prof":        "cpu.prof",
+		"testdata/in.txt": "testdata/in.txt",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("zipped files = %q, want %q", got, want)
+	}
+	if n != len(want) {
+		t.Errorf("zipWorkspace reported %d files, want %d", n, len(want))
+	}
+
+	// Programs are named like when they are run.
+	b, _, err = zipWorkspace(dir, "package main\n\nfunc main() {}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err = zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := zr.File[0].Name; name != "main.go" {
+		t.Errorf("name of the source = %q, want main.go", name)
+	}
+}
+
+func TestArtifacts(t *testing.T) {
+	var mu sync.Mutex
+	var status string
+	reports := make(map[string]string)
+	stopped := make(chan struct{}, 1)
+	bs := newBlobStore()
+	ex := newExecutor(bs, execConfig{gc: "go", fmt: "gofmt"}, func(action, data string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		switch action {
+		case statusUpdate, appendStdout, appendStderr:
+			status += data
+		case reportProfile:
+			var r struct{ Name, ID, Mime string }
+			if err := json.Unmarshal([]byte(data), &r); err != nil {
+				t.Errorf("invalid reportProfile: %v", data)
+			}
+			if b := bs.Retrieve(r.ID); b.mime != r.Mime {
+				t.Errorf("MIME type of blob %s = %q, want %q", r.Name, b.mime, r.Mime)
+			}
+			reports[r.Name] = r.Mime
+		case statusStopped:
+			stopped <- struct{}{}
+		}
+		return nil
+	})
+	defer ex.Close()
+	do := func(action, data string) {
+		t.Helper()
+		ex.Start(action, data)
+		select {
+		case <-stopped:
+		case <-time.After(time.Minute):
+			t.Fatal("timed out waiting for action to stop")
+		}
+	}
+
+	do(actionUpload, `{"name":"in.csv","data":"YSxi"}`)
+	do(actionRun, `package main
+		import ("io/ioutil"; "os")
+		func main() {
+			os.Mkdir("out", 0775)
+			ioutil.WriteFile("out/chart.png", []byte("\x89PNG\r\n\x1a\n"), 0664)
+			ioutil.WriteFile("data.json", []byte("{}"), 0664)
+			ioutil.WriteFile("page.html", []byte("<html>"), 0664)
+			ioutil.WriteFile("empty.txt", nil, 0664)
+			ioutil.WriteFile("in.csv", []byte("c,d"), 0664)
+			ioutil.WriteFile("cpu.prof", []byte("x"), 0664)
+		}`)
+	mu.Lock()
+	defer mu.Unlock()
+	want := map[string]string{
+		"out/chart.png": "image/png",
+		"data.json":     "application/json",
+		"page.html":     "text/plain; charset=utf-8",
+	}
+	if !reflect.DeepEqual(reports, want) {
+		t.Errorf("reported artifacts = %v, want %v\noutput:\n%s", reports, want, status)
+	}
+}
+
+func TestArtifactMIME(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"chart.png", "\x89PNG\r\n\x1a\n", "image/png"},
+		{"chart", "\x89PNG\r\n\x1a\n", "image/png"},
+		{"data.json", "{}", "application/json"},
+		{"data.csv", "a,b\n", "text/csv; charset=utf-8"},
+		{"doc.pdf", "%PDF-1.4\n", "application/pdf"},
+		{"notes", "hello", "text/plain; charset=utf-8"},
+		{"page.html", "<p>", "text/plain; charset=utf-8"},
+		{"image.svg", "<svg>", "text/plain; charset=utf-8"},
+	}
+	for _, tt := range tests {
+		if got := artifactMIME(tt.name, []byte(tt.data)); got != tt.want {
+			t.Errorf("artifactMIME(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSplitFiles(t *testing.T) {
+	tests := []struct {
+		in      string
+		main    string
+		files   []workspaceFile
+		wantErr string
+	}{{
+		in:   "package main\n",
+		main: "package main\n",
+	}, {
+		in:   "package main\n-- go.mod --\nmodule m\n-- a/a.go --\npackage a\n\n-- a/b.txt --\n",
+		main: "package main\n",
+		files: []workspaceFile{
+			{name: "go.mod", data: "module m\n"},
+			{name: "a/a.go", data: "package a\n\n"},
+			{name: "a/b.txt", data: ""},
+		},
+	}, {
+		in:   "x := `\n--  --\n-- not a marker\n`",
+		main: "x := `\n--  --\n-- not a marker\n`",
+	}, {
+		in:      "package main\n-- /etc/passwd --\n",
+		wantErr: "invalid file name",
+	}, {
+		in:      "package main\n-- a/../../b --\n",
+		wantErr: "invalid file name",
+	}, {
+		in:      "package main\n-- main.go --\n",
+		wantErr: "duplicate file name",
+	}, {
+		in:      "package main\n-- a.go --\n-- a.go --\n",
+		wantErr: "duplicate file name",
+	}}
+	for _, tt := range tests {
+		main, files, err := splitFiles(tt.in)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("splitFiles(%q) error = %v, want %q", tt.in, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("splitFiles(%q) error: %v", tt.in, err)
+			continue
+		}
+		if main != tt.main || !reflect.DeepEqual(files, tt.files) {
+			t.Errorf("splitFiles(%q) = (%q, %+v), want (%q, %+v)", tt.in, main, files, tt.main, tt.files)
+		}
+		if got := joinFiles(main, files); got != tt.in {
+			t.Errorf("joinFiles(splitFiles(%q)) = %q", tt.in, got)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE.md file.
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+)
+
+// A wsSession is the executor of a websocket client, which outlives the
+// connection so that a client that reconnects after a network failure can
+// resume an on-going run without losing its output.
+//
+// Upon connecting, the server sends a sessionToken message with a token.
+// A reconnecting client passes the token and the number of messages it has
+// received as the "resume" and "seq" query parameters of the websocket URL.
+// The server then replays all messages that the client missed and attaches
+// the new connection to the session. Sessions that are not resumed within
+// sessionResumeTimeout are closed.
+//
+// Other clients may watch the output of a session in read-only mode by
+// its watchID, which unlike the token does not allow controlling the session.
+type wsSession struct {
+	token   string
+	watchID string
+	cid     int64
+	user    string
+	ex      *executor
+
+	mu     sync.Mutex // Protects all fields below
+	send   func(action, data string) error
+	close  func()      // Closes the attached connection; nil if detached
+	gen    int         // Incremented upon every attach
+	seq    int         // Number of messages sent, including buffered ones
+	buf    []cachedMsg // Most recent messages; the last is message number seq
+	size   int         // Total size of the data in buf
+	timer  *time.Timer // Closes the session if it stays detached
+	closed bool
+
+	watchers map[*wsSession]bool // Sessions watching the output of this one
+}
+
+const (
+	// sessionResumeTimeout is how long a session is kept after its
+	// connection is lost.
+	sessionResumeTimeout = 2 * time.Minute
+
+	// maxSessionBuffer is the maximum size of the recent messages kept for
+	// replay. Older output is lost if the client is disconnected for long.
+	maxSessionBuffer = 1 << 20
+)
+
+// watchedActions are the messages that are forwarded to watchers.
+var watchedActions = map[string]bool{
+	clearOutput:   true,
+	markLines:     true,
+	markNotes:     true,
+	statusStarted: true,
+	statusStopped: true,
+	statusUpdate:  true,
+	appendStdout:  true,
+	appendStderr:  true,
+	reportProfile: true,
+}
+
+// sendMsg sends a message to the attached connection, if any, and buffers it
+// for replay. Errors are not reported since the executor should proceed with
+// any on-going run even if the connection is lost.
+func (s *wsSession) sendMsg(action, data string) error {
+	s.mu.Lock()
+	var ws []*wsSession
+	if watchedActions[action] {
+		for w := range s.watchers {
+			ws = append(ws, w)
+		}
+	}
+	s.sendMsgLocked(action, data)
+	s.mu.Unlock()
+
+	// Forwarded messages are not forwarded again to the watchers of w.
+	for _, w := range ws {
+		w.mu.Lock()
+		w.sendMsgLocked(action, data)
+		w.mu.Unlock()
+	}
+	return nil
+}
+
+// sendMsgLocked is sendMsg without forwarding to watchers.
+// The caller must hold s.mu.
+func (s *wsSession) sendMsgLocked(action, data string) {
+	s.seq++
+	s.buf = append(s.buf, cachedMsg{action, data})
+	s.size += len(data)
+	for s.size > maxSessionBuffer && len(s.buf) > 1 {
+		s.size -= len(s.buf[0].data)
+		s.buf = s.buf[1:]
+	}
+	if s.send != nil {
+		s.send(action, data)
+	}
+}
+
+// addWatcher starts forwarding the output of s to w after first replaying
+// the buffered output of s. It reports false if s has been closed.
+func (s *wsSession) addWatcher(w *wsSession) bool {
+	// Hold w.mu as well so that no output is forwarded to w before the
+	// replay completes. Locks are acquired in the order of the client IDs
+	// so that two sessions may watch each other.
+	if s.cid < w.cid {
+		s.mu.Lock()
+		w.mu.Lock()
+	} else {
+		w.mu.Lock()
+		s.mu.Lock()
+	}
+	defer s.mu.Unlock()
+	defer w.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	w.sendMsgLocked(clearOutput, "")
+	for _, m := range s.buf {
+		if watchedActions[m.action] {
+			w.sendMsgLocked(m.action, m.data)
+		}
+	}
+	if s.watchers == nil {
+		s.watchers = make(map[*wsSession]bool)
+	}
+	s.watchers[w] = true
+	return true
+}
+
+// removeWatcher stops forwarding the output of s to w.
+func (s *wsSession) removeWatcher(w *wsSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.watchers, w)
+}
+
+// attach attaches a connection to the session after replaying all messages
+// after message number seq. Any previously attached connection is closed.
+// It returns the generation of the attachment, which is passed to detach,
+// and reports false if the session has already been closed.
+func (s *wsSession) attach(send func(action, data string) error, close func(), seq int) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return 0, false
+	}
+	if s.close != nil {
+		s.close() // Take over from a stale connection
+	}
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	first := s.seq - len(s.buf) // Message number preceding buf[0]
+	if seq > s.seq || seq < 0 {
+		seq = s.seq
+	}
+	if seq < first {
+		seq = first
+	}
+	for _, m := range s.buf[seq-first:] {
+		send(m.action, m.data)
+	}
+	s.send, s.close = send, close
+	s.gen++
+	return s.gen, true
+}
+
+// detach detaches the connection of the given generation from the session
+// and calls expire if the session is not resumed within sessionResumeTimeout.
+// It does nothing if another connection has since been attached.
+func (s *wsSession) detach(gen int, expire func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.gen != gen || s.closed {
+		return
+	}
+	s.send, s.close = nil, nil
+	s.timer = time.AfterFunc(sessionResumeTimeout, func() {
+		// Mark the session closed while locked so that it cannot be
+		// resumed while it is being closed.
+		s.mu.Lock()
+		expired := s.send == nil && !s.closed
+		s.closed = s.closed || expired
+		s.mu.Unlock()
+		if expired {
+			expire()
+		}
+	})
+}
+
+// newSession starts a new session for a websocket client and sends the
+// client its token.
+func (pg *playground) newSession(cid int64, user string) *wsSession {
+	var b [24]byte
+	rand.Read(b[:])
+	s := &wsSession{token: hex.EncodeToString(b[:16]), watchID: hex.EncodeToString(b[16:]), cid: cid, user: user}
+	s.ex = newExecutor(pg.bs, pg.exConf, s.sendMsg)
+	pg.sessionsMu.Lock()
+	pg.sessions[s.token] = s
+	pg.sessionsMu.Unlock()
+	s.sendMsg(sessionToken, s.token)
+	return s
+}
+
+// lookupSession returns the session with the token if it belongs to user.
+func (pg *playground) lookupSession(token, user string) *wsSession {
+	pg.sessionsMu.Lock()
+	defer pg.sessionsMu.Unlock()
+	if s := pg.sessions[token]; s != nil && s.user == user {
+		return s
+	}
+	return nil
+}
+
+// userSessions returns the sessions of user in the order they started.
+func (pg *playground) userSessions(user string) []*wsSession {
+	pg.sessionsMu.Lock()
+	defer pg.sessionsMu.Unlock()
+	var ss []*wsSession
+	for _, s := range pg.sessions {
+		if s.user == user {
+			ss = append(ss, s)
+		}
+	}
+	sort.Slice(ss, func(i, j int) bool { return ss[i].cid < ss[j].cid })
+	return ss
+}
+
+// lookupWatch returns the session with the watch ID.
+func (pg *playground) lookupWatch(watchID string) *wsSession {
+	pg.sessionsMu.Lock()
+	defer pg.sessionsMu.Unlock()
+	for _, s := range pg.sessions {
+		if s.watchID == watchID {
+			return s
+		}
+	}
+	return nil
+}
+
+// closeSession closes the session and its executor.
+// Any watchers are informed that the session ended.
+func (pg *playground) closeSession(s *wsSession) {
+	pg.sessionsMu.Lock()
+	delete(pg.sessions, s.token)
+	pg.sessionsMu.Unlock()
+
+	s.mu.Lock()
+	s.closed = true
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	ws := s.watchers
+	s.watchers = nil
+	s.mu.Unlock()
+	s.ex.Close()
+	for w := range ws {
+		w.sendMsg(statusUpdate, "Watched session ended.\n")
+	}
+}
+
+// closeSessions closes all sessions.
+func (pg *playground) closeSessions() {
+	pg.sessionsMu.Lock()
+	var ss []*wsSession
+	for _, s := range pg.sessions {
+		ss = append(ss, s)
+	}
+	pg.sessionsMu.Unlock()
+	for _, s := range ss {
+		pg.closeSession(s)
+	}
+}